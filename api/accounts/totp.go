@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accounts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// TotpEnrollment is an unconfirmed TOTP enrollment of a password account.
+type TotpEnrollment struct {
+	AccountId string `json:"account_id,omitempty"`
+	// Secret is the base32 encoded TOTP secret.
+	Secret string `json:"secret,omitempty"`
+	// Url is the otpauth:// URL of the TOTP secret, which authenticator apps
+	// accept directly or as a QR code.
+	Url string `json:"url,omitempty"`
+}
+
+type TotpEnrollResult struct {
+	Item     *TotpEnrollment
+	response *api.Response
+}
+
+func (n TotpEnrollResult) GetItem() *TotpEnrollment {
+	return n.Item
+}
+
+func (n TotpEnrollResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type TotpConfirmResult struct {
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+	response      *api.Response
+}
+
+func (n TotpConfirmResult) GetRecoveryCodes() []string {
+	return n.RecoveryCodes
+}
+
+func (n TotpConfirmResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type TotpRemoveResult struct {
+	response *api.Response
+}
+
+func (n TotpRemoveResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// EnrollTotp creates a TOTP enrollment for the password account, replacing
+// any unconfirmed enrollment.  The enrollment must be confirmed with
+// ConfirmTotp before it is used to authenticate.
+func (c *Client) EnrollTotp(ctx context.Context, accountId string, opt ...Option) (*TotpEnrollResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into EnrollTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in EnrollTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:enroll-totp", accountId), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EnrollTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during EnrollTotp call: %w", err)
+	}
+
+	target := new(TotpEnrollResult)
+	target.Item = new(TotpEnrollment)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EnrollTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// ConfirmTotp confirms the TOTP enrollment of the password account with a
+// TOTP code.  The returned recovery codes are not retrievable afterwards.
+func (c *Client) ConfirmTotp(ctx context.Context, accountId, code string, opt ...Option) (*TotpConfirmResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into ConfirmTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in ConfirmTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]any{
+		"code": code,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:confirm-totp", accountId), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ConfirmTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ConfirmTotp call: %w", err)
+	}
+
+	target := new(TotpConfirmResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ConfirmTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RemoveTotp removes the TOTP enrollment of the password account, if any.
+func (c *Client) RemoveTotp(ctx context.Context, accountId string, opt ...Option) (*TotpRemoveResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into RemoveTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in RemoveTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:remove-totp", accountId), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RemoveTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RemoveTotp call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RemoveTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &TotpRemoveResult{response: resp}, nil
+}
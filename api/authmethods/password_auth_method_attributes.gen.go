@@ -11,8 +11,15 @@ import (
 )
 
 type PasswordAuthMethodAttributes struct {
-	MinLoginNameLength uint32 `json:"min_login_name_length,omitempty"`
-	MinPasswordLength  uint32 `json:"min_password_length,omitempty"`
+	MinLoginNameLength          uint32 `json:"min_login_name_length,omitempty"`
+	MinPasswordLength           uint32 `json:"min_password_length,omitempty"`
+	TotpRequired                bool   `json:"totp_required,omitempty"`
+	MinPasswordCharacterClasses uint32 `json:"min_password_character_classes,omitempty"`
+	DenyCommonPasswords         bool   `json:"deny_common_passwords,omitempty"`
+	PasswordHistoryCount        uint32 `json:"password_history_count,omitempty"`
+	MaxPasswordAgeDays          uint32 `json:"max_password_age_days,omitempty"`
+	LockoutThreshold            uint32 `json:"lockout_threshold,omitempty"`
+	LockoutDurationSeconds      uint32 `json:"lockout_duration_seconds,omitempty"`
 }
 
 func AttributesMapToPasswordAuthMethodAttributes(in map[string]interface{}) (*PasswordAuthMethodAttributes, error) {
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authmethods
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// ScimToken is the token an identity provider presents to the controller's
+// SCIM API to provision the users, groups and accounts of an auth method.
+type ScimToken struct {
+	Id           string    `json:"id,omitempty"`
+	AuthMethodId string    `json:"auth_method_id,omitempty"`
+	Token        string    `json:"token,omitempty"`
+	CreatedTime  time.Time `json:"created_time,omitempty"`
+}
+
+type ScimTokenCreateResult struct {
+	Item     *ScimToken
+	response *api.Response
+}
+
+func (n ScimTokenCreateResult) GetItem() *ScimToken {
+	return n.Item
+}
+
+func (n ScimTokenCreateResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type ScimTokenDeleteResult struct {
+	response *api.Response
+}
+
+func (n ScimTokenDeleteResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// CreateScimToken creates the SCIM token of the auth method, revoking its
+// previous SCIM token if it has one.  The returned token's value is not
+// retrievable afterwards.
+func (c *Client) CreateScimToken(ctx context.Context, authMethodId string, opt ...Option) (*ScimTokenCreateResult, error) {
+	if authMethodId == "" {
+		return nil, fmt.Errorf("empty authMethodId value passed into CreateScimToken request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in CreateScimToken request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("auth-methods/%s:create-scim-token", authMethodId), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CreateScimToken request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during CreateScimToken call: %w", err)
+	}
+
+	target := new(ScimTokenCreateResult)
+	target.Item = new(ScimToken)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CreateScimToken response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// DeleteScimToken revokes the SCIM token of the auth method.
+func (c *Client) DeleteScimToken(ctx context.Context, authMethodId string, opt ...Option) (*ScimTokenDeleteResult, error) {
+	if authMethodId == "" {
+		return nil, fmt.Errorf("empty authMethodId value passed into DeleteScimToken request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in DeleteScimToken request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("auth-methods/%s:delete-scim-token", authMethodId), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DeleteScimToken request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during DeleteScimToken call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding DeleteScimToken response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &ScimTokenDeleteResult{response: resp}, nil
+}
@@ -7,6 +7,7 @@ package authtokens
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/api"
 )
@@ -80,3 +81,51 @@ func WithRecursive(recurse bool) Option {
 		o.withRecursive = true
 	}
 }
+
+func WithDescription(inDescription string) Option {
+	return func(o *options) {
+		o.postMap["description"] = inDescription
+	}
+}
+
+func DefaultDescription() Option {
+	return func(o *options) {
+		o.postMap["description"] = nil
+	}
+}
+
+func WithExpirationTime(inExpirationTime time.Time) Option {
+	return func(o *options) {
+		o.postMap["expiration_time"] = inExpirationTime
+	}
+}
+
+func DefaultExpirationTime() Option {
+	return func(o *options) {
+		o.postMap["expiration_time"] = nil
+	}
+}
+
+func WithGrantStrings(inGrantStrings []string) Option {
+	return func(o *options) {
+		o.postMap["grant_strings"] = inGrantStrings
+	}
+}
+
+func DefaultGrantStrings() Option {
+	return func(o *options) {
+		o.postMap["grant_strings"] = nil
+	}
+}
+
+func WithName(inName string) Option {
+	return func(o *options) {
+		o.postMap["name"] = inName
+	}
+}
+
+func DefaultName() Option {
+	return func(o *options) {
+		o.postMap["name"] = nil
+	}
+}
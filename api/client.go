@@ -46,6 +46,8 @@ const (
 	EnvBoundarySRVLookup     = "BOUNDARY_SRV_LOOKUP"
 
 	AsciiCastMimeType = "application/x-asciicast"
+	PcapngMimeType    = "application/x-pcapng"
+	TextMimeType      = "text/plain"
 	StreamChunkSize   = 1024 * 64 // stream chuck buffer size
 )
 
@@ -109,6 +111,18 @@ type Config struct {
 
 	// SRVLookup enables the client to lookup the host through DNS SRV lookup
 	SRVLookup bool
+
+	// TokenRefreshInterval, if non-zero, causes the client to refresh its auth
+	// token before making a request once this much time has passed since the
+	// token was set or last refreshed. Refreshing replaces the token value and
+	// resets the time the token becomes stale, so the interval should be
+	// shorter than the controller's auth_token_time_to_stale. If the refresh
+	// fails the request is made with the current token.
+	TokenRefreshInterval time.Duration
+
+	// TokenRefreshFunc, if set, is called with the new token value each time
+	// the client refreshes its auth token, e.g. to store it.
+	TokenRefreshFunc func(token string)
 }
 
 // TLSConfig contains the parameters needed to configure TLS on the HTTP client
@@ -382,6 +396,26 @@ func parseRateLimit(val string) (rate float64, burst int, err error) {
 type Client struct {
 	modifyLock sync.RWMutex
 	config     *Config
+
+	tokenRefresh *tokenRefreshState
+}
+
+// tokenRefreshState is shared by a client and its clones, since refreshing the
+// token invalidates the previous token value for all of them.
+type tokenRefreshState struct {
+	// lock ensures only one request refreshes the token at a time.
+	lock sync.Mutex
+	// refreshedTime is when the token was set or last refreshed.
+	refreshedTime time.Time
+	// refreshed is the last value a token was refreshed to. Refreshing keeps
+	// the token's id, so any other value with the same id is no longer valid.
+	refreshed string
+}
+
+func newTokenRefreshState() *tokenRefreshState {
+	return &tokenRefreshState{
+		refreshedTime: time.Now(),
+	}
 }
 
 // NewClient returns a new client for the given configuration.
@@ -426,7 +460,8 @@ func NewClient(c *Config) (*Client, error) {
 	}
 
 	return &Client{
-		config: c,
+		config:       c,
+		tokenRefresh: newTokenRefreshState(),
 	}, nil
 }
 
@@ -517,6 +552,38 @@ func (c *Client) SetToken(token string) {
 	defer c.modifyLock.Unlock()
 
 	c.config.Token = token
+
+	c.tokenRefresh.lock.Lock()
+	c.tokenRefresh.refreshedTime = time.Now()
+	c.tokenRefresh.lock.Unlock()
+}
+
+// SetTokenRefreshInterval sets the interval after which the client refreshes
+// its auth token before making a request. A zero interval disables refreshing.
+func (c *Client) SetTokenRefreshInterval(interval time.Duration) {
+	c.modifyLock.Lock()
+	defer c.modifyLock.Unlock()
+
+	c.config.TokenRefreshInterval = interval
+}
+
+// SetTokenRefreshFunc sets the function called with the new token value each
+// time the client refreshes its auth token.
+func (c *Client) SetTokenRefreshFunc(fn func(token string)) {
+	c.modifyLock.Lock()
+	defer c.modifyLock.Unlock()
+
+	c.config.TokenRefreshFunc = fn
+}
+
+// SetTokenRefreshedTime sets when the auth token was last refreshed, for
+// tokens which were stored and not just obtained, so they are refreshed on
+// time.
+func (c *Client) SetTokenRefreshedTime(t time.Time) {
+	c.tokenRefresh.lock.Lock()
+	defer c.tokenRefresh.lock.Unlock()
+
+	c.tokenRefresh.refreshedTime = t
 }
 
 // RecoveryKmsWrapper gets the configured recovery KMS wrapper.
@@ -562,18 +629,20 @@ func (c *Client) Clone() *Client {
 	config := c.config
 
 	newConfig := &Config{
-		Addr:               config.Addr,
-		Token:              config.Token,
-		RecoveryKmsWrapper: config.RecoveryKmsWrapper,
-		HttpClient:         config.HttpClient,
-		Headers:            make(http.Header),
-		MaxRetries:         config.MaxRetries,
-		Timeout:            config.Timeout,
-		Backoff:            config.Backoff,
-		CheckRetry:         config.CheckRetry,
-		Limiter:            config.Limiter,
-		OutputCurlString:   config.OutputCurlString,
-		SRVLookup:          config.SRVLookup,
+		Addr:                 config.Addr,
+		Token:                config.Token,
+		RecoveryKmsWrapper:   config.RecoveryKmsWrapper,
+		HttpClient:           config.HttpClient,
+		Headers:              make(http.Header),
+		MaxRetries:           config.MaxRetries,
+		Timeout:              config.Timeout,
+		Backoff:              config.Backoff,
+		CheckRetry:           config.CheckRetry,
+		Limiter:              config.Limiter,
+		OutputCurlString:     config.OutputCurlString,
+		SRVLookup:            config.SRVLookup,
+		TokenRefreshInterval: config.TokenRefreshInterval,
+		TokenRefreshFunc:     config.TokenRefreshFunc,
 	}
 	if config.TLSConfig != nil {
 		newConfig.TLSConfig = new(TLSConfig)
@@ -585,7 +654,7 @@ func (c *Client) Clone() *Client {
 		newConfig.Headers[k] = vSlice
 	}
 
-	return &Client{config: newConfig}
+	return &Client{config: newConfig, tokenRefresh: c.tokenRefresh}
 }
 
 func copyHeaders(in http.Header) http.Header {
@@ -711,10 +780,21 @@ func (c *Client) Do(r *retryablehttp.Request, opt ...Option) (*Response, error)
 	token := c.config.Token
 	recoveryKmsWrapper := c.config.RecoveryKmsWrapper
 	outputCurlString := c.config.OutputCurlString && !opts.withSkipCurlOuptut
+	tokenRefreshInterval := c.config.TokenRefreshInterval
 	c.modifyLock.RUnlock()
 
 	ctx := r.Context()
 
+	if tokenRefreshInterval > 0 && recoveryKmsWrapper == nil && !outputCurlString && !opts.withSkipTokenRefresh {
+		// Only swap the token if the request uses the client's token and
+		// not one set on the request by the caller.
+		usesClientToken := r.Header.Get("authorization") == "Bearer "+token
+		token = c.refreshToken(ctx, token)
+		if usesClientToken {
+			r.Header.Set("authorization", "Bearer "+token)
+		}
+	}
+
 	if limiter != nil {
 		if err := limiter.Wait(ctx); err != nil {
 			return nil, fmt.Errorf("error waiting on rate limiter: %w", err)
@@ -823,3 +903,84 @@ func (c *Client) Do(r *retryablehttp.Request, opt ...Option) (*Response, error)
 
 	return &Response{resp: result}, nil
 }
+
+// authTokenId returns the public id of an auth token. Auth tokens have the
+// form <public id prefix>_<public id>_<value>.
+func authTokenId(token string) (string, bool) {
+	split := strings.Split(token, "_")
+	if len(split) != 3 {
+		return "", false
+	}
+	return strings.Join(split[0:2], "_"), true
+}
+
+// refreshToken refreshes the given auth token if the token refresh interval
+// has passed since it was set or last refreshed, and returns the token to use
+// for requests. Errors are not returned, since the current token may still be
+// valid; refreshing is attempted again after the next interval.
+func (c *Client) refreshToken(ctx context.Context, token string) string {
+	state := c.tokenRefresh
+	state.lock.Lock()
+	defer state.lock.Unlock()
+
+	tokenId, ok := authTokenId(token)
+	if !ok {
+		return token
+	}
+
+	// The token may have been refreshed by another request or a clone of this
+	// client, in which case the previous value is no longer valid.
+	if refreshedId, _ := authTokenId(state.refreshed); refreshedId == tokenId && state.refreshed != token {
+		c.modifyLock.Lock()
+		c.config.Token = state.refreshed
+		c.modifyLock.Unlock()
+		return state.refreshed
+	}
+
+	c.modifyLock.RLock()
+	interval := c.config.TokenRefreshInterval
+	refreshFunc := c.config.TokenRefreshFunc
+	c.modifyLock.RUnlock()
+	if time.Since(state.refreshedTime) < interval {
+		return token
+	}
+
+	newToken, err := func() (string, error) {
+		req, err := c.NewRequest(ctx, "POST", fmt.Sprintf("auth-tokens/%s:refresh", tokenId), map[string]any{})
+		if err != nil {
+			return "", err
+		}
+		resp, err := c.Do(req, withSkipTokenRefresh())
+		if err != nil {
+			return "", err
+		}
+		var item struct {
+			Token string `json:"token"`
+		}
+		apiErr, err := resp.Decode(&item)
+		if err != nil {
+			return "", err
+		}
+		if apiErr != nil {
+			return "", apiErr
+		}
+		if item.Token == "" {
+			return "", errors.New("empty token in refresh response")
+		}
+		return item.Token, nil
+	}()
+	state.refreshedTime = time.Now()
+	if err != nil {
+		return token
+	}
+	state.refreshed = newToken
+
+	c.modifyLock.Lock()
+	c.config.Token = newToken
+	c.modifyLock.Unlock()
+
+	if refreshFunc != nil {
+		refreshFunc(newToken)
+	}
+	return newToken
+}
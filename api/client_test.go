@@ -4,9 +4,14 @@
 package api
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConfigSetAddress(t *testing.T) {
@@ -79,3 +84,140 @@ func TestConfigSetAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestClientTokenRefresh(t *testing.T) {
+	const (
+		oldToken = "at_1234567890_oldvalue"
+		newToken = "at_1234567890_newvalue"
+	)
+	tests := []struct {
+		name          string
+		refreshStatus int
+		refreshedAgo  time.Duration
+		wantRefreshes int
+		wantToken     string
+	}{
+		{
+			name:          "not due",
+			refreshStatus: http.StatusOK,
+			refreshedAgo:  time.Minute,
+			wantToken:     oldToken,
+		},
+		{
+			name:          "refreshed",
+			refreshStatus: http.StatusOK,
+			refreshedAgo:  2 * time.Hour,
+			wantRefreshes: 1,
+			wantToken:     newToken,
+		},
+		{
+			name:          "refresh failed",
+			refreshStatus: http.StatusForbidden,
+			refreshedAgo:  2 * time.Hour,
+			wantRefreshes: 1,
+			wantToken:     oldToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			var refreshes int
+			var gotTokens []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/v1/auth-tokens/at_1234567890:refresh" {
+					refreshes++
+					assert.Equal("Bearer "+oldToken, r.Header.Get("authorization"))
+					w.WriteHeader(tt.refreshStatus)
+					if tt.refreshStatus == http.StatusOK {
+						_, _ = w.Write([]byte(`{"id":"at_1234567890","token":"` + newToken + `"}`))
+						return
+					}
+					_, _ = w.Write([]byte(`{"kind":"PermissionDenied"}`))
+					return
+				}
+				gotTokens = append(gotTokens, r.Header.Get("authorization"))
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer srv.Close()
+
+			var refreshedTokens []string
+			client, err := NewClient(&Config{
+				Addr:                 srv.URL,
+				TokenRefreshInterval: time.Hour,
+				TokenRefreshFunc: func(token string) {
+					refreshedTokens = append(refreshedTokens, token)
+				},
+			})
+			require.NoError(err)
+			client.SetToken(oldToken)
+			client.SetTokenRefreshedTime(time.Now().Add(-tt.refreshedAgo))
+
+			// A request by a clone after the client refreshed the token must
+			// use the new token and not refresh it again.
+			clone := client.Clone()
+			for _, c := range []*Client{client, clone} {
+				req, err := c.NewRequest(context.Background(), "GET", "scopes", nil)
+				require.NoError(err)
+				_, err = c.Do(req)
+				require.NoError(err)
+			}
+
+			assert.Equal(tt.wantRefreshes, refreshes)
+			assert.Equal(tt.wantToken, client.Token())
+			assert.Equal(tt.wantToken, clone.Token())
+			assert.Equal([]string{"Bearer " + tt.wantToken, "Bearer " + tt.wantToken}, gotTokens)
+			if tt.wantToken == newToken {
+				assert.Equal([]string{newToken}, refreshedTokens)
+			} else {
+				assert.Empty(refreshedTokens)
+			}
+		})
+	}
+}
+
+func TestClientTokenRefresh_Superseded(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	// Each refresh replaces the token value with the next one in values.
+	values := []string{"at_1234567890_first", "at_1234567890_second", "at_1234567890_third"}
+	var refreshes int
+	var gotTokens []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/auth-tokens/at_1234567890:refresh" {
+			assert.Equal("Bearer "+values[refreshes], r.Header.Get("authorization"))
+			refreshes++
+			_, _ = w.Write([]byte(`{"id":"at_1234567890","token":"` + values[refreshes] + `"}`))
+			return
+		}
+		gotTokens = append(gotTokens, r.Header.Get("authorization"))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(&Config{
+		Addr:                 srv.URL,
+		TokenRefreshInterval: time.Hour,
+	})
+	require.NoError(err)
+	client.SetToken(values[0])
+	clone := client.Clone()
+
+	do := func(c *Client) {
+		req, err := c.NewRequest(context.Background(), "GET", "scopes", nil)
+		require.NoError(err)
+		_, err = c.Do(req)
+		require.NoError(err)
+	}
+	for range values[1:] {
+		client.SetTokenRefreshedTime(time.Now().Add(-2 * time.Hour))
+		do(client)
+	}
+	assert.Equal(values[2], client.tokenRefresh.refreshed)
+
+	// The clone still holds the first value, which was superseded twice.
+	do(clone)
+	assert.Equal(2, refreshes)
+	assert.Equal(values[2], clone.Token())
+	assert.Equal([]string{"Bearer " + values[1], "Bearer " + values[2], "Bearer " + values[2]}, gotTokens)
+}
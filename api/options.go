@@ -16,7 +16,8 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withSkipCurlOuptut bool
+	withSkipCurlOuptut   bool
+	withSkipTokenRefresh bool
 }
 
 func getDefaultOptions() options {
@@ -30,3 +31,11 @@ func WithSkipCurlOutput(skip bool) Option {
 		o.withSkipCurlOuptut = true
 	}
 }
+
+// withSkipTokenRefresh tells the client to not refresh its token before the
+// current call, which is used for the refresh call itself.
+func withSkipTokenRefresh() Option {
+	return func(o *options) {
+		o.withSkipTokenRefresh = true
+	}
+}
@@ -17,9 +17,19 @@ import (
 // Download will of course download the request session recording resource.
 // Currently it always requests a mime-type of asciicast.
 func (c *Client) Download(ctx context.Context, contentId string, opt ...Option) (io.ReadCloser, error) {
+	return c.DownloadAs(ctx, contentId, api.AsciiCastMimeType, opt...)
+}
+
+// DownloadAs downloads the requested session recording resource in the format
+// of the given mime-type, such as api.PcapngMimeType for the connection
+// recordings of tcp sessions or api.TextMimeType for a transcript of a
+// channel recording.
+func (c *Client) DownloadAs(ctx context.Context, contentId string, mimeType string, opt ...Option) (io.ReadCloser, error) {
 	switch {
 	case contentId == "":
 		return nil, fmt.Errorf("empty content id value passed into download request")
+	case mimeType == "":
+		return nil, fmt.Errorf("empty mime type value passed into download request")
 	case c.client == nil:
 		return nil, fmt.Errorf("nil client")
 	}
@@ -30,8 +40,8 @@ func (c *Client) Download(ctx context.Context, contentId string, opt ...Option)
 	if err != nil {
 		return nil, fmt.Errorf("error creating download request: %w", err)
 	}
-	opts.queryMap["mime_type"] = api.AsciiCastMimeType
-	req.Header.Set("Accept", api.AsciiCastMimeType)
+	opts.queryMap["mime_type"] = mimeType
+	req.Header.Set("Accept", mimeType)
 
 	if len(opts.queryMap) > 0 {
 		q := url.Values{}
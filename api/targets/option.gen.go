@@ -127,6 +127,30 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithTcpTargetBandwidthLimit(inBandwidthLimit uint64) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bandwidth_limit"] = inBandwidthLimit
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetBandwidthLimit() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bandwidth_limit"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithBrokeredCredentialSourceIds(inBrokeredCredentialSourceIds []string) Option {
 	return func(o *options) {
 		o.postMap["brokered_credential_source_ids"] = inBrokeredCredentialSourceIds
@@ -139,6 +163,78 @@ func DefaultBrokeredCredentialSourceIds() Option {
 	}
 }
 
+func WithHttpTargetDefaultClientPort(inDefaultClientPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_client_port"] = inDefaultClientPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetDefaultClientPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_client_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithKubernetesTargetDefaultClientPort(inDefaultClientPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_client_port"] = inDefaultClientPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultKubernetesTargetDefaultClientPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_client_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPostgresTargetDefaultClientPort(inDefaultClientPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_client_port"] = inDefaultClientPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetDefaultClientPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_client_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshTargetDefaultClientPort(inDefaultClientPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -187,6 +283,102 @@ func DefaultTcpTargetDefaultClientPort() Option {
 	}
 }
 
+func WithUdpTargetDefaultClientPort(inDefaultClientPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_client_port"] = inDefaultClientPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultUdpTargetDefaultClientPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_client_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithHttpTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithKubernetesTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultKubernetesTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPostgresTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshTargetDefaultPort(inDefaultPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -235,6 +427,30 @@ func DefaultTcpTargetDefaultPort() Option {
 	}
 }
 
+func WithUdpTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultUdpTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -271,12 +487,48 @@ func WithSshTargetEnableSessionRecording(inEnableSessionRecording bool) Option {
 	}
 }
 
+func WithHttpTargetEnableTls(inEnableTls bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["enable_tls"] = inEnableTls
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithHostId(inHostId string) Option {
 	return func(o *options) {
 		o.postMap["host_id"] = inHostId
 	}
 }
 
+func WithTcpTargetIdleTimeoutSeconds(inIdleTimeoutSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idle_timeout_seconds"] = inIdleTimeoutSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetIdleTimeoutSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idle_timeout_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithIngressWorkerFilter(inIngressWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["ingress_worker_filter"] = inIngressWorkerFilter
@@ -301,6 +553,30 @@ func DefaultInjectedApplicationCredentialSourceIds() Option {
 	}
 }
 
+func WithSshTargetKnownHostKeys(inKnownHostKeys string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["known_host_keys"] = inKnownHostKeys
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetKnownHostKeys() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["known_host_keys"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -313,6 +589,54 @@ func DefaultName() Option {
 	}
 }
 
+func WithSshTargetRecordingCompression(inRecordingCompression string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["recording_compression"] = inRecordingCompression
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetRecordingCompression() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["recording_compression"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithSshTargetRecordingEncryption(inRecordingEncryption string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["recording_encryption"] = inRecordingEncryption
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetRecordingEncryption() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["recording_encryption"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithScopeId(inScopeId string) Option {
 	return func(o *options) {
 		o.postMap["scope_id"] = inScopeId
@@ -325,6 +649,30 @@ func WithScopeName(inScopeName string) Option {
 	}
 }
 
+func WithTcpTargetSessionByteQuota(inSessionByteQuota uint64) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_byte_quota"] = inSessionByteQuota
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetSessionByteQuota() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_byte_quota"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSessionConnectionLimit(inSessionConnectionLimit int32) Option {
 	return func(o *options) {
 		o.postMap["session_connection_limit"] = inSessionConnectionLimit
@@ -373,6 +721,198 @@ func DefaultSshTargetStorageBucketId() Option {
 	}
 }
 
+func WithTcpTargetTerminateTls(inTerminateTls bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["terminate_tls"] = inTerminateTls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetTerminateTls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["terminate_tls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithHttpTargetTlsCaCertificates(inTlsCaCertificates string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_ca_certificates"] = inTlsCaCertificates
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetTlsCaCertificates() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_ca_certificates"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithKubernetesTargetTlsCaCertificates(inTlsCaCertificates string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_ca_certificates"] = inTlsCaCertificates
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultKubernetesTargetTlsCaCertificates() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_ca_certificates"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTcpTargetTlsCaCertificates(inTlsCaCertificates string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_ca_certificates"] = inTlsCaCertificates
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetTlsCaCertificates() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_ca_certificates"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTcpTargetTlsMinVersion(inTlsMinVersion string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_min_version"] = inTlsMinVersion
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetTlsMinVersion() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_min_version"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithHttpTargetTlsServerName(inTlsServerName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_server_name"] = inTlsServerName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetTlsServerName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_server_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithKubernetesTargetTlsServerName(inTlsServerName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_server_name"] = inTlsServerName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultKubernetesTargetTlsServerName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_server_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTcpTargetTlsServerName(inTlsServerName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_server_name"] = inTlsServerName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetTlsServerName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tls_server_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["worker_filter"] = inWorkerFilter
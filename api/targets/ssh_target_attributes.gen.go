@@ -15,6 +15,9 @@ type SshTargetAttributes struct {
 	DefaultClientPort      uint32 `json:"default_client_port,omitempty"`
 	StorageBucketId        string `json:"storage_bucket_id,omitempty"`
 	EnableSessionRecording bool   `json:"enable_session_recording,omitempty"`
+	KnownHostKeys          string `json:"known_host_keys,omitempty"`
+	RecordingCompression   string `json:"recording_compression,omitempty"`
+	RecordingEncryption    string `json:"recording_encryption,omitempty"`
 }
 
 func AttributesMapToSshTargetAttributes(in map[string]interface{}) (*SshTargetAttributes, error) {
@@ -11,8 +11,15 @@ import (
 )
 
 type TcpTargetAttributes struct {
-	DefaultPort       uint32 `json:"default_port,omitempty"`
-	DefaultClientPort uint32 `json:"default_client_port,omitempty"`
+	DefaultPort        uint32 `json:"default_port,omitempty"`
+	DefaultClientPort  uint32 `json:"default_client_port,omitempty"`
+	BandwidthLimit     uint64 `json:"bandwidth_limit,omitempty"`
+	SessionByteQuota   uint64 `json:"session_byte_quota,omitempty"`
+	TerminateTls       bool   `json:"terminate_tls,omitempty"`
+	TlsServerName      string `json:"tls_server_name,omitempty"`
+	TlsCaCertificates  string `json:"tls_ca_certificates,omitempty"`
+	TlsMinVersion      string `json:"tls_min_version,omitempty"`
+	IdleTimeoutSeconds uint32 `json:"idle_timeout_seconds,omitempty"`
 }
 
 func AttributesMapToTcpTargetAttributes(in map[string]interface{}) (*TcpTargetAttributes, error) {
@@ -23,6 +23,7 @@ const (
 	AuthorizedCollectionActionsField            = "authorized_collection_actions"
 	ExpirationTimeField                         = "expiration_time"
 	ApproximateLastUsedTimeField                = "approximate_last_used_time"
+	ApiTokenField                               = "api_token"
 	MembersField                                = "members"
 	MemberIdsField                              = "member_ids"
 	HostCatalogIdField                          = "host_catalog_id"
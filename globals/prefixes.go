@@ -108,6 +108,14 @@ const (
 	TcpTargetPrefix = "ttcp"
 	// SshTargetPrefix is the prefix for TCP targets
 	SshTargetPrefix = "tssh"
+	// HttpTargetPrefix is the prefix for HTTP targets
+	HttpTargetPrefix = "thttp"
+	// PostgresTargetPrefix is the prefix for PostgreSQL targets
+	PostgresTargetPrefix = "tpostgres"
+	// UdpTargetPrefix is the prefix for UDP targets
+	UdpTargetPrefix = "tudp"
+	// KubernetesTargetPrefix is the prefix for Kubernetes targets
+	KubernetesTargetPrefix = "tkube"
 
 	// WorkerPrefix is the prefix for workers
 	WorkerPrefix = "w"
@@ -121,6 +129,9 @@ const (
 	ConnectionRecordingPrefix = "cr"
 	// ChannelRecordingPrefix is the prefix for channel recordings
 	ChannelRecordingPrefix = "chr"
+
+	// ScimTokenPrefix is the prefix for SCIM tokens
+	ScimTokenPrefix = "scimt"
 )
 
 var prefixToResourceType = map[string]resource.Type{
@@ -158,6 +169,10 @@ var prefixToResourceType = map[string]resource.Type{
 	SessionPrefix:                              resource.Session,
 	TcpTargetPrefix:                            resource.Target,
 	SshTargetPrefix:                            resource.Target,
+	HttpTargetPrefix:                           resource.Target,
+	PostgresTargetPrefix:                       resource.Target,
+	UdpTargetPrefix:                            resource.Target,
+	KubernetesTargetPrefix:                     resource.Target,
 	WorkerPrefix:                               resource.Worker,
 	PluginStorageBucketPrefix:                  resource.StorageBucket,
 	SessionRecordingPrefix:                     resource.SessionRecording,
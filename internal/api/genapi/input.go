@@ -486,12 +486,13 @@ var inputStructs = []*structInfo{
 		outFile: "authtokens/authtokens.gen.go",
 		templates: []*template.Template{
 			clientTemplate,
+			commonCreateTemplate,
 			readTemplate,
 			deleteTemplate,
 			listTemplate,
 		},
 		pluralResourceName:  "auth-tokens",
-		createResponseTypes: []string{ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
+		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
 	},
 	// Credentials
@@ -907,6 +908,48 @@ var inputStructs = []*structInfo{
 			},
 		},
 	},
+	{
+		inProto:        &targets.HttpTargetAttributes{},
+		outFile:        "targets/http_target_attributes.gen.go",
+		subtypeName:    "HttpTarget",
+		parentTypeName: "Target",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "EnableTls",
+				SkipDefault: true,
+			},
+		},
+	},
+	{
+		inProto:        &targets.PostgresTargetAttributes{},
+		outFile:        "targets/postgres_target_attributes.gen.go",
+		subtypeName:    "PostgresTarget",
+		parentTypeName: "Target",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &targets.UdpTargetAttributes{},
+		outFile:        "targets/udp_target_attributes.gen.go",
+		subtypeName:    "UdpTarget",
+		parentTypeName: "Target",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &targets.KubernetesTargetAttributes{},
+		outFile:        "targets/kubernetes_target_attributes.gen.go",
+		subtypeName:    "KubernetesTarget",
+		parentTypeName: "Target",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &targets.Target{},
 		outFile: "targets/target.gen.go",
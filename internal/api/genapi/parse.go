@@ -102,6 +102,7 @@ var (
 	stringValueName = (&wrapperspb.StringValue{}).ProtoReflect().Descriptor().FullName()
 	boolValueName   = (&wrapperspb.BoolValue{}).ProtoReflect().Descriptor().FullName()
 	uInt32ValueName = (&wrapperspb.UInt32Value{}).ProtoReflect().Descriptor().FullName()
+	uInt64ValueName = (&wrapperspb.UInt64Value{}).ProtoReflect().Descriptor().FullName()
 	int32ValueName  = (&wrapperspb.Int32Value{}).ProtoReflect().Descriptor().FullName()
 	structValueName = (&_struct.Struct{}).ProtoReflect().Descriptor().FullName()
 	timestampName   = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
@@ -117,6 +118,8 @@ func messageKind(fd protoreflect.FieldDescriptor) (ptr, pkg, name string) {
 		return "", "", "bool"
 	case uInt32ValueName:
 		return "", "", "uint32"
+	case uInt64ValueName:
+		return "", "", "uint64"
 	case int32ValueName:
 		return "", "", "int32"
 	case structValueName:
@@ -223,6 +223,7 @@ func TestSelfReadingDifferentOutputFields(t *testing.T) {
 		tc.Controller().IamRepoFn,
 		tc.Controller().AuthTokenRepoFn,
 		tc.Controller().LdapRepoFn,
+		tc.Controller().ScimRepoFn,
 	)
 	require.NoError(t, err)
 
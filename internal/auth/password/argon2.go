@@ -220,3 +220,69 @@ func (c *Argon2Credential) oplog(op oplog.OpType) oplog.Metadata {
 	}
 	return metadata
 }
+
+// A Argon2CredentialHistory is a previous Argon2Credential of an Account. It
+// is used to prevent the reuse of previous passwords.  It is owned by an
+// Account.
+type Argon2CredentialHistory struct {
+	*store.Argon2CredentialHistory
+	tableName string
+}
+
+// newArgon2CredentialHistory returns the history entry of c, which is being
+// replaced.  The salt of c must already be encrypted.
+func newArgon2CredentialHistory(c *Argon2Credential) *Argon2CredentialHistory {
+	return &Argon2CredentialHistory{
+		Argon2CredentialHistory: &store.Argon2CredentialHistory{
+			PrivateId:         c.PrivateId,
+			PasswordAccountId: c.PasswordAccountId,
+			PasswordConfId:    c.PasswordConfId,
+			CtSalt:            c.CtSalt,
+			DerivedKey:        c.DerivedKey,
+			KeyId:             c.KeyId,
+		},
+	}
+}
+
+// TableName returns the table name.
+func (c *Argon2CredentialHistory) TableName() string {
+	if c != nil && c.tableName != "" {
+		return c.tableName
+	}
+	return "auth_password_argon2_cred_history"
+}
+
+// SetTableName sets the table name.
+func (c *Argon2CredentialHistory) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *Argon2CredentialHistory) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(Argon2CredentialHistory).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, c.Argon2CredentialHistory, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	c.KeyId = keyId
+	return nil
+}
+
+func (c *Argon2CredentialHistory) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(Argon2CredentialHistory).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c.Argon2CredentialHistory, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (c *Argon2CredentialHistory) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id":  []string{c.PrivateId},
+		"resource-type":       []string{"argon2 credential history"},
+		"op-type":             []string{op.String()},
+		"password-account-id": []string{c.PasswordAccountId},
+	}
+}
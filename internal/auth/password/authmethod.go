@@ -12,6 +12,13 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// Default values of the MinLoginNameLength and MinPasswordLength settings of
+// an AuthMethod.
+const (
+	defaultMinLoginNameLength = 3
+	defaultMinPasswordLength  = 8
+)
+
 // A AuthMethod contains accounts and password configurations. It is owned
 // by a scope.
 type AuthMethod struct {
@@ -28,7 +35,8 @@ func allocAuthMethod() AuthMethod {
 // NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
 // Name and description are the only valid options. All other options are
 // ignored.  MinLoginNameLength and MinPasswordLength are pre-set to the
-// default values of 5 and 8 respectively.
+// default values of 3 and 8 respectively.  The other password policy
+// settings are disabled by default.
 func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMethod, error) {
 	const op = "password.NewAuthMethod"
 	if scopeId == "" {
@@ -41,8 +49,8 @@ func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMet
 			ScopeId:            scopeId,
 			Name:               opts.withName,
 			Description:        opts.withDescription,
-			MinLoginNameLength: 3,
-			MinPasswordLength:  8,
+			MinLoginNameLength: defaultMinLoginNameLength,
+			MinPasswordLength:  defaultMinPasswordLength,
 		},
 	}
 	return a, nil
@@ -27,6 +27,8 @@ type options struct {
 	withPassword          bool
 	withOrderByCreateTime bool
 	ascending             bool
+	withTotpCode          string
+	withNewPassword       string
 }
 
 func getDefaultOptions() options {
@@ -95,3 +97,18 @@ func WithOrderByCreateTime(ascending bool) Option {
 		o.ascending = ascending
 	}
 }
+
+// WithTotpCode provides an optional TOTP or recovery code.
+func WithTotpCode(code string) Option {
+	return func(o *options) {
+		o.withTotpCode = code
+	}
+}
+
+// WithNewPassword provides an optional new password, which replaces an
+// expired password when authenticating.
+func WithNewPassword(password string) Option {
+	return func(o *options) {
+		o.withNewPassword = password
+	}
+}
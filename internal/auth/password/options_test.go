@@ -68,4 +68,18 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.ascending = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithTotpCode", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithTotpCode("123456"))
+		testOpts := getDefaultOptions()
+		testOpts.withTotpCode = "123456"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithNewPassword", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithNewPassword("new-password"))
+		testOpts := getDefaultOptions()
+		testOpts.withNewPassword = "new-password"
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package password
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// maxCharacterClasses is the number of character classes counted by
+// characterClasses.
+const maxCharacterClasses = 4
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+// commonPasswords is the set of commonly used and breached passwords, in
+// lowercase, rejected when the DenyCommonPasswords setting of an auth method
+// is enabled.
+var commonPasswords = func() map[string]struct{} {
+	m := make(map[string]struct{})
+	s := bufio.NewScanner(strings.NewReader(commonPasswordsFile))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		m[strings.ToLower(line)] = struct{}{}
+	}
+	return m
+}()
+
+// isCommonPassword reports whether password is a commonly used or breached
+// password, ignoring case.
+func isCommonPassword(password string) bool {
+	_, ok := commonPasswords[strings.ToLower(password)]
+	return ok
+}
+
+// characterClasses returns the number of character classes in s.  The
+// classes are lowercase letters, uppercase letters, digits and all other
+// characters.
+func characterClasses(s string) int {
+	var lower, upper, digit, other int
+	for _, r := range s {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return lower + upper + digit + other
+}
+
+// checkComplexity returns an error if password does not satisfy the
+// complexity rules of the configuration.  Length is checked by the callers.
+//
+// An error with code PasswordTooSimple is returned if password contains
+// fewer than MinPasswordCharacterClasses character classes, or if
+// MinPasswordCharacterClasses is set and password contains loginName. An
+// error with code PasswordCommon is returned if DenyCommonPasswords is set
+// and password is a commonly used password.
+func (c *currentConfig) checkComplexity(ctx context.Context, loginName, password string) error {
+	const op = "password.(currentConfig).checkComplexity"
+	if c.MinPasswordCharacterClasses > 0 {
+		if characterClasses(password) < c.MinPasswordCharacterClasses {
+			return errors.New(ctx, errors.PasswordTooSimple, op,
+				fmt.Sprintf("must contain at least %d of lowercase letters, uppercase letters, digits and symbols", c.MinPasswordCharacterClasses))
+		}
+		if loginName != "" && strings.Contains(strings.ToLower(password), strings.ToLower(loginName)) {
+			return errors.New(ctx, errors.PasswordTooSimple, op, "must not contain the login name")
+		}
+	}
+	if c.DenyCommonPasswords && isCommonPassword(password) {
+		return errors.New(ctx, errors.PasswordCommon, op, "must not be a commonly used password")
+	}
+	return nil
+}
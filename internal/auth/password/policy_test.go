@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package password
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_characterClasses(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{in: "", want: 0},
+		{in: "password", want: 1},
+		{in: "PASSWORD", want: 1},
+		{in: "Password", want: 2},
+		{in: "Passw0rd", want: 3},
+		{in: "Passw0rd!", want: 4},
+		{in: "12345678", want: 1},
+		{in: "p@ss wörd", want: 2},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, characterClasses(tt.in), "%q", tt.in)
+	}
+}
+
+func Test_isCommonPassword(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(isCommonPassword("password"))
+	assert.True(isCommonPassword("PassWord1"))
+	assert.True(isCommonPassword("12345678"))
+	assert.False(isCommonPassword(""))
+	assert.False(isCommonPassword("# Commonly used and breached passwords rejected when the deny_common_passwords"))
+	assert.False(isCommonPassword("correct horse battery staple"))
+}
+
+func TestCurrentConfig_checkComplexity(t *testing.T) {
+	tests := []struct {
+		name      string
+		cc        currentConfig
+		loginName string
+		password  string
+		wantCode  errors.Code
+	}{
+		{
+			name:     "disabled",
+			password: "password",
+		},
+		{
+			name:     "too-few-classes",
+			cc:       currentConfig{MinPasswordCharacterClasses: 3},
+			password: "Password",
+			wantCode: errors.PasswordTooSimple,
+		},
+		{
+			name:     "enough-classes",
+			cc:       currentConfig{MinPasswordCharacterClasses: 3},
+			password: "Passw0rd",
+		},
+		{
+			name:      "contains-login-name",
+			cc:        currentConfig{MinPasswordCharacterClasses: 3},
+			loginName: "kazmierczak",
+			password:  "Kazmierczak-1",
+			wantCode:  errors.PasswordTooSimple,
+		},
+		{
+			name:      "login-name-allowed-without-classes",
+			loginName: "kazmierczak",
+			password:  "kazmierczak",
+		},
+		{
+			name:     "common",
+			cc:       currentConfig{DenyCommonPasswords: true},
+			password: "Password123",
+			wantCode: errors.PasswordCommon,
+		},
+		{
+			name:     "uncommon",
+			cc:       currentConfig{DenyCommonPasswords: true},
+			password: "correct horse battery staple",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cc.checkComplexity(context.Background(), tt.loginName, tt.password)
+			if tt.wantCode == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Truef(t, errors.Match(errors.T(tt.wantCode), err), "unexpected error %v", err)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 const (
 	argon2ConfigurationPrefix = "arg2conf"
 	argon2CredentialPrefix    = "arg2cred"
+	totpPrefix                = "pwtotp"
 )
 
 func newArgon2ConfigurationId(ctx context.Context) (string, error) {
@@ -33,3 +34,12 @@ func newArgon2CredentialId(ctx context.Context) (string, error) {
 	}
 	return id, nil
 }
+
+func newTotpId(ctx context.Context) (string, error) {
+	const op = "password.newTotpId"
+	id, err := db.NewPrivateId(ctx, totpPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
@@ -24,4 +24,9 @@ func Test_PrivateIds(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, strings.HasPrefix(id, argon2CredentialPrefix+"_"))
 	})
+	t.Run("totp", func(t *testing.T) {
+		id, err := newTotpId(ctx)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, totpPrefix+"_"))
+	})
 }
@@ -16,23 +16,129 @@ select acct.name,                        -- Account.Name
        acct.version,                     -- Account.Version
        cred.private_id as credential_id, -- Account.CredentialId
        cred.private_id,                  -- Argon2Credential.PrivateId
+       cred.password_account_id,         -- Argon2Credential.PasswordAccountId
        cred.password_conf_id,            -- Argon2Credential.PasswordConfId
        cred.salt,                        -- Argon2Credential.CtSalt/Salt
        cred.derived_key,                 -- Argon2Credential.DerivedKey
+       cred.key_id,                      -- Argon2Credential.KeyId
        conf.key_length,                  -- Argon2Configuration.KeyLength
        conf.iterations,                  -- Argon2Configuration.Iterations
        conf.memory,                      -- Argon2Configuration.Memory
        conf.threads,                     -- Argon2Configuration.Threads
-       meth.password_conf_id = cred.password_conf_id as is_current_conf
+       meth.password_conf_id = cred.password_conf_id as is_current_conf,
+       meth.totp_required,               -- authAccount.TotpRequired
+       meth.lockout_threshold,           -- authAccount.LockoutThreshold
+       meth.lockout_threshold > 0
+         and lockout.locked_time is not null
+         and (meth.lockout_duration_seconds = 0
+              or lockout.locked_time + make_interval(secs => meth.lockout_duration_seconds) > current_timestamp)
+         as is_locked,
+       coalesce(lockout.failed_attempt_count, 0) as failed_attempt_count,
+       meth.max_password_age_days > 0
+         and cred.create_time + make_interval(days => meth.max_password_age_days) < current_timestamp
+         as is_password_expired
   from auth_password_argon2_cred cred,
        auth_password_argon2_conf conf,
-       auth_password_account acct,
-       auth_password_method meth
+       auth_password_method meth,
+       auth_password_account acct
+  left join auth_password_account_lockout lockout
+         on lockout.password_account_id = acct.public_id
  where acct.auth_method_id = @auth_method_id
    and acct.login_name = @login_name
    and cred.password_conf_id = conf.private_id
    and cred.password_account_id = acct.public_id
    and acct.auth_method_id = meth.public_id ;
+`
+	// recordFailedAttemptQuery increments the failed authentication attempt
+	// count of an account and locks the account once the count reaches the
+	// lockout threshold.  The count restarts if a previous lock has expired.
+	recordFailedAttemptQuery = `
+insert into auth_password_account_lockout as lockout
+       (password_account_id, failed_attempt_count, last_failed_time, locked_time)
+values (@password_account_id, 1, current_timestamp,
+        case when @lockout_threshold <= 1 then current_timestamp end)
+    on conflict (password_account_id) do update
+   set failed_attempt_count = case when lockout.locked_time is null
+                                   then lockout.failed_attempt_count + 1
+                                   else 1
+                              end,
+       last_failed_time     = current_timestamp,
+       locked_time          = case when (case when lockout.locked_time is null
+                                              then lockout.failed_attempt_count + 1
+                                              else 1
+                                         end) >= @lockout_threshold
+                                   then current_timestamp
+                              end
+returning failed_attempt_count, locked_time is not null as locked;
+`
+	resetFailedAttemptsQuery = `
+delete
+  from auth_password_account_lockout
+ where password_account_id = @password_account_id;
+`
+	// previousCredentialsQuery returns the current credential of an account
+	// followed by its previous credentials, most recent first.
+	previousCredentialsQuery = `
+select cred.salt,                        -- Argon2CredentialHistory.CtSalt/Salt
+       cred.derived_key,                 -- Argon2CredentialHistory.DerivedKey
+       cred.key_id,                      -- Argon2CredentialHistory.KeyId
+       conf.key_length,                  -- Argon2Configuration.KeyLength
+       conf.iterations,                  -- Argon2Configuration.Iterations
+       conf.memory,                      -- Argon2Configuration.Memory
+       conf.threads                      -- Argon2Configuration.Threads
+  from (
+        select 0 as rank, password_conf_id, salt, derived_key, key_id, create_time
+          from auth_password_argon2_cred
+         where password_account_id = @password_account_id
+     union all
+        select 1 as rank, password_conf_id, salt, derived_key, key_id, create_time
+          from auth_password_argon2_cred_history
+         where password_account_id = @password_account_id
+       ) cred,
+       auth_password_argon2_conf conf
+ where cred.password_conf_id = conf.private_id
+ order by cred.rank, cred.create_time desc
+ limit @limit;
+`
+	// pruneCredentialHistoryQuery deletes all but the @keep most recent
+	// previous credentials of an account.
+	pruneCredentialHistoryQuery = `
+delete
+  from auth_password_argon2_cred_history
+ where password_account_id = @password_account_id
+   and private_id not in (
+       select private_id
+         from auth_password_argon2_cred_history
+        where password_account_id = @password_account_id
+        order by create_time desc
+        limit @keep
+   );
+`
+	// unenrolledTotpAccountsQuery counts the accounts of an auth method
+	// without a confirmed TOTP enrollment.
+	unenrolledTotpAccountsQuery = `
+select count(*)
+  from auth_password_account acct
+  left join auth_password_totp totp
+         on totp.password_account_id = acct.public_id
+        and totp.confirmed
+ where acct.auth_method_id = @auth_method_id
+   and totp.private_id is null;
+`
+	// totpRequiredQuery returns whether an auth method requires TOTP.
+	totpRequiredQuery = `
+select totp_required
+  from auth_password_method
+ where public_id = @auth_method_id;
+`
+	// accountTotpRequiredQuery returns whether the auth method of an account
+	// requires TOTP.
+	accountTotpRequiredQuery = `
+select meth.totp_required
+  from auth_password_method meth
+  join auth_password_account acct
+    on acct.auth_method_id = meth.public_id
+ where acct.public_id = @account_id;
 `
 	currentConfigForAccountQuery = `
 select *
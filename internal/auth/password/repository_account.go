@@ -5,6 +5,7 @@ package password
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"regexp"
 	"strings"
@@ -30,6 +31,9 @@ import (
 //
 // Both a.Name and a.Description are optional. If a.Name is set, it must be
 // unique within a.AuthMethodId.
+//
+// Accounts cannot be created in an auth method which requires TOTP, since they
+// could not authenticate to enroll; an error with code Conflict is returned.
 func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Account, opt ...Option) (*Account, error) {
 	const op = "password.(Repository).CreateAccount"
 	if a == nil {
@@ -81,6 +85,9 @@ func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Accou
 		if cc.MinPasswordLength > len(opts.password) {
 			return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("must be longer than %v", cc.MinPasswordLength))
 		}
+		if err := cc.checkComplexity(ctx, a.LoginName, opts.password); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		if cred, err = newArgon2Credential(ctx, a.PublicId, opts.password, cc.argon2()); err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -98,7 +105,10 @@ func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Accou
 	var newCred *Argon2Credential
 	var newAccount *Account
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
+			if err := checkTotpNotRequired(ctx, reader, totpRequiredQuery, sql.Named("auth_method_id", a.AuthMethodId)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 			newAccount = a.clone()
 			if err := w.Create(ctx, newAccount, db.WithOplog(oplogWrapper, a.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -5,6 +5,7 @@ package password
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/go-dbw"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
 )
 
 // CreateAuthMethod inserts m into the repository and returns a new
@@ -40,6 +42,9 @@ func (r *Repository) CreateAuthMethod(ctx context.Context, m *AuthMethod, opt ..
 	if m.PublicId != "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
 	}
+	if m.MinPasswordCharacterClasses > maxCharacterClasses {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("min password character classes must not be greater than %d", maxCharacterClasses))
+	}
 	m = m.Clone()
 
 	opts := GetOpts(opt...)
@@ -168,18 +173,22 @@ func (r *Repository) DeleteAuthMethod(ctx context.Context, scopeId, publicId str
 	return rowsDeleted, nil
 }
 
-// TODO: Fix the MinPasswordLength and MinLoginNameLength update path so they dont have
-//  to rely on the response of NewAuthMethod but instead can be unset in order to be
-//  set to the default values.
-
 // UpdateAuthMethod will update an auth method in the repository and return
-// the written auth method.  MinPasswordLength and MinLoginNameLength should
-// not be set to null, but instead use the default values returned by
-// NewAuthMethod.  fieldMaskPaths provides field_mask.proto paths for fields
-// that should be updated.  Fields will be set to NULL if the field is a zero
-// value and included in fieldMask. Name, Description, MinPasswordLength,
-// and MinLoginNameLength are the only updatable fields, If no updatable fields
-// are included in the fieldMaskPaths, then an error is returned.
+// the written auth method.  fieldMaskPaths provides field_mask.proto paths
+// for fields that should be updated.  Name and Description will be set to
+// NULL if the field is a zero value and included in fieldMask.
+// MinPasswordLength and MinLoginNameLength are set to their default values
+// if they are a zero value and included in fieldMask, and the other
+// password policy settings are set to their zero value, which disables
+// them.  Name, Description, MinPasswordLength, MinLoginNameLength,
+// TotpRequired, MinPasswordCharacterClasses, DenyCommonPasswords,
+// PasswordHistoryCount, MaxPasswordAgeDays, LockoutThreshold and
+// LockoutDurationSeconds are the only updatable fields, If no updatable
+// fields are included in the fieldMaskPaths, then an error is returned.
+//
+// TotpRequired can only be set if every account of the auth method has a
+// confirmed TOTP enrollment, otherwise an error with code Conflict is
+// returned, since accounts without one could no longer authenticate to enroll.
 func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMethod, version uint32, fieldMaskPaths []string, opt ...Option) (*AuthMethod, int, error) {
 	const op = "password.(Repository).UpdateAuthMethod"
 	if authMethod == nil {
@@ -191,26 +200,58 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 	if authMethod.ScopeId == "" {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
 	}
+	if authMethod.MinPasswordCharacterClasses > maxCharacterClasses {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("min password character classes must not be greater than %d", maxCharacterClasses))
+	}
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("Name", f):
 		case strings.EqualFold("Description", f):
 		case strings.EqualFold("MinLoginNameLength", f):
 		case strings.EqualFold("MinPasswordLength", f):
+		case strings.EqualFold("TotpRequired", f):
+		case strings.EqualFold("MinPasswordCharacterClasses", f):
+		case strings.EqualFold("DenyCommonPasswords", f):
+		case strings.EqualFold("PasswordHistoryCount", f):
+		case strings.EqualFold("MaxPasswordAgeDays", f):
+		case strings.EqualFold("LockoutThreshold", f):
+		case strings.EqualFold("LockoutDurationSeconds", f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
 	}
+	authMethod = authMethod.Clone()
+	if authMethod.MinPasswordLength == 0 {
+		authMethod.MinPasswordLength = defaultMinPasswordLength
+	}
+	if authMethod.MinLoginNameLength == 0 {
+		authMethod.MinLoginNameLength = defaultMinLoginNameLength
+	}
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			"Name":               authMethod.Name,
-			"Description":        authMethod.Description,
-			"MinPasswordLength":  authMethod.MinPasswordLength,
-			"MinLoginNameLength": authMethod.MinLoginNameLength,
+			"Name":                        authMethod.Name,
+			"Description":                 authMethod.Description,
+			"MinPasswordLength":           authMethod.MinPasswordLength,
+			"MinLoginNameLength":          authMethod.MinLoginNameLength,
+			"TotpRequired":                authMethod.TotpRequired,
+			"MinPasswordCharacterClasses": authMethod.MinPasswordCharacterClasses,
+			"DenyCommonPasswords":         authMethod.DenyCommonPasswords,
+			"PasswordHistoryCount":        authMethod.PasswordHistoryCount,
+			"MaxPasswordAgeDays":          authMethod.MaxPasswordAgeDays,
+			"LockoutThreshold":            authMethod.LockoutThreshold,
+			"LockoutDurationSeconds":      authMethod.LockoutDurationSeconds,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{
+			"TotpRequired",
+			"MinPasswordCharacterClasses",
+			"DenyCommonPasswords",
+			"PasswordHistoryCount",
+			"MaxPasswordAgeDays",
+			"LockoutThreshold",
+			"LockoutDurationSeconds",
+		},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "field mask must not be empty")
@@ -233,6 +274,11 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 				db.WithOplog(oplogWrapper, upAuthMethod.oplog(oplog.OpType_OP_TYPE_UPDATE)),
 				db.WithVersion(&version),
 			}
+			if upAuthMethod.TotpRequired && strutil.StrListContains(dbMask, "TotpRequired") {
+				if err := checkTotpEnrolled(ctx, reader, upAuthMethod.PublicId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			var err error
 			rowsUpdated, err = w.Update(
 				ctx,
@@ -351,3 +397,52 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 	}
 	return authMethods, nil
 }
+
+// checkTotpEnrolled returns an error with code Conflict if any account of the
+// auth method with authMethodId has no confirmed TOTP enrollment.
+func checkTotpEnrolled(ctx context.Context, reader db.Reader, authMethodId string) error {
+	const op = "password.checkTotpEnrolled"
+	rows, err := reader.Query(ctx, unenrolledTotpAccountsQuery, []any{sql.Named("auth_method_id", authMethodId)})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var unenrolled int
+	for rows.Next() {
+		if err := rows.Scan(&unenrolled); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if unenrolled > 0 {
+		return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("totp cannot be required while %d accounts have no confirmed totp enrollment", unenrolled))
+	}
+	return nil
+}
+
+// checkTotpNotRequired returns an error with code Conflict if TOTP is required
+// by the auth method the query selects with args, since an account without a
+// confirmed TOTP enrollment could not authenticate to enroll.
+func checkTotpNotRequired(ctx context.Context, reader db.Reader, query string, args ...any) error {
+	const op = "password.checkTotpNotRequired"
+	rows, err := reader.Query(ctx, query, args)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var required bool
+	for rows.Next() {
+		if err := rows.Scan(&required); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if required {
+		return errors.New(ctx, errors.Conflict, op, "auth method requires totp, so accounts must have a confirmed totp enrollment")
+	}
+	return nil
+}
@@ -678,6 +678,27 @@ func TestRepository_UpdateAuthMethod(t *testing.T) {
 			wantRowsUpdate:   1,
 			skipVersionCheck: true,
 		},
+		{
+			name: "require totp",
+			args: args{
+				updates: &store.AuthMethod{
+					TotpRequired: true,
+				},
+				fieldMaskPaths: []string{"TotpRequired"},
+			},
+			wantErr:        false,
+			wantRowsUpdate: 1,
+		},
+		{
+			name: "unset totp required",
+			args: args{
+				updates:        &store.AuthMethod{},
+				fieldMaskPaths: []string{"TotpRequired"},
+			},
+			wantErr:          false,
+			wantRowsUpdate:   1,
+			skipVersionCheck: true,
+		},
 		{
 			name: "noop update",
 			args: args{
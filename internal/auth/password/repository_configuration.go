@@ -123,9 +123,12 @@ func (r *Repository) setArgon2Conf(ctx context.Context, scopeId string, c *Argon
 }
 
 type currentConfig struct {
-	ConfType           string
-	MinLoginNameLength int
-	MinPasswordLength  int
+	ConfType                    string
+	MinLoginNameLength          int
+	MinPasswordLength           int
+	MinPasswordCharacterClasses int
+	DenyCommonPasswords         bool
+	PasswordHistoryCount        int
 
 	*Argon2Configuration
 }
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package password
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+)
+
+// Reasons for failed authentication attempts reported in audit events.
+const (
+	failedReasonUnknownLoginName = "unknown login name"
+	failedReasonInvalidPassword  = "invalid password"
+	failedReasonInvalidTotpCode  = "invalid totp code"
+	failedReasonAccountLocked    = "account locked"
+)
+
+// UnlockAccount unlocks the account with accountId and resets its failed
+// authentication attempt count.  It returns the number of lockout records
+// deleted, which is 0 if the account has no failed attempts.
+func (r *Repository) UnlockAccount(ctx context.Context, scopeId, accountId string) (int, error) {
+	const op = "password.(Repository).UnlockAccount"
+	if accountId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+	n, err := r.writer.Exec(ctx, resetFailedAttemptsQuery, []any{sql.Named("password_account_id", accountId)})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return n, nil
+}
+
+// failedAttempt records a failed authentication attempt of acct and writes
+// an audit event for it.  The attempt is only counted if the auth method of
+// acct has a lockout threshold.
+func (r *Repository) failedAttempt(ctx context.Context, acct *authAccount, reason string) {
+	const op = "password.(Repository).failedAttempt"
+	details := &store.FailedAuthentication{
+		AuthMethodId:       acct.GetAuthMethodId(),
+		AccountId:          acct.GetPublicId(),
+		LoginName:          acct.GetLoginName(),
+		Reason:             reason,
+		FailedAttemptCount: acct.FailedAttemptCount,
+		Locked:             acct.IsLocked,
+	}
+	if acct.LockoutThreshold > 0 && !acct.IsLocked {
+		rows, err := r.writer.Query(ctx, recordFailedAttemptQuery, []any{
+			sql.Named("password_account_id", acct.GetPublicId()),
+			sql.Named("lockout_threshold", acct.LockoutThreshold),
+		})
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to record failed authentication attempt", "account_id", acct.GetPublicId()))
+		} else {
+			defer rows.Close()
+			for rows.Next() {
+				if err := rows.Scan(&details.FailedAttemptCount, &details.Locked); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("unable to record failed authentication attempt", "account_id", acct.GetPublicId()))
+				}
+			}
+		}
+	}
+	auditFailedAuthentication(ctx, details)
+}
+
+// resetFailedAttempts resets the failed authentication attempt count of
+// acct after a successful authentication.
+func (r *Repository) resetFailedAttempts(ctx context.Context, acct *authAccount) error {
+	const op = "password.(Repository).resetFailedAttempts"
+	if acct.FailedAttemptCount == 0 {
+		return nil
+	}
+	if _, err := r.writer.Exec(ctx, resetFailedAttemptsQuery, []any{sql.Named("password_account_id", acct.GetPublicId())}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// auditFailedAuthentication writes an audit event for a failed
+// authentication attempt.  The event is written separately from the audit
+// event of the API request, which does not include the reason.
+func auditFailedAuthentication(ctx context.Context, details *store.FailedAuthentication) {
+	const op = "password.auditFailedAuthentication"
+	info := &event.RequestInfo{
+		Method:   "Authenticate",
+		PublicId: details.AccountId,
+	}
+	if reqInfo, ok := event.RequestInfoFromContext(ctx); ok {
+		info.Id = reqInfo.Id
+		info.Method = reqInfo.Method
+		info.Path = reqInfo.Path
+		info.ClientIp = reqInfo.ClientIp
+	}
+	err := event.WriteAudit(ctx, op,
+		event.WithRequestInfo(info),
+		event.WithAuth(&event.Auth{
+			UserInfo: &event.UserInfo{AuthAccountId: details.AccountId},
+		}),
+		event.WithRequest(&event.Request{
+			Operation: "password.authenticate.failed",
+			Details:   details,
+		}),
+		event.WithFlush(),
+	)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write failed authentication audit event"))
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package password
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Lockout(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	scopeId := o.GetPublicId()
+	am := TestAuthMethods(t, conn, scopeId, 1)[0]
+	passwd := "12345678"
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	acct, err := NewAccount(ctx, am.GetPublicId(), WithLoginName("kazmierczak"))
+	require.NoError(t, err)
+	acct, err = repo.CreateAccount(ctx, scopeId, acct, WithPassword(passwd))
+	require.NoError(t, err)
+
+	upd := am.Clone()
+	upd.LockoutThreshold = 3
+	upd, _, err = repo.UpdateAuthMethod(ctx, upd, am.GetVersion(), []string{"LockoutThreshold"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), upd.GetLockoutThreshold())
+
+	authenticate := func(pw string) *Account {
+		got, err := repo.Authenticate(ctx, scopeId, am.GetPublicId(), acct.GetLoginName(), pw)
+		require.NoError(t, err)
+		return got
+	}
+
+	// A successful authentication resets the count.
+	assert.Nil(t, authenticate("wrong-password"))
+	assert.Nil(t, authenticate("wrong-password"))
+	assert.NotNil(t, authenticate(passwd))
+	assert.Nil(t, authenticate("wrong-password"))
+	assert.Nil(t, authenticate("wrong-password"))
+	assert.NotNil(t, authenticate(passwd))
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, authenticate("wrong-password"))
+	}
+	assert.Nil(t, authenticate(passwd))
+	got, err := repo.ChangePassword(ctx, scopeId, acct.GetPublicId(), passwd, "new-password", acct.GetVersion())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	n, err := repo.UnlockAccount(ctx, scopeId, acct.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.NotNil(t, authenticate(passwd))
+	n, err = repo.UnlockAccount(ctx, scopeId, acct.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	t.Run("duration", func(t *testing.T) {
+		upd.LockoutDurationSeconds = 60
+		upd, _, err = repo.UpdateAuthMethod(ctx, upd, upd.GetVersion(), []string{"LockoutDurationSeconds"})
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			assert.Nil(t, authenticate("wrong-password"))
+		}
+		assert.Nil(t, authenticate(passwd))
+
+		_, err = rw.Exec(ctx, "update auth_password_account_lockout set locked_time = now() - interval '61 seconds' where password_account_id = ?", []any{acct.GetPublicId()})
+		require.NoError(t, err)
+		// The count restarts once the lock expires.
+		assert.Nil(t, authenticate("wrong-password"))
+		assert.NotNil(t, authenticate(passwd))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			assert.Nil(t, authenticate("wrong-password"))
+		}
+		assert.Nil(t, authenticate(passwd))
+
+		upd.LockoutThreshold = 0
+		upd, _, err = repo.UpdateAuthMethod(ctx, upd, upd.GetVersion(), []string{"LockoutThreshold"})
+		require.NoError(t, err)
+		assert.NotNil(t, authenticate(passwd))
+	})
+}
+
+func TestRepository_UnlockAccount_InvalidParameters(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	_, err = repo.UnlockAccount(ctx, o.GetPublicId(), "")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, err = repo.UnlockAccount(ctx, "", "apw_1234567890")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, err = repo.UnlockAccount(ctx, o.GetPublicId(), "apw_1234567890")
+	assert.True(t, errors.IsNotFoundError(err))
+}
+
+func TestRepository_PasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	scopeId := o.GetPublicId()
+	am := TestAuthMethods(t, conn, scopeId, 1)[0]
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	upd := am.Clone()
+	upd.MinPasswordCharacterClasses = 3
+	upd.DenyCommonPasswords = true
+	upd.PasswordHistoryCount = 3
+	upd, _, err = repo.UpdateAuthMethod(ctx, upd, am.GetVersion(),
+		[]string{"MinPasswordCharacterClasses", "DenyCommonPasswords", "PasswordHistoryCount"})
+	require.NoError(t, err)
+
+	isCode := func(c errors.Code, err error) bool { return errors.Match(errors.T(c), err) }
+
+	acct, err := NewAccount(ctx, am.GetPublicId(), WithLoginName("kazmierczak"))
+	require.NoError(t, err)
+	_, err = repo.CreateAccount(ctx, scopeId, acct, WithPassword("password"))
+	assert.Truef(t, isCode(errors.PasswordTooSimple, err), "unexpected error %v", err)
+	_, err = repo.CreateAccount(ctx, scopeId, acct, WithPassword("Password123"))
+	assert.Truef(t, isCode(errors.PasswordCommon, err), "unexpected error %v", err)
+	acct, err = repo.CreateAccount(ctx, scopeId, acct, WithPassword("First-pass1"))
+	require.NoError(t, err)
+
+	passwords := []string{"First-pass1", "Second-pass2", "Third-pass3", "Fourth-pass4"}
+	for i := 1; i < len(passwords); i++ {
+		acct, err = repo.ChangePassword(ctx, scopeId, acct.GetPublicId(), passwords[i-1], passwords[i], acct.GetVersion())
+		require.NoError(t, err)
+		require.NotNil(t, acct)
+	}
+
+	// The current and the 2 previous passwords are remembered.
+	for _, pw := range passwords[1:3] {
+		_, err = repo.SetPassword(ctx, scopeId, acct.GetPublicId(), pw, acct.GetVersion())
+		assert.Truef(t, isCode(errors.PasswordReused, err), "unexpected error %v", err)
+	}
+	_, err = repo.ChangePassword(ctx, scopeId, acct.GetPublicId(), passwords[3], passwords[1], acct.GetVersion())
+	assert.Truef(t, isCode(errors.PasswordReused, err), "unexpected error %v", err)
+	acct, err = repo.SetPassword(ctx, scopeId, acct.GetPublicId(), passwords[0], acct.GetVersion())
+	require.NoError(t, err)
+
+	var history []*Argon2CredentialHistory
+	require.NoError(t, rw.SearchWhere(ctx, &history, "password_account_id = ?", []any{acct.GetPublicId()}))
+	assert.Len(t, history, 2)
+}
+
+func TestRepository_Authenticate_PasswordExpired(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	scopeId := o.GetPublicId()
+	am := TestAuthMethods(t, conn, scopeId, 1)[0]
+	passwd := "12345678"
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	acct, err := NewAccount(ctx, am.GetPublicId(), WithLoginName("kazmierczak"))
+	require.NoError(t, err)
+	acct, err = repo.CreateAccount(ctx, scopeId, acct, WithPassword(passwd))
+	require.NoError(t, err)
+
+	upd := am.Clone()
+	upd.MaxPasswordAgeDays = 30
+	_, _, err = repo.UpdateAuthMethod(ctx, upd, am.GetVersion(), []string{"MaxPasswordAgeDays"})
+	require.NoError(t, err)
+
+	first, err := repo.Authenticate(ctx, scopeId, am.GetPublicId(), acct.GetLoginName(), passwd)
+	require.NoError(t, err)
+	require.NotNil(t, first)
+
+	for _, q := range []string{
+		"alter table auth_password_argon2_cred disable trigger immutable_columns",
+		"update auth_password_argon2_cred set create_time = now() - interval '31 days' where password_account_id = ?",
+		"alter table auth_password_argon2_cred enable trigger immutable_columns",
+	} {
+		var args []any
+		if q[0] == 'u' {
+			args = []any{acct.GetPublicId()}
+		}
+		_, err = rw.Exec(ctx, q, args)
+		require.NoError(t, err)
+	}
+
+	got, err := repo.Authenticate(ctx, scopeId, am.GetPublicId(), acct.GetLoginName(), "wrong-password")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = repo.Authenticate(ctx, scopeId, am.GetPublicId(), acct.GetLoginName(), passwd)
+	assert.Truef(t, errors.Match(errors.T(errors.PasswordExpired), err), "unexpected error %v", err)
+	assert.Nil(t, got)
+
+	got, err = repo.Authenticate(ctx, scopeId, am.GetPublicId(), acct.GetLoginName(), passwd, WithNewPassword(passwd))
+	assert.Truef(t, errors.Match(errors.T(errors.PasswordsEqual), err), "unexpected error %v", err)
+	assert.Nil(t, got)
+
+	got, err = repo.Authenticate(ctx, scopeId, am.GetPublicId(), acct.GetLoginName(), passwd, WithNewPassword("new-password"))
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.NotEqual(t, first.CredentialId, got.CredentialId)
+
+	got, err = repo.Authenticate(ctx, scopeId, am.GetPublicId(), acct.GetLoginName(), "new-password")
+	require.NoError(t, err)
+	assert.NotNil(t, got)
+}
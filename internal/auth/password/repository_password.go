@@ -9,11 +9,13 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/hashicorp/boundary/internal/auth/password/store"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"golang.org/x/crypto/argon2"
 )
 
@@ -22,6 +24,12 @@ type authAccount struct {
 	*Argon2Credential
 	*Argon2Configuration
 	IsCurrentConf bool
+	TotpRequired  bool
+
+	LockoutThreshold   uint32
+	IsLocked           bool
+	FailedAttemptCount uint32
+	IsPasswordExpired  bool
 }
 
 // Authenticate authenticates loginName and password match for loginName in
@@ -32,10 +40,27 @@ type authAccount struct {
 // password. A new CredentialId is generated when a user's password is
 // changed and the old one is deleted.
 //
+// If the account has a confirmed TOTP enrollment, a TOTP code or a recovery
+// code must also be provided with the WithTotpCode option. An error with code
+// TotpCodeRequired is returned if the password matches but no code was
+// provided, and an error with code TotpEnrollmentRequired is returned if the
+// password matches but the auth method requires TOTP and the account has no
+// confirmed enrollment.
+//
+// If the password of the account is older than the maximum password age of
+// authMethodId, an error with code PasswordExpired is returned if the
+// password matches but no new password was provided with the
+// WithNewPassword option.  Otherwise the password is changed to the new
+// password, as with ChangePassword, and the updated account is returned.
+//
+// If the auth method has a lockout threshold, failed attempts are counted
+// and nil is returned for a locked account.  A successful authentication
+// resets the count.  An audit event is written for each failed attempt.
+//
 // Authenticate will update the stored values for password to the current
 // password settings for authMethodId if authentication is successful and
 // the stored values are not using the current password settings.
-func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*Account, error) {
+func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string, opt ...Option) (*Account, error) {
 	const op = "password.(Repository).Authenticate"
 	if authMethodId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing authMethodId", errors.WithoutEvent())
@@ -55,13 +80,49 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
 	}
 
-	acct, err := r.authenticate(ctx, scopeId, authMethodId, loginName, password)
+	acct, ok, err := r.authenticate(ctx, scopeId, authMethodId, loginName, password)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	if acct == nil {
+	switch {
+	case acct == nil:
+		auditFailedAuthentication(ctx, &store.FailedAuthentication{
+			AuthMethodId: authMethodId,
+			LoginName:    loginName,
+			Reason:       failedReasonUnknownLoginName,
+		})
+		return nil, nil
+	case acct.IsLocked:
+		r.failedAttempt(ctx, acct, failedReasonAccountLocked)
+		return nil, nil
+	case !ok:
+		r.failedAttempt(ctx, acct, failedReasonInvalidPassword)
+		return nil, nil
+	}
+
+	opts := GetOpts(opt...)
+	if acct.IsPasswordExpired && opts.withNewPassword == "" {
+		return nil, errors.New(ctx, errors.PasswordExpired, op, "password expired", errors.WithoutEvent())
+	}
+	ok, err = r.verifyTotp(ctx, scopeId, acct, opts.withTotpCode)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithoutEvent())
+	}
+	if !ok {
+		r.failedAttempt(ctx, acct, failedReasonInvalidTotpCode)
 		return nil, nil
 	}
+	if err := r.resetFailedAttempts(ctx, acct); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	if acct.IsPasswordExpired {
+		updated, err := r.ChangePassword(ctx, scopeId, acct.PublicId, password, opts.withNewPassword, acct.Version)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithoutEvent())
+		}
+		return updated, nil
+	}
 
 	if !acct.IsCurrentConf {
 		cc, err := r.currentConfig(ctx, authMethodId)
@@ -113,6 +174,13 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 // Returns nil, db.ErrorRecordNotFound if the account doesn't exist.
 // Returns nil, nil if old does not match the stored password for accountId.
 // Returns nil, error with code PasswordsEqual if old and new are equal.
+// Returns nil, error with code PasswordTooShort, PasswordTooSimple or
+// PasswordCommon if new does not satisfy the password policy of the auth
+// method, and PasswordReused if new equals one of the previous passwords
+// remembered by the auth method.
+//
+// Failed attempts are counted and audited as with Authenticate, and nil,
+// nil is returned if the account is locked.
 func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old, new string, version uint32) (*Account, error) {
 	const op = "password.(Repository).ChangePassword"
 	if accountId == "" {
@@ -151,13 +219,23 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
 	}
 
-	acct, err := r.authenticate(ctx, scopeId, authAccount.GetAuthMethodId(), authAccount.GetLoginName(), old)
+	acct, ok, err := r.authenticate(ctx, scopeId, authAccount.GetAuthMethodId(), authAccount.GetLoginName(), old)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	if acct == nil {
+	switch {
+	case acct == nil:
+		return nil, nil
+	case acct.IsLocked:
+		r.failedAttempt(ctx, acct, failedReasonAccountLocked)
+		return nil, nil
+	case !ok:
+		r.failedAttempt(ctx, acct, failedReasonInvalidPassword)
 		return nil, nil
 	}
+	if err := r.resetFailedAttempts(ctx, acct); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
 	cc, err := r.currentConfig(ctx, authAccount.GetAuthMethodId())
 	if err != nil {
@@ -166,6 +244,12 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 	if cc.MinPasswordLength > len(new) {
 		return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("must be at least %d", cc.MinPasswordLength))
 	}
+	if err := cc.checkComplexity(ctx, authAccount.GetLoginName(), new); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := r.checkReuse(ctx, scopeId, accountId, new, cc.PasswordHistoryCount); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	newCred, err := newArgon2Credential(ctx, accountId, new, cc.argon2())
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -201,6 +285,9 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 			if err = w.Create(ctx, newCred, db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create new credential"))
 			}
+			if err := addCredentialHistory(ctx, w, oplogWrapper, oldCred, cc.PasswordHistoryCount); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 			return nil
 		},
 	)
@@ -213,19 +300,22 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 	return updatedAccount, nil
 }
 
-func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*authAccount, error) {
+// authenticate returns the account for loginName in authMethodId and
+// reports whether password matches its credential.  It returns nil, false
+// if there is no such account.
+func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*authAccount, bool, error) {
 	const op = "password.(Repository).authenticate"
 	var accts []authAccount
 
 	rows, err := r.reader.Query(ctx, authenticateQuery, []any{sql.Named("auth_method_id", authMethodId), sql.Named("login_name", loginName)})
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, false, errors.Wrap(ctx, err, op)
 	}
 	defer rows.Close()
 	for rows.Next() {
 		var aa authAccount
 		if err := r.reader.ScanRows(ctx, rows, &aa); err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, false, errors.Wrap(ctx, err, op)
 		}
 		accts = append(accts, aa)
 	}
@@ -233,10 +323,10 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 	var acct authAccount
 	switch {
 	case len(accts) == 0:
-		return nil, nil
+		return nil, false, nil
 	case len(accts) > 1:
 		// this should never happen
-		return nil, errors.New(ctx, errors.Unknown, op, "multiple accounts returned for user name")
+		return nil, false, errors.New(ctx, errors.Unknown, op, "multiple accounts returned for user name")
 	default:
 		acct = accts[0]
 	}
@@ -244,23 +334,91 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 	// We don't pass a wrapper in here because for ecryption we want to indicate the expected key ID
 	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(acct.GetKeyId()))
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+		return nil, false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
 	}
 
 	if err := acct.decrypt(ctx, databaseWrapper); err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("unable to decrypt credential"))
+		return nil, false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("unable to decrypt credential"))
 	}
 
 	inputKey := argon2.IDKey([]byte(password), acct.Salt, acct.Iterations, acct.Memory, uint8(acct.Threads), acct.KeyLength)
 	if subtle.ConstantTimeCompare(inputKey, acct.DerivedKey) == 0 {
 		// authentication failed, password does not match
-		return nil, nil
+		return &acct, false, nil
 	}
-	return &acct, nil
+	return &acct, true, nil
+}
+
+// previousCredential is a credential of an account with its argon2
+// configuration, as returned by previousCredentialsQuery.
+type previousCredential struct {
+	*Argon2CredentialHistory
+	*Argon2Configuration
+}
+
+// checkReuse returns an error with code PasswordReused if password matches
+// the current credential of accountId or one of its historyCount - 1 most
+// recent previous credentials.  A historyCount of 0 disables the check.
+func (r *Repository) checkReuse(ctx context.Context, scopeId, accountId, password string, historyCount int) error {
+	const op = "password.(Repository).checkReuse"
+	if historyCount <= 0 {
+		return nil
+	}
+	rows, err := r.reader.Query(ctx, previousCredentialsQuery, []any{sql.Named("password_account_id", accountId), sql.Named("limit", historyCount)})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var creds []previousCredential
+	for rows.Next() {
+		var c previousCredential
+		if err := r.reader.ScanRows(ctx, rows, &c); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, c)
+	}
+	for _, c := range creds {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(c.KeyId))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := c.decrypt(ctx, databaseWrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("unable to decrypt credential"))
+		}
+		key := argon2.IDKey([]byte(password), c.Salt, c.Iterations, c.Memory, uint8(c.Threads), c.KeyLength)
+		if subtle.ConstantTimeCompare(key, c.DerivedKey) == 1 {
+			return errors.New(ctx, errors.PasswordReused, op, fmt.Sprintf("must not equal any of the last %d passwords", historyCount))
+		}
+	}
+	return nil
+}
+
+// addCredentialHistory adds cred, which is being replaced, to the previous
+// credentials of its account if historyCount is greater than 1, and deletes
+// the previous credentials of the account no longer needed to check
+// historyCount passwords.
+func addCredentialHistory(ctx context.Context, w db.Writer, oplogWrapper wrapping.Wrapper, cred *Argon2Credential, historyCount int) error {
+	const op = "password.addCredentialHistory"
+	if historyCount > 1 {
+		h := newArgon2CredentialHistory(cred)
+		if err := w.Create(ctx, h, db.WithOplog(oplogWrapper, h.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential history"))
+		}
+	}
+	keep := historyCount - 1
+	if keep < 0 {
+		keep = 0
+	}
+	if _, err := w.Exec(ctx, pruneCredentialHistoryQuery, []any{sql.Named("password_account_id", cred.PasswordAccountId), sql.Named("keep", keep)}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to prune credential history"))
+	}
+	return nil
 }
 
 // SetPassword sets the password for accountId to password. If password
 // contains an empty string, the password for accountId will be deleted.
+// The password must satisfy the password policy of the auth method, as with
+// ChangePassword.
 func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, password string, version uint32) (*Account, error) {
 	const op = "password.(Repository).SetPassword"
 	if accountId == "" {
@@ -282,18 +440,32 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
 	}
 
+	cc, err := r.currentConfigForAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
 	var newCred *Argon2Credential
 	if password != "" {
-		cc, err := r.currentConfigForAccount(ctx, accountId)
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
 		if cc == nil {
 			return nil, errors.New(ctx, errors.RecordNotFound, op, "unable to retrieve current configuration")
 		}
 		if cc.MinPasswordLength > len(password) {
 			return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("password must be at least %v", cc.MinPasswordLength))
 		}
+		authAccount, err := r.LookupAccount(ctx, accountId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if authAccount == nil {
+			return nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+		}
+		if err := cc.checkComplexity(ctx, authAccount.GetLoginName(), password); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if err := r.checkReuse(ctx, scopeId, accountId, password, cc.PasswordHistoryCount); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		newCred, err = newArgon2Credential(ctx, accountId, password, cc.argon2())
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
@@ -325,6 +497,10 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 				}
 			}
 			if oldCred.PrivateId != "" {
+				oldArgon2Cred := &Argon2Credential{Argon2Credential: &store.Argon2Credential{}}
+				if err := rr.LookupWhere(ctx, oldArgon2Cred, "private_id = ?", []any{oldCred.PrivateId}); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
 				dCred := oldCred.clone()
 				rowsDeleted, err := w.Delete(ctx, dCred, db.WithOplog(oplogWrapper, oldCred.oplog(oplog.OpType_OP_TYPE_DELETE)))
 				if err != nil {
@@ -333,6 +509,11 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 				if rowsDeleted > 1 {
 					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
 				}
+				if cc != nil {
+					if err := addCredentialHistory(ctx, w, oplogWrapper, oldArgon2Cred, cc.PasswordHistoryCount); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+				}
 			}
 			if newCred != nil {
 				return w.Create(ctx, newCred, db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE)))
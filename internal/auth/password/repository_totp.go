@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package password
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// EnrollTotp creates an unconfirmed TOTP enrollment for accountId, replacing
+// any existing unconfirmed enrollment, and returns its secret.  The
+// enrollment is not used to authenticate until it is confirmed with
+// ConfirmTotp.
+//
+// Returns an error with code NotUnique if the account already has a confirmed
+// enrollment, which must be deleted with DeleteTotp first.
+func (r *Repository) EnrollTotp(ctx context.Context, scopeId, accountId string) (*TotpEnrollment, error) {
+	const op = "password.(Repository).EnrollTotp"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("account %s not found", accountId))
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+
+	totp, err := newTotp(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	enrollment := newTotpEnrollment(totp, acct.GetLoginName())
+	dbTotp := totp.clone()
+	if err := dbTotp.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			existing, err := lookupTotp(ctx, reader, accountId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if existing != nil {
+				if existing.GetConfirmed() {
+					return errors.New(ctx, errors.NotUnique, op, "account already has a confirmed totp enrollment")
+				}
+				if _, err := w.Delete(ctx, existing, db.WithOplog(oplogWrapper, existing.oplog(oplog.OpType_OP_TYPE_DELETE))); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete unconfirmed totp enrollment"))
+				}
+			}
+			if err := w.Create(ctx, dbTotp, db.WithOplog(oplogWrapper, dbTotp.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create totp enrollment"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return enrollment, nil
+}
+
+// ConfirmTotp confirms the TOTP enrollment of accountId if code is a current
+// TOTP code of its secret, and returns new recovery codes for the account.
+// The recovery codes are not stored and cannot be retrieved again.
+//
+// Returns nil, error with code RecordNotFound if the account has no
+// enrollment.  Returns nil, nil if code is not a current TOTP code.
+func (r *Repository) ConfirmTotp(ctx context.Context, scopeId, accountId, code string) ([]string, error) {
+	const op = "password.(Repository).ConfirmTotp"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if code == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing code")
+	}
+
+	totp, err := lookupTotp(ctx, r.reader, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if totp == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("totp enrollment for account %s not found", accountId))
+	}
+	if totp.GetConfirmed() {
+		return nil, errors.New(ctx, errors.NotUnique, op, "totp enrollment is already confirmed")
+	}
+	if err := r.decryptTotp(ctx, scopeId, totp); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	step, ok := totp.verify(code, timeNow())
+	if !ok {
+		return nil, nil
+	}
+
+	codes, hashes, err := newRecoveryCodes(ctx, totp.GetPrivateId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	upd := allocTotp()
+	upd.PrivateId = totp.GetPrivateId()
+	upd.PasswordAccountId = accountId
+	upd.Confirmed = true
+	upd.LastUsedStep = step
+	items := make([]any, 0, len(hashes))
+	for _, h := range hashes {
+		items = append(items, h)
+	}
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			rowsUpdated, err := w.Update(ctx, upd, []string{"Confirmed", "LastUsedStep"}, nil,
+				db.WithOplog(oplogWrapper, upd.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithWhere("confirmed = false"))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.NotUnique, op, "totp enrollment is already confirmed")
+			}
+			if err := w.CreateItems(ctx, items, db.WithOplog(oplogWrapper, hashes[0].oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create recovery codes"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return codes, nil
+}
+
+// DeleteTotp deletes the TOTP enrollment and recovery codes of accountId,
+// confirmed or not, returning the number of enrollments deleted.  A confirmed
+// enrollment cannot be deleted while the account's auth method requires TOTP,
+// since the account could no longer authenticate; an error with code Conflict
+// is returned.
+func (r *Repository) DeleteTotp(ctx context.Context, scopeId, accountId string) (int, error) {
+	const op = "password.(Repository).DeleteTotp"
+	if accountId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			totp, err := lookupTotp(ctx, reader, accountId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if totp == nil {
+				rowsDeleted = 0
+				return nil
+			}
+			if totp.GetConfirmed() {
+				if err := checkTotpNotRequired(ctx, reader, accountTotpRequiredQuery, sql.Named("account_id", accountId)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			rowsDeleted, err = w.Delete(ctx, totp, db.WithOplog(oplogWrapper, totp.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return rowsDeleted, nil
+}
+
+// verifyTotp verifies the second factor of an account whose password has
+// been authenticated.  Accounts without a confirmed enrollment only pass if
+// their auth method does not require TOTP.  code is either a TOTP code, which
+// can only be used once, or a recovery code, which is deleted when used.
+//
+// Returns false if code is neither a valid TOTP code nor a recovery code.
+// Returns an error with code TotpCodeRequired if code is empty, and with code
+// TotpEnrollmentRequired if the auth method requires TOTP and the account has
+// no confirmed enrollment.
+func (r *Repository) verifyTotp(ctx context.Context, scopeId string, acct *authAccount, code string) (bool, error) {
+	const op = "password.(Repository).verifyTotp"
+	totp, err := lookupTotp(ctx, r.reader, acct.GetPublicId())
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case (totp == nil || !totp.GetConfirmed()) && acct.TotpRequired:
+		return false, errors.New(ctx, errors.TotpEnrollmentRequired, op, "auth method requires totp", errors.WithoutEvent())
+	case totp == nil || !totp.GetConfirmed():
+		return true, nil
+	case code == "":
+		return false, errors.New(ctx, errors.TotpCodeRequired, op, "missing totp code", errors.WithoutEvent())
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+	if err := r.decryptTotp(ctx, scopeId, totp); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+
+	if step, ok := totp.verify(code, timeNow()); ok {
+		upd := allocTotp()
+		upd.PrivateId = totp.GetPrivateId()
+		upd.PasswordAccountId = totp.GetPasswordAccountId()
+		upd.LastUsedStep = step
+		var rowsUpdated int
+		_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, w db.Writer) error {
+				var err error
+				// The where clause rejects a code which was used by a
+				// concurrent authentication.
+				rowsUpdated, err = w.Update(ctx, upd, []string{"LastUsedStep"}, nil,
+					db.WithOplog(oplogWrapper, upd.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+					db.WithWhere("last_used_step < ?", step))
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update last used step"))
+		}
+		return rowsUpdated == 1, nil
+	}
+
+	rc := &TotpRecoveryCode{
+		TotpRecoveryCode: &store.TotpRecoveryCode{
+			TotpId:   totp.GetPrivateId(),
+			CodeHash: recoveryCodeHash(code),
+		},
+	}
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Delete(ctx, rc, db.WithOplog(oplogWrapper, rc.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete recovery code"))
+	}
+	return rowsDeleted == 1, nil
+}
+
+func (r *Repository) decryptTotp(ctx context.Context, scopeId string, totp *Totp) error {
+	const op = "password.(Repository).decryptTotp"
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(totp.GetKeyId()))
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := totp.decrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to decrypt totp secret"))
+	}
+	return nil
+}
+
+// lookupTotp returns the TOTP enrollment of accountId, or nil if it has none.
+func lookupTotp(ctx context.Context, reader db.Reader, accountId string) (*Totp, error) {
+	const op = "password.lookupTotp"
+	totp := allocTotp()
+	if err := reader.LookupWhere(ctx, totp, "password_account_id = ?", []any{accountId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return totp, nil
+}
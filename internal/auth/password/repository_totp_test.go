@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Totp(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	scopeId := o.GetPublicId()
+	am := TestAuthMethods(t, conn, scopeId, 1)[0]
+	passwd := "12345678"
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	acct, err := NewAccount(ctx, am.GetPublicId(), WithLoginName("kazmierczak"))
+	require.NoError(t, err)
+	acct, err = repo.CreateAccount(ctx, scopeId, acct, WithPassword(passwd))
+	require.NoError(t, err)
+
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = time.Now })
+	codeAt := func(secret string, offset int64) string {
+		b, err := base32NoPadding.DecodeString(secret)
+		require.NoError(t, err)
+		return totpCode(b, now.Unix()/30+offset)
+	}
+	authenticate := func(code string) (*Account, error) {
+		return repo.Authenticate(ctx, scopeId, am.GetPublicId(), acct.GetLoginName(), passwd, WithTotpCode(code))
+	}
+
+	// An unconfirmed enrollment is not used to authenticate.
+	e, err := repo.EnrollTotp(ctx, scopeId, acct.GetPublicId())
+	require.NoError(t, err)
+	got, err := authenticate("")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	// Enrolling again replaces the unconfirmed enrollment.
+	e2, err := repo.EnrollTotp(ctx, scopeId, acct.GetPublicId())
+	require.NoError(t, err)
+	assert.NotEqual(t, e.Secret, e2.Secret)
+	codes, err := repo.ConfirmTotp(ctx, scopeId, acct.GetPublicId(), codeAt(e.Secret, 0))
+	require.NoError(t, err)
+	assert.Nil(t, codes)
+
+	codes, err = repo.ConfirmTotp(ctx, scopeId, acct.GetPublicId(), codeAt(e2.Secret, -1))
+	require.NoError(t, err)
+	require.Len(t, codes, recoveryCodeCount)
+
+	_, err = repo.EnrollTotp(ctx, scopeId, acct.GetPublicId())
+	assert.Truef(t, errors.Match(errors.T(errors.NotUnique), err), "unexpected error %v", err)
+	_, err = repo.ConfirmTotp(ctx, scopeId, acct.GetPublicId(), codeAt(e2.Secret, 0))
+	assert.Truef(t, errors.Match(errors.T(errors.NotUnique), err), "unexpected error %v", err)
+
+	t.Run("code-required", func(t *testing.T) {
+		got, err := authenticate("")
+		assert.Truef(t, errors.Match(errors.T(errors.TotpCodeRequired), err), "unexpected error %v", err)
+		assert.Nil(t, got)
+	})
+	t.Run("wrong-password", func(t *testing.T) {
+		got, err := repo.Authenticate(ctx, scopeId, am.GetPublicId(), acct.GetLoginName(), "wrong-password", WithTotpCode(codeAt(e2.Secret, 0)))
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("totp-code", func(t *testing.T) {
+		// The code used to confirm the enrollment can't be used again.
+		got, err := authenticate(codeAt(e2.Secret, -1))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		got, err = authenticate(codeAt(e2.Secret, 0))
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, acct.GetPublicId(), got.GetPublicId())
+
+		got, err = authenticate(codeAt(e2.Secret, 0))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		got, err = authenticate("000000")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("recovery-code", func(t *testing.T) {
+		got, err := authenticate(codes[0])
+		require.NoError(t, err)
+		require.NotNil(t, got)
+
+		got, err = authenticate(codes[0])
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("required", func(t *testing.T) {
+		upd := am.Clone()
+		upd.TotpRequired = true
+		upd, _, err := repo.UpdateAuthMethod(ctx, upd, am.GetVersion(), []string{"TotpRequired"})
+		require.NoError(t, err)
+		require.True(t, upd.GetTotpRequired())
+
+		got, err := authenticate(codes[1])
+		require.NoError(t, err)
+		require.NotNil(t, got)
+
+		// The account could no longer authenticate without its enrollment,
+		// so it can't be deleted while totp is required.
+		n, err := repo.DeleteTotp(ctx, scopeId, acct.GetPublicId())
+		assert.Truef(t, errors.Match(errors.T(errors.Conflict), err), "unexpected error %v", err)
+		assert.Equal(t, db.NoRowsAffected, n)
+		got, err = authenticate(codes[2])
+		require.NoError(t, err)
+		require.NotNil(t, got)
+
+		// For the same reason, no accounts can be created.
+		newAcct, err := NewAccount(ctx, am.GetPublicId(), WithLoginName("nowak"))
+		require.NoError(t, err)
+		newAcct, err = repo.CreateAccount(ctx, scopeId, newAcct, WithPassword(passwd))
+		assert.Truef(t, errors.Match(errors.T(errors.Conflict), err), "unexpected error %v", err)
+		assert.Nil(t, newAcct)
+
+		// Once totp is no longer required, the enrollment can be deleted.
+		am, err := repo.LookupAuthMethod(ctx, am.GetPublicId())
+		require.NoError(t, err)
+		upd = am.Clone()
+		upd.TotpRequired = false
+		_, _, err = repo.UpdateAuthMethod(ctx, upd, am.GetVersion(), []string{"TotpRequired"})
+		require.NoError(t, err)
+		n, err = repo.DeleteTotp(ctx, scopeId, acct.GetPublicId())
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		got, err = authenticate("")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		n, err = repo.DeleteTotp(ctx, scopeId, acct.GetPublicId())
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+}
+
+func TestRepository_Totp_RequireUnenrolled(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	scopeId := o.GetPublicId()
+	am := TestAuthMethods(t, conn, scopeId, 1)[0]
+	passwd := "12345678"
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	createAccount := func(loginName string) *Account {
+		t.Helper()
+		acct, err := NewAccount(ctx, am.GetPublicId(), WithLoginName(loginName))
+		require.NoError(t, err)
+		acct, err = repo.CreateAccount(ctx, scopeId, acct, WithPassword(passwd))
+		require.NoError(t, err)
+		return acct
+	}
+	enrolled := createAccount("kazmierczak")
+	unenrolled := createAccount("nowak")
+
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = time.Now })
+	confirm := func(accountId string) {
+		t.Helper()
+		e, err := repo.EnrollTotp(ctx, scopeId, accountId)
+		require.NoError(t, err)
+		b, err := base32NoPadding.DecodeString(e.Secret)
+		require.NoError(t, err)
+		codes, err := repo.ConfirmTotp(ctx, scopeId, accountId, totpCode(b, now.Unix()/30))
+		require.NoError(t, err)
+		require.NotEmpty(t, codes)
+	}
+	requireTotp := func() error {
+		am, err := repo.LookupAuthMethod(ctx, am.GetPublicId())
+		require.NoError(t, err)
+		upd := am.Clone()
+		upd.TotpRequired = true
+		_, _, err = repo.UpdateAuthMethod(ctx, upd, am.GetVersion(), []string{"TotpRequired"})
+		return err
+	}
+
+	// The unenrolled account could no longer authenticate in order to
+	// enroll, so totp can't be required yet.
+	confirm(enrolled.GetPublicId())
+	err = requireTotp()
+	assert.Truef(t, errors.Match(errors.T(errors.Conflict), err), "unexpected error %v", err)
+
+	// An unconfirmed enrollment is not enough either.
+	_, err = repo.EnrollTotp(ctx, scopeId, unenrolled.GetPublicId())
+	require.NoError(t, err)
+	err = requireTotp()
+	assert.Truef(t, errors.Match(errors.T(errors.Conflict), err), "unexpected error %v", err)
+
+	am, err = repo.LookupAuthMethod(ctx, am.GetPublicId())
+	require.NoError(t, err)
+	assert.False(t, am.GetTotpRequired())
+	got, err := repo.Authenticate(ctx, scopeId, am.GetPublicId(), unenrolled.GetLoginName(), passwd)
+	require.NoError(t, err)
+	assert.NotNil(t, got)
+
+	confirm(unenrolled.GetPublicId())
+	require.NoError(t, requireTotp())
+}
+
+func TestRepository_Totp_InvalidParameters(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	am := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	acct := TestAccount(t, conn, am.GetPublicId(), "kazmierczak")
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	isInvalid := func(err error) bool { return errors.Match(errors.T(errors.InvalidParameter), err) }
+	_, err = repo.EnrollTotp(ctx, o.GetPublicId(), "")
+	assert.True(t, isInvalid(err))
+	_, err = repo.EnrollTotp(ctx, "", acct.GetPublicId())
+	assert.True(t, isInvalid(err))
+	_, err = repo.ConfirmTotp(ctx, o.GetPublicId(), acct.GetPublicId(), "")
+	assert.True(t, isInvalid(err))
+	_, err = repo.DeleteTotp(ctx, o.GetPublicId(), "")
+	assert.True(t, isInvalid(err))
+
+	_, err = repo.ConfirmTotp(ctx, o.GetPublicId(), acct.GetPublicId(), "123456")
+	assert.True(t, errors.IsNotFoundError(err))
+	_, err = repo.EnrollTotp(ctx, o.GetPublicId(), "apw_1234567890")
+	assert.True(t, errors.IsNotFoundError(err))
+}
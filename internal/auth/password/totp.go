@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package password
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// TOTP parameters.  These are the defaults of RFC 6238 and the only values
+// supported by most authenticator apps.
+const (
+	totpPeriod       = 30 * time.Second
+	totpDigits       = 6
+	totpSecretLength = 20
+
+	// totpSkew is the number of time steps before and after the current one
+	// for which codes are accepted, to allow for clock drift.
+	totpSkew = 1
+
+	// totpIssuer is the issuer shown by authenticator apps.
+	totpIssuer = "Boundary"
+
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+)
+
+var (
+	base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	// timeNow is replaced in tests.
+	timeNow = time.Now
+)
+
+// A Totp is the TOTP enrollment of an Account.  It is owned by an Account.
+type Totp struct {
+	*store.Totp
+	tableName string
+}
+
+func allocTotp() *Totp {
+	return &Totp{
+		Totp: &store.Totp{},
+	}
+}
+
+func newTotp(ctx context.Context, accountId string) (*Totp, error) {
+	const op = "password.newTotp"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	id, err := newTotpId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	secret := make([]byte, totpSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	return &Totp{
+		Totp: &store.Totp{
+			PrivateId:         id,
+			PasswordAccountId: accountId,
+			Secret:            secret,
+		},
+	}, nil
+}
+
+func (t *Totp) clone() *Totp {
+	cp := proto.Clone(t.Totp)
+	return &Totp{
+		Totp: cp.(*store.Totp),
+	}
+}
+
+// TableName returns the table name.
+func (t *Totp) TableName() string {
+	if t != nil && t.tableName != "" {
+		return t.tableName
+	}
+	return "auth_password_totp"
+}
+
+// SetTableName sets the table name.
+func (t *Totp) SetTableName(n string) {
+	t.tableName = n
+}
+
+func (t *Totp) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(Totp).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, t.Totp, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	t.KeyId = keyId
+	return nil
+}
+
+func (t *Totp) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(Totp).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t.Totp, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (t *Totp) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id":  []string{t.PrivateId},
+		"resource-type":       []string{"password totp"},
+		"op-type":             []string{op.String()},
+		"password-account-id": []string{t.PasswordAccountId},
+	}
+}
+
+// verify reports whether code is the TOTP code of a time step within
+// totpSkew steps of now and after the last used step.  The matching step is
+// returned.
+func (t *Totp) verify(code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	current := now.Unix() / int64(totpPeriod/time.Second)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= t.LastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(t.Secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpCode returns the TOTP code of the time step, as defined by RFC 6238
+// using HMAC-SHA1.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	// Dynamic truncation, RFC 4226 section 5.3.
+	offset := sum[len(sum)-1] & 0x0f
+	v := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, v%1000000)
+}
+
+// A TotpEnrollment is an unconfirmed TOTP enrollment of an Account.  It is
+// returned once, when the enrollment is created, so the secret can be added
+// to an authenticator app.
+type TotpEnrollment struct {
+	AccountId string
+	// Secret is the base32 encoded TOTP secret.
+	Secret string
+	// Url is the otpauth:// URL of the secret, which authenticator apps
+	// accept as a QR code.
+	Url string
+}
+
+func newTotpEnrollment(t *Totp, loginName string) *TotpEnrollment {
+	secret := base32NoPadding.EncodeToString(t.Secret)
+	label := url.PathEscape(totpIssuer + ":" + loginName)
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", totpIssuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(totpDigits))
+	q.Set("period", fmt.Sprint(int(totpPeriod/time.Second)))
+	return &TotpEnrollment{
+		AccountId: t.PasswordAccountId,
+		Secret:    secret,
+		Url:       "otpauth://totp/" + label + "?" + q.Encode(),
+	}
+}
+
+// A TotpRecoveryCode is the hash of a single use code which can be used
+// instead of a TOTP code.  It is owned by a Totp.
+type TotpRecoveryCode struct {
+	*store.TotpRecoveryCode
+	tableName string
+}
+
+// TableName returns the table name.
+func (c *TotpRecoveryCode) TableName() string {
+	if c != nil && c.tableName != "" {
+		return c.tableName
+	}
+	return "auth_password_totp_recovery_code"
+}
+
+// SetTableName sets the table name.
+func (c *TotpRecoveryCode) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *TotpRecoveryCode) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id": []string{c.TotpId},
+		"resource-type":      []string{"password totp recovery code"},
+		"op-type":            []string{op.String()},
+	}
+}
+
+// newRecoveryCodes returns new recovery codes and their hashes.  Codes are
+// formatted as two groups of lowercase base32 characters, e.g. "abcde-fghij".
+func newRecoveryCodes(ctx context.Context, totpId string) ([]string, []*TotpRecoveryCode, error) {
+	const op = "password.newRecoveryCodes"
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]*TotpRecoveryCode, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+		}
+		code := strings.ToLower(base32NoPadding.EncodeToString(b))[:recoveryCodeLength]
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+		hashes = append(hashes, &TotpRecoveryCode{
+			TotpRecoveryCode: &store.TotpRecoveryCode{
+				TotpId:   totpId,
+				CodeHash: recoveryCodeHash(code),
+			},
+		})
+	}
+	return codes, hashes, nil
+}
+
+// recoveryCodeHash returns the hash of a recovery code, ignoring case,
+// whitespace and dashes.
+func recoveryCodeHash(code string) []byte {
+	code = strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ', '\t':
+			return -1
+		}
+		return r
+	}, strings.ToLower(code))
+	sum := sha256.Sum256([]byte(code))
+	return sum[:]
+}
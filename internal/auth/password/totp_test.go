@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package password
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_totpCode(t *testing.T) {
+	// The SHA1 test vectors of RFC 6238 appendix B, truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		time int64
+		want string
+	}{
+		{time: 59, want: "287082"},
+		{time: 1111111109, want: "081804"},
+		{time: 1111111111, want: "050471"},
+		{time: 1234567890, want: "005924"},
+		{time: 2000000000, want: "279037"},
+		{time: 20000000000, want: "353130"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, totpCode(secret, tt.time/30), "time %d", tt.time)
+	}
+}
+
+func TestTotp_verify(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111111, 0)
+	step := now.Unix() / 30
+
+	tests := []struct {
+		name         string
+		code         string
+		lastUsedStep int64
+		wantStep     int64
+		wantOk       bool
+	}{
+		{name: "current", code: totpCode(secret, step), wantStep: step, wantOk: true},
+		{name: "previous", code: totpCode(secret, step-1), wantStep: step - 1, wantOk: true},
+		{name: "next", code: totpCode(secret, step+1), wantStep: step + 1, wantOk: true},
+		{name: "whitespace", code: " " + totpCode(secret, step) + " ", wantStep: step, wantOk: true},
+		{name: "too-old", code: totpCode(secret, step-2)},
+		{name: "too-new", code: totpCode(secret, step+2)},
+		{name: "reused", code: totpCode(secret, step), lastUsedStep: step},
+		{name: "after-later-code", code: totpCode(secret, step-1), lastUsedStep: step},
+		{name: "wrong", code: "000000"},
+		{name: "short", code: "12345"},
+		{name: "empty", code: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			totp := &Totp{Totp: &store.Totp{Secret: secret, LastUsedStep: tt.lastUsedStep}}
+			gotStep, gotOk := totp.verify(tt.code, now)
+			assert.Equal(tt.wantOk, gotOk)
+			assert.Equal(tt.wantStep, gotStep)
+		})
+	}
+}
+
+func Test_newTotpEnrollment(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	totp, err := newTotp(context.Background(), "apw_1234567890")
+	require.NoError(err)
+	assert.Len(totp.Secret, totpSecretLength)
+
+	e := newTotpEnrollment(totp, "alice")
+	assert.Equal("apw_1234567890", e.AccountId)
+	secret, err := base32NoPadding.DecodeString(e.Secret)
+	require.NoError(err)
+	assert.Equal(totp.Secret, secret)
+
+	u, err := url.Parse(e.Url)
+	require.NoError(err)
+	assert.Equal("otpauth", u.Scheme)
+	assert.Equal("totp", u.Host)
+	assert.Equal("/Boundary:alice", u.Path)
+	assert.Equal(e.Secret, u.Query().Get("secret"))
+	assert.Equal("Boundary", u.Query().Get("issuer"))
+	assert.Equal("6", u.Query().Get("digits"))
+	assert.Equal("30", u.Query().Get("period"))
+}
+
+func Test_newRecoveryCodes(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	codes, hashes, err := newRecoveryCodes(context.Background(), "pwtotp_1234567890")
+	require.NoError(err)
+	require.Len(codes, recoveryCodeCount)
+	require.Len(hashes, recoveryCodeCount)
+
+	seen := make(map[string]bool)
+	for i, c := range codes {
+		assert.Len(c, recoveryCodeLength+1)
+		assert.Equal(byte('-'), c[recoveryCodeLength/2])
+		assert.False(seen[c], "duplicate recovery code")
+		seen[c] = true
+
+		assert.Equal("pwtotp_1234567890", hashes[i].TotpId)
+		assert.Equal(recoveryCodeHash(c), hashes[i].CodeHash)
+		// Codes are accepted regardless of case and dashes.
+		assert.Equal(hashes[i].CodeHash, recoveryCodeHash(strings.ToUpper(strings.ReplaceAll(c, "-", ""))))
+	}
+}
@@ -54,15 +54,28 @@ func (atv *authTokenView) toAuthToken() *AuthToken {
 type AuthToken struct {
 	*store.AuthToken
 	tableName string `gorm:"-"`
+
+	// grants are the grants of an API token, which are stored separately.
+	grants []string `gorm:"-"`
 }
 
 func (s *AuthToken) clone() *AuthToken {
 	cp := proto.Clone(s.AuthToken)
 	return &AuthToken{
 		AuthToken: cp.(*store.AuthToken),
+		grants:    append([]string(nil), s.grants...),
 	}
 }
 
+// Grants returns the grants of an API token.  If an API token has grants, its
+// permissions are limited to the permissions of its user which are also
+// allowed by its grants.  It is empty for API tokens without grants and auth
+// tokens created by authenticating, which have all the permissions of their
+// user.
+func (s *AuthToken) Grants() []string {
+	return s.grants
+}
+
 // allocAuthToken is just easier/better than leaking the underlying type
 // bits to the repo, since the repo needs to alloc this type quite often.
 func allocAuthToken() *AuthToken {
@@ -12,9 +12,18 @@
 // expiration time and a last accessed time which are used to determine if the
 // token can still be used.
 //
+// An API token is a long-lived, named auth token created by its user instead
+// of by authenticating.  It has an explicit expiration time and never becomes
+// stale.  Its permissions can be limited to a subset of the permissions of its
+// user by grants.
+//
+// A token can be refreshed, which replaces its value and resets its last
+// accessed time without changing its expiration time.  This allows clients to
+// keep using a token for its whole lifetime without it becoming stale.
+//
 // # Repository
 //
 // A repository provides methods for creating, validating a provided token value,
-// and deleting the auth token.  At validation time if the token is determined
+// refreshing, and deleting the auth token.  At validation time if the token is determined
 // to be expired or stale it will be removed from the backing storage by the repo.
 package authtoken
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authtoken
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/authtoken/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/perms"
+)
+
+// defaultAuthTokenGrantTableName is the table where the grants of API tokens
+// are stored.
+const defaultAuthTokenGrantTableName = "auth_token_grant"
+
+// authTokenGrant is a grant of an API token.  The permissions of an API token
+// with grants are limited to the permissions of its user which are also
+// allowed by its grants.
+type authTokenGrant struct {
+	*store.AuthTokenGrant
+	tableName string `gorm:"-"`
+}
+
+func allocAuthTokenGrant() *authTokenGrant {
+	return &authTokenGrant{
+		AuthTokenGrant: &store.AuthTokenGrant{},
+	}
+}
+
+// newAuthTokenGrant creates a new in memory grant of the API token with
+// authTokenId.  The grant must parse successfully.
+func newAuthTokenGrant(ctx context.Context, authTokenId, grant string) (*authTokenGrant, error) {
+	const op = "authtoken.newAuthTokenGrant"
+	if authTokenId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth token id")
+	}
+	if grant == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing grant")
+	}
+	// Validate that the grant parses successfully. Note that we fake the scope
+	// here to avoid a lookup as the scope is only relevant at actual ACL
+	// checking time and we just care that it parses correctly.
+	perm, err := perms.Parse(ctx, "o_abcd1234", grant)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("parsing grant string"))
+	}
+	return &authTokenGrant{
+		AuthTokenGrant: &store.AuthTokenGrant{
+			AuthTokenId:    authTokenId,
+			RawGrant:       grant,
+			CanonicalGrant: perm.CanonicalString(),
+		},
+	}, nil
+}
+
+// TableName returns the table name for the auth token grant.
+func (g *authTokenGrant) TableName() string {
+	if g.tableName != "" {
+		return g.tableName
+	}
+	return defaultAuthTokenGrantTableName
+}
+
+// SetTableName sets the table name.  If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (g *authTokenGrant) SetTableName(n string) {
+	g.tableName = n
+}
@@ -12,8 +12,9 @@ import (
 )
 
 var (
-	defaultTokenTimeToLiveDuration  = 7 * 24 * time.Hour
-	defaultTokenTimeToStaleDuration = 24 * time.Hour
+	defaultTokenTimeToLiveDuration    = 7 * 24 * time.Hour
+	defaultTokenTimeToStaleDuration   = 24 * time.Hour
+	defaultApiTokenTimeToLiveDuration = 90 * 24 * time.Hour
 )
 
 // getOpts - iterate the inbound Options and return a struct
@@ -38,6 +39,9 @@ type options struct {
 	withPublicId                 string
 	withPasswordOptions          []password.Option
 	withIamOptions               []iam.Option
+	withDescription              string
+	withExpirationTime           time.Time
+	withGrants                   []string
 }
 
 func getDefaultOptions() options {
@@ -116,3 +120,27 @@ func WithIamOptions(with ...iam.Option) Option {
 		o.withIamOptions = with
 	}
 }
+
+// WithDescription provides an optional description for an API token.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithExpirationTime provides an optional expiration time for an API token.
+// If it is not provided, API tokens expire after 90 days.
+func WithExpirationTime(t time.Time) Option {
+	return func(o *options) {
+		o.withExpirationTime = t
+	}
+}
+
+// WithGrants provides optional grants for an API token, which limit its
+// permissions to the permissions of its user which are also allowed by the
+// grants.
+func WithGrants(grants []string) Option {
+	return func(o *options) {
+		o.withGrants = grants
+	}
+}
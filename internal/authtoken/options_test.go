@@ -71,4 +71,30 @@ func Test_GetOpts(t *testing.T) {
 		opts = getOpts(WithIamOptions(iam.WithName("foobar")))
 		assert.NotEmpty(opts.withIamOptions)
 	})
+
+	t.Run("WithDescription", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDescription("test-desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test-desc"
+		assert.Equal(opts, testOpts)
+	})
+
+	t.Run("WithExpirationTime", func(t *testing.T) {
+		assert := assert.New(t)
+		exp := time.Now().Add(time.Hour)
+		opts := getOpts(WithExpirationTime(exp))
+		testOpts := getDefaultOptions()
+		testOpts.withExpirationTime = exp
+		assert.Equal(opts, testOpts)
+	})
+
+	t.Run("WithGrants", func(t *testing.T) {
+		assert := assert.New(t)
+		grants := []string{"id=*;type=target;actions=read"}
+		opts := getOpts(WithGrants(grants))
+		testOpts := getDefaultOptions()
+		testOpts.withGrants = grants
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -148,6 +148,126 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 	return newAuthToken, nil
 }
 
+// CreateApiToken inserts a long-lived API token with name into the
+// repository and returns it.  The returned API token contains the token value.
+// The provided IAM User ID must be associated to the provided auth account id
+// or an error will be returned.  The name must be unique within the auth
+// account.  API tokens expire after 90 days unless WithExpirationTime is
+// provided, and do not become stale.  If WithGrants is provided, the
+// permissions of the API token are limited to the permissions of the user
+// which are also allowed by the grants.  The WithDescription, WithExpirationTime,
+// WithGrants and WithPublicId options are supported and all other options are
+// ignored.
+func (r *Repository) CreateApiToken(ctx context.Context, withIamUser *iam.User, withAuthAccountId, name string, opt ...Option) (*AuthToken, error) {
+	const op = "authtoken.(Repository).CreateApiToken"
+	if withIamUser == nil || withIamUser.User == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user")
+	}
+	if withIamUser.GetPublicId() == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	if withAuthAccountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth account id")
+	}
+	if name == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	opts := getOpts(opt...)
+	expiration := time.Now().Add(defaultApiTokenTimeToLiveDuration)
+	if !opts.withExpirationTime.IsZero() {
+		if !opts.withExpirationTime.After(time.Now()) {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "expiration time is not in the future")
+		}
+		expiration = opts.withExpirationTime
+	}
+
+	at, err := newAuthToken(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if opts.withPublicId == "" {
+		id, err := NewAuthTokenId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		opts.withPublicId = id
+	}
+	at.PublicId = opts.withPublicId
+	at.AuthAccountId = withAuthAccountId
+	at.Status = string(IssuedStatus)
+	at.Name = name
+	at.Description = opts.withDescription
+	at.ApiToken = true
+
+	grants := make([]any, 0, len(opts.withGrants))
+	for _, g := range opts.withGrants {
+		grant, err := newAuthTokenGrant(ctx, at.PublicId, g)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		grants = append(grants, grant)
+		at.grants = append(at.grants, g)
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, withIamUser.GetScopeId(), kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	// We truncate the expiration time to the nearest second to make testing in different platforms with
+	// different time resolutions easier.
+	expirationProto, err := ptypes.TimestampProto(expiration.Truncate(time.Second))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidTimeStamp))
+	}
+	at.ExpirationTime = &timestamp.Timestamp{Timestamp: expirationProto}
+
+	var newApiToken *AuthToken
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, w db.Writer) error {
+			acct := allocAuthAccount()
+			acct.PublicId = withAuthAccountId
+			if err := read.LookupByPublicId(ctx, acct); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("auth account lookup"))
+			}
+			if acct.GetIamUserId() != withIamUser.GetPublicId() {
+				return errors.New(ctx, errors.InvalidParameter, op,
+					fmt.Sprintf("auth account %q mismatch with iam user %q", withAuthAccountId, withIamUser.GetPublicId()))
+			}
+			at.ScopeId = acct.GetScopeId()
+			at.AuthMethodId = acct.GetAuthMethodId()
+			at.IamUserId = acct.GetIamUserId()
+
+			newApiToken = at.clone()
+			if err := newApiToken.encrypt(ctx, databaseWrapper); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			// tokens are not replicated, so they don't need oplog entries.
+			if err := w.Create(ctx, newApiToken); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if len(grants) > 0 {
+				if err := w.CreateItems(ctx, grants); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create grants"))
+				}
+			}
+			newApiToken.CtToken = nil
+
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("api token %q already exists for auth account %q", name, withAuthAccountId))
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return newApiToken, nil
+}
+
 // LookupAuthToken returns the AuthToken for the provided id. Returns nil, nil if no AuthToken is found for id.
 // For security reasons, the actual token is not included in the returned AuthToken.
 // All exported options are ignored.
@@ -170,6 +290,13 @@ func (r *Repository) LookupAuthToken(ctx context.Context, id string, opt ...Opti
 	}
 
 	at := atv.toAuthToken()
+	if at.GetApiToken() {
+		grants, err := r.lookupGrants(ctx, at.GetPublicId())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		at.grants = grants[at.GetPublicId()]
+	}
 	if opts.withTokenValue {
 		databaseWrapper, err := r.kms.GetWrapper(ctx, at.GetScopeId(), kms.KeyPurposeDatabase, kms.WithKeyId(at.GetKeyId()))
 		if err != nil {
@@ -225,9 +352,12 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 
 	now := time.Now()
 	sinceLastAccessed := now.Sub(lastAccessed) + timeSkew
+	// API tokens are long-lived and used by automation which may only run
+	// occasionally, so they only expire and never become stale.
+	stale := sinceLastAccessed >= r.timeToStaleDuration && !retAT.GetApiToken()
 	// TODO (jimlambrt 9/2020) - investigate the need for the timeSkew and see
 	// if it can be eliminated.
-	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= r.timeToStaleDuration {
+	if now.After(exp.Add(-timeSkew)) || stale {
 		// If the token has expired or has become too stale, delete it from the DB.
 		_, err = r.writer.DoTx(
 			ctx,
@@ -290,6 +420,101 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	return retAT, nil
 }
 
+// RefreshAuthToken rotates the value of the issued auth token with the
+// provided id and resets its approximate last access time, so the token does
+// not become stale.  The previous token value is invalid once the token has
+// been refreshed.  The expiration time of the token is not changed, so a token
+// can only be refreshed until it reaches its time to live.  The returned auth
+// token contains the new token value.  If no valid auth token is found for the
+// id a RecordNotFound error is returned.  All options are ignored.
+//
+// NOTE: Do not log or add the token string to any errors to avoid leaking it as it is a secret.
+func (r *Repository) RefreshAuthToken(ctx context.Context, id string, opt ...Option) (*AuthToken, error) {
+	const op = "authtoken.(Repository).RefreshAuthToken"
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+
+	at, err := r.LookupAuthToken(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if at == nil || at.GetStatus() != string(IssuedStatus) {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "issued auth token not found")
+	}
+
+	exp, err := ptypes.Timestamp(at.GetExpirationTime().GetTimestamp())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("expiration time"), errors.WithCode(errors.InvalidTimeStamp))
+	}
+	lastAccessed, err := ptypes.Timestamp(at.GetApproximateLastAccessTime().GetTimestamp())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("last accessed time"), errors.WithCode(errors.InvalidTimeStamp))
+	}
+	now := time.Now()
+	stale := now.Sub(lastAccessed)+timeSkew >= r.timeToStaleDuration && !at.GetApiToken()
+	if now.After(exp.Add(-timeSkew)) || stale {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "auth token has expired")
+	}
+
+	newToken, err := newAuthToken(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, at.GetScopeId(), kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	var refreshed *AuthToken
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			updateAt := allocAuthToken()
+			updateAt.PublicId = id
+			updateAt.Token = newToken.GetToken()
+			if err := updateAt.encrypt(ctx, databaseWrapper); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			// Tokens are not replicated, so they don't need oplog entries.
+			// Setting the ApproximateLastAccessTime to null through using the
+			// null mask allows a defined db's trigger to set
+			// ApproximateLastAccessTime to the commit timestamp.
+			rowsUpdated, err := w.Update(ctx, updateAt, []string{"CtToken", "KeyId"}, []string{"ApproximateLastAccessTime"}, db.WithWhere("status = ?", IssuedStatus))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated == 0 {
+				return errors.New(ctx, errors.RecordNotFound, op, "issued auth token not found")
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+
+			// we need a new repo, that's using the same reader/writer as this TxHandler
+			txRepo := Repository{
+				reader: reader,
+				writer: w,
+				kms:    r.kms,
+			}
+			refreshed, err = txRepo.LookupAuthToken(ctx, id, withTokenValue())
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if refreshed == nil {
+				return errors.New(ctx, errors.RecordNotFound, op, "refreshed auth token not found")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(id))
+	}
+	return refreshed, nil
+}
+
 // ListAuthTokens lists auth tokens in the given scopes and supports the
 // WithLimit option.
 func (r *Repository) ListAuthTokens(ctx context.Context, withScopeIds []string, opt ...Option) ([]*AuthToken, error) {
@@ -305,16 +530,46 @@ func (r *Repository) ListAuthTokens(ctx context.Context, withScopeIds []string,
 	if err := r.reader.SearchWhere(ctx, &atvs, "auth_account_id in (select public_id from auth_account where scope_id in (?))", []any{withScopeIds}, db.WithLimit(opts.withLimit)); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	var apiTokenIds []string
+	for _, atv := range atvs {
+		if atv.GetApiToken() {
+			apiTokenIds = append(apiTokenIds, atv.GetPublicId())
+		}
+	}
+	grants, err := r.lookupGrants(ctx, apiTokenIds...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	authTokens := make([]*AuthToken, 0, len(atvs))
 	for _, atv := range atvs {
 		atv.Token = ""
 		atv.CtToken = nil
 		atv.KeyId = ""
-		authTokens = append(authTokens, atv.toAuthToken())
+		at := atv.toAuthToken()
+		at.grants = grants[at.GetPublicId()]
+		authTokens = append(authTokens, at)
 	}
 	return authTokens, nil
 }
 
+// lookupGrants returns the raw grants of the API tokens with the provided
+// ids, keyed by the id of the API token.
+func (r *Repository) lookupGrants(ctx context.Context, authTokenIds ...string) (map[string][]string, error) {
+	const op = "authtoken.(Repository).lookupGrants"
+	if len(authTokenIds) == 0 {
+		return nil, nil
+	}
+	var grants []*authTokenGrant
+	if err := r.reader.SearchWhere(ctx, &grants, "auth_token_id in (?)", []any{authTokenIds}, db.WithLimit(-1), db.WithOrder("create_time, canonical_grant")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := make(map[string][]string, len(authTokenIds))
+	for _, g := range grants {
+		ret[g.GetAuthTokenId()] = append(ret[g.GetAuthTokenId()], g.GetRawGrant())
+	}
+	return ret, nil
+}
+
 // DeleteAuthToken deletes the token with the provided id from the repository returning a count of the
 // number of records deleted.  All options are ignored.
 func (r *Repository) DeleteAuthToken(ctx context.Context, id string, opt ...Option) (int, error) {
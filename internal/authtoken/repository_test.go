@@ -297,6 +297,146 @@ func TestRepository_CreateAuthToken(t *testing.T) {
 	}
 }
 
+func TestRepository_CreateApiToken(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo := iam.TestRepo(t, conn, wrapper)
+
+	org1, _ := iam.TestScopes(t, repo)
+	am := password.TestAuthMethods(t, conn, org1.GetPublicId(), 1)[0]
+	aAcct := password.TestAccount(t, conn, am.GetPublicId(), "name1")
+	iam.TestSetPrimaryAuthMethod(t, repo, org1, am.PublicId)
+	u1 := iam.TestUser(t, repo, org1.PublicId, iam.WithAccountIds(aAcct.PublicId))
+
+	org2, _ := iam.TestScopes(t, repo)
+	u2 := iam.TestUser(t, repo, org2.GetPublicId())
+
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name       string
+		iamUser    *iam.User
+		authAcctId string
+		tokenName  string
+		opt        []Option
+		wantErr    bool
+	}{
+		{
+			name:       "valid",
+			iamUser:    u1,
+			authAcctId: aAcct.GetPublicId(),
+			tokenName:  "valid",
+		},
+		{
+			name:       "valid-with-options",
+			iamUser:    u1,
+			authAcctId: aAcct.GetPublicId(),
+			tokenName:  "valid-with-options",
+			opt: []Option{
+				WithDescription("desc"),
+				WithExpirationTime(expiration),
+				WithGrants([]string{"id=*;type=target;actions=read,list", "id=*;type=session;actions=list"}),
+			},
+		},
+		{
+			name:       "duplicate-name",
+			iamUser:    u1,
+			authAcctId: aAcct.GetPublicId(),
+			tokenName:  "valid",
+			wantErr:    true,
+		},
+		{
+			name:       "no-name",
+			iamUser:    u1,
+			authAcctId: aAcct.GetPublicId(),
+			wantErr:    true,
+		},
+		{
+			name:       "expiration-in-the-past",
+			iamUser:    u1,
+			authAcctId: aAcct.GetPublicId(),
+			tokenName:  "expiration-in-the-past",
+			opt:        []Option{WithExpirationTime(time.Now().Add(-time.Hour))},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid-grant",
+			iamUser:    u1,
+			authAcctId: aAcct.GetPublicId(),
+			tokenName:  "invalid-grant",
+			opt:        []Option{WithGrants([]string{"id=*;actions=invalid"})},
+			wantErr:    true,
+		},
+		{
+			name:       "unconnected-authaccount-user",
+			iamUser:    u2,
+			authAcctId: aAcct.GetPublicId(),
+			tokenName:  "unconnected-authaccount-user",
+			wantErr:    true,
+		},
+		{
+			name:      "no-authacctid",
+			iamUser:   u1,
+			tokenName: "no-authacctid",
+			wantErr:   true,
+		},
+		{
+			name:       "no-userid",
+			authAcctId: aAcct.GetPublicId(),
+			tokenName:  "no-userid",
+			wantErr:    true,
+		},
+	}
+
+	// The tests must run in order since the duplicate name test depends on the
+	// valid test.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(ctx, rw, rw, kms)
+			require.NoError(err)
+			require.NotNil(repo)
+			got, err := repo.CreateApiToken(ctx, tt.iamUser, tt.authAcctId, tt.tokenName, tt.opt...)
+			if tt.wantErr {
+				assert.Error(err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			db.AssertPublicId(t, globals.AuthTokenPrefix, got.PublicId)
+			assert.NotEmpty(got.GetToken())
+			assert.True(got.GetApiToken())
+			assert.Equal(tt.tokenName, got.GetName())
+			assert.Equal(tt.authAcctId, got.GetAuthAccountId())
+			assert.Equal(string(IssuedStatus), got.GetStatus())
+
+			opts := getOpts(tt.opt...)
+			assert.Equal(opts.withDescription, got.GetDescription())
+			gotExpiration := got.GetExpirationTime().AsTime()
+			if opts.withExpirationTime.IsZero() {
+				assert.WithinDuration(time.Now().Add(defaultApiTokenTimeToLiveDuration), gotExpiration, time.Minute)
+			} else {
+				assert.True(opts.withExpirationTime.Equal(gotExpiration))
+			}
+			assert.ElementsMatch(opts.withGrants, got.Grants())
+
+			found, err := repo.LookupAuthToken(ctx, got.GetPublicId())
+			require.NoError(err)
+			require.NotNil(found)
+			assert.True(found.GetApiToken())
+			assert.Equal(tt.tokenName, found.GetName())
+			assert.ElementsMatch(opts.withGrants, found.Grants())
+
+			// We should find no oplog since tokens are not replicated, so they don't need oplog entries.
+			assert.Error(db.TestVerifyOplog(t, rw, got.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_CREATE)))
+		})
+	}
+}
+
 func TestRepository_LookupAuthToken(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -573,6 +713,142 @@ func TestRepository_ValidateToken_expired(t *testing.T) {
 	}
 }
 
+func TestRepository_ValidateToken_apiToken(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	baseAT := TestAuthToken(t, conn, kms, org.GetPublicId())
+	aAcct := allocAuthAccount()
+	aAcct.PublicId = baseAT.GetAuthAccountId()
+	require.NoError(t, rw.LookupByPublicId(ctx, aAcct))
+	iamUser, _, err := iamRepo.LookupUser(ctx, aAcct.GetIamUserId())
+	require.NoError(t, err)
+	require.NotNil(t, iamUser)
+
+	assert, require := assert.New(t), require.New(t)
+	timeSkew = 20 * time.Millisecond
+	repo, err := NewRepository(ctx, rw, rw, kms, WithTokenTimeToStaleDuration(1*time.Millisecond))
+	require.NoError(err)
+
+	at, err := repo.CreateApiToken(ctx, iamUser, baseAT.GetAuthAccountId(), "automation")
+	require.NoError(err)
+
+	// API tokens never become stale, only expire.
+	got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+	require.NoError(err)
+	assert.NotNil(got)
+}
+
+func TestRepository_RefreshAuthToken(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	baseAT := TestAuthToken(t, conn, kms, org.GetPublicId())
+	aAcct := allocAuthAccount()
+	aAcct.PublicId = baseAT.GetAuthAccountId()
+	require.NoError(t, rw.LookupByPublicId(ctx, aAcct))
+	iamUser, _, err := iamRepo.LookupUser(ctx, aAcct.GetIamUserId())
+	require.NoError(t, err)
+	require.NotNil(t, iamUser)
+
+	tests := []struct {
+		name               string
+		staleDuration      time.Duration
+		expirationDuration time.Duration
+		id                 string
+		wantErr            errors.Code
+	}{
+		{
+			name:               "valid",
+			staleDuration:      defaultTokenTimeToStaleDuration,
+			expirationDuration: defaultTokenTimeToLiveDuration,
+		},
+		{
+			name:               "missing-id",
+			staleDuration:      defaultTokenTimeToStaleDuration,
+			expirationDuration: defaultTokenTimeToLiveDuration,
+			id:                 "-",
+			wantErr:            errors.InvalidPublicId,
+		},
+		{
+			name:               "not-found",
+			staleDuration:      defaultTokenTimeToStaleDuration,
+			expirationDuration: defaultTokenTimeToLiveDuration,
+			id:                 globals.AuthTokenPrefix + "_1234567890",
+			wantErr:            errors.RecordNotFound,
+		},
+		{
+			name:               "stale",
+			staleDuration:      1 * time.Millisecond,
+			expirationDuration: defaultTokenTimeToLiveDuration,
+			wantErr:            errors.RecordNotFound,
+		},
+		{
+			name:               "expired",
+			staleDuration:      defaultTokenTimeToStaleDuration,
+			expirationDuration: 1 * time.Millisecond,
+			wantErr:            errors.RecordNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			timeSkew = 20 * time.Millisecond
+
+			repo, err := NewRepository(ctx, rw, rw, kms,
+				WithTokenTimeToLiveDuration(tt.expirationDuration),
+				WithTokenTimeToStaleDuration(tt.staleDuration))
+			require.NoError(err)
+
+			at, err := repo.CreateAuthToken(ctx, iamUser, baseAT.GetAuthAccountId())
+			require.NoError(err)
+
+			id := at.GetPublicId()
+			switch tt.id {
+			case "-":
+				id = ""
+			case "":
+			default:
+				id = tt.id
+			}
+
+			got, err := repo.RefreshAuthToken(ctx, id)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal(at.GetPublicId(), got.GetPublicId())
+			assert.NotEmpty(got.GetToken())
+			assert.NotEqual(at.GetToken(), got.GetToken())
+			assert.Empty(cmp.Diff(at.GetExpirationTime(), got.GetExpirationTime(), protocmp.Transform()))
+
+			// The previous token value is no longer valid.
+			validated, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+			require.NoError(err)
+			assert.Nil(validated)
+
+			validated, err = repo.ValidateToken(ctx, got.GetPublicId(), got.GetToken())
+			require.NoError(err)
+			assert.NotNil(validated)
+		})
+	}
+}
+
 func TestRepository_DeleteAuthToken(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
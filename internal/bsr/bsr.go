@@ -25,6 +25,7 @@ const (
 	messagesFileNameTemplate   = "messages-%s.data"
 	requestsFileNameTemplate   = "requests-%s.data"
 	sessionMetaFileName        = "session-meta.json"
+	dictionaryFileName         = "compression.dict"
 
 	bsrPubKeyFileName           = "bsrKey.pub"
 	wrappedBsrKeyFileName       = "wrappedBsrKey"
@@ -45,6 +46,15 @@ type Session struct {
 }
 
 // NewSession creates a Session container for a given session id.
+// Supports the following options:
+//   - WithSupportsMultiplex: the session's protocol records channels.
+//   - WithCompression: the Compression for the session's chunks, which is
+//     returned by the Compression method of its containers.
+//   - WithEncryption: the Encryption for the session's chunks, which is
+//     returned by the Encryption method of its containers.
+//
+// A zstd dictionary is selected for each connection or channel, since the
+// data of a session's channels differs too much to share one.
 func NewSession(ctx context.Context, meta *SessionRecordingMeta, sessionMeta *SessionMeta, f storage.FS, keys *kms.Keys, options ...Option) (*Session, error) {
 	const op = "bsr.NewSession"
 
@@ -57,12 +67,6 @@ func NewSession(ctx context.Context, meta *SessionRecordingMeta, sessionMeta *Se
 		return nil, fmt.Errorf("%s: missing session id: %w", op, ErrInvalidParameter)
 	case !is.Nil(sessionMeta.StaticHost) && !is.Nil(sessionMeta.DynamicHost):
 		return nil, fmt.Errorf("%s: sessionMeta cannot contain both static and dynamic host information: %w", op, ErrInvalidParameter)
-	case len(sessionMeta.StaticJSONCredentials) == 0 &&
-		len(sessionMeta.StaticUsernamePasswordCredentials) == 0 &&
-		len(sessionMeta.StaticSshPrivateKeyCredentials) == 0 &&
-		len(sessionMeta.VaultGenericLibraries) == 0 &&
-		len(sessionMeta.VaultSshCertificateLibraries) == 0:
-		return nil, fmt.Errorf("%s: missing credential information: %w", op, ErrInvalidParameter)
 	case is.Nil(sessionMeta.User):
 		return nil, fmt.Errorf("%s: missing session user: %w", op, ErrInvalidParameter)
 	case is.Nil(sessionMeta.Target):
@@ -77,6 +81,13 @@ func NewSession(ctx context.Context, meta *SessionRecordingMeta, sessionMeta *Se
 
 	opts := getOpts(options...)
 
+	switch {
+	case !ValidCompression(opts.withCompression):
+		return nil, fmt.Errorf("%s: invalid compression: %w", op, ErrInvalidParameter)
+	case !ValidEncryption(opts.withEncryption):
+		return nil, fmt.Errorf("%s: invalid encryption: %w", op, ErrInvalidParameter)
+	}
+
 	c, err := f.New(ctx, fmt.Sprintf(bsrFileNameTemplate, meta.Id))
 	if err != nil {
 		return nil, err
@@ -110,6 +121,8 @@ func NewSession(ctx context.Context, meta *SessionRecordingMeta, sessionMeta *Se
 		return nil, err
 	}
 
+	nc.compression, nc.encryption = opts.withCompression, opts.withEncryption
+
 	err = meta.writeMeta(ctx, nc)
 	if err != nil {
 		return nil, err
@@ -254,7 +267,12 @@ type Connection struct {
 }
 
 // NewConnection creates a Connection container for a given connection id.
-func (s *Session) NewConnection(ctx context.Context, meta *ConnectionRecordingMeta) (*Connection, error) {
+// Supports the following options:
+//   - WithDictionary: a zstd dictionary for the connection's chunks, which
+//     requires the session to use ZstdCompression. It is stored in the
+//     connection so that it can be used to decompress the chunks when the
+//     BSR is opened.
+func (s *Session) NewConnection(ctx context.Context, meta *ConnectionRecordingMeta, options ...Option) (*Connection, error) {
 	const op = "bsr.(Session).NewConnection"
 
 	switch {
@@ -263,6 +281,10 @@ func (s *Session) NewConnection(ctx context.Context, meta *ConnectionRecordingMe
 	case meta.Id == "":
 		return nil, fmt.Errorf("%s: missing connection id: %w", op, ErrInvalidParameter)
 	}
+	opts := getOpts(options...)
+	if err := validDictionary(s.compression, opts.withDictionary); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
 	name := fmt.Sprintf(connectionFileNameTemplate, meta.Id)
 	sc, err := s.container.container.SubContainer(ctx, name, storage.WithCreateFile(), storage.WithFileAccessMode(storage.WriteOnly))
@@ -277,6 +299,12 @@ func (s *Session) NewConnection(ctx context.Context, meta *ConnectionRecordingMe
 	if err != nil {
 		return nil, err
 	}
+	nc.compression, nc.encryption = s.compression, s.encryption
+	if len(opts.withDictionary) > 0 {
+		if err := nc.writeDictionary(ctx, opts.withDictionary); err != nil {
+			return nil, err
+		}
+	}
 	if _, err := nc.WriteMeta(ctx, "id", meta.Id); err != nil {
 		return nil, err
 	}
@@ -315,6 +343,9 @@ func (s *Session) OpenConnection(ctx context.Context, connId string) (*Connectio
 	if err != nil {
 		return nil, err
 	}
+	if err := cc.readDictionary(ctx); err != nil {
+		return nil, err
+	}
 
 	// Load and verify connection metadata
 	sha256Reader, err := crypto.NewSha256SumReader(ctx, cc.metaFile)
@@ -352,7 +383,12 @@ func (s *Session) OpenConnection(ctx context.Context, connId string) (*Connectio
 }
 
 // NewChannel creates a Channel container for a given channel id.
-func (c *Connection) NewChannel(ctx context.Context, meta *ChannelRecordingMeta) (*Channel, error) {
+// Supports the following options:
+//   - WithDictionary: a zstd dictionary for the channel's chunks, which
+//     requires the session to use ZstdCompression. It is stored in the
+//     channel so that it can be used to decompress the chunks when the BSR
+//     is opened. The channel does not use the dictionary of its connection.
+func (c *Connection) NewChannel(ctx context.Context, meta *ChannelRecordingMeta, options ...Option) (*Channel, error) {
 	const op = "bsr.(Connection).NewChannel"
 
 	if !c.multiplexed {
@@ -365,6 +401,10 @@ func (c *Connection) NewChannel(ctx context.Context, meta *ChannelRecordingMeta)
 	case meta.Id == "":
 		return nil, fmt.Errorf("%s: missing channel id: %w", op, ErrInvalidParameter)
 	}
+	opts := getOpts(options...)
+	if err := validDictionary(c.compression, opts.withDictionary); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
 	name := fmt.Sprintf(channelFileNameTemplate, meta.Id)
 	sc, err := c.container.container.SubContainer(ctx, name, storage.WithCreateFile(), storage.WithFileAccessMode(storage.WriteOnly))
@@ -378,6 +418,12 @@ func (c *Connection) NewChannel(ctx context.Context, meta *ChannelRecordingMeta)
 	if err != nil {
 		return nil, err
 	}
+	nc.compression, nc.encryption = c.compression, c.encryption
+	if len(opts.withDictionary) > 0 {
+		if err := nc.writeDictionary(ctx, opts.withDictionary); err != nil {
+			return nil, err
+		}
+	}
 	if _, err := nc.WriteMeta(ctx, "id", meta.Id); err != nil {
 		return nil, err
 	}
@@ -416,6 +462,9 @@ func (c *Connection) OpenChannel(ctx context.Context, chanId string) (*Channel,
 	if err != nil {
 		return nil, err
 	}
+	if err := cc.readDictionary(ctx); err != nil {
+		return nil, err
+	}
 
 	// Load and verify channel metadata
 	sha256Reader, err := crypto.NewSha256SumReader(ctx, cc.metaFile)
@@ -495,6 +544,38 @@ func (c *Connection) NewRequestsWriter(ctx context.Context, dir Direction) (io.W
 	return checksum.NewFile(ctx, m, c.checksums)
 }
 
+// OpenMessageScanner opens a ChunkScanner for a connection's recorded messages.
+func (c *Connection) OpenMessageScanner(ctx context.Context, dir Direction) (*ChunkScanner, error) {
+	const op = "bsr.(Connection).OpenMessageScanner"
+
+	messagesName := fmt.Sprintf(messagesFileNameTemplate, dir.String())
+	m, err := c.container.container.OpenFile(ctx, messagesName, storage.WithFileAccessMode(storage.ReadOnly))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	expectedSum, err := c.shaSums.Sum(messagesName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return NewChunkScanner(ctx, m, WithSha256Sum(expectedSum), WithKeys(c.keys), WithDictionary(c.dictionary))
+}
+
+// OpenRequestScanner opens a ChunkScanner for a connection's recorded requests.
+func (c *Connection) OpenRequestScanner(ctx context.Context, dir Direction) (*ChunkScanner, error) {
+	const op = "bsr.(Connection).OpenRequestScanner"
+
+	requestName := fmt.Sprintf(requestsFileNameTemplate, dir.String())
+	m, err := c.container.container.OpenFile(ctx, requestName, storage.WithFileAccessMode(storage.ReadOnly))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	expectedSum, err := c.shaSums.Sum(requestName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return NewChunkScanner(ctx, m, WithSha256Sum(expectedSum), WithKeys(c.keys), WithDictionary(c.dictionary))
+}
+
 // Close closes the Connection container.
 func (c *Connection) Close(ctx context.Context) error {
 	return c.container.close(ctx)
@@ -571,7 +652,7 @@ func (c *Channel) OpenMessageScanner(ctx context.Context, dir Direction) (*Chunk
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return NewChunkScanner(ctx, m, WithSha256Sum(expectedSum))
+	return NewChunkScanner(ctx, m, WithSha256Sum(expectedSum), WithKeys(c.keys), WithDictionary(c.dictionary))
 }
 
 // OpenRequestScanner opens a ChunkScanner for a channel's recorded requests.
@@ -587,5 +668,5 @@ func (c *Channel) OpenRequestScanner(ctx context.Context, dir Direction) (*Chunk
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return NewChunkScanner(ctx, m, WithSha256Sum(expectedSum))
+	return NewChunkScanner(ctx, m, WithSha256Sum(expectedSum), WithKeys(c.keys), WithDictionary(c.dictionary))
 }
@@ -159,17 +159,24 @@ func TestOpenBSRMethods(t *testing.T) {
 	require.NotNil(t, opSesh)
 	sesh.Meta.connections = opSesh.Meta.connections
 	require.Equal(t, sesh.Meta, opSesh.Meta)
+	require.Equal(t, []string{connectionId}, opSesh.Meta.Connections())
 
 	opConn, err := opSesh.OpenConnection(ctx, connectionId)
 	require.NoError(t, err)
 	require.NotNil(t, opConn)
 	conn.Meta.channels = opConn.Meta.channels
 	require.Equal(t, conn.Meta, opConn.Meta)
+	require.Equal(t, []string{channelId}, opConn.Meta.Channels())
 
 	opChan, err := opConn.OpenChannel(ctx, channelId)
 	require.NoError(t, err)
 	require.NotNil(t, opChan)
 	require.Equal(t, ch.Meta, opChan.Meta)
+
+	// Containers opened for reading can be closed.
+	require.NoError(t, opChan.Close(ctx))
+	require.NoError(t, opConn.Close(ctx))
+	require.NoError(t, opSesh.Close(ctx))
 }
 
 func TestOpenSession(t *testing.T) {
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/bsr"
 	"github.com/hashicorp/boundary/internal/bsr/internal/fstest"
 	"github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
 	"github.com/hashicorp/boundary/internal/storage"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/stretchr/testify/assert"
@@ -717,3 +718,116 @@ func TestChannelNewRequestsWriterErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionCompression(t *testing.T) {
+	ctx := context.Background()
+
+	keys, err := kms.CreateKeys(ctx, kms.TestWrapper(t), "session")
+	require.NoError(t, err)
+	dict, err := os.ReadFile("testdata/zstd.dict")
+	require.NoError(t, err)
+
+	protocol := bsr.Protocol("TEST_COMPRESSION")
+	require.NoError(t, bsr.RegisterSummaryAllocFunc(protocol, bsr.SessionContainer, func(context.Context) bsr.Summary {
+		return &bsr.BaseSessionSummary{}
+	}))
+	require.NoError(t, bsr.RegisterSummaryAllocFunc(protocol, bsr.ConnectionContainer, func(context.Context) bsr.Summary {
+		return &bsr.BaseConnectionSummary{}
+	}))
+	require.NoError(t, bsr.RegisterSummaryAllocFunc(protocol, bsr.ChannelContainer, func(context.Context) bsr.Summary {
+		return &bsr.BaseChannelSummary{}
+	}))
+
+	f := &fstest.MemFS{}
+	srm := bsr.TestSessionRecordingMeta("session_recording", protocol)
+	s, err := bsr.NewSession(ctx, srm, bsr.TestSessionMeta("session"), f, keys, bsr.WithSupportsMultiplex(true),
+		bsr.WithCompression(bsr.ZstdCompression), bsr.WithEncryption(bsr.AesGcmEncryption))
+	require.NoError(t, err)
+	assert.Equal(t, bsr.ZstdCompression, s.Compression())
+	assert.Equal(t, bsr.AesGcmEncryption, s.Encryption())
+	assert.Nil(t, s.Dictionary())
+	require.NoError(t, s.EncodeSummary(ctx, &bsr.BaseSessionSummary{Id: "session", ConnectionCount: 1}))
+
+	// The connection is recorded without a dictionary, and only its
+	// channel uses one.
+	c, err := s.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: "connection"})
+	require.NoError(t, err)
+	assert.Equal(t, bsr.ZstdCompression, c.Compression())
+	assert.Equal(t, bsr.AesGcmEncryption, c.Encryption())
+	assert.Nil(t, c.Dictionary())
+	require.NoError(t, c.EncodeSummary(ctx, &bsr.BaseConnectionSummary{Id: "connection", ChannelCount: 1}))
+	ch, err := c.NewChannel(ctx, &bsr.ChannelRecordingMeta{Id: "channel", Type: "session"}, bsr.WithDictionary(dict))
+	require.NoError(t, err)
+	assert.Equal(t, bsr.ZstdCompression, ch.Compression())
+	assert.Equal(t, bsr.AesGcmEncryption, ch.Encryption())
+	assert.Equal(t, dict, ch.Dictionary())
+	require.NoError(t, ch.EncodeSummary(ctx, &bsr.BaseChannelSummary{Id: "channel", ConnectionRecordingId: "connection"}))
+
+	const data = "user@host:~$ ls -la\r\n"
+	write := func(w io.Writer, d []byte) {
+		dw, err := tcp.NewDataWriter(ctx, w, bsr.Inbound, bsr.ZstdCompression, bsr.AesGcmEncryption, "session", bsr.WithKeys(keys), bsr.WithDictionary(d))
+		require.NoError(t, err)
+		_, err = dw.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, dw.Close())
+	}
+	w, err := c.NewMessagesWriter(ctx, bsr.Inbound)
+	require.NoError(t, err)
+	write(w, nil)
+	w, err = ch.NewMessagesWriter(ctx, bsr.Inbound)
+	require.NoError(t, err)
+	write(w, dict)
+	require.NoError(t, ch.Close(ctx))
+	require.NoError(t, c.Close(ctx))
+	require.NoError(t, s.Close(ctx))
+
+	keyFn := func(kms.WrappedKeys) (kms.UnwrappedKeys, error) {
+		return kms.UnwrappedKeys{BsrKey: keys.BsrKey, PrivKey: keys.PrivKey}, nil
+	}
+	read := func(scanner *bsr.ChunkScanner) string {
+		var got []byte
+		require.NoError(t, bsr.ChunkWalk(ctx, scanner, func(_ context.Context, c bsr.Chunk) error {
+			switch cc := c.(type) {
+			case *bsr.HeaderChunk:
+				assert.Equal(t, bsr.ZstdCompression, cc.Compression)
+				assert.Equal(t, bsr.AesGcmEncryption, cc.Encryption)
+			case *tcp.DataChunk:
+				got = append(got, cc.Data...)
+			}
+			return nil
+		}))
+		return string(got)
+	}
+	opSesh, err := bsr.OpenSession(ctx, srm.Id, f, keyFn)
+	require.NoError(t, err)
+	opConn, err := opSesh.OpenConnection(ctx, "connection")
+	require.NoError(t, err)
+	assert.Nil(t, opConn.Dictionary())
+	scanner, err := opConn.OpenMessageScanner(ctx, bsr.Inbound)
+	require.NoError(t, err)
+	assert.Equal(t, data, read(scanner))
+	opChan, err := opConn.OpenChannel(ctx, "channel")
+	require.NoError(t, err)
+	assert.Equal(t, dict, opChan.Dictionary())
+	scanner, err = opChan.OpenMessageScanner(ctx, bsr.Inbound)
+	require.NoError(t, err)
+	assert.Equal(t, data, read(scanner))
+
+	// A dictionary is only used with zstd and must be a zstd dictionary.
+	gzipSession, err := bsr.NewSession(ctx, srm, bsr.TestSessionMeta("session"), &fstest.MemFS{}, keys,
+		bsr.WithCompression(bsr.GzipCompression))
+	require.NoError(t, err)
+	_, err = gzipSession.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: "connection"}, bsr.WithDictionary(dict))
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	zstdSession, err := bsr.NewSession(ctx, srm, bsr.TestSessionMeta("session"), &fstest.MemFS{}, keys,
+		bsr.WithCompression(bsr.ZstdCompression))
+	require.NoError(t, err)
+	_, err = zstdSession.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: "connection"}, bsr.WithDictionary([]byte("not a dictionary")))
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	_, err = bsr.NewSession(ctx, srm, bsr.TestSessionMeta("session"), &fstest.MemFS{}, keys,
+		bsr.WithCompression(bsr.Compression(255)))
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	_, err = bsr.NewSession(ctx, srm, bsr.TestSessionMeta("session"), &fstest.MemFS{}, keys,
+		bsr.WithEncryption(bsr.Encryption(255)))
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+}
@@ -4,10 +4,16 @@
 package bsr_test
 
 import (
+	"bytes"
+	"context"
+	"io"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/bsr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidCompression(t *testing.T) {
@@ -26,6 +32,11 @@ func TestValidCompression(t *testing.T) {
 			bsr.GzipCompression,
 			true,
 		},
+		{
+			bsr.ZstdCompression.String(),
+			bsr.ZstdCompression,
+			true,
+		},
 		{
 			"something else",
 			bsr.Compression(255),
@@ -57,6 +68,11 @@ func TestCompressionString(t *testing.T) {
 			bsr.GzipCompression,
 			"gzip",
 		},
+		{
+			bsr.ZstdCompression.String(),
+			bsr.ZstdCompression,
+			"zstd",
+		},
 		{
 			"something else",
 			bsr.Compression(255),
@@ -71,3 +87,101 @@ func TestCompressionString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCompression(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    bsr.Compression
+		wantErr bool
+	}{
+		{"none", bsr.NoCompression, false},
+		{"gzip", bsr.GzipCompression, false},
+		{"zstd", bsr.ZstdCompression, false},
+		{"", bsr.NoCompression, true},
+		{"lz4", bsr.NoCompression, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := bsr.ParseCompression(tc.in)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestZstdCompression(t *testing.T) {
+	ctx := context.Background()
+	ts := bsr.NewTimestamp(time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC))
+	dict, err := os.ReadFile("testdata/zstd.dict")
+	require.NoError(t, err)
+
+	encode := func(t *testing.T, c bsr.Compression, options ...bsr.Option) ([]byte, []bsr.Chunk) {
+		t.Helper()
+		header, err := bsr.NewHeader(ctx, "TEST", bsr.Inbound, ts, c, bsr.NoEncryption, "sess_123456789")
+		require.NoError(t, err)
+		end, err := bsr.NewEnd(ctx, "TEST", bsr.Inbound, ts)
+		require.NoError(t, err)
+		chunks := []bsr.Chunk{
+			header,
+			&testChunk{
+				BaseChunk: &bsr.BaseChunk{Protocol: "TEST", Direction: bsr.Inbound, Timestamp: ts, Type: "TEST"},
+				Data:      []byte("user@host:~$ sudo systemctl status nginx\r\n"),
+			},
+			end,
+		}
+		var buf bytes.Buffer
+		enc, err := bsr.NewChunkEncoder(ctx, &buf, c, bsr.NoEncryption, options...)
+		require.NoError(t, err)
+		for _, chunk := range chunks {
+			_, err := enc.Encode(ctx, chunk)
+			require.NoError(t, err)
+		}
+		return buf.Bytes(), chunks
+	}
+	decode := func(t *testing.T, encoded []byte, options ...bsr.Option) ([]bsr.Chunk, error) {
+		t.Helper()
+		dec, err := bsr.NewChunkDecoder(ctx, bytes.NewReader(encoded), options...)
+		require.NoError(t, err)
+		var got []bsr.Chunk
+		for {
+			chunk, err := dec.Decode(ctx)
+			if err == io.EOF {
+				return got, nil
+			}
+			if err != nil {
+				return got, err
+			}
+			got = append(got, chunk)
+		}
+	}
+
+	gzipEncoded, _ := encode(t, bsr.GzipCompression)
+	zstdEncoded, want := encode(t, bsr.ZstdCompression)
+	got, err := decode(t, zstdEncoded)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	dictEncoded, want := encode(t, bsr.ZstdCompression, bsr.WithDictionary(dict))
+	got, err = decode(t, dictEncoded, bsr.WithDictionary(dict))
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// Small chunks have less overhead with zstd, and even less when they
+	// resemble the dictionary.
+	assert.Less(t, len(zstdEncoded), len(gzipEncoded))
+	assert.Less(t, len(dictEncoded), len(zstdEncoded))
+
+	// Chunks compressed with a dictionary can't be decompressed without it.
+	_, err = decode(t, dictEncoded)
+	assert.ErrorIs(t, err, bsr.ErrChunkDecode)
+
+	_, err = bsr.NewChunkEncoder(ctx, &bytes.Buffer{}, bsr.GzipCompression, bsr.NoEncryption, bsr.WithDictionary(dict))
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	_, err = bsr.NewChunkEncoder(ctx, &bytes.Buffer{}, bsr.ZstdCompression, bsr.NoEncryption, bsr.WithDictionary([]byte("not a dictionary")))
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+}
@@ -61,6 +61,15 @@ type container struct {
 	// Field used for reading and writing
 	keys     *kms.Keys
 	metaName string
+
+	// The compression and encryption selected for the session with
+	// WithCompression and WithEncryption, and the optional zstd dictionary
+	// of the connection or channel selected with WithDictionary.  Only the
+	// dictionary is known when reading, since the compression and
+	// encryption are in the header of each file.
+	compression Compression
+	encryption  Encryption
+	dictionary  []byte
 }
 
 // newContainer creates a container for the given type backed by the provide storage.Container.
@@ -482,12 +491,84 @@ func (c *container) WriteBinaryChecksum(_ context.Context, sum []byte, fname str
 	return c.checksums.WriteString(fmt.Sprintf("%x *%s\n", sum, fname))
 }
 
+// Compression returns the Compression that chunks recorded in the container
+// should be compressed with.
+func (c *container) Compression() Compression {
+	return c.compression
+}
+
+// Encryption returns the Encryption that chunks recorded in the container
+// should be encrypted with.
+func (c *container) Encryption() Encryption {
+	return c.encryption
+}
+
+// Dictionary returns the zstd dictionary chunks recorded in the container are
+// compressed with, or nil if there is none.
+func (c *container) Dictionary() []byte {
+	return c.dictionary
+}
+
+// writeDictionary stores the zstd dictionary the container's chunks are
+// compressed with, so it can be used to decompress them when the container
+// is opened.
+func (c *container) writeDictionary(ctx context.Context, d []byte) error {
+	df, err := c.create(ctx, dictionaryFileName)
+	if err != nil {
+		return err
+	}
+	dfw, err := checksum.NewFile(ctx, df, c.checksums)
+	if err != nil {
+		return err
+	}
+	if _, err := dfw.Write(d); err != nil {
+		dfw.Close()
+		return err
+	}
+	if err := dfw.Close(); err != nil {
+		return err
+	}
+	c.dictionary = d
+	return nil
+}
+
+// readDictionary loads the zstd dictionary the container's chunks are
+// compressed with.  The dictionary is only stored for containers recorded
+// with one.
+func (c *container) readDictionary(ctx context.Context) error {
+	if _, ok := c.shaSums[dictionaryFileName]; !ok {
+		return nil
+	}
+	return c.decodeFile(ctx, dictionaryFileName, func(_ context.Context, r io.Reader) error {
+		// The reader wraps io.EOF, so it's returned by io.ReadAll.
+		var err error
+		c.dictionary, err = io.ReadAll(r)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		return nil
+	})
+}
+
 // close closes a container, closing the underlying files in a container.
 func (c *container) close(_ context.Context) error {
 	const op = "bsr.(container).close"
 
 	var closeError error
 
+	// Containers opened for reading only have their meta file open.
+	if c.journal == nil {
+		if c.metaFile != nil {
+			if err := c.metaFile.Close(); err != nil {
+				closeError = errors.Join(closeError, fmt.Errorf("%s: %w", op, err))
+			}
+		}
+		if err := c.container.Close(); err != nil {
+			closeError = errors.Join(closeError, fmt.Errorf("%s: %w", op, err))
+		}
+		return closeError
+	}
+
 	if err := c.meta.Close(); err != nil {
 		closeError = errors.Join(closeError, fmt.Errorf("%s: %w", op, err))
 	}
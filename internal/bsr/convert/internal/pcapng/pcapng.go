@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package pcapng provides a minimal writer for pcap-ng capture files.
+// See: https://www.ietf.org/archive/id/draft-ietf-opsawg-pcapng-01.html
+package pcapng
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Block types and section header fields.
+const (
+	sectionHeaderBlockType   uint32 = 0x0A0D0D0A
+	interfaceDescBlockType   uint32 = 0x00000001
+	enhancedPacketBlockType  uint32 = 0x00000006
+	byteOrderMagic           uint32 = 0x1A2B3C4D
+	sectionLengthUnspecified uint64 = 0xFFFFFFFFFFFFFFFF
+	majorVersion             uint16 = 1
+	minorVersion             uint16 = 0
+)
+
+// Option codes.
+const (
+	optEndOfOpt     uint16 = 0
+	optShbUserAppl  uint16 = 4
+	optIfTsResol    uint16 = 9
+	optEpbFlags     uint16 = 2
+	nanosecondResol uint8  = 9
+)
+
+// LinkTypeRaw is the link type for packets which begin with an IPv4 or IPv6
+// header.
+const LinkTypeRaw uint16 = 101
+
+// Direction is the direction of a packet relative to the capturing
+// interface, as recorded in the flags of an enhanced packet block.
+type Direction uint32
+
+const (
+	// Unknown indicates the direction of the packet is not known.
+	Unknown Direction = 0
+	// Inbound indicates the packet was received by the interface.
+	Inbound Direction = 1
+	// Outbound indicates the packet was sent by the interface.
+	Outbound Direction = 2
+)
+
+// Writer writes a pcap-ng section with a single interface.  Packet
+// timestamps are written with nanosecond resolution.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter writes the section header and interface description blocks to w
+// and returns a Writer for the packets of the interface.
+func NewWriter(w io.Writer, linkType uint16, application string) (*Writer, error) {
+	const op = "pcapng.NewWriter"
+	if w == nil {
+		return nil, fmt.Errorf("%s: missing writer", op)
+	}
+
+	var shb []byte
+	shb = binary.LittleEndian.AppendUint32(shb, byteOrderMagic)
+	shb = binary.LittleEndian.AppendUint16(shb, majorVersion)
+	shb = binary.LittleEndian.AppendUint16(shb, minorVersion)
+	shb = binary.LittleEndian.AppendUint64(shb, sectionLengthUnspecified)
+	if application != "" {
+		shb = appendOption(shb, optShbUserAppl, []byte(application))
+	}
+	shb = appendOption(shb, optEndOfOpt, nil)
+	if err := writeBlock(w, sectionHeaderBlockType, shb); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var idb []byte
+	idb = binary.LittleEndian.AppendUint16(idb, linkType)
+	idb = binary.LittleEndian.AppendUint16(idb, 0)
+	// A snap length of zero means packets are never truncated.
+	idb = binary.LittleEndian.AppendUint32(idb, 0)
+	idb = appendOption(idb, optIfTsResol, []byte{nanosecondResol})
+	idb = appendOption(idb, optEndOfOpt, nil)
+	if err := writeBlock(w, interfaceDescBlockType, idb); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Writer{w: w}, nil
+}
+
+// WritePacket writes data as an enhanced packet block captured at ts.
+func (w *Writer) WritePacket(ts time.Time, dir Direction, data []byte) error {
+	const op = "pcapng.(Writer).WritePacket"
+	nanos := uint64(ts.UnixNano())
+
+	var epb []byte
+	// All packets belong to the first and only interface of the section.
+	epb = binary.LittleEndian.AppendUint32(epb, 0)
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(nanos>>32))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(nanos))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(len(data)))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(len(data)))
+	epb = append(epb, data...)
+	epb = pad(epb)
+	if dir != Unknown {
+		epb = appendOption(epb, optEpbFlags, binary.LittleEndian.AppendUint32(nil, uint32(dir)))
+		epb = appendOption(epb, optEndOfOpt, nil)
+	}
+	if err := writeBlock(w.w, enhancedPacketBlockType, epb); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// writeBlock writes a block with the given type and body, which must already
+// be padded to 32 bits.
+func writeBlock(w io.Writer, blockType uint32, body []byte) error {
+	total := uint32(len(body) + 12)
+	b := make([]byte, 0, total)
+	b = binary.LittleEndian.AppendUint32(b, blockType)
+	b = binary.LittleEndian.AppendUint32(b, total)
+	b = append(b, body...)
+	b = binary.LittleEndian.AppendUint32(b, total)
+	_, err := w.Write(b)
+	return err
+}
+
+// appendOption appends an option with the given code and value, padded to
+// 32 bits.
+func appendOption(b []byte, code uint16, value []byte) []byte {
+	b = binary.LittleEndian.AppendUint16(b, code)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(value)))
+	b = append(b, value...)
+	return pad(b)
+}
+
+// pad pads b with zeros to a multiple of 32 bits.
+func pad(b []byte) []byte {
+	for len(b)%4 != 0 {
+		b = append(b, 0)
+	}
+	return b
+}
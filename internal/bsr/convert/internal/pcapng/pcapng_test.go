@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcapng
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, LinkTypeRaw, "boundary")
+	require.NoError(t, err)
+
+	le := binary.LittleEndian
+	var want []byte
+	// Section header block with the application and end of options.
+	want = le.AppendUint32(want, 0x0A0D0D0A)
+	want = le.AppendUint32(want, 44)
+	want = le.AppendUint32(want, 0x1A2B3C4D)
+	want = le.AppendUint16(want, 1)
+	want = le.AppendUint16(want, 0)
+	want = le.AppendUint64(want, 0xFFFFFFFFFFFFFFFF)
+	want = le.AppendUint16(want, 4)
+	want = le.AppendUint16(want, 8)
+	want = append(want, "boundary"...)
+	want = le.AppendUint32(want, 0)
+	want = le.AppendUint32(want, 44)
+	// Interface description block with the timestamp resolution.
+	want = le.AppendUint32(want, 1)
+	want = le.AppendUint32(want, 32)
+	want = le.AppendUint16(want, LinkTypeRaw)
+	want = le.AppendUint16(want, 0)
+	want = le.AppendUint32(want, 0)
+	want = le.AppendUint16(want, 9)
+	want = le.AppendUint16(want, 1)
+	want = append(want, 9, 0, 0, 0)
+	want = le.AppendUint32(want, 0)
+	want = le.AppendUint32(want, 32)
+	assert.Equal(t, want, buf.Bytes())
+
+	buf.Reset()
+	ts := time.Unix(0, 0x0000000123456789)
+	require.NoError(t, w.WritePacket(ts, Outbound, []byte("hello")))
+	want = nil
+	want = le.AppendUint32(want, 6)
+	want = le.AppendUint32(want, 52)
+	want = le.AppendUint32(want, 0)
+	want = le.AppendUint32(want, 0x1)
+	want = le.AppendUint32(want, 0x23456789)
+	want = le.AppendUint32(want, 5)
+	want = le.AppendUint32(want, 5)
+	want = append(want, "hello\x00\x00\x00"...)
+	want = le.AppendUint16(want, 2)
+	want = le.AppendUint16(want, 4)
+	want = le.AppendUint32(want, uint32(Outbound))
+	want = le.AppendUint32(want, 0)
+	want = le.AppendUint32(want, 52)
+	assert.Equal(t, want, buf.Bytes())
+
+	_, err = NewWriter(nil, LinkTypeRaw, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+)
+
+// mergeWalk steps through the chunks of the inbound and outbound scanners in
+// the order of their timestamps, calling f for each.  The chunks of each
+// scanner are expected to already be in timestamp order. When chunks from
+// both scanners share a timestamp, the inbound chunk is walked first. Like
+// bsr.ChunkWalk, the walk terminates early if f or either scanner returns an
+// error.
+func mergeWalk(ctx context.Context, inbound *bsr.ChunkScanner, outbound *bsr.ChunkScanner, f bsr.ChunkReadFunc) error {
+	const op = "convert.mergeWalk"
+
+	switch {
+	case is.Nil(inbound):
+		return fmt.Errorf("%s: missing inbound scanner: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(outbound):
+		return fmt.Errorf("%s: missing outbound scanner: %w", op, bsr.ErrInvalidParameter)
+	case f == nil:
+		return fmt.Errorf("%s: missing chunk read func: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	scan := func(s *bsr.ChunkScanner) (bsr.Chunk, error) {
+		c, err := s.Scan(ctx)
+		switch {
+		case err == io.EOF:
+			return nil, nil
+		case err != nil:
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return c, nil
+	}
+
+	in, err := scan(inbound)
+	if err != nil {
+		return err
+	}
+	out, err := scan(outbound)
+	if err != nil {
+		return err
+	}
+	for in != nil || out != nil {
+		var next bsr.Chunk
+		if out == nil || (in != nil && !in.GetTimestamp().AsTime().After(out.GetTimestamp().AsTime())) {
+			next = in
+			if in, err = scan(inbound); err != nil {
+				return err
+			}
+		} else {
+			next = out
+			if out, err = scan(outbound); err != nil {
+				return err
+			}
+		}
+		if ferr := f(ctx, next); ferr != nil {
+			return fmt.Errorf("%s: %w", op, ferr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/convert/internal/pcapng"
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/hashicorp/boundary/internal/storage"
+)
+
+const (
+	// pcapngApplication is recorded as the application which wrote the
+	// pcap-ng file.
+	pcapngApplication = "boundary"
+
+	// maxSegmentSize is the largest payload of a synthesized tcp segment,
+	// which is bounded by the 16 bit total length of the ipv4 header.
+	maxSegmentSize = 0xFFFF - ipv4HeaderLen - tcpHeaderLen
+
+	ipv4HeaderLen = 20
+	tcpHeaderLen  = 20
+)
+
+// The client's address is not recorded, so synthesized frames use addresses
+// from the ranges reserved for documentation (RFC 5737). The endpoint's
+// address is used when it is an ipv4 address.
+var (
+	defaultClientAddr   = netip.AddrPortFrom(netip.AddrFrom4([4]byte{192, 0, 2, 1}), 49152)
+	defaultEndpointAddr = netip.AddrFrom4([4]byte{198, 51, 100, 1})
+)
+
+// TCP flags used by synthesized segments.
+const (
+	tcpFin uint8 = 0x01
+	tcpSyn uint8 = 0x02
+	tcpPsh uint8 = 0x08
+	tcpAck uint8 = 0x10
+)
+
+// ToPcapng accepts a bsr.Session and will convert the messages of the given
+// tcp connection to a pcap-ng capture. The recorded data is written as
+// synthesized ipv4 tcp segments timestamped with the time the data was
+// recorded, preceded by a three way handshake and followed by the closing of
+// the connection. Data from the client is marked as inbound and data from the
+// endpoint as outbound.
+// The tmp file will be used to write the pcap-ng file to disk.
+// It returns an io.ReadCloser to the converted pcap-ng file.
+func ToPcapng(ctx context.Context, session *bsr.Session, tmp storage.TempFile, connectionId string, _ ...Option) (io.ReadCloser, error) {
+	const op = "convert.ToPcapng"
+
+	switch {
+	case is.Nil(session):
+		return nil, fmt.Errorf("%s: missing session: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(session.Meta):
+		return nil, fmt.Errorf("%s: missing session meta: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(tmp):
+		return nil, fmt.Errorf("%s: missing temp file: %w", op, bsr.ErrInvalidParameter)
+	case connectionId == "":
+		return nil, fmt.Errorf("%s: missing connection id: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	switch session.Meta.Protocol {
+	case tcp.Protocol:
+		conn, err := session.OpenConnection(ctx, connectionId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		inbound, err := conn.OpenMessageScanner(ctx, bsr.Inbound)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		outbound, err := conn.OpenMessageScanner(ctx, bsr.Outbound)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		return tcpConnectionToPcapng(ctx, inbound, outbound, endpointAddr(session.SessionMeta), tmp)
+	default:
+		return nil, fmt.Errorf("%s: %w", op, ErrUnsupportedProtocol)
+	}
+}
+
+// endpointAddr returns the address of the session's endpoint to use in
+// synthesized frames.
+func endpointAddr(meta *bsr.SessionMeta) netip.AddrPort {
+	addr := defaultEndpointAddr
+	var port uint16
+	if meta == nil {
+		return netip.AddrPortFrom(addr, port)
+	}
+	if meta.Target != nil {
+		port = uint16(meta.Target.DefaultPort)
+	}
+	if u, err := url.Parse(meta.Endpoint); err == nil {
+		if a, err := netip.ParseAddr(u.Hostname()); err == nil && a.Unmap().Is4() {
+			addr = a.Unmap()
+		}
+		if p, err := strconv.ParseUint(u.Port(), 10, 16); err == nil {
+			port = uint16(p)
+		}
+	}
+	return netip.AddrPortFrom(addr, port)
+}
+
+// tcpConnectionToPcapng will convert a recording of a tcp connection from a
+// BSR into a pcap-ng capture. This expects two bsr.ChunkScanners, one for the
+// inbound messages and one for the outbound messages of the connection, and
+// the address of the endpoint. This also expects a io.ReadWriteSeeker that
+// will be used to write the capture. This is then reset and returned as a
+// io.ReadCloser. The caller should call Close on the returned io.ReadCloser
+// after reading the capture.
+func tcpConnectionToPcapng(ctx context.Context, inbound *bsr.ChunkScanner, outbound *bsr.ChunkScanner, endpoint netip.AddrPort, w io.ReadWriteSeeker) (io.ReadCloser, error) {
+	const op = "convert.tcpConnectionToPcapng"
+
+	switch {
+	case is.Nil(inbound):
+		return nil, fmt.Errorf("%s: missing inbound scanner: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(outbound):
+		return nil, fmt.Errorf("%s: missing outbound scanner: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(w):
+		return nil, fmt.Errorf("%s: missing read write seeker: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	pw, err := pcapng.NewWriter(w, pcapng.LinkTypeRaw, pcapngApplication)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	conn := &tcpFlow{
+		w:        pw,
+		client:   &tcpPeer{addr: defaultClientAddr},
+		endpoint: &tcpPeer{addr: endpoint},
+	}
+
+	// Both directions start with a header chunk and finish with an end
+	// chunk. The connection is opened when the first header is seen and
+	// closed once both directions have ended.
+	var headers, ends int
+	if err := mergeWalk(ctx, inbound, outbound, func(ctx context.Context, c bsr.Chunk) error {
+		if c.GetProtocol() != tcp.Protocol {
+			return ErrUnsupportedProtocol
+		}
+		ts := c.GetTimestamp().AsTime()
+		switch c.GetType() {
+		case bsr.ChunkHeader:
+			headers++
+			if headers > 2 {
+				return fmt.Errorf("multiple header chunks: %w", ErrMalformedBsr)
+			}
+			if headers == 1 {
+				return conn.open(ts)
+			}
+		case bsr.ChunkEnd:
+			ends++
+			if ends == 2 {
+				return conn.close(ts)
+			}
+		case tcp.DataChunkType:
+			if headers == 0 {
+				return fmt.Errorf("data chunk before header: %w", ErrMalformedBsr)
+			}
+			cc := c.(*tcp.DataChunk)
+			return conn.send(ts, c.GetDirection(), cc.Data)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := w.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var r io.ReadCloser
+	if v, ok := w.(io.ReadCloser); ok {
+		r = v
+	} else {
+		r = io.NopCloser(w)
+	}
+	return r, nil
+}
+
+// tcpPeer is one side of a synthesized tcp connection.
+type tcpPeer struct {
+	addr netip.AddrPort
+	// seq is the next sequence number sent by the peer.
+	seq uint32
+	// ipId is the identification of the next ipv4 packet sent by the peer.
+	ipId uint16
+}
+
+// tcpFlow writes the segments of a synthesized tcp connection between the
+// client and the endpoint.
+type tcpFlow struct {
+	w        *pcapng.Writer
+	client   *tcpPeer
+	endpoint *tcpPeer
+}
+
+// open writes the three way handshake of the connection.
+func (f *tcpFlow) open(ts time.Time) error {
+	if err := f.segment(ts, f.client, f.endpoint, tcpSyn, nil); err != nil {
+		return err
+	}
+	if err := f.segment(ts, f.endpoint, f.client, tcpSyn|tcpAck, nil); err != nil {
+		return err
+	}
+	return f.segment(ts, f.client, f.endpoint, tcpAck, nil)
+}
+
+// close writes the closing of the connection, which the client initiates.
+func (f *tcpFlow) close(ts time.Time) error {
+	if err := f.segment(ts, f.client, f.endpoint, tcpFin|tcpAck, nil); err != nil {
+		return err
+	}
+	if err := f.segment(ts, f.endpoint, f.client, tcpFin|tcpAck, nil); err != nil {
+		return err
+	}
+	return f.segment(ts, f.client, f.endpoint, tcpAck, nil)
+}
+
+// send writes data sent in the given direction as one or more segments.
+func (f *tcpFlow) send(ts time.Time, dir bsr.Direction, data []byte) error {
+	src, dst := f.client, f.endpoint
+	if dir == bsr.Outbound {
+		src, dst = f.endpoint, f.client
+	}
+	for len(data) > 0 {
+		n := len(data)
+		if n > maxSegmentSize {
+			n = maxSegmentSize
+		}
+		if err := f.segment(ts, src, dst, tcpPsh|tcpAck, data[:n]); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+// segment writes a tcp segment from src to dst and advances the sequence
+// number of src.
+func (f *tcpFlow) segment(ts time.Time, src, dst *tcpPeer, flags uint8, payload []byte) error {
+	var ack uint32
+	if flags&tcpAck != 0 {
+		ack = dst.seq
+	}
+
+	seg := make([]byte, tcpHeaderLen, tcpHeaderLen+len(payload))
+	binary.BigEndian.PutUint16(seg[0:], src.addr.Port())
+	binary.BigEndian.PutUint16(seg[2:], dst.addr.Port())
+	binary.BigEndian.PutUint32(seg[4:], src.seq)
+	binary.BigEndian.PutUint32(seg[8:], ack)
+	seg[12] = (tcpHeaderLen / 4) << 4
+	seg[13] = flags
+	binary.BigEndian.PutUint16(seg[14:], 0xFFFF)
+	seg = append(seg, payload...)
+
+	// The tcp checksum covers a pseudo header made up of the addresses,
+	// protocol and segment length.
+	srcIp, dstIp := src.addr.Addr().As4(), dst.addr.Addr().As4()
+	pseudo := make([]byte, 0, 12)
+	pseudo = append(pseudo, srcIp[:]...)
+	pseudo = append(pseudo, dstIp[:]...)
+	pseudo = append(pseudo, 0, 6)
+	pseudo = binary.BigEndian.AppendUint16(pseudo, uint16(len(seg)))
+	binary.BigEndian.PutUint16(seg[16:], checksum(pseudo, seg))
+
+	pkt := make([]byte, ipv4HeaderLen, ipv4HeaderLen+len(seg))
+	pkt[0] = 0x45
+	binary.BigEndian.PutUint16(pkt[2:], uint16(ipv4HeaderLen+len(seg)))
+	binary.BigEndian.PutUint16(pkt[4:], src.ipId)
+	// Don't fragment.
+	binary.BigEndian.PutUint16(pkt[6:], 0x4000)
+	pkt[8] = 64
+	pkt[9] = 6
+	copy(pkt[12:], srcIp[:])
+	copy(pkt[16:], dstIp[:])
+	binary.BigEndian.PutUint16(pkt[10:], checksum(pkt))
+	pkt = append(pkt, seg...)
+
+	src.ipId++
+	src.seq += uint32(len(payload))
+	if flags&(tcpSyn|tcpFin) != 0 {
+		src.seq++
+	}
+
+	dir := pcapng.Inbound
+	if src == f.endpoint {
+		dir = pcapng.Outbound
+	}
+	return f.w.WritePacket(ts, dir, pkt)
+}
+
+// checksum returns the internet checksum (RFC 1071) of the concatenated data.
+func checksum(data ...[]byte) uint16 {
+	var sum uint32
+	var odd bool
+	var last byte
+	for _, d := range data {
+		for _, b := range d {
+			if odd {
+				sum += uint32(last)<<8 | uint32(b)
+			} else {
+				last = b
+			}
+			odd = !odd
+		}
+	}
+	if odd {
+		sum += uint32(last) << 8
+	}
+	for sum>>16 != 0 {
+		sum = sum&0xFFFF + sum>>16
+	}
+	return ^uint16(sum)
+}
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/ssh"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testScanner returns a ChunkScanner for the encoded chunks.
+func testScanner(t *testing.T, chunks ...bsr.Chunk) *bsr.ChunkScanner {
+	t.Helper()
+	ctx := context.Background()
+	var buf bytes.Buffer
+	buf.Write(bsr.Magic.Bytes())
+	enc, err := bsr.NewChunkEncoder(ctx, &buf, bsr.NoCompression, bsr.NoEncryption)
+	require.NoError(t, err)
+	for _, c := range chunks {
+		_, err := enc.Encode(ctx, c)
+		require.NoError(t, err)
+	}
+	s, err := bsr.NewChunkScanner(ctx, bytes.NewBuffer(buf.Bytes()))
+	require.NoError(t, err)
+	return s
+}
+
+// testTempFile returns a temporary file which is removed when the test ends.
+func testTempFile(t *testing.T, pattern string) io.ReadWriteSeeker {
+	t.Helper()
+	f, err := os.CreateTemp("", pattern)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		f.Close()
+		os.Remove(f.Name())
+	})
+	return f
+}
+
+func testHeader(p bsr.Protocol, d bsr.Direction, ts time.Time) *bsr.HeaderChunk {
+	return &bsr.HeaderChunk{
+		BaseChunk: &bsr.BaseChunk{
+			Protocol:  p,
+			Direction: d,
+			Timestamp: bsr.NewTimestamp(ts),
+			Type:      bsr.ChunkHeader,
+		},
+		Compression: bsr.NoCompression,
+		Encryption:  bsr.NoEncryption,
+		SessionId:   "s_123456789",
+	}
+}
+
+func testEnd(p bsr.Protocol, d bsr.Direction, ts time.Time) *bsr.EndChunk {
+	return &bsr.EndChunk{
+		BaseChunk: &bsr.BaseChunk{
+			Protocol:  p,
+			Direction: d,
+			Timestamp: bsr.NewTimestamp(ts),
+			Type:      bsr.ChunkEnd,
+		},
+	}
+}
+
+func testTcpData(d bsr.Direction, ts time.Time, data string) *tcp.DataChunk {
+	return &tcp.DataChunk{
+		BaseChunk: &bsr.BaseChunk{
+			Protocol:  tcp.Protocol,
+			Direction: d,
+			Timestamp: bsr.NewTimestamp(ts),
+			Type:      tcp.DataChunkType,
+		},
+		Data: []byte(data),
+	}
+}
+
+// testPacket is an enhanced packet block read from a pcap-ng file.
+type testPacket struct {
+	ts    time.Time
+	flags uint32
+	data  []byte
+}
+
+// readTestPcapng reads the packets from a pcap-ng file written by
+// tcpConnectionToPcapng.
+func readTestPcapng(t *testing.T, b []byte) []testPacket {
+	t.Helper()
+	var blockTypes []uint32
+	var packets []testPacket
+	for len(b) > 0 {
+		require.GreaterOrEqual(t, len(b), 12)
+		blockType := binary.LittleEndian.Uint32(b)
+		total := binary.LittleEndian.Uint32(b[4:])
+		require.Zero(t, total%4)
+		require.LessOrEqual(t, int(total), len(b))
+		require.Equal(t, total, binary.LittleEndian.Uint32(b[total-4:]))
+		blockTypes = append(blockTypes, blockType)
+		if blockType == 6 {
+			body := b[8 : total-4]
+			nanos := uint64(binary.LittleEndian.Uint32(body[4:]))<<32 | uint64(binary.LittleEndian.Uint32(body[8:]))
+			capLen := binary.LittleEndian.Uint32(body[12:])
+			data := body[20 : 20+capLen]
+			opts := body[20+(capLen+3)/4*4:]
+			require.Equal(t, uint16(2), binary.LittleEndian.Uint16(opts))
+			packets = append(packets, testPacket{
+				ts:    time.Unix(0, int64(nanos)).UTC(),
+				flags: binary.LittleEndian.Uint32(opts[4:]),
+				data:  data,
+			})
+		}
+		b = b[total:]
+	}
+	require.GreaterOrEqual(t, len(blockTypes), 2)
+	assert.Equal(t, []uint32{0x0A0D0D0A, 1}, blockTypes[:2])
+	return packets
+}
+
+func Test_tcpConnectionToPcapng(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+	endpoint := netip.MustParseAddrPort("10.0.0.5:5432")
+
+	r, err := tcpConnectionToPcapng(ctx,
+		testScanner(t,
+			testHeader(tcp.Protocol, bsr.Inbound, ts),
+			testTcpData(bsr.Inbound, ts.Add(time.Second), "hello"),
+			testTcpData(bsr.Inbound, ts.Add(3*time.Second), "bye"),
+			testEnd(tcp.Protocol, bsr.Inbound, ts.Add(4*time.Second)),
+		),
+		testScanner(t,
+			testHeader(tcp.Protocol, bsr.Outbound, ts),
+			testTcpData(bsr.Outbound, ts.Add(2*time.Second), "world"),
+			testEnd(tcp.Protocol, bsr.Outbound, ts.Add(5*time.Second)),
+		),
+		endpoint,
+		testTempFile(t, "*.pcapng"),
+	)
+	require.NoError(t, err)
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	type segment struct {
+		ts       time.Time
+		flags    uint32
+		src, dst netip.AddrPort
+		seq, ack uint32
+		tcpFlags uint8
+		payload  string
+	}
+	client := defaultClientAddr
+	want := []segment{
+		{ts, 1, client, endpoint, 0, 0, tcpSyn, ""},
+		{ts, 2, endpoint, client, 0, 1, tcpSyn | tcpAck, ""},
+		{ts, 1, client, endpoint, 1, 1, tcpAck, ""},
+		{ts.Add(time.Second), 1, client, endpoint, 1, 1, tcpPsh | tcpAck, "hello"},
+		{ts.Add(2 * time.Second), 2, endpoint, client, 1, 6, tcpPsh | tcpAck, "world"},
+		{ts.Add(3 * time.Second), 1, client, endpoint, 6, 6, tcpPsh | tcpAck, "bye"},
+		{ts.Add(5 * time.Second), 1, client, endpoint, 9, 6, tcpFin | tcpAck, ""},
+		{ts.Add(5 * time.Second), 2, endpoint, client, 6, 10, tcpFin | tcpAck, ""},
+		{ts.Add(5 * time.Second), 1, client, endpoint, 10, 7, tcpAck, ""},
+	}
+	var got []segment
+	for _, p := range readTestPcapng(t, b) {
+		pkt := p.data
+		require.GreaterOrEqual(t, len(pkt), ipv4HeaderLen+tcpHeaderLen)
+		assert.Equal(t, byte(0x45), pkt[0])
+		assert.Equal(t, len(pkt), int(binary.BigEndian.Uint16(pkt[2:])))
+		assert.Equal(t, byte(6), pkt[9])
+		// A valid checksum sums to zero.
+		assert.Zero(t, checksum(pkt[:ipv4HeaderLen]))
+		seg := pkt[ipv4HeaderLen:]
+		pseudo := append(append([]byte{}, pkt[12:20]...), 0, 6)
+		pseudo = binary.BigEndian.AppendUint16(pseudo, uint16(len(seg)))
+		assert.Zero(t, checksum(pseudo, seg))
+
+		got = append(got, segment{
+			ts:       p.ts,
+			flags:    p.flags,
+			src:      netip.AddrPortFrom(netip.AddrFrom4([4]byte(pkt[12:16])), binary.BigEndian.Uint16(seg[0:])),
+			dst:      netip.AddrPortFrom(netip.AddrFrom4([4]byte(pkt[16:20])), binary.BigEndian.Uint16(seg[2:])),
+			seq:      binary.BigEndian.Uint32(seg[4:]),
+			ack:      binary.BigEndian.Uint32(seg[8:]),
+			tcpFlags: seg[13],
+			payload:  string(seg[tcpHeaderLen:]),
+		})
+	}
+	assert.Equal(t, want, got)
+
+	t.Run("large data", func(t *testing.T) {
+		data := bytes.Repeat([]byte("a"), maxSegmentSize+10)
+		r, err := tcpConnectionToPcapng(ctx,
+			testScanner(t,
+				testHeader(tcp.Protocol, bsr.Inbound, ts),
+				testTcpData(bsr.Inbound, ts, string(data)),
+				testEnd(tcp.Protocol, bsr.Inbound, ts),
+			),
+			testScanner(t,
+				testHeader(tcp.Protocol, bsr.Outbound, ts),
+				testEnd(tcp.Protocol, bsr.Outbound, ts),
+			),
+			endpoint,
+			testTempFile(t, "*.pcapng"),
+		)
+		require.NoError(t, err)
+		defer r.Close()
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		packets := readTestPcapng(t, b)
+		// The handshake, two data segments and the closing of the connection.
+		require.Len(t, packets, 8)
+		assert.Len(t, packets[3].data, 0xFFFF)
+		assert.Len(t, packets[4].data, ipv4HeaderLen+tcpHeaderLen+10)
+	})
+	t.Run("errors", func(t *testing.T) {
+		_, err := tcpConnectionToPcapng(ctx, nil, testScanner(t), endpoint, testTempFile(t, "*.pcapng"))
+		assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+		_, err = tcpConnectionToPcapng(ctx, testScanner(t), nil, endpoint, testTempFile(t, "*.pcapng"))
+		assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+		_, err = tcpConnectionToPcapng(ctx, testScanner(t), testScanner(t), endpoint, nil)
+		assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+
+		_, err = tcpConnectionToPcapng(ctx,
+			testScanner(t, testTcpData(bsr.Inbound, ts, "hello")),
+			testScanner(t),
+			endpoint,
+			testTempFile(t, "*.pcapng"),
+		)
+		assert.ErrorIs(t, err, ErrMalformedBsr)
+
+		_, err = tcpConnectionToPcapng(ctx,
+			testScanner(t, testHeader(ssh.Protocol, bsr.Inbound, ts)),
+			testScanner(t),
+			endpoint,
+			testTempFile(t, "*.pcapng"),
+		)
+		assert.ErrorIs(t, err, ErrUnsupportedProtocol)
+	})
+}
+
+func Test_endpointAddr(t *testing.T) {
+	cases := []struct {
+		name string
+		meta *bsr.SessionMeta
+		want netip.AddrPort
+	}{
+		{
+			name: "nil",
+			want: netip.AddrPortFrom(defaultEndpointAddr, 0),
+		},
+		{
+			name: "ipv4",
+			meta: &bsr.SessionMeta{Endpoint: "tcp://10.0.0.5:22"},
+			want: netip.MustParseAddrPort("10.0.0.5:22"),
+		},
+		{
+			name: "hostname",
+			meta: &bsr.SessionMeta{Endpoint: "tcp://db.example.com:5432"},
+			want: netip.AddrPortFrom(defaultEndpointAddr, 5432),
+		},
+		{
+			name: "ipv6",
+			meta: &bsr.SessionMeta{Endpoint: "tcp://[2001:db8::1]:22"},
+			want: netip.AddrPortFrom(defaultEndpointAddr, 22),
+		},
+		{
+			name: "target default port",
+			meta: &bsr.SessionMeta{Endpoint: "tcp://10.0.0.5", Target: &bsr.Target{DefaultPort: 8080}},
+			want: netip.MustParseAddrPort("10.0.0.5:8080"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, endpointAddr(tc.meta))
+		})
+	}
+}
+
+func Test_mergeWalk(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+
+	var got []string
+	err := mergeWalk(ctx,
+		testScanner(t,
+			testTcpData(bsr.Inbound, ts, "in 0"),
+			testTcpData(bsr.Inbound, ts.Add(2*time.Second), "in 2"),
+		),
+		testScanner(t,
+			testTcpData(bsr.Outbound, ts, "out 0"),
+			testTcpData(bsr.Outbound, ts.Add(time.Second), "out 1"),
+			testTcpData(bsr.Outbound, ts.Add(3*time.Second), "out 3"),
+		),
+		func(_ context.Context, c bsr.Chunk) error {
+			got = append(got, string(c.(*tcp.DataChunk).Data))
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"in 0", "out 0", "out 1", "in 2", "out 3"}, got)
+
+	err = mergeWalk(ctx, testScanner(t, testTcpData(bsr.Inbound, ts, "in")), testScanner(t), func(context.Context, bsr.Chunk) error {
+		return ErrMalformedBsr
+	})
+	assert.ErrorIs(t, err, ErrMalformedBsr)
+	assert.ErrorIs(t, mergeWalk(ctx, nil, testScanner(t), nil), bsr.ErrInvalidParameter)
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+	"github.com/hashicorp/boundary/internal/bsr/ssh"
+	"github.com/hashicorp/boundary/internal/storage"
+)
+
+// Markers which prefix the lines of a transcript with the direction of the
+// data.
+const (
+	transcriptInbound  = ">"
+	transcriptOutbound = "<"
+	transcriptComment  = "#"
+)
+
+// ToTranscript accepts a bsr.Session and will convert the underlying BSR
+// channel to a plain text transcript. This is intended for ssh exec and
+// subsystem channels, whose data is not meant to be played back on a
+// terminal. Every line of the transcript is prefixed with the time the data
+// was recorded and a marker for its direction: ">" for data sent by the
+// client and "<" for data sent by the endpoint. Lines beginning with "#"
+// describe the requests made on the channel.
+// The tmp file will be used to write the transcript to disk.
+// It returns an io.ReadCloser to the converted transcript.
+// This supports the following options:
+//   - WithChannelId to indicate this conversion should occur on a channel on a multiplexed session
+func ToTranscript(ctx context.Context, session *bsr.Session, tmp storage.TempFile, connectionId string, options ...Option) (io.ReadCloser, error) {
+	const op = "convert.ToTranscript"
+
+	switch {
+	case is.Nil(session):
+		return nil, fmt.Errorf("%s: missing session: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(session.Meta):
+		return nil, fmt.Errorf("%s: missing session meta: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(tmp):
+		return nil, fmt.Errorf("%s: missing temp file: %w", op, bsr.ErrInvalidParameter)
+	case connectionId == "":
+		return nil, fmt.Errorf("%s: missing connection id: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	opts := getOpts(options...)
+
+	switch session.Meta.Protocol {
+	case ssh.Protocol:
+		chanId := opts.withChannelId
+		switch {
+		case chanId == "":
+			return nil, fmt.Errorf("%s: protocol %q requires channel id to convert: %w", op, ssh.Protocol, bsr.ErrInvalidParameter)
+		}
+
+		conn, err := session.OpenConnection(ctx, connectionId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		ch, err := conn.OpenChannel(ctx, chanId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		reqScanner, err := ch.OpenRequestScanner(ctx, bsr.Inbound)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		inScanner, err := ch.OpenMessageScanner(ctx, bsr.Inbound)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		outScanner, err := ch.OpenMessageScanner(ctx, bsr.Outbound)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		return sshChannelToTranscript(ctx, reqScanner, inScanner, outScanner, tmp)
+	default:
+		return nil, fmt.Errorf("%s: %w", op, ErrUnsupportedProtocol)
+	}
+}
+
+// sshChannelToTranscript will convert a recording of an ssh channel from a
+// BSR into a plain text transcript. This expects three bsr.ChunkScanners. One
+// for the recording of the inbound ssh requests, which are described at the
+// start of the transcript, and one for each direction of the recorded
+// messages. This also expects a io.ReadWriteSeeker that will be used to write
+// the transcript. This is then reset and returned as a io.ReadCloser. The
+// caller should call Close on the returned io.ReadCloser after reading the
+// transcript.
+func sshChannelToTranscript(ctx context.Context, requestScanner *bsr.ChunkScanner, inboundScanner *bsr.ChunkScanner, outboundScanner *bsr.ChunkScanner, w io.ReadWriteSeeker) (io.ReadCloser, error) {
+	const op = "convert.sshChannelToTranscript"
+
+	switch {
+	case is.Nil(requestScanner):
+		return nil, fmt.Errorf("%s: missing request scanner: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(inboundScanner):
+		return nil, fmt.Errorf("%s: missing inbound message scanner: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(outboundScanner):
+		return nil, fmt.Errorf("%s: missing outbound message scanner: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(w):
+		return nil, fmt.Errorf("%s: missing read write seeker: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	bw := bufio.NewWriter(w)
+
+	// Describe the program the channel was opened for.
+	if err := bsr.ChunkWalk(ctx, requestScanner, func(ctx context.Context, c bsr.Chunk) error {
+		if c.GetProtocol() != ssh.Protocol {
+			return ErrUnsupportedProtocol
+		}
+		var desc string
+		switch c.GetType() {
+		case ssh.ExecReqChunkType:
+			desc = "exec " + strconv.Quote(c.(*ssh.ExecRequest).GetCommand())
+		case ssh.SubsystemReqChunkType:
+			desc = "subsystem " + strconv.Quote(c.(*ssh.SubsystemRequest).GetSubsystemName())
+		case ssh.ShellReqChunkType:
+			desc = "shell"
+		default:
+			return nil
+		}
+		return writeTranscriptLine(bw, c.GetTimestamp().AsTime(), transcriptComment, []byte(desc))
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var headers int
+	if err := mergeWalk(ctx, inboundScanner, outboundScanner, func(ctx context.Context, c bsr.Chunk) error {
+		if c.GetProtocol() != ssh.Protocol {
+			return ErrUnsupportedProtocol
+		}
+		switch c.GetType() {
+		case bsr.ChunkHeader:
+			headers++
+			if headers > 2 {
+				return fmt.Errorf("multiple header chunks: %w", ErrMalformedBsr)
+			}
+		case ssh.DataChunkType:
+			if headers == 0 {
+				return fmt.Errorf("data chunk before header: %w", ErrMalformedBsr)
+			}
+			marker := transcriptInbound
+			if c.GetDirection() == bsr.Outbound {
+				marker = transcriptOutbound
+			}
+			ts := c.GetTimestamp().AsTime()
+			data := c.(*ssh.DataChunk).Data
+			// Data which ends with a newline does not start another line.
+			data = bytes.TrimSuffix(data, []byte("\n"))
+			for _, line := range bytes.Split(data, []byte("\n")) {
+				if err := writeTranscriptLine(bw, ts, marker, line); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := bw.Flush(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := w.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var r io.ReadCloser
+	if v, ok := w.(io.ReadCloser); ok {
+		r = v
+	} else {
+		r = io.NopCloser(w)
+	}
+	return r, nil
+}
+
+// writeTranscriptLine writes a line of a transcript. Bytes which are not
+// printable, such as control characters or invalid utf8, are written as
+// escape sequences so that binary data can't corrupt the transcript.
+func writeTranscriptLine(w *bufio.Writer, ts time.Time, marker string, line []byte) error {
+	w.WriteString(ts.UTC().Format(time.RFC3339Nano))
+	w.WriteByte(' ')
+	w.WriteString(marker)
+	w.WriteByte(' ')
+	for len(line) > 0 {
+		r, size := utf8.DecodeRune(line)
+		switch {
+		case r == utf8.RuneError && size <= 1:
+			fmt.Fprintf(w, `\x%02x`, line[0])
+		case r == '\t' || unicode.IsPrint(r):
+			w.Write(line[:size])
+		case r == '\r':
+			w.WriteString(`\r`)
+		case r < utf8.RuneSelf:
+			fmt.Fprintf(w, `\x%02x`, r)
+		default:
+			fmt.Fprintf(w, `\u%04x`, r)
+		}
+		line = line[size:]
+	}
+	_, err := w.WriteString("\n")
+	return err
+}
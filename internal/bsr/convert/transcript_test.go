@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	sshv1 "github.com/hashicorp/boundary/internal/bsr/gen/ssh/v1"
+	"github.com/hashicorp/boundary/internal/bsr/ssh"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSshData(d bsr.Direction, ts time.Time, data string) *ssh.DataChunk {
+	return &ssh.DataChunk{
+		BaseChunk: &bsr.BaseChunk{
+			Protocol:  ssh.Protocol,
+			Direction: d,
+			Timestamp: bsr.NewTimestamp(ts),
+			Type:      ssh.DataChunkType,
+		},
+		Data: []byte(data),
+	}
+}
+
+func Test_sshChannelToTranscript(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+
+	cases := []struct {
+		name            string
+		requestScanner  *bsr.ChunkScanner
+		inboundScanner  *bsr.ChunkScanner
+		outboundScanner *bsr.ChunkScanner
+		want            string
+		wantErr         error
+	}{
+		{
+			name: "exec",
+			requestScanner: testScanner(t,
+				testHeader(ssh.Protocol, bsr.Inbound, ts),
+				&ssh.ExecRequest{
+					BaseChunk: &bsr.BaseChunk{
+						Protocol:  ssh.Protocol,
+						Direction: bsr.Inbound,
+						Timestamp: bsr.NewTimestamp(ts),
+						Type:      ssh.ExecReqChunkType,
+					},
+					ExecRequest: &sshv1.ExecRequest{
+						RequestType: "exec",
+						Command:     "cat > notes.txt",
+					},
+				},
+				testEnd(ssh.Protocol, bsr.Inbound, ts.Add(3*time.Second)),
+			),
+			inboundScanner: testScanner(t,
+				testHeader(ssh.Protocol, bsr.Inbound, ts),
+				testSshData(bsr.Inbound, ts.Add(time.Second), "first line\nsecond line\n"),
+				testSshData(bsr.Inbound, ts.Add(2*time.Second), "\x00binary\xff\r\n"),
+				testEnd(ssh.Protocol, bsr.Inbound, ts.Add(3*time.Second)),
+			),
+			outboundScanner: testScanner(t,
+				testHeader(ssh.Protocol, bsr.Outbound, ts),
+				testSshData(bsr.Outbound, ts.Add(1500*time.Millisecond), "done\tok"),
+				testEnd(ssh.Protocol, bsr.Outbound, ts.Add(3*time.Second)),
+			),
+			want: `2023-03-16T10:47:03.000000014Z # exec "cat > notes.txt"
+2023-03-16T10:47:04.000000014Z > first line
+2023-03-16T10:47:04.000000014Z > second line
+2023-03-16T10:47:04.500000014Z < done	ok
+2023-03-16T10:47:05.000000014Z > \x00binary\xff\r
+`,
+		},
+		{
+			name: "subsystem",
+			requestScanner: testScanner(t,
+				testHeader(ssh.Protocol, bsr.Inbound, ts),
+				&ssh.SubsystemRequest{
+					BaseChunk: &bsr.BaseChunk{
+						Protocol:  ssh.Protocol,
+						Direction: bsr.Inbound,
+						Timestamp: bsr.NewTimestamp(ts),
+						Type:      ssh.SubsystemReqChunkType,
+					},
+					SubsystemRequest: &sshv1.SubsystemRequest{
+						RequestType:   "subsystem",
+						SubsystemName: "sftp",
+					},
+				},
+				testEnd(ssh.Protocol, bsr.Inbound, ts.Add(3*time.Second)),
+			),
+			inboundScanner: testScanner(t,
+				testHeader(ssh.Protocol, bsr.Inbound, ts),
+				testEnd(ssh.Protocol, bsr.Inbound, ts.Add(3*time.Second)),
+			),
+			outboundScanner: testScanner(t,
+				testHeader(ssh.Protocol, bsr.Outbound, ts),
+				testEnd(ssh.Protocol, bsr.Outbound, ts.Add(3*time.Second)),
+			),
+			want: `2023-03-16T10:47:03.000000014Z # subsystem "sftp"
+`,
+		},
+		{
+			name:           "data-before-header",
+			requestScanner: testScanner(t),
+			inboundScanner: testScanner(t,
+				testSshData(bsr.Inbound, ts, "hello"),
+			),
+			outboundScanner: testScanner(t),
+			wantErr:         ErrMalformedBsr,
+		},
+		{
+			name:           "unsupported-protocol",
+			requestScanner: testScanner(t),
+			inboundScanner: testScanner(t,
+				testHeader(tcp.Protocol, bsr.Inbound, ts),
+			),
+			outboundScanner: testScanner(t),
+			wantErr:         ErrUnsupportedProtocol,
+		},
+		{
+			name:            "missing-request-scanner",
+			inboundScanner:  testScanner(t),
+			outboundScanner: testScanner(t),
+			wantErr:         bsr.ErrInvalidParameter,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := sshChannelToTranscript(ctx, tc.requestScanner, tc.inboundScanner, tc.outboundScanner, testTempFile(t, "*.txt"))
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			defer r.Close()
+			got, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func Test_writeTranscriptLine(t *testing.T) {
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 0, time.FixedZone("", 3600))
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	require.NoError(t, writeTranscriptLine(w, ts, transcriptOutbound, []byte("héllo \x1b[0m\u200b")))
+	require.NoError(t, w.Flush())
+	assert.Equal(t, "2023-03-16T09:47:03Z < héllo \\x1b[0m\\u200b\n", buf.String())
+}
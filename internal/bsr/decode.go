@@ -16,6 +16,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/bsr/internal/is"
 	"github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/klauspost/compress/zstd"
 )
 
 // DecodeChunkFunc is a function that given a BaseChunk and the data portion
@@ -72,12 +73,28 @@ type ChunkDecoder struct {
 	compression Compression
 	encryption  Encryption
 
-	keys *kms.Keys
+	keys       *kms.Keys
+	cipher     *chunkCipher
+	dictionary []byte
+	zstd       *zstd.Decoder
+	raw        bool
+}
+
+// rawChunk is a chunk whose data has not been decoded.
+type rawChunk struct {
+	*BaseChunk
+	data []byte
+}
+
+// MarshalData returns the data of the chunk as it was read.
+func (c *rawChunk) MarshalData(_ context.Context) ([]byte, error) {
+	return c.data, nil
 }
 
 // NewChunkDecoder creates a ChunkDecoder that can decode the data read from
-// the given io.Reader. Supports the WithKeys option which will be used when
-// support for encrypted chunks is added.
+// the given io.Reader. Supports the following options:
+//   - WithKeys: required to decode chunks that were encrypted.
+//   - WithDictionary: the zstd dictionary chunks were compressed with, if any.
 func NewChunkDecoder(_ context.Context, r io.Reader, options ...Option) (*ChunkDecoder, error) {
 	const op = "bsr.NewChunkDecoder"
 
@@ -92,6 +109,8 @@ func NewChunkDecoder(_ context.Context, r io.Reader, options ...Option) (*ChunkD
 		compression: NoCompression,
 		encryption:  NoEncryption,
 		keys:        opts.withKeys,
+		dictionary:  opts.withDictionary,
+		raw:         opts.withRawChunks,
 	}, nil
 }
 
@@ -126,6 +145,9 @@ func (d *ChunkDecoder) Decode(ctx context.Context) (Chunk, error) {
 	crc := crc32.NewIEEE()
 
 	length, buf = binary.BigEndian.Uint32(buf[:lengthSize]), buf[lengthSize:]
+	// The protocol, type, direction and timestamp are authenticated along
+	// with the data of encrypted chunks.
+	additional := buf
 	databuf := make([]byte, length)
 	_, err = io.ReadAtLeast(d.r, databuf, int(length))
 	if err != nil {
@@ -166,6 +188,29 @@ func (d *ChunkDecoder) Decode(ctx context.Context) (Chunk, error) {
 		return nil, fmt.Errorf("%s: %w: %w", op, err, ErrChunkDecode)
 	}
 
+	if d.raw && chunkType != ChunkHeader && chunkType != ChunkEnd {
+		return &rawChunk{BaseChunk: b, data: databuf}, nil
+	}
+
+	switch chunkType {
+	// HEAD and END are never encrypted
+	case ChunkHeader, ChunkEnd:
+	default:
+		switch d.encryption {
+		case AesGcmEncryption:
+			if d.cipher == nil {
+				d.cipher, err = newChunkCipher(d.keys)
+				if err != nil {
+					return nil, fmt.Errorf("%s: unable to decrypt chunk: %w: %w", op, err, ErrChunkDecode)
+				}
+			}
+			databuf, err = d.cipher.open(databuf, additional)
+			if err != nil {
+				return nil, fmt.Errorf("%s: unable to decrypt chunk: %w: %w", op, err, ErrChunkDecode)
+			}
+		}
+	}
+
 	decompressBuf := bytes.NewBuffer(databuf)
 	var decompressor io.ReadCloser
 	switch chunkType {
@@ -179,6 +224,17 @@ func (d *ChunkDecoder) Decode(ctx context.Context) (Chunk, error) {
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w: %w", op, err, ErrChunkDecode)
 			}
+		case ZstdCompression:
+			if d.zstd == nil {
+				d.zstd, err = newZstdDecoder(d.dictionary)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w: %w", op, err, ErrChunkDecode)
+				}
+			}
+			decompressor, err = newZstdCompressionReader(decompressBuf, d.zstd)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w: %w", op, err, ErrChunkDecode)
+			}
 		default:
 			decompressor = newNullCompressionReader(decompressBuf)
 		}
@@ -11,18 +11,27 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io"
+
+	"github.com/klauspost/compress/zstd"
 )
 
 // ChunkEncoder will encode a chunk and write it to the writer.
-// It will compress the chunk data based on the compression.
+// It will compress and then encrypt the chunk data based on the compression
+// and encryption.
 type ChunkEncoder struct {
 	w           io.Writer
 	compression Compression
 	encryption  Encryption
+
+	cipher *chunkCipher
+	zstd   *zstd.Encoder
 }
 
-// NewChunkEncoder creates a ChunkEncoder.
-func NewChunkEncoder(ctx context.Context, w io.Writer, c Compression, e Encryption) (*ChunkEncoder, error) {
+// NewChunkEncoder creates a ChunkEncoder. Supports the following options:
+//   - WithKeys: required when using AesGcmEncryption.
+//   - WithDictionary: a zstd dictionary to compress chunks with when using
+//     ZstdCompression.
+func NewChunkEncoder(ctx context.Context, w io.Writer, c Compression, e Encryption, options ...Option) (*ChunkEncoder, error) {
 	const op = "bsr.NewChunkEncoder"
 
 	if w == nil {
@@ -37,10 +46,36 @@ func NewChunkEncoder(ctx context.Context, w io.Writer, c Compression, e Encrypti
 		return nil, fmt.Errorf("%s: invalid encryption: %w", op, ErrInvalidParameter)
 	}
 
+	opts := getOpts(options...)
+
+	if len(opts.withDictionary) > 0 && c != ZstdCompression {
+		return nil, fmt.Errorf("%s: dictionary requires zstd compression: %w", op, ErrInvalidParameter)
+	}
+
+	var zc *zstd.Encoder
+	if c == ZstdCompression {
+		var err error
+		zc, err = newZstdEncoder(opts.withDictionary)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	var cc *chunkCipher
+	if e == AesGcmEncryption {
+		var err error
+		cc, err = newChunkCipher(opts.withKeys)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	return &ChunkEncoder{
 		w:           w,
 		compression: c,
 		encryption:  e,
+		cipher:      cc,
+		zstd:        zc,
 	}, nil
 }
 
@@ -64,6 +99,8 @@ func (e ChunkEncoder) Encode(ctx context.Context, c Chunk) (int, error) {
 		switch e.compression {
 		case GzipCompression:
 			compressor = gzip.NewWriter(&buf)
+		case ZstdCompression:
+			compressor = newZstdCompressionWriter(&buf, e.zstd)
 		default:
 			compressor = newNullCompressionWriter(&buf)
 		}
@@ -76,17 +113,36 @@ func (e ChunkEncoder) Encode(ctx context.Context, c Chunk) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	length := buf.Len()
 
 	t := c.GetTimestamp().marshal()
 
+	base := make([]byte, 0, chunkBaseSize-lengthSize)
+	base = append(base, c.GetProtocol()...)
+	base = append(base, c.GetType()...)
+	base = append(base, byte(c.GetDirection()))
+	base = append(base, t...)
+
+	data = buf.Bytes()
+	switch c.GetType() {
+	// Header should not be encrypted since it is needed to know which
+	// encryption was used. End has no data to protect.
+	case ChunkHeader, ChunkEnd:
+	default:
+		if e.cipher != nil {
+			// The rest of the chunk is authenticated with the data, so that
+			// encrypted data can't be moved to another chunk.
+			data, err = e.cipher.seal(data, base)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	length := len(data)
+
 	// calculate CRC for protocol+type+dir+timestamp+data
 	crced := make([]byte, 0, chunkBaseSize+length)
-	crced = append(crced, c.GetProtocol()...)
-	crced = append(crced, c.GetType()...)
-	crced = append(crced, byte(c.GetDirection()))
-	crced = append(crced, t...)
-	crced = append(crced, buf.Bytes()...)
+	crced = append(crced, base...)
+	crced = append(crced, data...)
 
 	crc := crc32.NewIEEE()
 	_, err = crc.Write(crced)
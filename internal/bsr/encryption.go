@@ -3,8 +3,29 @@
 
 package bsr
 
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+	"github.com/hashicorp/boundary/internal/bsr/kms"
+	"golang.org/x/crypto/hkdf"
+)
+
 const (
 	encryptionSize = 1
+
+	// chunkKeySize is the size of the AES-256 key chunks are encrypted with.
+	chunkKeySize = 32
+
+	// chunkKeyInfo is the HKDF info used to derive the chunk encryption key
+	// from the BSR key, so that the key used for chunks is never used for
+	// anything else, such as the signatures made with the BSR key.
+	chunkKeyInfo = "boundary bsr chunk encryption"
 )
 
 // Encryption is used to identify the encryption used for the data in chunks.
@@ -13,12 +34,18 @@ type Encryption uint8
 // Supported encryption methods.
 const (
 	NoEncryption Encryption = iota
+	// AesGcmEncryption encrypts the data of each chunk with AES-256-GCM using
+	// a key derived from the session's BSR key. The chunk's protocol, type,
+	// direction and timestamp are authenticated along with the data.
+	AesGcmEncryption
 )
 
 func (e Encryption) String() string {
 	switch e {
 	case NoEncryption:
 		return "no encryption"
+	case AesGcmEncryption:
+		return "aes-gcm"
 	default:
 		return "unknown encryption"
 	}
@@ -27,8 +54,78 @@ func (e Encryption) String() string {
 // ValidEncryption checks if a given Encryption is valid.
 func ValidEncryption(e Encryption) bool {
 	switch e {
-	case NoEncryption:
+	case NoEncryption, AesGcmEncryption:
 		return true
 	}
 	return false
 }
+
+// ParseEncryption returns the Encryption named by s, which is one of "none"
+// or "aes-gcm". These are the names used to configure the encryption of
+// recordings.
+func ParseEncryption(s string) (Encryption, error) {
+	const op = "bsr.ParseEncryption"
+	switch s {
+	case "none":
+		return NoEncryption, nil
+	case "aes-gcm":
+		return AesGcmEncryption, nil
+	}
+	return NoEncryption, fmt.Errorf("%s: unknown encryption %q: %w", op, s, ErrInvalidParameter)
+}
+
+// chunkCipher encrypts and decrypts the data of chunks.
+type chunkCipher struct {
+	aead cipher.AEAD
+}
+
+// newChunkCipher creates a chunkCipher keyed from the BSR key in keys.
+func newChunkCipher(keys *kms.Keys) (*chunkCipher, error) {
+	const op = "bsr.newChunkCipher"
+
+	switch {
+	case is.Nil(keys):
+		return nil, fmt.Errorf("%s: missing keys: %w", op, ErrInvalidParameter)
+	case is.Nil(keys.BsrKey), len(keys.BsrKey.GetKey()) == 0:
+		return nil, fmt.Errorf("%s: missing bsr key: %w", op, ErrInvalidParameter)
+	}
+
+	key := make([]byte, chunkKeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, keys.BsrKey.GetKey(), nil, []byte(chunkKeyInfo)), key); err != nil {
+		return nil, fmt.Errorf("%s: unable to derive chunk key: %w", op, err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &chunkCipher{aead: aead}, nil
+}
+
+// seal encrypts data, returning a random nonce followed by the ciphertext.
+// The additional data is authenticated but not encrypted.
+func (c *chunkCipher) seal(data, additional []byte) ([]byte, error) {
+	const op = "bsr.(chunkCipher).seal"
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(data)+c.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("%s: unable to read nonce: %w", op, err)
+	}
+	return c.aead.Seal(nonce, nonce, data, additional), nil
+}
+
+// open decrypts data sealed by seal with the same additional data.
+func (c *chunkCipher) open(data, additional []byte) ([]byte, error) {
+	const op = "bsr.(chunkCipher).open"
+	if len(data) < c.aead.NonceSize() {
+		return nil, fmt.Errorf("%s: missing nonce", op)
+	}
+	nonce, ciphertext := data[:c.aead.NonceSize()], data[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, additional)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return plaintext, nil
+}
@@ -4,10 +4,16 @@
 package bsr_test
 
 import (
+	"bytes"
+	"context"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/kms"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidEncrpytion(t *testing.T) {
@@ -21,6 +27,11 @@ func TestValidEncrpytion(t *testing.T) {
 			bsr.NoEncryption,
 			true,
 		},
+		{
+			bsr.AesGcmEncryption.String(),
+			bsr.AesGcmEncryption,
+			true,
+		},
 		{
 			"something else",
 			bsr.Encryption(255),
@@ -47,6 +58,11 @@ func TestEncryptionString(t *testing.T) {
 			bsr.NoEncryption,
 			"no encryption",
 		},
+		{
+			bsr.AesGcmEncryption.String(),
+			bsr.AesGcmEncryption,
+			"aes-gcm",
+		},
 		{
 			"something else",
 			bsr.Encryption(255),
@@ -61,3 +77,98 @@ func TestEncryptionString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseEncryption(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    bsr.Encryption
+		wantErr bool
+	}{
+		{"none", bsr.NoEncryption, false},
+		{"aes-gcm", bsr.AesGcmEncryption, false},
+		{"", bsr.NoEncryption, true},
+		{"aes-cbc", bsr.NoEncryption, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := bsr.ParseEncryption(tc.in)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestChunkEncryption(t *testing.T) {
+	ctx := context.Background()
+	ts := bsr.NewTimestamp(time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC))
+
+	keys, err := kms.CreateKeys(ctx, kms.TestWrapper(t), "sess_123456789")
+	require.NoError(t, err)
+	otherKeys, err := kms.CreateKeys(ctx, kms.TestWrapper(t), "sess_123456789")
+	require.NoError(t, err)
+
+	for _, c := range []bsr.Compression{bsr.NoCompression, bsr.GzipCompression} {
+		t.Run(c.String(), func(t *testing.T) {
+			header, err := bsr.NewHeader(ctx, "TEST", bsr.Inbound, ts, c, bsr.AesGcmEncryption, "sess_123456789")
+			require.NoError(t, err)
+			end, err := bsr.NewEnd(ctx, "TEST", bsr.Inbound, ts)
+			require.NoError(t, err)
+			want := []bsr.Chunk{
+				header,
+				&testChunk{
+					BaseChunk: &bsr.BaseChunk{Protocol: "TEST", Direction: bsr.Inbound, Timestamp: ts, Type: "TEST"},
+					Data:      []byte("password: hunter2"),
+				},
+				&testChunk{
+					BaseChunk: &bsr.BaseChunk{Protocol: "TEST", Direction: bsr.Inbound, Timestamp: ts, Type: "TEST"},
+					Data:      []byte{},
+				},
+				end,
+			}
+
+			var buf bytes.Buffer
+			enc, err := bsr.NewChunkEncoder(ctx, &buf, c, bsr.AesGcmEncryption, bsr.WithKeys(keys))
+			require.NoError(t, err)
+			for _, chunk := range want {
+				_, err := enc.Encode(ctx, chunk)
+				require.NoError(t, err)
+			}
+			encoded := buf.Bytes()
+			assert.NotContains(t, string(encoded), "hunter2")
+
+			dec, err := bsr.NewChunkDecoder(ctx, bytes.NewReader(encoded), bsr.WithKeys(keys))
+			require.NoError(t, err)
+			var got []bsr.Chunk
+			for {
+				chunk, err := dec.Decode(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				got = append(got, chunk)
+			}
+			assert.Equal(t, want, got)
+
+			// Only the header can be decoded without the key the data was
+			// encrypted with.
+			for _, opts := range [][]bsr.Option{nil, {bsr.WithKeys(otherKeys)}} {
+				dec, err := bsr.NewChunkDecoder(ctx, bytes.NewReader(encoded), opts...)
+				require.NoError(t, err)
+				_, err = dec.Decode(ctx)
+				require.NoError(t, err)
+				_, err = dec.Decode(ctx)
+				assert.ErrorIs(t, err, bsr.ErrChunkDecode)
+			}
+		})
+	}
+
+	_, err = bsr.NewChunkEncoder(ctx, &bytes.Buffer{}, bsr.NoCompression, bsr.AesGcmEncryption)
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	_, err = bsr.NewChunkEncoder(ctx, &bytes.Buffer{}, bsr.NoCompression, bsr.AesGcmEncryption, bsr.WithKeys(&kms.Keys{}))
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"sync"
 	"time"
 
@@ -92,6 +93,16 @@ func (m *MemFS) Open(_ context.Context, n string) (storage.Container, error) {
 	return c, nil
 }
 
+// List returns the sorted names of the containers in the MemFS.
+func (m *MemFS) List(_ context.Context) ([]string, error) {
+	names := make([]string, 0, len(m.Containers))
+	for n := range m.Containers {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
 // MemContainer is a storage.Container that resides in memory.
 type MemContainer struct {
 	Name string
@@ -74,6 +74,22 @@ func (fs *LocalFS) Open(_ context.Context, name string) (storage.Container, erro
 	}, nil
 }
 
+// List returns the names of the root containers within the local FS.
+func (fs *LocalFS) List(_ context.Context) ([]string, error) {
+	const op = "fstest.(LocalFS).List"
+	entries, err := os.ReadDir(fs.Path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			names = append(names, e.Name())
+		}
+	}
+	return names, nil
+}
+
 // joinPath joins a parent path with the single name child container or file.
 // The name cannot contain any path seperators.
 // The name cannot contain `..`
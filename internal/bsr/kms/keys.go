@@ -66,6 +66,28 @@ type UnwrappedKeys struct {
 // KeyUnwrapCallbackFunc is used by OpenSession to unwrap BSR and private keys
 type KeyUnwrapCallbackFunc func(WrappedKeys) (UnwrappedKeys, error)
 
+// NewKeyUnwrapCallbackFunc returns a KeyUnwrapCallbackFunc which unwraps the
+// BSR and private keys using the provided bsrWrapper.
+func NewKeyUnwrapCallbackFunc(ctx context.Context, bsrWrapper wrapping.Wrapper) KeyUnwrapCallbackFunc {
+	const op = "kms.NewKeyUnwrapCallbackFunc"
+	return func(w WrappedKeys) (UnwrappedKeys, error) {
+		k := &Keys{
+			WrappedBsrKey:  w.WrappedBsrKey,
+			WrappedPrivKey: w.WrappedPrivKey,
+		}
+		if _, err := k.UnwrapBsrKey(ctx, bsrWrapper); err != nil {
+			return UnwrappedKeys{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if _, err := k.UnwrapPrivKey(ctx, bsrWrapper); err != nil {
+			return UnwrappedKeys{}, fmt.Errorf("%s: %w", op, err)
+		}
+		return UnwrappedKeys{
+			BsrKey:  k.BsrKey,
+			PrivKey: k.PrivKey,
+		}, nil
+	}
+}
+
 // CreateKeys creates new bsr keys, wrapping and signing keys as required
 // using the provided bsrWrapper. Supported options: WithRandomReader
 func CreateKeys(ctx context.Context, bsrWrapper wrapping.Wrapper, sessionId string, opt ...Option) (*Keys, error) {
@@ -281,6 +281,35 @@ func TestCreateBsrKeys(t *testing.T) {
 	}
 }
 
+func TestNewKeyUnwrapCallbackFunc(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	testBsrWrapper := kms.TestWrapper(t)
+
+	keys, err := kms.CreateKeys(testCtx, testBsrWrapper, "session-id")
+	require.NoError(t, err)
+	wrapped := kms.WrappedKeys{
+		WrappedBsrKey:  keys.WrappedBsrKey,
+		WrappedPrivKey: keys.WrappedPrivKey,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := kms.NewKeyUnwrapCallbackFunc(testCtx, testBsrWrapper)(wrapped)
+		require.NoError(err)
+		assert.Equal(keys.BsrKey.Key, got.BsrKey.Key)
+		assert.Equal(keys.PrivKey.Key, got.PrivKey.Key)
+	})
+	t.Run("wrong-wrapper", func(t *testing.T) {
+		_, err := kms.NewKeyUnwrapCallbackFunc(testCtx, kms.TestWrapper(t))(wrapped)
+		assert.ErrorIs(t, err, kms.ErrDecrypt)
+	})
+	t.Run("missing-keys", func(t *testing.T) {
+		_, err := kms.NewKeyUnwrapCallbackFunc(testCtx, testBsrWrapper)(kms.WrappedKeys{})
+		assert.ErrorIs(t, err, kms.ErrInvalidParameter)
+	})
+}
+
 func TestBsrKeys_UnwrapBsrKey(t *testing.T) {
 	t.Parallel()
 	testCtx := context.Background()
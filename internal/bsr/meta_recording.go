@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 )
 
@@ -72,6 +73,11 @@ func decodeSessionRecordingMeta(ctx context.Context, r io.Reader) (*SessionRecor
 	return s, nil
 }
 
+// Connections returns the ids of the connections in an opened BSR Session.
+func (s *SessionRecordingMeta) Connections() []string {
+	return containerIds(s.connections, connectionFileNameTemplate)
+}
+
 // ConnectionRecordingMeta contains metadata about a connection in a BSR.
 type ConnectionRecordingMeta struct {
 	Id       string
@@ -120,6 +126,23 @@ func decodeConnectionRecordingMeta(ctx context.Context, r io.Reader) (*Connectio
 	return c, nil
 }
 
+// Channels returns the ids of the channels in an opened BSR Connection.
+func (c *ConnectionRecordingMeta) Channels() []string {
+	return containerIds(c.channels, channelFileNameTemplate)
+}
+
+// containerIds returns the sorted ids of the named containers, whose names are
+// the id formatted with the template.
+func containerIds(names map[string]bool, template string) []string {
+	suffix := strings.TrimPrefix(template, "%s")
+	ids := make([]string, 0, len(names))
+	for n := range names {
+		ids = append(ids, strings.TrimSuffix(n, suffix))
+	}
+	sort.Strings(ids)
+	return ids
+}
+
 // ChannelRecordingMeta contains metadata about a channel in a BSR.
 type ChannelRecordingMeta struct {
 	Id   string
@@ -22,6 +22,10 @@ type options struct {
 	withSupportsMultiplex bool
 	withKeys              *kms.Keys
 	withSha256Sum         []byte
+	withCompression       Compression
+	withEncryption        Encryption
+	withDictionary        []byte
+	withRawChunks         bool
 }
 
 func getDefaultOptions() options {
@@ -29,6 +33,10 @@ func getDefaultOptions() options {
 		withSupportsMultiplex: false,
 		withKeys:              nil,
 		withSha256Sum:         nil,
+		withCompression:       NoCompression,
+		withEncryption:        NoEncryption,
+		withDictionary:        nil,
+		withRawChunks:         false,
 	}
 }
 
@@ -53,3 +61,36 @@ func WithSha256Sum(b []byte) Option {
 		o.withSha256Sum = b
 	}
 }
+
+// WithCompression is used to select the Compression for the chunks recorded
+// in a session.
+func WithCompression(c Compression) Option {
+	return func(o *options) {
+		o.withCompression = c
+	}
+}
+
+// WithEncryption is used to select the Encryption for the chunks recorded in
+// a session.
+func WithEncryption(e Encryption) Option {
+	return func(o *options) {
+		o.withEncryption = e
+	}
+}
+
+// WithDictionary is used to provide a zstd dictionary, as created by
+// "zstd --train", for chunks compressed with ZstdCompression.
+func WithDictionary(d []byte) Option {
+	return func(o *options) {
+		o.withDictionary = d
+	}
+}
+
+// withRawChunks is used to decode chunks other than headers and ends without
+// decrypting, decompressing or decoding their data, so that the chunks of a
+// BSR can be verified without its keys.
+func withRawChunks() Option {
+	return func(o *options) {
+		o.withRawChunks = true
+	}
+}
@@ -22,6 +22,9 @@ func Test_GetOpts(t *testing.T) {
 			withSupportsMultiplex: false,
 			withKeys:              nil,
 			withSha256Sum:         nil,
+			withCompression:       NoCompression,
+			withDictionary:        nil,
+			withRawChunks:         false,
 		}
 		assert.Equal(opts, testOpts)
 	})
@@ -54,4 +57,32 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withSha256Sum = sum
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithCompression", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithCompression(ZstdCompression))
+		testOpts := getDefaultOptions()
+		testOpts.withCompression = ZstdCompression
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithEncryption", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithEncryption(AesGcmEncryption))
+		testOpts := getDefaultOptions()
+		testOpts.withEncryption = AesGcmEncryption
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDictionary", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDictionary([]byte("dictionary")))
+		testOpts := getDefaultOptions()
+		testOpts.withDictionary = []byte("dictionary")
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("withRawChunks", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(withRawChunks())
+		testOpts := getDefaultOptions()
+		testOpts.withRawChunks = true
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+)
+
+func init() {
+	if err := bsr.RegisterChunkType(Protocol, DataChunkType, DecodeChunk); err != nil {
+		panic(err)
+	}
+
+	if err := bsr.RegisterSummaryAllocFunc(Protocol, bsr.SessionContainer, bsr.AllocSessionSummary); err != nil {
+		panic(err)
+	}
+
+	if err := bsr.RegisterSummaryAllocFunc(Protocol, bsr.ConnectionContainer, bsr.AllocConnectionSummary); err != nil {
+		panic(err)
+	}
+}
+
+const (
+	// Protocol is used to identify chunks that are recorded from tcp.
+	Protocol bsr.Protocol = "BTCP"
+
+	// MaxDataSize is used by the DataWriter to determine if data should be
+	// broken into multiple chunks.
+	MaxDataSize = 256 * 1024
+)
+
+// Chunk types
+const (
+	DataChunkType bsr.ChunkType = "DATA"
+)
+
+// DataChunk contains the raw byte data from a tcp connection.
+type DataChunk struct {
+	*bsr.BaseChunk
+	Data []byte
+}
+
+// NewDataChunk constructs a DataChunk.
+func NewDataChunk(ctx context.Context, d bsr.Direction, t *bsr.Timestamp, data []byte) (*DataChunk, error) {
+	const op = "tcp.NewDataChunk"
+
+	baseChunk, err := bsr.NewBaseChunk(ctx, Protocol, d, t, DataChunkType)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to create base chunk: %w", op, err)
+	}
+
+	return &DataChunk{
+		BaseChunk: baseChunk,
+		Data:      data,
+	}, nil
+}
+
+// MarshalData returns the data for a DataChunk.
+func (c *DataChunk) MarshalData(_ context.Context) ([]byte, error) {
+	return c.Data, nil
+}
+
+// DecodeChunk will decode any known tcp Chunk type. If the chunk type is
+// not a tcp chunk type, an error is returned.
+func DecodeChunk(_ context.Context, bc *bsr.BaseChunk, data []byte) (bsr.Chunk, error) {
+	const op = "tcp.DecodeChunk"
+
+	if is.Nil(bc) {
+		return nil, fmt.Errorf("%s: nil base chunk: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	if bc.Protocol != Protocol {
+		return nil, fmt.Errorf("%s: invalid protocol %s", op, bc.Protocol)
+	}
+
+	switch bc.Type {
+	case DataChunkType:
+		return &DataChunk{
+			BaseChunk: bc,
+			Data:      data,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%s: unsupported chunk type %s", op, bc.Type)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tcp_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDataChunk(t *testing.T) {
+	ctx := context.Background()
+	ts := bsr.NewTimestamp(time.Now())
+
+	got, err := tcp.NewDataChunk(ctx, bsr.Inbound, ts, []byte("foo"))
+	require.NoError(t, err)
+	assert.Equal(t, tcp.Protocol, got.GetProtocol())
+	assert.Equal(t, tcp.DataChunkType, got.GetType())
+	assert.Equal(t, bsr.Inbound, got.GetDirection())
+	assert.Equal(t, ts, got.GetTimestamp())
+	data, err := got.MarshalData(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("foo"), data)
+
+	_, err = tcp.NewDataChunk(ctx, bsr.UnknownDirection, ts, []byte("foo"))
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	_, err = tcp.NewDataChunk(ctx, bsr.Inbound, nil, []byte("foo"))
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+}
+
+func TestDecodeChunk(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name    string
+		bc      *bsr.BaseChunk
+		data    []byte
+		want    bsr.Chunk
+		wantErr bool
+	}{
+		{
+			name: "data",
+			bc:   &bsr.BaseChunk{Protocol: tcp.Protocol, Type: tcp.DataChunkType},
+			data: []byte("foo"),
+			want: &tcp.DataChunk{
+				BaseChunk: &bsr.BaseChunk{Protocol: tcp.Protocol, Type: tcp.DataChunkType},
+				Data:      []byte("foo"),
+			},
+		},
+		{
+			name:    "nil base chunk",
+			data:    []byte("foo"),
+			wantErr: true,
+		},
+		{
+			name:    "wrong protocol",
+			bc:      &bsr.BaseChunk{Protocol: "BSSH", Type: tcp.DataChunkType},
+			data:    []byte("foo"),
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			bc:      &bsr.BaseChunk{Protocol: tcp.Protocol, Type: "UNKN"},
+			data:    []byte("foo"),
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tcp.DecodeChunk(ctx, tc.bc, tc.data)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+Package tcp defines chunk types for recordings of raw tcp connections.
+
+A tcp connection is recorded as two message files in its connection
+container, one per direction. Each file contains a header chunk, a data chunk
+for each write of data seen on the connection in that direction, and an end
+chunk.
+*/
+package tcp
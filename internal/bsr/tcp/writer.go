@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tcp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+)
+
+// DataWriter is an io.WriteCloser that records the data written to it as
+// timestamped DataChunks for one direction of a tcp connection. It is safe
+// for concurrent use.
+type DataWriter struct {
+	ctx context.Context
+	dir bsr.Direction
+
+	mu     sync.Mutex
+	enc    *bsr.ChunkEncoder
+	closed bool
+}
+
+// NewDataWriter creates a DataWriter that encodes chunks to w. The bsr magic
+// string and a header chunk are written to w before returning. Closing the
+// DataWriter writes an end chunk and closes w if it is an io.Closer. Options
+// are passed through to the bsr.ChunkEncoder, such as bsr.WithKeys which is
+// required when using bsr.AesGcmEncryption.
+func NewDataWriter(ctx context.Context, w io.Writer, dir bsr.Direction, c bsr.Compression, e bsr.Encryption, sessionId string, options ...bsr.Option) (*DataWriter, error) {
+	const op = "tcp.NewDataWriter"
+
+	switch {
+	case is.Nil(w):
+		return nil, fmt.Errorf("%s: writer cannot be nil: %w", op, bsr.ErrInvalidParameter)
+	case !bsr.ValidDirection(dir):
+		return nil, fmt.Errorf("%s: invalid direction: %w", op, bsr.ErrInvalidParameter)
+	case sessionId == "":
+		return nil, fmt.Errorf("%s: missing session id: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	enc, err := bsr.NewChunkEncoder(ctx, w, c, e, options...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := w.Write(bsr.Magic.Bytes()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	h, err := bsr.NewHeader(ctx, Protocol, dir, bsr.NewTimestamp(time.Now()), c, e, sessionId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := enc.Encode(ctx, h); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &DataWriter{
+		ctx: ctx,
+		dir: dir,
+		enc: enc,
+	}, nil
+}
+
+// Write records p as one or more DataChunks timestamped with the current
+// time. Data larger than MaxDataSize is split across multiple chunks.
+func (w *DataWriter) Write(p []byte) (int, error) {
+	const op = "tcp.(DataWriter).Write"
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return 0, fmt.Errorf("%s: writer is closed", op)
+	}
+
+	ts := bsr.NewTimestamp(time.Now())
+	var written int
+	for len(p) > 0 {
+		n := len(p)
+		if n > MaxDataSize {
+			n = MaxDataSize
+		}
+		c, err := NewDataChunk(w.ctx, w.dir, ts, p[:n])
+		if err != nil {
+			return written, fmt.Errorf("%s: %w", op, err)
+		}
+		if _, err := w.enc.Encode(w.ctx, c); err != nil {
+			return written, fmt.Errorf("%s: %w", op, err)
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// Close writes an end chunk and closes the underlying writer. Calling Close
+// more than once is not an error.
+func (w *DataWriter) Close() error {
+	const op = "tcp.(DataWriter).Close"
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	end, err := bsr.NewEnd(w.ctx, Protocol, w.dir, bsr.NewTimestamp(time.Now()))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := w.enc.Encode(w.ctx, end); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := w.enc.Close(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tcp_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataWriter(t *testing.T) {
+	ctx := context.Background()
+
+	for _, compression := range []bsr.Compression{bsr.NoCompression, bsr.GzipCompression} {
+		t.Run(compression.String(), func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			var buf bytes.Buffer
+			w, err := tcp.NewDataWriter(ctx, &buf, bsr.Outbound, compression, bsr.NoEncryption, "s_1234567890")
+			require.NoError(err)
+
+			large := bytes.Repeat([]byte("a"), tcp.MaxDataSize+1)
+			n, err := w.Write([]byte("hello"))
+			require.NoError(err)
+			assert.Equal(5, n)
+			n, err = w.Write(large)
+			require.NoError(err)
+			assert.Equal(len(large), n)
+			require.NoError(w.Close())
+			require.NoError(w.Close())
+			_, err = w.Write([]byte("closed"))
+			assert.Error(err)
+
+			s, err := bsr.NewChunkScanner(ctx, &buf)
+			require.NoError(err)
+			var types []bsr.ChunkType
+			var data []byte
+			for {
+				c, err := s.Scan(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(err)
+				assert.Equal(bsr.Outbound, c.GetDirection())
+				types = append(types, c.GetType())
+				switch c := c.(type) {
+				case *bsr.HeaderChunk:
+					assert.Equal(compression, c.Compression)
+					assert.Equal("s_1234567890", c.SessionId)
+				case *tcp.DataChunk:
+					data = append(data, c.Data...)
+				}
+			}
+			assert.Equal([]bsr.ChunkType{bsr.ChunkHeader, tcp.DataChunkType, tcp.DataChunkType, tcp.DataChunkType, bsr.ChunkEnd}, types)
+			assert.Equal(append([]byte("hello"), large...), data)
+		})
+	}
+}
+
+func TestNewDataWriter_Errors(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+
+	_, err := tcp.NewDataWriter(ctx, nil, bsr.Inbound, bsr.NoCompression, bsr.NoEncryption, "s_1234567890")
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	_, err = tcp.NewDataWriter(ctx, &buf, bsr.UnknownDirection, bsr.NoCompression, bsr.NoEncryption, "s_1234567890")
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	_, err = tcp.NewDataWriter(ctx, &buf, bsr.Inbound, bsr.NoCompression, bsr.NoEncryption, "")
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	_, err = tcp.NewDataWriter(ctx, &buf, bsr.Inbound, bsr.Compression(100), bsr.NoEncryption, "s_1234567890")
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+}
@@ -0,0 +1,448 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bsr
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr/internal/checksum"
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+	"github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/storage"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/crypto"
+)
+
+// Verification is the result of verifying a container of a BSR. Problems
+// found in the BSR, such as a checksum that does not match or a truncated
+// file, are recorded as Problems rather than returned as errors so that every
+// problem in the BSR can be reported.
+type Verification struct {
+	Type ContainerType `json:"type"`
+	Id   string        `json:"id"`
+	// Protocol is the protocol of the session. It is only set for the session.
+	Protocol Protocol `json:"protocol,omitempty"`
+	// ChannelType is the type of a channel. It is only set for channels.
+	ChannelType string `json:"channel_type,omitempty"`
+	// PublicKey is the public key stored in the BSR. It is only set for the
+	// session.
+	PublicKey ed25519.PublicKey `json:"public_key,omitempty"`
+	// SessionMeta is only set for the session.
+	SessionMeta *SessionMeta `json:"session_meta,omitempty"`
+	// Summary is nil if the summary could not be decoded, or if no summary is
+	// registered for the protocol and container type.
+	Summary     Summary             `json:"summary,omitempty"`
+	Files       []*FileVerification `json:"files,omitempty"`
+	Problems    []string            `json:"problems,omitempty"`
+	Connections []*Verification     `json:"connections,omitempty"`
+	Channels    []*Verification     `json:"channels,omitempty"`
+}
+
+// FileVerification is the result of verifying a file listed in the checksums
+// of a container.
+type FileVerification struct {
+	Name string `json:"name"`
+	// Chunks, Start and End are only set for files of chunks. Start and End
+	// are the timestamps of the first and last chunk.
+	Chunks   uint64    `json:"chunks,omitempty"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Problems []string  `json:"problems,omitempty"`
+}
+
+// Verified returns true if no problems were found in the container, its
+// files, or the containers it contains.
+func (v *Verification) Verified() bool {
+	if len(v.Problems) > 0 {
+		return false
+	}
+	for _, f := range v.Files {
+		if len(f.Problems) > 0 {
+			return false
+		}
+	}
+	for _, c := range v.Connections {
+		if !c.Verified() {
+			return false
+		}
+	}
+	for _, c := range v.Channels {
+		if !c.Verified() {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *Verification) problemf(format string, a ...any) {
+	v.Problems = append(v.Problems, fmt.Sprintf(format, a...))
+}
+
+func (f *FileVerification) problemf(format string, a ...any) {
+	f.Problems = append(f.Problems, fmt.Sprintf(format, a...))
+}
+
+// VerifySession verifies a BSR without the keys used to record it, so that a
+// downloaded BSR can be verified offline. It verifies the self signature of
+// the public key stored in the BSR, the signature of the checksums of every
+// container, and the checksum of every file listed in them. Files of chunks
+// are also checked for truncated, corrupt or out of order chunks.
+//
+// If pubKey is provided, the public key stored in the BSR must match it and
+// the checksums are verified with pubKey. Otherwise the public key stored in
+// the BSR is used, which only proves that the BSR was not modified after it
+// was signed, not who signed it.
+//
+// An error is only returned if the BSR cannot be opened.
+func VerifySession(ctx context.Context, sessionRecordingId string, f storage.FS, pubKey ed25519.PublicKey) (*Verification, error) {
+	const op = "bsr.VerifySession"
+
+	switch {
+	case sessionRecordingId == "":
+		return nil, fmt.Errorf("%s: missing session recording id: %w", op, ErrInvalidParameter)
+	case is.Nil(f):
+		return nil, fmt.Errorf("%s: missing storage fs: %w", op, ErrInvalidParameter)
+	case pubKey != nil && len(pubKey) != ed25519.PublicKeySize:
+		return nil, fmt.Errorf("%s: expected public key with %d bytes and got %d: %w", op, ed25519.PublicKeySize, len(pubKey), ErrInvalidParameter)
+	}
+
+	c, err := f.Open(ctx, fmt.Sprintf(bsrFileNameTemplate, sessionRecordingId))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer c.Close()
+
+	v := &Verification{
+		Type: SessionContainer,
+		Id:   sessionRecordingId,
+	}
+	cc := &container{container: c}
+	keys := v.verifyPubKey(ctx, cc, pubKey)
+	v.verifyChecksums(ctx, cc, keys)
+
+	meta := &SessionRecordingMeta{}
+	v.decodeFile(ctx, cc, fmt.Sprintf(metaFileNameTemplate, SessionContainer), func(r io.Reader) error {
+		m, err := decodeSessionRecordingMeta(ctx, r)
+		if err != nil {
+			return err
+		}
+		meta = m
+		return nil
+	})
+	if meta.Id != sessionRecordingId {
+		v.problemf("meta file has session recording id %q", meta.Id)
+	}
+	v.Protocol = meta.Protocol
+
+	v.SessionMeta = &SessionMeta{}
+	v.decodeFile(ctx, cc, sessionMetaFileName, func(r io.Reader) error {
+		return json.NewDecoder(r).Decode(v.SessionMeta)
+	})
+	v.decodeSummary(ctx, cc, meta.Protocol)
+	v.verifyFiles(ctx, cc, meta.Protocol)
+
+	for _, id := range meta.Connections() {
+		cv := &Verification{
+			Type: ConnectionContainer,
+			Id:   id,
+		}
+		v.Connections = append(v.Connections, cv)
+		cv.verifyConnection(ctx, c, keys, meta.Protocol)
+	}
+
+	return v, nil
+}
+
+func (v *Verification) verifyConnection(ctx context.Context, sc storage.Container, keys *kms.Keys, p Protocol) {
+	c, err := sc.SubContainer(ctx, fmt.Sprintf(connectionFileNameTemplate, v.Id))
+	if err != nil {
+		v.problemf("unable to open connection: %s", err)
+		return
+	}
+	defer c.Close()
+
+	cc := &container{container: c}
+	v.verifyChecksums(ctx, cc, keys)
+
+	meta := &ConnectionRecordingMeta{}
+	v.decodeFile(ctx, cc, fmt.Sprintf(metaFileNameTemplate, ConnectionContainer), func(r io.Reader) error {
+		m, err := decodeConnectionRecordingMeta(ctx, r)
+		if err != nil {
+			return err
+		}
+		meta = m
+		return nil
+	})
+	if meta.Id != v.Id {
+		v.problemf("meta file has connection id %q", meta.Id)
+	}
+	v.decodeSummary(ctx, cc, p)
+	v.verifyFiles(ctx, cc, p)
+
+	for _, id := range meta.Channels() {
+		cv := &Verification{
+			Type: ChannelContainer,
+			Id:   id,
+		}
+		v.Channels = append(v.Channels, cv)
+		cv.verifyChannel(ctx, c, keys, p)
+	}
+}
+
+func (v *Verification) verifyChannel(ctx context.Context, cc storage.Container, keys *kms.Keys, p Protocol) {
+	c, err := cc.SubContainer(ctx, fmt.Sprintf(channelFileNameTemplate, v.Id))
+	if err != nil {
+		v.problemf("unable to open channel: %s", err)
+		return
+	}
+	defer c.Close()
+
+	chc := &container{container: c}
+	v.verifyChecksums(ctx, chc, keys)
+
+	meta := &ChannelRecordingMeta{}
+	v.decodeFile(ctx, chc, fmt.Sprintf(metaFileNameTemplate, ChannelContainer), func(r io.Reader) error {
+		m, err := decodeChannelRecordingMeta(ctx, r)
+		if err != nil {
+			return err
+		}
+		meta = m
+		return nil
+	})
+	if meta.Id != v.Id {
+		v.problemf("meta file has channel id %q", meta.Id)
+	}
+	v.ChannelType = meta.Type
+	v.decodeSummary(ctx, chc, p)
+	v.verifyFiles(ctx, chc, p)
+}
+
+// verifyPubKey verifies the self signature of the public key stored in the
+// BSR and compares it with pubKey. It returns the keys to verify the
+// checksums with, or nil if there is no key to verify them with.
+func (v *Verification) verifyPubKey(ctx context.Context, cc *container, pubKey ed25519.PublicKey) *kms.Keys {
+	key, err := cc.loadKey(ctx, bsrPubKeyFileName)
+	if err != nil {
+		v.problemf("unable to load public key: %s", err)
+		if pubKey == nil {
+			return nil
+		}
+		return &kms.Keys{PubKey: ed25519KeyInfo(pubKey)}
+	}
+	v.PublicKey = ed25519.PublicKey(key.GetKey())
+
+	keys := &kms.Keys{PubKey: key}
+	keys.PubKeySelfSignature, err = cc.loadSignature(ctx, pubKeySelfSignatureFileName)
+	if err != nil {
+		v.problemf("unable to load public key self signature: %s", err)
+	} else if ok, err := keys.VerifyPubKeySelfSignature(ctx); err != nil || !ok {
+		v.problemf("public key self signature is not valid")
+	}
+
+	if pubKey != nil && !bytes.Equal(pubKey, v.PublicKey) {
+		v.problemf("public key does not match the expected public key")
+		// Nothing signed by the key in the BSR can be trusted, so verify the
+		// checksums with the expected key.
+		return &kms.Keys{PubKey: ed25519KeyInfo(pubKey)}
+	}
+	return keys
+}
+
+func ed25519KeyInfo(k ed25519.PublicKey) *wrapping.KeyInfo {
+	return &wrapping.KeyInfo{
+		KeyType:     wrapping.KeyType_Ed25519,
+		KeyEncoding: wrapping.KeyEncoding_Bytes,
+		Key:         k,
+	}
+}
+
+// verifyChecksums verifies the signature of the checksums of the container and
+// loads them. The checksums are loaded even if the signature is not valid, so
+// that the files can still be checked.
+func (v *Verification) verifyChecksums(ctx context.Context, cc *container, keys *kms.Keys) {
+	f, err := cc.container.OpenFile(ctx, checksumFileName)
+	if err != nil {
+		v.problemf("unable to open %s: %s", checksumFileName, err)
+		return
+	}
+	defer f.Close()
+	var sums bytes.Buffer
+	if _, err := sums.ReadFrom(f); err != nil {
+		v.problemf("unable to read %s: %s", checksumFileName, err)
+		return
+	}
+
+	sig, err := cc.loadSignature(ctx, sigFileName)
+	switch {
+	case err != nil:
+		v.problemf("unable to load %s: %s", sigFileName, err)
+	case keys == nil:
+		v.problemf("%s not verified: no public key", sigFileName)
+	default:
+		if ok, err := keys.VerifySignatureWithPubKey(ctx, sig, sums.Bytes()); err != nil || !ok {
+			v.problemf("%s is not a valid signature of %s", sigFileName, checksumFileName)
+		}
+	}
+
+	cc.shaSums, err = checksum.LoadSha256Sums(&sums)
+	if err != nil {
+		v.problemf("unable to load %s: %s", checksumFileName, err)
+	}
+}
+
+// verifyFiles verifies every file listed in the checksums of the container.
+func (v *Verification) verifyFiles(ctx context.Context, cc *container, p Protocol) {
+	names := make([]string, 0, len(cc.shaSums))
+	for n := range cc.shaSums {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		fv := &FileVerification{Name: n}
+		v.Files = append(v.Files, fv)
+		fv.verify(ctx, cc, p)
+	}
+}
+
+func (f *FileVerification) verify(ctx context.Context, cc *container, p Protocol) {
+	file, err := cc.container.OpenFile(ctx, f.Name)
+	if err != nil {
+		f.problemf("unable to open: %s", err)
+		return
+	}
+	defer file.Close()
+
+	expected, _ := cc.shaSums.Sum(f.Name)
+	for _, d := range []Direction{Inbound, Outbound} {
+		if f.Name == fmt.Sprintf(messagesFileNameTemplate, d.String()) ||
+			f.Name == fmt.Sprintf(requestsFileNameTemplate, d.String()) {
+			f.verifyChunks(ctx, file, expected, p, d)
+			return
+		}
+	}
+
+	r, err := crypto.NewSha256SumReader(ctx, file)
+	if err != nil {
+		f.problemf("unable to read: %s", err)
+		return
+	}
+	// The reader wraps io.EOF, so io.Copy returns it as an error.
+	if _, err := io.Copy(io.Discard, r); err != nil && !errors.Is(err, io.EOF) {
+		f.problemf("unable to read: %s", err)
+		return
+	}
+	sum, err := r.Sum(ctx, crypto.WithHexEncoding(true))
+	if err != nil {
+		f.problemf("unable to calculate checksum: %s", err)
+		return
+	}
+	if !bytes.Equal(sum, expected) {
+		f.problemf("checksum does not match")
+	}
+}
+
+// verifyChunks checks that a file of chunks starts with a header chunk, ends
+// with an end chunk, and that its chunks are in order. The chunks are not
+// decrypted or decompressed, since that requires the BSR key.
+func (f *FileVerification) verifyChunks(ctx context.Context, r io.Reader, expected []byte, p Protocol, d Direction) {
+	s, err := NewChunkScanner(ctx, r, WithSha256Sum(expected), withRawChunks())
+	if err != nil {
+		f.problemf("unable to read chunks: %s", err)
+		return
+	}
+	defer s.Close()
+
+	var ended, mismatch bool
+	for {
+		c, err := s.Scan(ctx)
+		if errors.Is(err, ErrChecksum) {
+			// The sum is compared at the end chunk and again at io.EOF.
+			if !mismatch {
+				f.problemf("checksum does not match")
+				mismatch = true
+			}
+			err = nil
+			if c == nil {
+				err = io.EOF
+			}
+		}
+		switch {
+		case err == io.EOF:
+			if !ended {
+				f.problemf("missing end chunk; file is truncated")
+			}
+			return
+		case err != nil:
+			f.problemf("chunk %d is truncated or corrupt: %s", f.Chunks+1, err)
+			return
+		}
+
+		f.Chunks++
+		ts := c.GetTimestamp().AsTime()
+		switch {
+		case ended:
+			f.problemf("chunk %d follows the end chunk", f.Chunks)
+		case f.Chunks == 1 && c.GetType() != ChunkHeader:
+			f.problemf("chunk 1 is a %s chunk; expected a header chunk", c.GetType())
+		case f.Chunks > 1 && c.GetType() == ChunkHeader:
+			f.problemf("chunk %d is a second header chunk", f.Chunks)
+		case f.Chunks > 1 && ts.Before(f.End):
+			f.problemf("chunk %d is out of order: %s is before %s", f.Chunks, ts.Format(time.RFC3339Nano), f.End.Format(time.RFC3339Nano))
+		}
+		if c.GetProtocol() != p {
+			f.problemf("chunk %d has protocol %q; expected %q", f.Chunks, c.GetProtocol(), p)
+		}
+		if c.GetDirection() != d {
+			f.problemf("chunk %d has direction %s; expected %s", f.Chunks, c.GetDirection(), d)
+		}
+
+		if f.Chunks == 1 {
+			f.Start = ts
+		}
+		if ts.After(f.End) {
+			f.End = ts
+		}
+		if c.GetType() == ChunkEnd {
+			ended = true
+		}
+	}
+}
+
+// decodeFile decodes a file of the container with fn. The file's checksum is
+// verified separately by verifyFiles.
+func (v *Verification) decodeFile(ctx context.Context, cc *container, name string, fn func(r io.Reader) error) {
+	f, err := cc.container.OpenFile(ctx, name)
+	if err != nil {
+		v.problemf("unable to open %s: %s", name, err)
+		return
+	}
+	defer f.Close()
+	if err := fn(f); err != nil {
+		v.problemf("unable to decode %s: %s", name, err)
+	}
+}
+
+func (v *Verification) decodeSummary(ctx context.Context, cc *container, p Protocol) {
+	af, ok := summaryAllocFuncs.get(p, v.Type)
+	if !ok {
+		return
+	}
+	summary := af(ctx)
+	v.decodeFile(ctx, cc, fmt.Sprintf(summaryFileNameTemplate, v.Type), func(r io.Reader) error {
+		if err := json.NewDecoder(r).Decode(summary); err != nil {
+			return err
+		}
+		v.Summary = summary
+		return nil
+	})
+}
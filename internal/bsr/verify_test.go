@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bsr_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/hashicorp/boundary/internal/storage/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testVerifySession records a tcp session with a connection to a local FS in a
+// temp dir. The outbound messages of the connection are written by
+// writeOutbound.
+func testVerifySession(t *testing.T, writeOutbound func(*bsr.ChunkEncoder)) (string, *kms.Keys) {
+	t.Helper()
+	ctx := context.Background()
+
+	keys, err := kms.CreateKeys(ctx, kms.TestWrapper(t), "session")
+	require.NoError(t, err)
+	dir := t.TempDir()
+	f, err := local.NewFS(ctx, dir)
+	require.NoError(t, err)
+
+	s, err := bsr.NewSession(ctx, bsr.TestSessionRecordingMeta("session_recording", tcp.Protocol), bsr.TestSessionMeta("session"), f, keys,
+		bsr.WithCompression(bsr.ZstdCompression))
+	require.NoError(t, err)
+	require.NoError(t, s.EncodeSummary(ctx, &bsr.BaseSessionSummary{Id: "session", ConnectionCount: 1}))
+	c, err := s.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: "connection"})
+	require.NoError(t, err)
+	require.NoError(t, c.EncodeSummary(ctx, &bsr.BaseConnectionSummary{Id: "connection", BytesUp: 21}))
+
+	w, err := c.NewMessagesWriter(ctx, bsr.Inbound)
+	require.NoError(t, err)
+	dw, err := tcp.NewDataWriter(ctx, w, bsr.Inbound, c.Compression(), bsr.AesGcmEncryption, "session", bsr.WithKeys(keys))
+	require.NoError(t, err)
+	_, err = dw.Write([]byte("user@host:~$ ls -la\r\n"))
+	require.NoError(t, err)
+	require.NoError(t, dw.Close())
+
+	w, err = c.NewMessagesWriter(ctx, bsr.Outbound)
+	require.NoError(t, err)
+	_, err = w.Write(bsr.Magic.Bytes())
+	require.NoError(t, err)
+	enc, err := bsr.NewChunkEncoder(ctx, w, c.Compression(), bsr.AesGcmEncryption, bsr.WithKeys(keys))
+	require.NoError(t, err)
+	writeOutbound(enc)
+	require.NoError(t, enc.Close())
+
+	require.NoError(t, c.Close(ctx))
+	require.NoError(t, s.Close(ctx))
+	return filepath.Join(dir, "session_recording.bsr"), keys
+}
+
+// testOutbound writes a header chunk, followed by data chunks and an end chunk
+// if end is true, at the offsets from a fixed time.
+func testOutbound(t *testing.T, end bool, offsets ...time.Duration) func(*bsr.ChunkEncoder) {
+	ctx := context.Background()
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 0, time.UTC)
+	return func(enc *bsr.ChunkEncoder) {
+		for i, o := range offsets {
+			var c bsr.Chunk
+			var err error
+			switch {
+			case i == 0:
+				c, err = bsr.NewHeader(ctx, tcp.Protocol, bsr.Outbound, bsr.NewTimestamp(ts.Add(o)), bsr.ZstdCompression, bsr.AesGcmEncryption, "session")
+			case end && i == len(offsets)-1:
+				c, err = bsr.NewEnd(ctx, tcp.Protocol, bsr.Outbound, bsr.NewTimestamp(ts.Add(o)))
+			default:
+				c = &tcp.DataChunk{
+					BaseChunk: &bsr.BaseChunk{
+						Protocol:  tcp.Protocol,
+						Direction: bsr.Outbound,
+						Timestamp: bsr.NewTimestamp(ts.Add(o)),
+						Type:      tcp.DataChunkType,
+					},
+					Data: []byte("total 0\r\n"),
+				}
+			}
+			require.NoError(t, err)
+			_, err = enc.Encode(ctx, c)
+			require.NoError(t, err)
+		}
+	}
+}
+
+func TestVerifySession(t *testing.T) {
+	ctx := context.Background()
+
+	verify := func(t *testing.T, path string, pubKey ed25519.PublicKey) *bsr.Verification {
+		f, err := local.NewFS(ctx, filepath.Dir(path), local.WithReadOnly(true))
+		require.NoError(t, err)
+		v, err := bsr.VerifySession(ctx, "session_recording", f, pubKey)
+		require.NoError(t, err)
+		return v
+	}
+	problems := func(v *bsr.Verification) []string {
+		var p []string
+		var walk func(*bsr.Verification)
+		walk = func(v *bsr.Verification) {
+			p = append(p, v.Problems...)
+			for _, f := range v.Files {
+				for _, fp := range f.Problems {
+					p = append(p, f.Name+": "+fp)
+				}
+			}
+			for _, c := range v.Connections {
+				walk(c)
+			}
+			for _, c := range v.Channels {
+				walk(c)
+			}
+		}
+		walk(v)
+		return p
+	}
+
+	t.Run("verified", func(t *testing.T) {
+		path, keys := testVerifySession(t, testOutbound(t, true, 0, time.Second, 2*time.Second))
+		for _, pubKey := range []ed25519.PublicKey{nil, keys.PubKey.Key} {
+			v := verify(t, path, pubKey)
+			assert.Empty(t, problems(v))
+			assert.True(t, v.Verified())
+			assert.Equal(t, tcp.Protocol, v.Protocol)
+			assert.Equal(t, ed25519.PublicKey(keys.PubKey.Key), v.PublicKey)
+			assert.Equal(t, "target123", v.SessionMeta.Target.PublicId)
+			require.NotNil(t, v.Summary)
+			assert.Equal(t, uint64(1), v.Summary.(bsr.SessionSummary).GetConnectionCount())
+
+			require.Len(t, v.Connections, 1)
+			c := v.Connections[0]
+			assert.Equal(t, "connection", c.Id)
+			require.NotNil(t, c.Summary)
+			assert.Equal(t, uint64(21), c.Summary.(bsr.ConnectionSummary).GetBytesUp())
+			var names []string
+			for _, f := range c.Files {
+				names = append(names, f.Name)
+				if f.Name == "messages-outbound.data" {
+					assert.Equal(t, uint64(3), f.Chunks)
+					assert.Equal(t, 2*time.Second, f.End.Sub(f.Start))
+				}
+			}
+			assert.Equal(t, []string{"connection-recording-summary.json", "connection-recording.meta", "messages-inbound.data", "messages-outbound.data"}, names)
+		}
+	})
+	t.Run("wrong-public-key", func(t *testing.T) {
+		path, _ := testVerifySession(t, testOutbound(t, true, 0, time.Second, 2*time.Second))
+		other, _, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		v := verify(t, path, other)
+		assert.False(t, v.Verified())
+		assert.Contains(t, v.Problems, "public key does not match the expected public key")
+		assert.Contains(t, v.Problems, "SHA256SUM.sig is not a valid signature of SHA256SUM")
+		assert.Contains(t, v.Connections[0].Problems, "SHA256SUM.sig is not a valid signature of SHA256SUM")
+	})
+	t.Run("modified-checksums", func(t *testing.T) {
+		path, _ := testVerifySession(t, testOutbound(t, true, 0, time.Second, 2*time.Second))
+		// Modify the meta and update its checksum, which only the signature
+		// of the checksums can detect.
+		meta := filepath.Join(path, "connection.connection", "connection-recording.meta")
+		b, err := os.ReadFile(meta)
+		require.NoError(t, err)
+		oldSum := sha256.Sum256(b)
+		b = append(b, "channel: injected.channel\n"...)
+		require.NoError(t, os.WriteFile(meta, b, 0o600))
+		newSum := sha256.Sum256(b)
+
+		sums := filepath.Join(path, "connection.connection", "SHA256SUM")
+		b, err = os.ReadFile(sums)
+		require.NoError(t, err)
+		b = bytes.Replace(b, []byte(hex.EncodeToString(oldSum[:])), []byte(hex.EncodeToString(newSum[:])), 1)
+		require.NoError(t, os.WriteFile(sums, b, 0o600))
+		v := verify(t, path, nil)
+		p := problems(v)
+		require.Len(t, p, 2)
+		assert.Equal(t, "SHA256SUM.sig is not a valid signature of SHA256SUM", p[0])
+		assert.Contains(t, p[1], "unable to open channel")
+	})
+	t.Run("modified-meta", func(t *testing.T) {
+		path, _ := testVerifySession(t, testOutbound(t, true, 0, time.Second, 2*time.Second))
+		meta := filepath.Join(path, "connection.connection", "connection-recording.meta")
+		b, err := os.ReadFile(meta)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(meta, append(b, "channel: injected.channel\n"...), 0o600))
+		v := verify(t, path, nil)
+		p := problems(v)
+		require.Len(t, p, 2)
+		assert.Equal(t, "connection-recording.meta: checksum does not match", p[0])
+		assert.Contains(t, p[1], "unable to open channel")
+	})
+	t.Run("truncated", func(t *testing.T) {
+		path, _ := testVerifySession(t, testOutbound(t, true, 0, time.Second, 2*time.Second))
+		data := filepath.Join(path, "connection.connection", "messages-inbound.data")
+		b, err := os.ReadFile(data)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(data, b[:len(b)-5], 0o600))
+		v := verify(t, path, nil)
+		assert.False(t, v.Verified())
+		assert.Equal(t, []string{
+			"messages-inbound.data: checksum does not match",
+			"messages-inbound.data: missing end chunk; file is truncated",
+		}, problems(v))
+	})
+	t.Run("corrupt", func(t *testing.T) {
+		path, _ := testVerifySession(t, testOutbound(t, true, 0, time.Second, 2*time.Second))
+		data := filepath.Join(path, "connection.connection", "messages-outbound.data")
+		b, err := os.ReadFile(data)
+		require.NoError(t, err)
+		// Flip a bit in the data of the second chunk.
+		b[len(b)-40] ^= 1
+		require.NoError(t, os.WriteFile(data, b, 0o600))
+		v := verify(t, path, nil)
+		p := problems(v)
+		require.Len(t, p, 1)
+		assert.Contains(t, p[0], "messages-outbound.data: chunk 2 is truncated or corrupt")
+	})
+	t.Run("out-of-order", func(t *testing.T) {
+		path, _ := testVerifySession(t, testOutbound(t, true, 2*time.Second, time.Second, 3*time.Second))
+		v := verify(t, path, nil)
+		assert.Equal(t, []string{
+			"messages-outbound.data: chunk 2 is out of order: 2023-03-16T10:47:04Z is before 2023-03-16T10:47:05Z",
+		}, problems(v))
+	})
+	t.Run("missing-end", func(t *testing.T) {
+		path, _ := testVerifySession(t, testOutbound(t, false, 0, time.Second))
+		v := verify(t, path, nil)
+		assert.Equal(t, []string{
+			"messages-outbound.data: missing end chunk; file is truncated",
+		}, problems(v))
+	})
+}
@@ -135,6 +135,11 @@ type Command struct {
 
 	client *api.Client
 
+	// keyringType and keyringTokenName are set when the client's token was
+	// read from a keyring, so a refreshed token can be stored in it.
+	keyringType      string
+	keyringTokenName string
+
 	// This will be intialized, if needed, in Config() when instantiating a
 	// recovery wrapper, if requested. It's then called as a deferred function
 	// on the Run method of the various generated commands.
@@ -328,6 +333,7 @@ func (c *Command) Client(opt ...Option) (*api.Client, error) {
 		authToken := c.ReadTokenFromKeyring(keyringType, tokenName)
 		if authToken != nil {
 			c.client.SetToken(authToken.Token)
+			c.refreshKeyringToken(keyringType, tokenName, authToken)
 		}
 	}
 
@@ -59,7 +59,7 @@ func (b *Server) CreateInitialLoginRole(ctx context.Context) (*iam.Role, error)
 		"id=*;type=scope;actions=list,no-op",
 		"id=*;type=auth-method;actions=authenticate,list",
 		"id={{.Account.Id}};actions=read,change-password",
-		"id=*;type=auth-token;actions=list,read:self,delete:self",
+		"id=*;type=auth-token;actions=list,read:self,delete:self,refresh:self",
 	}); err != nil {
 		return nil, fmt.Errorf("error creating grant for default generated grants: %w", err)
 	}
@@ -628,9 +628,9 @@ func (b *Server) RegisterPlugin(ctx context.Context, name string, hostClient plg
 }
 
 // unprivilegedDevUserRoleSetup adds dev user to the role that grants
-// list/read:self/cancel:self on sessions and read:self/delete:self/list on
-// tokens. It also creates a role with an `authorize-session` grant for the
-// provided targetId.
+// list/read:self/cancel:self on sessions and
+// read:self/delete:self/refresh:self/list on tokens. It also creates a role
+// with an `authorize-session` grant for the provided targetId.
 func unprivilegedDevUserRoleSetup(ctx context.Context, repo *iam.Repository, userId, projectId, targetId string) error {
 	roles, err := repo.ListRoles(ctx, []string{projectId})
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/api/authtokens"
 	nkeyring "github.com/jefferai/keyring"
@@ -28,6 +29,10 @@ const (
 	DefaultTokenName = "default"
 	LoginCollection  = "login"
 	PassPrefix       = "HashiCorp_Boundary"
+
+	// TokenRefreshInterval is how often a token read from a keyring is
+	// refreshed, which keeps it from becoming stale between uses.
+	TokenRefreshInterval = time.Hour
 )
 
 func (c *Command) DiscoverKeyringTokenInfo() (string, string, error) {
@@ -171,6 +176,81 @@ func (c *Command) ReadTokenFromKeyring(keyringType, tokenName string) *authtoken
 	return nil
 }
 
+// WriteTokenToKeyring stores the auth token in the keyring of the given type
+// under tokenName.
+func WriteTokenToKeyring(keyringType, tokenName string, token *authtokens.AuthToken) error {
+	marshaled, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("Error marshaling auth token to save to keyring: %w", err)
+	}
+
+	switch keyringType {
+	case NoneKeyring:
+		return nil
+
+	case WincredKeyring, KeychainKeyring:
+		if err := zkeyring.Set(StoredTokenName, tokenName, base64.RawStdEncoding.EncodeToString(marshaled)); err != nil {
+			return fmt.Errorf("Error saving auth token to %q keyring: %w", keyringType, err)
+		}
+
+	default:
+		krConfig := nkeyring.Config{
+			LibSecretCollectionName: LoginCollection,
+			PassPrefix:              PassPrefix,
+			AllowedBackends:         []nkeyring.BackendType{nkeyring.BackendType(keyringType)},
+		}
+
+		kr, err := nkeyring.Open(krConfig)
+		if err != nil {
+			return fmt.Errorf("Error opening %q keyring: %w", keyringType, err)
+		}
+
+		if err := kr.Set(nkeyring.Item{
+			Key:  tokenName,
+			Data: []byte(base64.RawStdEncoding.EncodeToString(marshaled)),
+		}); err != nil {
+			return fmt.Errorf("Error storing token in %q keyring: %w", keyringType, err)
+		}
+	}
+	return nil
+}
+
+// refreshKeyringToken configures the client to refresh the auth token read
+// from the keyring before it becomes stale, and to store the new token value
+// in the keyring.
+func (c *Command) refreshKeyringToken(keyringType, tokenName string, authToken *authtokens.AuthToken) {
+	c.keyringType = keyringType
+	c.keyringTokenName = tokenName
+
+	// API tokens do not become stale.
+	if authToken.ApiToken {
+		return
+	}
+	c.client.SetTokenRefreshedTime(authToken.ApproximateLastUsedTime)
+	c.client.SetTokenRefreshInterval(TokenRefreshInterval)
+	c.client.SetTokenRefreshFunc(func(token string) {
+		authToken.Token = token
+		authToken.ApproximateLastUsedTime = time.Now()
+		if _, err := c.StoreRefreshedToken(authToken); err != nil {
+			c.UI.Error(err.Error())
+			c.UI.Warn(fmt.Sprintf("The refreshed token was not saved to the keyring and the stored token is no longer valid. The token is:\n\n%s\n\nIt must be manually passed in via the BOUNDARY_TOKEN env var or -token flag.", token))
+		}
+	})
+}
+
+// StoreRefreshedToken stores the refreshed auth token in the keyring the
+// client's token was read from. It returns false if the client's token was not
+// read from a keyring.
+func (c *Command) StoreRefreshedToken(authToken *authtokens.AuthToken) (bool, error) {
+	if c.keyringType == "" {
+		return false, nil
+	}
+	if err := WriteTokenToKeyring(c.keyringType, c.keyringTokenName, authToken); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func TokenIdFromToken(token string) (string, error) {
 	split := strings.Split(token, "_")
 	if len(split) < 3 {
@@ -117,6 +117,30 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "change-password",
 			}, nil
 		},
+		"accounts enroll-totp": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "enroll-totp",
+			}, nil
+		},
+		"accounts confirm-totp": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "confirm-totp",
+			}, nil
+		},
+		"accounts remove-totp": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "remove-totp",
+			}, nil
+		},
+		"accounts unlock": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "unlock",
+			}, nil
+		},
 		"accounts create": func() (cli.Command, error) {
 			return &accountscmd.Command{
 				Command: base.NewCommand(ui),
@@ -243,12 +267,30 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "change-state",
 			}, nil
 		},
+		"auth-methods create-scim-token": func() (cli.Command, error) {
+			return &authmethodscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "create-scim-token",
+			}, nil
+		},
+		"auth-methods delete-scim-token": func() (cli.Command, error) {
+			return &authmethodscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "delete-scim-token",
+			}, nil
+		},
 
 		"auth-tokens": func() (cli.Command, error) {
 			return &authtokenscmd.Command{
 				Command: base.NewCommand(ui),
 			}, nil
 		},
+		"auth-tokens create": func() (cli.Command, error) {
+			return &authtokenscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"auth-tokens read": func() (cli.Command, error) {
 			return &authtokenscmd.Command{
 				Command: base.NewCommand(ui),
@@ -267,6 +309,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "list",
 			}, nil
 		},
+		"auth-tokens refresh": func() (cli.Command, error) {
+			return &authtokenscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "refresh",
+			}, nil
+		},
 
 		"config": func() (cli.Command, error) {
 			return &config.Command{
@@ -1047,6 +1095,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui),
 			}, nil
 		},
+		"session-recordings verify": func() (cli.Command, error) {
+			return &sessionrecordingscmd.VerifyCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"session-recordings inspect": func() (cli.Command, error) {
+			return &sessionrecordingscmd.InspectCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"storage-buckets": func() (cli.Command, error) {
 			return &storagebucketscmd.Command{
@@ -1131,6 +1189,30 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"targets create http": func() (cli.Command, error) {
+			return &targetscmd.HttpCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"targets create postgres": func() (cli.Command, error) {
+			return &targetscmd.PostgresCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"targets create udp": func() (cli.Command, error) {
+			return &targetscmd.UdpCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"targets create kubernetes": func() (cli.Command, error) {
+			return &targetscmd.KubernetesCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"targets update": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui),
@@ -1149,6 +1231,30 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"targets update http": func() (cli.Command, error) {
+			return &targetscmd.HttpCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"targets update postgres": func() (cli.Command, error) {
+			return &targetscmd.PostgresCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"targets update udp": func() (cli.Command, error) {
+			return &targetscmd.UdpCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"targets update kubernetes": func() (cli.Command, error) {
+			return &targetscmd.KubernetesCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"targets add-host-sources": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui),
@@ -24,18 +24,28 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
 	flagPassword        string
 	flagCurrentPassword string
 	flagNewPassword     string
+	flagCode            string
+
+	totpEnrollResult  *accounts.TotpEnrollResult
+	totpConfirmResult *accounts.TotpConfirmResult
+	totpRemoveResult  *accounts.TotpRemoveResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"change-password": {"id", "current-password", "new-password", "version"},
 		"set-password":    {"id", "password", "version"},
+		"enroll-totp":     {"id"},
+		"confirm-totp":    {"id", "code"},
+		"remove-totp":     {"id"},
+		"unlock":          {"id"},
 	}
 }
 
@@ -47,6 +57,18 @@ func extraSynopsisFuncImpl(c *Command) string {
 	case "set-password":
 		return "Directly set the password on an account"
 
+	case "enroll-totp":
+		return "Create a TOTP enrollment for a password account"
+
+	case "confirm-totp":
+		return "Confirm the TOTP enrollment of a password account"
+
+	case "remove-totp":
+		return "Remove the TOTP enrollment of a password account"
+
+	case "unlock":
+		return "Unlock a password account locked after failed authentication attempts"
+
 	default:
 		return ""
 	}
@@ -91,6 +113,54 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "enroll-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts enroll-totp [options] [args]",
+			"",
+			"  This command creates a TOTP enrollment for a password-type account and displays its secret, to be added to an authenticator app. The enrollment is not used to authenticate until it is confirmed with \"boundary accounts confirm-totp\". Example:",
+			"",
+			"    Create a TOTP enrollment for a password-type account:",
+			"",
+			`      $ boundary accounts enroll-totp -id acctpw_1234567890`,
+			"",
+			"",
+		})
+	case "confirm-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts confirm-totp [options] [args]",
+			"",
+			"  This command confirms the TOTP enrollment of a password-type account with a code from the authenticator app, and displays the recovery codes of the enrollment. The recovery codes are only displayed once. Example:",
+			"",
+			"    Confirm the TOTP enrollment of a password-type account:",
+			"",
+			`      $ boundary accounts confirm-totp -id acctpw_1234567890 -code <empty, to be read by stdin>`,
+			"",
+			"",
+		})
+	case "remove-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts remove-totp [options] [args]",
+			"",
+			"  This command removes the TOTP enrollment of a password-type account. Example:",
+			"",
+			"    Remove the TOTP enrollment of a password-type account:",
+			"",
+			`      $ boundary accounts remove-totp -id acctpw_1234567890`,
+			"",
+			"",
+		})
+	case "unlock":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts unlock [options] [args]",
+			"",
+			"  This command unlocks a password-type account which was locked after too many failed authentication attempts, and resets its failed attempt count. Example:",
+			"",
+			"    Unlock a password-type account:",
+			"",
+			`      $ boundary accounts unlock -id acctpw_1234567890`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -116,6 +186,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagNewPassword,
 				Usage:  "The new password for the account. If not specified, the command will prompt for the password to be entered in a non-echoing way.",
 			})
+		case "code":
+			f.StringVar(&base.StringVar{
+				Name:   "code",
+				Target: &c.flagCode,
+				Usage:  "The current code of the authenticator app. If not specified, the command will prompt for the code to be entered in a non-echoing way.",
+			})
 		}
 	}
 }
@@ -224,6 +300,17 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]accounts.O
 		}
 	}
 
+	if strutil.StrListContains(flagsMap[c.Func], "code") && c.flagCode == "" {
+		fmt.Print("Please enter the code of the authenticator app (it will be hidden): ")
+		value, err := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the code. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", err.Error()))
+			return false
+		}
+		c.flagCode = strings.TrimSpace(value)
+	}
+
 	return true
 }
 
@@ -241,10 +328,93 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *accou
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "enroll-totp":
+		var err error
+		c.totpEnrollResult, err = accountClient.EnrollTotp(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "confirm-totp":
+		var err error
+		c.totpConfirmResult, err = accountClient.ConfirmTotp(c.Context, c.FlagId, c.flagCode, opts...)
+		return nil, nil, nil, err
+	case "remove-totp":
+		var err error
+		c.totpRemoveResult, err = accountClient.RemoveTotp(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "unlock":
+		result, err := accountClient.Unlock(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "enroll-totp":
+		item := c.totpEnrollResult.GetItem()
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(base.WrapForHelpText([]string{
+				"",
+				"TOTP enrollment information:",
+				fmt.Sprintf("  Account ID:     %s", item.AccountId),
+				fmt.Sprintf("  Secret:         %s", item.Secret),
+				fmt.Sprintf("  URL:            %s", item.Url),
+				"",
+				"  Add the secret to an authenticator app, then confirm the enrollment with \"boundary accounts confirm-totp\".",
+			}))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.totpEnrollResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "confirm-totp":
+		switch base.Format(c.UI) {
+		case "table":
+			output := []string{
+				"",
+				"TOTP recovery codes:",
+			}
+			for _, code := range c.totpConfirmResult.GetRecoveryCodes() {
+				output = append(output, fmt.Sprintf("  %s", code))
+			}
+			output = append(output,
+				"",
+				"  Each recovery code can be used once instead of a TOTP code. Store them securely; they cannot be retrieved again.",
+			)
+			c.UI.Output(base.WrapForHelpText(output))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.totpConfirmResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "remove-totp":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output("The TOTP enrollment was removed successfully.")
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.totpRemoveResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*accounts.Account) string {
 	if len(items) == 0 {
 		return "No accounts found"
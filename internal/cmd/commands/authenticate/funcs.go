@@ -5,7 +5,6 @@ package authenticate
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -14,8 +13,6 @@ import (
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/api/authtokens"
 	"github.com/hashicorp/boundary/internal/cmd/base"
-	nkeyring "github.com/jefferai/keyring"
-	zkeyring "github.com/zalando/go-keyring"
 )
 
 func saveAndOrPrintToken(c *base.Command, result *authmethods.AuthenticateResult) int {
@@ -52,45 +49,11 @@ func saveAndOrPrintToken(c *base.Command, result *authmethods.AuthenticateResult
 		tokenName != "none" &&
 		keyringType != "" &&
 		tokenName != "" {
-		marshaled, err := json.Marshal(token)
-		if err != nil {
-			c.UI.Error(fmt.Sprintf("Error marshaling auth token to save to keyring: %s", err))
+		if err := base.WriteTokenToKeyring(keyringType, tokenName, token); err != nil {
+			c.UI.Error(err.Error())
 			gotErr = true
 		} else {
-			switch keyringType {
-			case "wincred", "keychain":
-				if err := zkeyring.Set(base.StoredTokenName, tokenName, base64.RawStdEncoding.EncodeToString(marshaled)); err != nil {
-					c.UI.Error(fmt.Sprintf("Error saving auth token to %q keyring: %s", keyringType, err))
-					gotErr = true
-				}
-
-			default:
-				krConfig := nkeyring.Config{
-					LibSecretCollectionName: "login",
-					PassPrefix:              "HashiCorp_Boundary",
-					AllowedBackends:         []nkeyring.BackendType{nkeyring.BackendType(keyringType)},
-				}
-
-				kr, err := nkeyring.Open(krConfig)
-				if err != nil {
-					c.UI.Error(fmt.Sprintf("Error opening %q keyring: %s", keyringType, err))
-					gotErr = true
-					break
-				}
-
-				if err := kr.Set(nkeyring.Item{
-					Key:  tokenName,
-					Data: []byte(base64.RawStdEncoding.EncodeToString(marshaled)),
-				}); err != nil {
-					c.UI.Error(fmt.Sprintf("Error storing token in %q keyring: %s", keyringType, err))
-					gotErr = true
-					break
-				}
-			}
-
-			if !gotErr {
-				c.UI.Output("\nThe token was successfully stored in the chosen keyring and is not displayed here.")
-			}
+			c.UI.Output("\nThe token was successfully stored in the chosen keyring and is not displayed here.")
 		}
 	}
 
@@ -29,6 +29,7 @@ var (
 var (
 	envPassword  = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
 	envLoginName = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+	envTotpCode  = "BOUNDARY_AUTHENTICATE_PASSWORD_TOTP_CODE"
 )
 
 type PasswordCommand struct {
@@ -36,6 +37,7 @@ type PasswordCommand struct {
 
 	flagLoginName string
 	flagPassword  string
+	flagTotpCode  string
 
 	Opts       []common.Option
 	parsedOpts *common.Options
@@ -75,6 +77,13 @@ func (c *PasswordCommand) Flags() *base.FlagSets {
 		Usage:  "The password associated with the login name. If blank, the command will prompt for the password to be entered interactively in a non-echoing way. Otherwise, this can refer to a file on disk (file://) from which a password will be read or an env var (env://) from which the password will be read.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "totp-code",
+		Target: &c.flagTotpCode,
+		EnvVar: envTotpCode,
+		Usage:  "The TOTP code or a recovery code of the account, if it has a TOTP enrollment. If blank and the account requires one, the command will prompt for the code to be entered interactively in a non-echoing way.",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:   "auth-method-id",
 		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
@@ -185,11 +194,54 @@ func (c *PasswordCommand) Run(args []string) int {
 		c.flagPassword = password
 	}
 
-	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login",
-		map[string]any{
-			"login_name": c.flagLoginName,
-			"password":   c.flagPassword,
-		})
+	attrs := map[string]any{
+		"login_name": c.flagLoginName,
+		"password":   c.flagPassword,
+	}
+	if c.flagTotpCode != "" {
+		attrs["totp_code"] = c.flagTotpCode
+	}
+	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", attrs)
+prompt:
+	for err != nil {
+		switch {
+		case attrs["totp_code"] == nil && requestFieldError(err, "attributes.totp_code"):
+			fmt.Print("Please enter the TOTP code or a recovery code (it will be hidden): ")
+			value, readErr := password.Read(os.Stdin)
+			fmt.Print("\n")
+			if readErr != nil {
+				c.UI.Error(fmt.Sprintf("An error occurred attempting to read the TOTP code. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", readErr.Error()))
+				return base.CommandUserError
+			}
+			attrs["totp_code"] = strings.TrimSpace(value)
+		case attrs["new_password"] == nil && requestFieldError(err, "attributes.new_password"):
+			c.UI.Warn("The password has expired and must be changed.")
+			fmt.Print("Please enter the new password (it will be hidden): ")
+			value, readErr := password.Read(os.Stdin)
+			fmt.Print("\n")
+			if readErr != nil {
+				c.UI.Error(fmt.Sprintf("An error occurred attempting to read the password. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", readErr.Error()))
+				return base.CommandUserError
+			}
+			fmt.Print("Please enter it one more time for confirmation: ")
+			confirmation, readErr := password.Read(os.Stdin)
+			fmt.Print("\n")
+			if readErr != nil {
+				c.UI.Error(fmt.Sprintf("An error occurred attempting to read the password. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", readErr.Error()))
+				return base.CommandUserError
+			}
+			if strings.TrimSpace(value) != strings.TrimSpace(confirmation) {
+				c.UI.Error("Entered password and confirmation value did not match.")
+				return base.CommandUserError
+			}
+			attrs["new_password"] = strings.TrimSpace(value)
+		default:
+			// Not an error which can be resolved by prompting for more
+			// input.
+			break prompt
+		}
+		result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", attrs)
+	}
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.PrintApiError(apiErr, "Error from controller when performing authentication")
@@ -201,3 +253,19 @@ func (c *PasswordCommand) Run(args []string) int {
 
 	return saveAndOrPrintToken(c.Command, result)
 }
+
+// requestFieldError reports whether the authentication failed because of
+// the request field with the given name, e.g. because the account requires a
+// TOTP code which wasn't provided.
+func requestFieldError(err error, name string) bool {
+	apiErr := api.AsServerError(err)
+	if apiErr == nil || apiErr.Details == nil {
+		return false
+	}
+	for _, f := range apiErr.Details.RequestFields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -57,6 +57,26 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
+	case "create-scim-token":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create-scim-token [options] [args]",
+			"",
+			"  Create the token the identity provider of a password or OIDC auth method uses to provision its users, groups, and accounts through the controller's SCIM API at /scim/v2/. Any previous SCIM token of the auth method is revoked. The token is only displayed once. Example:",
+			"",
+			`      $ boundary auth-methods create-scim-token -id amoidc_1234567890`,
+			"",
+			"",
+		})
+	case "delete-scim-token":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods delete-scim-token [options] [args]",
+			"",
+			"  Revoke the SCIM token of an auth method. Example:",
+			"",
+			`      $ boundary auth-methods delete-scim-token -id amoidc_1234567890`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -221,4 +241,5 @@ func printItemTable(item *authmethods.AuthMethod, resp *api.Response) string {
 var keySubstMap = map[string]string{
 	"min_login_name_length": "Minimum Login Name Length",
 	"min_password_length":   "Minimum Password Length",
+	"totp_required":         "TOTP Required",
 }
@@ -18,14 +18,21 @@ func init() {
 }
 
 type extraPasswordCmdVars struct {
-	flagMinLoginNameLength string
-	flagMinPasswordLength  string
+	flagMinLoginNameLength          string
+	flagMinPasswordLength           string
+	flagTotpRequired                string
+	flagMinPasswordCharacterClasses string
+	flagDenyCommonPasswords         string
+	flagPasswordHistoryCount        string
+	flagMaxPasswordAgeDays          string
+	flagLockoutThreshold            string
+	flagLockoutDurationSeconds      string
 }
 
 func extraPasswordActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"min-login-name-length", "min-password-length"},
-		"update": {"min-login-name-length", "min-password-length"},
+		"create": {"min-login-name-length", "min-password-length", "totp-required", "min-password-character-classes", "deny-common-passwords", "password-history-count", "max-password-age-days", "lockout-threshold", "lockout-duration-seconds"},
+		"update": {"min-login-name-length", "min-password-length", "totp-required", "min-password-character-classes", "deny-common-passwords", "password-history-count", "max-password-age-days", "lockout-threshold", "lockout-duration-seconds"},
 	}
 }
 
@@ -74,6 +81,48 @@ func extraPasswordFlagsFuncImpl(c *PasswordCommand, set *base.FlagSets, f *base.
 				Target: &c.flagMinPasswordLength,
 				Usage:  "The minimum length of passwords",
 			})
+		case "totp-required":
+			f.StringVar(&base.StringVar{
+				Name:   "totp-required",
+				Target: &c.flagTotpRequired,
+				Usage:  `If "true", accounts must authenticate with a TOTP code in addition to their password. Accounts must enroll with "boundary accounts enroll-totp" before this is enabled.`,
+			})
+		case "min-password-character-classes":
+			f.StringVar(&base.StringVar{
+				Name:   "min-password-character-classes",
+				Target: &c.flagMinPasswordCharacterClasses,
+				Usage:  "The minimum number of character classes (lowercase letters, uppercase letters, digits and symbols) passwords must contain, between 0 and 4",
+			})
+		case "deny-common-passwords":
+			f.StringVar(&base.StringVar{
+				Name:   "deny-common-passwords",
+				Target: &c.flagDenyCommonPasswords,
+				Usage:  `If "true", commonly used passwords are rejected`,
+			})
+		case "password-history-count":
+			f.StringVar(&base.StringVar{
+				Name:   "password-history-count",
+				Target: &c.flagPasswordHistoryCount,
+				Usage:  "The number of previous passwords of an account which can not be reused",
+			})
+		case "max-password-age-days":
+			f.StringVar(&base.StringVar{
+				Name:   "max-password-age-days",
+				Target: &c.flagMaxPasswordAgeDays,
+				Usage:  "The number of days after which a password expires and must be changed when authenticating",
+			})
+		case "lockout-threshold":
+			f.StringVar(&base.StringVar{
+				Name:   "lockout-threshold",
+				Target: &c.flagLockoutThreshold,
+				Usage:  "The number of consecutive failed authentication attempts after which an account is locked",
+			})
+		case "lockout-duration-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "lockout-duration-seconds",
+				Target: &c.flagLockoutDurationSeconds,
+				Usage:  `The number of seconds a locked account stays locked. If 0, the account stays locked until it is unlocked with "boundary accounts unlock".`,
+			})
 		}
 	}
 }
@@ -112,6 +161,97 @@ func extraPasswordFlagHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, opt
 		addAttribute("min_password_length", uint32(length))
 	}
 
+	switch c.flagTotpRequired {
+	case "":
+	case "null":
+		addAttribute("totp_required", nil)
+	default:
+		required, err := strconv.ParseBool(c.flagTotpRequired)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagTotpRequired, err))
+			return false
+		}
+		addAttribute("totp_required", required)
+	}
+
+	switch c.flagMinPasswordCharacterClasses {
+	case "":
+	case "null":
+		addAttribute("min_password_character_classes", nil)
+	default:
+		value, err := strconv.ParseUint(c.flagMinPasswordCharacterClasses, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMinPasswordCharacterClasses, err))
+			return false
+		}
+		addAttribute("min_password_character_classes", uint32(value))
+	}
+
+	switch c.flagDenyCommonPasswords {
+	case "":
+	case "null":
+		addAttribute("deny_common_passwords", nil)
+	default:
+		deny, err := strconv.ParseBool(c.flagDenyCommonPasswords)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDenyCommonPasswords, err))
+			return false
+		}
+		addAttribute("deny_common_passwords", deny)
+	}
+
+	switch c.flagPasswordHistoryCount {
+	case "":
+	case "null":
+		addAttribute("password_history_count", nil)
+	default:
+		value, err := strconv.ParseUint(c.flagPasswordHistoryCount, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagPasswordHistoryCount, err))
+			return false
+		}
+		addAttribute("password_history_count", uint32(value))
+	}
+
+	switch c.flagMaxPasswordAgeDays {
+	case "":
+	case "null":
+		addAttribute("max_password_age_days", nil)
+	default:
+		value, err := strconv.ParseUint(c.flagMaxPasswordAgeDays, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxPasswordAgeDays, err))
+			return false
+		}
+		addAttribute("max_password_age_days", uint32(value))
+	}
+
+	switch c.flagLockoutThreshold {
+	case "":
+	case "null":
+		addAttribute("lockout_threshold", nil)
+	default:
+		value, err := strconv.ParseUint(c.flagLockoutThreshold, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagLockoutThreshold, err))
+			return false
+		}
+		addAttribute("lockout_threshold", uint32(value))
+	}
+
+	switch c.flagLockoutDurationSeconds {
+	case "":
+	case "null":
+		addAttribute("lockout_duration_seconds", nil)
+	default:
+		value, err := strconv.ParseUint(c.flagLockoutDurationSeconds, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagLockoutDurationSeconds, err))
+			return false
+		}
+		addAttribute("lockout_duration_seconds", uint32(value))
+	}
+
 	if attributes != nil {
 		*opts = append(*opts, authmethods.WithAttributes(attributes))
 	}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authmethodscmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraScimSynopsisFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+type extraCmdVars struct {
+	scimTokenResult       *authmethods.ScimTokenCreateResult
+	scimTokenDeleteResult *authmethods.ScimTokenDeleteResult
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create-scim-token": {"id"},
+		"delete-scim-token": {"id"},
+	}
+}
+
+func extraScimSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "create-scim-token":
+		return "Create the SCIM provisioning token of an auth method"
+	case "delete-scim-token":
+		return "Revoke the SCIM provisioning token of an auth method"
+	default:
+		return ""
+	}
+}
+
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *authmethods.AuthMethod, origItems []*authmethods.AuthMethod, origError error, amClient *authmethods.Client, _ uint32, opts []authmethods.Option) (*api.Response, *authmethods.AuthMethod, []*authmethods.AuthMethod, error) {
+	switch c.Func {
+	case "create-scim-token":
+		var err error
+		c.plural = "a SCIM token for auth method"
+		c.scimTokenResult, err = amClient.CreateScimToken(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "delete-scim-token":
+		var err error
+		c.plural = "the SCIM token of auth method"
+		c.scimTokenDeleteResult, err = amClient.DeleteScimToken(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	}
+	return origResp, origItem, origItems, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "create-scim-token":
+		item := c.scimTokenResult.GetItem()
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(base.WrapForHelpText([]string{
+				"",
+				"SCIM token information:",
+				fmt.Sprintf("  ID:                 %s", item.Id),
+				fmt.Sprintf("  Auth Method ID:     %s", item.AuthMethodId),
+				fmt.Sprintf("  Created Time:       %s", item.CreatedTime.Local().Format(time.RFC1123)),
+				fmt.Sprintf("  Token:              %s", item.Token),
+				"",
+				"  Configure the identity provider with the token; it cannot be retrieved again.",
+			}))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.scimTokenResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "delete-scim-token":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output("The SCIM token was revoked successfully.")
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.scimTokenDeleteResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -38,6 +38,8 @@ type Command struct {
 	Func string
 
 	plural string
+
+	extraCmdVars
 }
 
 func (c *Command) AutocompleteArgs() complete.Predictor {
@@ -68,6 +70,9 @@ func (c *Command) Help() string {
 
 	switch c.Func {
 
+	case "create":
+		helpStr = helpMap[c.Func]() + c.Flags().Help()
+
 	case "read":
 		helpStr = helpMap[c.Func]() + c.Flags().Help()
 
@@ -90,6 +95,8 @@ func (c *Command) Help() string {
 
 var flagsMap = map[string][]string{
 
+	"create": {"scope-id", "name", "description"},
+
 	"read": {"id"},
 
 	"delete": {"id"},
@@ -118,9 +125,6 @@ func (c *Command) Run(args []string) int {
 	case "":
 		return cli.RunResultHelp
 
-	case "create":
-		return cli.RunResultHelp
-
 	case "update":
 		return cli.RunResultHelp
 
@@ -144,6 +148,12 @@ func (c *Command) Run(args []string) int {
 	if strutil.StrListContains(flagsMap[c.Func], "scope-id") {
 		switch c.Func {
 
+		case "create":
+			if c.FlagScopeId == "" {
+				c.PrintCliError(errors.New("Scope ID must be passed in via -scope-id or BOUNDARY_SCOPE_ID"))
+				return base.CommandUserError
+			}
+
 		case "list":
 			if c.FlagScopeId == "" {
 				c.PrintCliError(errors.New("Scope ID must be passed in via -scope-id or BOUNDARY_SCOPE_ID"))
@@ -167,6 +177,22 @@ func (c *Command) Run(args []string) int {
 	}
 	authtokensClient := authtokens.NewClient(client)
 
+	switch c.FlagName {
+	case "":
+	case "null":
+		opts = append(opts, authtokens.DefaultName())
+	default:
+		opts = append(opts, authtokens.WithName(c.FlagName))
+	}
+
+	switch c.FlagDescription {
+	case "":
+	case "null":
+		opts = append(opts, authtokens.DefaultDescription())
+	default:
+		opts = append(opts, authtokens.WithDescription(c.FlagDescription))
+	}
+
 	switch c.FlagRecursive {
 	case true:
 		opts = append(opts, authtokens.WithRecursive(true))
@@ -187,6 +213,8 @@ func (c *Command) Run(args []string) int {
 
 	var items []*authtokens.AuthToken
 
+	var createResult *authtokens.AuthTokenCreateResult
+
 	var readResult *authtokens.AuthTokenReadResult
 
 	var deleteResult *authtokens.AuthTokenDeleteResult
@@ -195,6 +223,14 @@ func (c *Command) Run(args []string) int {
 
 	switch c.Func {
 
+	case "create":
+		createResult, err = authtokensClient.Create(c.Context, c.FlagScopeId, opts...)
+		if exitCode := c.checkFuncError(err); exitCode > 0 {
+			return exitCode
+		}
+		resp = createResult.GetResponse()
+		item = createResult.GetItem()
+
 	case "read":
 		readResult, err = authtokensClient.Read(c.Context, c.FlagId, opts...)
 		if exitCode := c.checkFuncError(err); exitCode > 0 {
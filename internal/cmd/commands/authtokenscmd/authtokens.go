@@ -18,10 +18,31 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary auth-tokens list -recursive `,
 			"",
+			"    Create a long-lived API token for the current user:",
+			"",
+			`      $ boundary auth-tokens create -scope-id o_1234567890 -name automation -ttl 720h`,
+			"",
+			"    Refresh the stored auth token:",
+			"",
+			`      $ boundary auth-tokens refresh`,
+			"",
 			"  Please see the auth-tokens subcommand help for detailed usage information.",
-			"  Note: To create an auth token, see the authenticate subcommand.",
+			"  Note: To create an auth token by logging in, see the authenticate subcommand.",
 		})
 
+	case "refresh":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-tokens refresh [options] [args]",
+			"",
+			"  This command replaces the value of the auth token used to make the request and resets the time it becomes stale. The previous token value is no longer valid. The expiration time of the token is not changed. If the token was read from a keyring, the new token is stored in it. Example:",
+			"",
+			"    Refresh the stored auth token:",
+			"",
+			`      $ boundary auth-tokens refresh`,
+			"",
+			"",
+		}) + c.Flags().Help()
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -17,11 +17,83 @@ import (
 const selfFlag = "self"
 
 func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
-func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]authtokens.Option) bool {
-	if c.Func != "delete" && c.Func != "read" {
+type extraCmdVars struct {
+	flagGrants []string
+	flagTtl    time.Duration
+
+	refreshResult       *authtokens.AuthTokenUpdateResult
+	refreshedTokenSaved bool
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create":  {"grant", "ttl"},
+		"refresh": {"id"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "create":
+		return "Create a long-lived API token for the current user"
+	case "refresh":
+		return "Refresh the stored auth token, replacing its value"
+	}
+	return ""
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "grant":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "grant",
+				Target: &c.flagGrants,
+				Usage:  "A grant limiting the permissions of the API token to a subset of the permissions of the user. May be specified multiple times. If not specified, the API token has all permissions of the user.",
+			})
+		case "ttl":
+			f.DurationVar(&base.DurationVar{
+				Name:   "ttl",
+				Target: &c.flagTtl,
+				Usage:  "The duration after which the API token expires. If not specified, the API token expires after 90 days.",
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]authtokens.Option) bool {
+	if c.Func == "create" {
+		if c.FlagName == "" || c.FlagName == "null" {
+			c.PrintCliError(errors.New("Name is required but not passed in via -name"))
+			return false
+		}
+		if len(c.flagGrants) > 0 {
+			*opts = append(*opts, authtokens.WithGrantStrings(c.flagGrants))
+		}
+		switch {
+		case c.flagTtl < 0:
+			c.PrintCliError(errors.New("TTL must not be negative"))
+			return false
+		case c.flagTtl > 0:
+			*opts = append(*opts, authtokens.WithExpirationTime(time.Now().Add(c.flagTtl)))
+		}
+		return true
+	}
+
+	if c.Func == "refresh" && c.FlagId == "" {
+		// Only the auth token used to make the request can be refreshed.
+		c.FlagId = selfFlag
+	}
+
+	if c.Func != "delete" && c.Func != "read" && c.Func != "refresh" {
 		if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" {
 			c.PrintCliError(errors.New("ID is required but not passed in via -id"))
 			return false
@@ -59,6 +131,51 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]authtokens.Op
 	return true
 }
 
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *authtokens.AuthToken, origItems []*authtokens.AuthToken, origError error, authtokenClient *authtokens.Client, version uint32, opts []authtokens.Option) (*api.Response, *authtokens.AuthToken, []*authtokens.AuthToken, error) {
+	switch c.Func {
+	case "refresh":
+		var err error
+		c.refreshResult, err = authtokenClient.Refresh(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		// The previous token value is no longer valid, so replace it in the
+		// keyring if that is where it was read from.
+		c.refreshedTokenSaved, err = c.StoreRefreshedToken(c.refreshResult.GetItem())
+		if err != nil {
+			c.PrintCliError(err)
+		}
+		return nil, nil, nil, nil
+	}
+	return origResp, origItem, origItems, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "refresh":
+		switch base.Format(c.UI) {
+		case "table":
+			item := c.refreshResult.GetItem()
+			if c.refreshedTokenSaved {
+				item.Token = ""
+			}
+			c.UI.Output(printItemTable(item, c.refreshResult.GetResponse()))
+			if c.refreshedTokenSaved {
+				c.UI.Output("\nThe new token was successfully stored in the chosen keyring and is not displayed here.")
+			}
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.refreshResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*authtokens.AuthToken) string {
 	if len(items) == 0 {
 		return "No auth tokens found"
@@ -83,6 +200,16 @@ func (c *Command) printListTable(items []*authtokens.AuthToken) string {
 				fmt.Sprintf("    Scope ID:                    %s", t.ScopeId),
 			)
 		}
+		if t.Name != "" {
+			output = append(output,
+				fmt.Sprintf("    Name:                        %s", t.Name),
+			)
+		}
+		if t.ApiToken {
+			output = append(output,
+				fmt.Sprintf("    API Token:                   %t", t.ApiToken),
+			)
+		}
 		if !t.ApproximateLastUsedTime.IsZero() {
 			output = append(output,
 				fmt.Sprintf("    Approximate Last Used Time:  %s", t.ApproximateLastUsedTime.Local().Format(time.RFC1123)),
@@ -134,6 +261,18 @@ func printItemTable(item *authtokens.AuthToken, resp *api.Response) string {
 		"Expiration Time":            item.ExpirationTime.Local().Format(time.RFC1123),
 		"Approximate Last Used Time": item.ApproximateLastUsedTime.Local().Format(time.RFC1123),
 	}
+	if item.Name != "" {
+		nonAttributeMap["Name"] = item.Name
+	}
+	if item.Description != "" {
+		nonAttributeMap["Description"] = item.Description
+	}
+	if item.ApiToken {
+		nonAttributeMap["API Token"] = item.ApiToken
+	}
+	if item.Token != "" {
+		nonAttributeMap["Token"] = item.Token
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -146,6 +285,14 @@ func printItemTable(item *authtokens.AuthToken, resp *api.Response) string {
 		base.ScopeInfoForOutput(item.Scope, maxLength),
 	}
 
+	if len(item.GrantStrings) > 0 {
+		ret = append(ret,
+			"",
+			"  Grants:",
+			base.WrapSlice(4, item.GrantStrings),
+		)
+	}
+
 	if len(item.AuthorizedActions) > 0 {
 		ret = append(ret,
 			"",
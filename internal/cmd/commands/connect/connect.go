@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
@@ -28,6 +29,7 @@ import (
 	targetspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-secure-stdlib/base62"
+	"github.com/hashicorp/yamux"
 	"github.com/mitchellh/cli"
 	"github.com/mr-tron/base58"
 	"github.com/posener/complete"
@@ -75,6 +77,7 @@ type Command struct {
 	flagExec       string
 	flagUsername   string
 	flagDbname     string
+	flagSessionCa  string
 
 	// HTTP
 	httpFlags
@@ -99,6 +102,10 @@ type Command struct {
 	connWg             *sync.WaitGroup
 	listenerCloseOnce  sync.Once
 	listener           *net.TCPListener
+	udpListener        *net.UDPConn
+	muxLock            sync.Mutex
+	muxSession         *yamux.Session
+	muxUnsupported     bool
 	listenerAddr       *net.TCPAddr
 	connsLeftCh        chan int32
 	connectionsLeft    *atomic.Int32
@@ -172,6 +179,14 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:      `Only needed if -target-id is not set. The authorization string returned from the Boundary controller via an "authorize-session" action against a target. If set to "-", the command will attempt to read in the authorization string from standard input.`,
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:       "session-ca-file",
+		Target:     &c.flagSessionCa,
+		EnvVar:     "BOUNDARY_CONNECT_SESSION_CA_FILE",
+		Completion: complete.PredictFiles("*"),
+		Usage:      `If set, the PEM encoded TLS certificate authority of the session is written to the given file. Clients trust it as the certificate authority of the certificate presented by the worker. Only sessions of targets on which the worker terminates TLS have one.`,
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:   "target-id",
 		Target: &c.flagTargetId,
@@ -300,6 +315,17 @@ func (c *Command) Run(args []string) (retCode int) {
 		}
 	}
 
+	if c.Func == "ssh" && !c.sshFlags.embedded() {
+		switch {
+		case len(c.flagSshLocalForwards) > 0:
+			c.PrintCliError(fmt.Errorf(`-local-forward is only supported with -style %q`, embeddedSshStyle))
+			return base.CommandUserError
+		case c.flagSshTty:
+			c.PrintCliError(fmt.Errorf(`-tty is only supported with -style %q`, embeddedSshStyle))
+			return base.CommandUserError
+		}
+	}
+
 	if c.flagExec == "" {
 		switch c.Func {
 		case "http":
@@ -449,6 +475,18 @@ func (c *Command) Run(args []string) (retCode int) {
 	}
 	c.expiration = tlsConf.Certificates[0].Leaf.NotAfter
 
+	if c.flagSessionCa != "" {
+		if len(c.sessionAuthzData.GetTlsCaCertificate()) == 0 {
+			c.PrintCliError(errors.New("The worker does not terminate the TLS connections of this session, so it has no TLS certificate authority"))
+			return base.CommandUserError
+		}
+		sessionCa := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.sessionAuthzData.GetTlsCaCertificate()})
+		if err := os.WriteFile(c.flagSessionCa, sessionCa, 0o644); err != nil {
+			c.PrintCliError(fmt.Errorf("Error writing session TLS certificate authority: %w", err))
+			return base.CommandCliError
+		}
+	}
+
 	// We don't _rely_ on client-side timeout verification but this prevents us
 	// seeming to be ready for a connection that will immediately fail when we
 	// try to actually make it
@@ -465,10 +503,21 @@ func (c *Command) Run(args []string) (retCode int) {
 		return dialer.DialContext(ctx, network, addr)
 	}
 
-	c.listener, err = net.ListenTCP("tcp", &net.TCPAddr{
-		IP:   listenAddr,
-		Port: c.flagListenPort,
-	})
+	var listener io.Closer
+	switch c.sessionAuthzData.GetType() {
+	case "udp":
+		c.udpListener, err = net.ListenUDP("udp", &net.UDPAddr{
+			IP:   listenAddr,
+			Port: c.flagListenPort,
+		})
+		listener = c.udpListener
+	default:
+		c.listener, err = net.ListenTCP("tcp", &net.TCPAddr{
+			IP:   listenAddr,
+			Port: c.flagListenPort,
+		})
+		listener = c.listener
+	}
 	if err != nil {
 		c.PrintCliError(fmt.Errorf("Error starting listening port: %w", err))
 		return base.CommandCliError
@@ -477,7 +526,7 @@ func (c *Command) Run(args []string) (retCode int) {
 	listenerCloseFunc := func() {
 		// Forces the for loop to exist instead of spinning on errors
 		c.connectionsLeft.Store(0)
-		if err := c.listener.Close(); err != nil {
+		if err := listener.Close(); err != nil {
 			c.PrintCliError(fmt.Errorf("Error closing listener on shutdown: %w", err))
 			retCode = 2
 		}
@@ -488,7 +537,15 @@ func (c *Command) Run(args []string) (retCode int) {
 		c.listenerCloseOnce.Do(listenerCloseFunc)
 	}()
 
-	c.listenerAddr = c.listener.Addr().(*net.TCPAddr)
+	switch {
+	case c.udpListener != nil:
+		// The address is only used for its ip and port, which are reported
+		// the same way for both kinds of listeners.
+		udpAddr := c.udpListener.LocalAddr().(*net.UDPAddr)
+		c.listenerAddr = &net.TCPAddr{IP: udpAddr.IP, Port: udpAddr.Port, Zone: udpAddr.Zone}
+	default:
+		c.listenerAddr = c.listener.Addr().(*net.TCPAddr)
+	}
 
 	if c.Func == "connect" {
 		// "connect" indicates there is no subcommand to the connect function.
@@ -527,6 +584,10 @@ func (c *Command) Run(args []string) (retCode int) {
 	c.connWg.Add(1)
 	go func() {
 		defer c.connWg.Done()
+		if c.udpListener != nil {
+			c.runUdpListener(workerAddr, transport, tofuToken)
+			return
+		}
 		for {
 			listeningConn, err := c.listener.AcceptTCP()
 			if err != nil {
@@ -549,16 +610,11 @@ func (c *Command) Run(args []string) (retCode int) {
 			go func() {
 				defer listeningConn.Close()
 				defer c.connWg.Done()
-				wsConn, err := c.getWsConn(
-					c.proxyCtx,
-					workerAddr,
-					transport)
+				netConn, err := c.proxyConn(workerAddr, transport, tofuToken)
 				if err != nil {
 					c.PrintCliError(err)
 				} else {
-					if err := c.runTcpProxyV1(wsConn, listeningConn, tofuToken); err != nil {
-						c.PrintCliError(err)
-					}
+					c.runTcpProxy(netConn, listeningConn)
 				}
 			}()
 		}
@@ -626,7 +682,7 @@ func (c *Command) Run(args []string) (retCode int) {
 	// this machine.
 	if sendSessionCancel && time.Now().Before(c.expiration.Add(-5*time.Minute)) {
 		ctx, cancel := context.WithTimeout(context.Background(), sessionCancelTimeout)
-		wsConn, err := c.getWsConn(ctx, workerAddr, transport)
+		wsConn, _, err := c.getWsConn(ctx, workerAddr, transport)
 		if err != nil {
 			c.PrintCliError(fmt.Errorf("error fetching connection to send session teardown request to worker: %w", err))
 		} else {
@@ -681,11 +737,18 @@ func (c *Command) printCredentials(creds []*targets.SessionCredential) error {
 	return nil
 }
 
+// getWsConn dials a websocket to the worker offering the provided
+// subprotocols, or globals.TcpProxyV1 if none is provided, and returns it with
+// the subprotocol the worker chose.
 func (c *Command) getWsConn(
 	ctx context.Context,
 	workerAddr string,
 	transport *http.Transport,
-) (*websocket.Conn, error) {
+	subprotocols ...string,
+) (*websocket.Conn, string, error) {
+	if len(subprotocols) == 0 {
+		subprotocols = []string{globals.TcpProxyV1}
+	}
 	conn, resp, err := websocket.Dial(
 		ctx,
 		fmt.Sprintf("ws://%s/v1/proxy", workerAddr),
@@ -693,31 +756,33 @@ func (c *Command) getWsConn(
 			HTTPClient: &http.Client{
 				Transport: transport,
 			},
-			Subprotocols: []string{globals.TcpProxyV1},
+			Subprotocols: subprotocols,
 		},
 	)
 	if err != nil {
 		switch {
 		case strings.Contains(err.Error(), "tls: internal error"):
-			return nil, errors.New("Session credentials were not accepted, or session is unauthorized")
+			return nil, "", errors.New("Session credentials were not accepted, or session is unauthorized")
 		case strings.Contains(err.Error(), "connect: connection refused"):
-			return nil, fmt.Errorf("Unable to connect to worker at %s", workerAddr)
+			return nil, "", fmt.Errorf("Unable to connect to worker at %s", workerAddr)
 		default:
-			return nil, fmt.Errorf("Error dialing the worker: %w", err)
+			return nil, "", fmt.Errorf("Error dialing the worker: %w", err)
 		}
 	}
 
 	if resp == nil {
-		return nil, errors.New("Response from worker is nil")
+		return nil, "", errors.New("Response from worker is nil")
 	}
 	if resp.Header == nil {
-		return nil, errors.New("Response header is nil")
+		return nil, "", errors.New("Response header is nil")
 	}
 	negProto := resp.Header.Get("Sec-WebSocket-Protocol")
-	if negProto != globals.TcpProxyV1 {
-		return nil, fmt.Errorf("Unexpected negotiated protocol: %s", negProto)
+	for _, p := range subprotocols {
+		if negProto == p {
+			return conn, negProto, nil
+		}
 	}
-	return conn, nil
+	return nil, "", fmt.Errorf("Unexpected negotiated protocol: %s", negProto)
 }
 
 func (c *Command) sendSessionTeardown(
@@ -736,14 +801,57 @@ func (c *Command) sendSessionTeardown(
 	return nil
 }
 
-func (c *Command) runTcpProxyV1(
-	wsConn *websocket.Conn,
+// runTcpProxy copies data between the connection accepted on the local
+// listener and the connection to the worker until both directions are done.
+// When either side closes its write half, the write half of the other side is
+// closed so protocols which half-close keep working.  Connections to the
+// worker over a whole websocket can't be half-closed, so both connections are
+// closed as soon as either side is done.
+func (c *Command) runTcpProxy(
+	netConn net.Conn,
 	listeningConn *net.TCPConn,
+) {
+	// Closing a yamux stream only closes its write half.
+	stream, halfClose := netConn.(*yamux.Stream)
+	closeConns := func() {
+		netConn.Close()
+		listeningConn.Close()
+	}
+
+	localWg := new(sync.WaitGroup)
+	localWg.Add(2)
+
+	go func() {
+		defer localWg.Done()
+		_, err := io.Copy(netConn, listeningConn)
+		if err != nil || !halfClose {
+			closeConns()
+			return
+		}
+		stream.Close()
+	}()
+	go func() {
+		defer localWg.Done()
+		_, err := io.Copy(listeningConn, netConn)
+		if err != nil || !halfClose {
+			closeConns()
+			return
+		}
+		listeningConn.CloseWrite()
+	}()
+	localWg.Wait()
+	closeConns()
+}
+
+// proxyHandshake sends the session's handshake to the worker over wsConn and
+// reads its result.
+func (c *Command) proxyHandshake(
+	wsConn *websocket.Conn,
 	tofuToken string,
-) error {
+) (*proxy.HandshakeResult, error) {
 	handshake := proxy.ClientHandshake{TofuToken: tofuToken}
 	if err := wspb.Write(c.proxyCtx, wsConn, &handshake); err != nil {
-		return fmt.Errorf("error sending handshake to worker: %w", err)
+		return nil, fmt.Errorf("error sending handshake to worker: %w", err)
 	}
 	var handshakeResult proxy.HandshakeResult
 	if err := wspb.Read(c.proxyCtx, wsConn, &handshakeResult); err != nil {
@@ -752,43 +860,18 @@ func (c *Command) runTcpProxyV1(
 			// There's no reason to think we'd be able to authorize any more
 			// connections after the first has failed
 			c.connsLeftCh <- 0
-			return errors.New("Unable to authorize connection")
+			return nil, errors.New("Unable to authorize connection")
 		}
 		switch {
 		case strings.Contains(err.Error(), "tofu token not allowed"):
 			// Nothing will be able to be done here, so cancel the context too
 			c.proxyCancel()
-			return errors.New("Session is already in use")
+			return nil, errors.New("Session is already in use")
 		default:
-			return fmt.Errorf("error reading handshake result: %w", err)
+			return nil, fmt.Errorf("error reading handshake result: %w", err)
 		}
 	}
-
-	if handshakeResult.GetConnectionsLeft() != -1 {
-		c.connsLeftCh <- handshakeResult.GetConnectionsLeft()
-	}
-
-	// Get a wrapped net.Conn so we can use io.Copy
-	netConn := websocket.NetConn(c.proxyCtx, wsConn, websocket.MessageBinary)
-
-	localWg := new(sync.WaitGroup)
-	localWg.Add(2)
-
-	go func() {
-		defer localWg.Done()
-		io.Copy(netConn, listeningConn)
-		netConn.Close()
-		listeningConn.Close()
-	}()
-	go func() {
-		defer localWg.Done()
-		io.Copy(listeningConn, netConn)
-		listeningConn.Close()
-		netConn.Close()
-	}()
-	localWg.Wait()
-
-	return nil
+	return &handshakeResult, nil
 }
 
 func (c *Command) updateConnsLeft(connsLeft int32) {
@@ -823,6 +906,7 @@ func (c *Command) handleExec(passthroughArgs []string) {
 	var args []string
 	var envs []string
 	var argsErr error
+	var stdin io.Reader = os.Stdin
 
 	var creds credentials
 	if c.sessionAuthz != nil {
@@ -856,9 +940,22 @@ func (c *Command) handleExec(passthroughArgs []string) {
 		creds = pgCreds
 
 	case "rdp":
-		args = append(args, c.rdpFlags.buildArgs(c, port, ip, addr)...)
+		rdpArgs, rdpStdin, rdpCreds, rdpErr := c.rdpFlags.buildArgs(c, port, ip, addr, creds)
+		if rdpErr != nil {
+			argsErr = rdpErr
+			break
+		}
+		args = append(args, rdpArgs...)
+		if rdpStdin != nil {
+			stdin = rdpStdin
+		}
+		creds = rdpCreds
 
 	case "ssh":
+		if c.sshFlags.embedded() {
+			c.runEmbeddedSsh(passthroughArgs, addr, creds)
+			return
+		}
 		sshArgs, sshEnvs, sshCreds, sshErr := c.sshFlags.buildArgs(c, port, ip, addr, creds)
 		if sshErr != nil {
 			argsErr = sshErr
@@ -869,13 +966,14 @@ func (c *Command) handleExec(passthroughArgs []string) {
 		creds = sshCreds
 
 	case "kube":
-		kubeArgs, err := c.kubeFlags.buildArgs(c, port, ip, addr)
+		kubeArgs, kubeEnvs, err := c.kubeFlags.buildArgs(c, port, ip, addr)
 		if err != nil {
 			c.PrintCliError(fmt.Errorf("Error parsing session args: %w", err))
 			c.execCmdReturnValue.Store(int32(3))
 			return
 		}
 		args = append(args, kubeArgs...)
+		envs = append(envs, kubeEnvs...)
 	}
 
 	if argsErr != nil {
@@ -922,7 +1020,7 @@ func (c *Command) handleExec(passthroughArgs []string) {
 	)
 	// Envs that came from subcommand handling
 	cmd.Env = append(cmd.Env, envs...)
-	cmd.Stdin = os.Stdin
+	cmd.Stdin = stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
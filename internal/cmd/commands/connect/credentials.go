@@ -28,14 +28,24 @@ type sshPrivateKey struct {
 	consumed bool
 }
 
+type sshCertificate struct {
+	Username    string `mapstructure:"username"`
+	PrivateKey  string `mapstructure:"private_key"`
+	Certificate string `mapstructure:"certificate"`
+
+	raw      *targets.SessionCredential
+	consumed bool
+}
+
 type credentials struct {
 	usernamePassword []usernamePassword
 	sshPrivateKey    []sshPrivateKey
+	sshCertificate   []sshCertificate
 	unspecified      []*targets.SessionCredential
 }
 
 func (c credentials) unconsumedSessionCredentials() []*targets.SessionCredential {
-	out := make([]*targets.SessionCredential, 0, len(c.sshPrivateKey)+len(c.usernamePassword)+len(c.unspecified))
+	out := make([]*targets.SessionCredential, 0, len(c.sshPrivateKey)+len(c.sshCertificate)+len(c.usernamePassword)+len(c.unspecified))
 
 	// Unspecified credentials cannot be consumed
 	out = append(out, c.unspecified...)
@@ -45,6 +55,11 @@ func (c credentials) unconsumedSessionCredentials() []*targets.SessionCredential
 			out = append(out, c.raw)
 		}
 	}
+	for _, c := range c.sshCertificate {
+		if !c.consumed {
+			out = append(out, c.raw)
+		}
+	}
 	for _, c := range c.usernamePassword {
 		if !c.consumed {
 			out = append(out, c.raw)
@@ -65,6 +80,7 @@ func parseCredentials(creds []*targets.SessionCredential) (credentials, error) {
 
 		var upCred usernamePassword
 		var spkCred sshPrivateKey
+		var scCred sshCertificate
 		switch credential.Type(cred.CredentialSource.CredentialType) {
 		case credential.UsernamePasswordType:
 			// Decode attributes from credential struct
@@ -89,6 +105,18 @@ func parseCredentials(creds []*targets.SessionCredential) (credentials, error) {
 				out.sshPrivateKey = append(out.sshPrivateKey, spkCred)
 				continue
 			}
+
+		case credential.SshCertificateType:
+			// Decode attributes from credential struct
+			if err := mapstructure.Decode(cred.Credential, &scCred); err != nil {
+				return credentials{}, err
+			}
+
+			if scCred.Username != "" && scCred.PrivateKey != "" && scCred.Certificate != "" {
+				scCred.raw = cred
+				out.sshCertificate = append(out.sshCertificate, scCred)
+				continue
+			}
 		}
 
 		// Credential type is unspecified, make a best effort attempt to parse
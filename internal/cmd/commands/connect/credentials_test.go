@@ -35,6 +35,17 @@ var (
 		},
 	}
 
+	typedSshCertificate = &targets.SessionCredential{
+		CredentialSource: &targets.CredentialSource{
+			CredentialType: string(credential.SshCertificateType),
+		},
+		Credential: map[string]any{
+			"username":    "user",
+			"private_key": "my-pk",
+			"certificate": "my-cert",
+		},
+	}
+
 	vaultUsernamePasswordDeprecatedSubtype = &targets.SessionCredential{
 		CredentialSource: &targets.CredentialSource{
 			Type: vault.Subtype.String(),
@@ -227,6 +238,23 @@ func Test_parseCredentials(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "ssh-certificate-typed",
+			creds: []*targets.SessionCredential{
+				typedSshCertificate,
+			},
+			wantCreds: credentials{
+				sshCertificate: []sshCertificate{
+					{
+						Username:    "user",
+						PrivateKey:  "my-pk",
+						Certificate: "my-cert",
+						raw:         typedSshCertificate,
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "vault-username-password-decoded",
 			creds: []*targets.SessionCredential{
@@ -480,6 +508,29 @@ func Test_unconsumedSessionCredentials(t *testing.T) {
 			},
 			wantCreds: []*targets.SessionCredential{staticSshPrivateKey},
 		},
+		{
+			name: "sc",
+			creds: credentials{
+				sshCertificate: []sshCertificate{
+					{
+						raw: typedSshCertificate,
+					},
+				},
+			},
+			wantCreds: []*targets.SessionCredential{typedSshCertificate},
+		},
+		{
+			name: "sc-consumed",
+			creds: credentials{
+				sshCertificate: []sshCertificate{
+					{
+						raw:      typedSshCertificate,
+						consumed: true,
+					},
+				},
+			},
+			wantCreds: nil,
+		},
 		{
 			name: "up",
 			creds: credentials{
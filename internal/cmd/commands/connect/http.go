@@ -58,7 +58,7 @@ func httpOptions(c *Command, set *base.FlagSets) {
 		Default:    "https",
 		EnvVar:     "BOUNDARY_CONNECT_HTTP_SCHEME",
 		Completion: complete.PredictNothing,
-		Usage:      `Specifies the scheme to use.`,
+		Usage:      `Specifies the scheme to use. Ignored for http targets, whose connections to the endpoint use TLS if it is enabled on the target.`,
 	})
 }
 
@@ -76,14 +76,24 @@ func (h *httpFlags) defaultExec() string {
 
 func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, error) {
 	var args []string
+	scheme := h.flagHttpScheme
 	host := h.flagHttpHost
-	if host == "" && c.sessionAuthzData.GetEndpoint() != "" {
-		hostUrl := c.sessionAuthzData.GetEndpoint()
-		u, err := url.Parse(hostUrl)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing endpoint URL: %w", err)
+	switch c.sessionAuthzData.GetType() {
+	case "http":
+		// The worker terminates the client's requests and sets the endpoint's
+		// Host header and TLS configuration itself, so the client speaks plain
+		// HTTP to the local proxy.
+		scheme = "http"
+		host = ""
+	default:
+		if host == "" && c.sessionAuthzData.GetEndpoint() != "" {
+			hostUrl := c.sessionAuthzData.GetEndpoint()
+			u, err := url.Parse(hostUrl)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing endpoint URL: %w", err)
+			}
+			host = u.Hostname()
 		}
-		host = u.Hostname()
 	}
 	switch h.flagHttpStyle {
 	case "curl":
@@ -95,9 +105,9 @@ func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, erro
 			host = strings.TrimSuffix(host, "/")
 			args = append(args, "-H", fmt.Sprintf("Host: %s", host))
 			args = append(args, "--resolve", fmt.Sprintf("%s:%s:%s", host, port, ip))
-			uri = fmt.Sprintf("%s://%s:%s", h.flagHttpScheme, host, port)
+			uri = fmt.Sprintf("%s://%s:%s", scheme, host, port)
 		} else {
-			uri = fmt.Sprintf("%s://%s", h.flagHttpScheme, addr)
+			uri = fmt.Sprintf("%s://%s", scheme, addr)
 		}
 		if h.flagHttpPath != "" {
 			uri = fmt.Sprintf("%s/%s", uri, strings.TrimPrefix(h.flagHttpPath, "/"))
@@ -4,8 +4,10 @@
 package connect
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"os"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
@@ -14,6 +16,14 @@ import (
 
 const (
 	kubeSynopsis = "Authorize a session against a target and invoke a Kubernetes client to connect"
+
+	// kubernetesTargetType is the type of the targets whose worker injects
+	// the service account token into the requests sent to the API server.
+	kubernetesTargetType = "kubernetes"
+
+	// kubeconfigName is the name of the cluster, user and context of the
+	// kubeconfig generated for kubernetes targets.
+	kubeconfigName = "boundary"
 )
 
 func kubeOptions(c *Command, set *base.FlagSets) {
@@ -56,14 +66,28 @@ func (f *kubeFlags) defaultExec() string {
 	return strings.ToLower(f.flagKubeStyle)
 }
 
-func (f *kubeFlags) buildArgs(c *Command, port, ip, addr string) ([]string, error) {
+func (f *kubeFlags) buildArgs(c *Command, port, ip, addr string) ([]string, []string, error) {
 	var args []string
+	var envs []string
+
+	// The worker talks TLS to the API server of kubernetes targets and
+	// authenticates the requests itself, so the client only needs to be
+	// pointed at the local listener.
+	if c.sessionAuthzData.GetType() == kubernetesTargetType {
+		path, err := writeKubeconfig(c, fmt.Sprintf("http://%s", addr))
+		if err != nil {
+			return nil, nil, err
+		}
+		envs = append(envs, fmt.Sprintf("KUBECONFIG=%s", path))
+		return args, envs, nil
+	}
+
 	host := f.flagKubeHost
 	if host == "" && c.sessionAuthzData.GetEndpoint() != "" {
 		hostUrl := c.sessionAuthzData.GetEndpoint()
 		u, err := url.Parse(hostUrl)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing endpoint URL: %w", err)
+			return nil, nil, fmt.Errorf("error parsing endpoint URL: %w", err)
 		}
 		host = u.Hostname()
 	}
@@ -75,5 +99,50 @@ func (f *kubeFlags) buildArgs(c *Command, port, ip, addr string) ([]string, erro
 		}
 		args = append(args, "--server", fmt.Sprintf("%s://%s", f.flagKubeScheme, addr))
 	}
-	return args, nil
+	return args, envs, nil
+}
+
+// writeKubeconfig writes a kubeconfig whose only context connects to server
+// without credentials to a temporary file which is removed when the command
+// exits, and returns its path.
+func writeKubeconfig(c *Command, server string) (string, error) {
+	kubeconfig := map[string]any{
+		"apiVersion":      "v1",
+		"kind":            "Config",
+		"current-context": kubeconfigName,
+		"clusters": []any{map[string]any{
+			"name":    kubeconfigName,
+			"cluster": map[string]any{"server": server},
+		}},
+		"users": []any{map[string]any{
+			"name": kubeconfigName,
+			"user": map[string]any{},
+		}},
+		"contexts": []any{map[string]any{
+			"name":    kubeconfigName,
+			"context": map[string]any{"cluster": kubeconfigName, "user": kubeconfigName},
+		}},
+	}
+	b, err := json.Marshal(kubeconfig)
+	if err != nil {
+		return "", fmt.Errorf("Error marshaling kubeconfig: %w", err)
+	}
+	f, err := os.CreateTemp("", "boundary-kubeconfig-*")
+	if err != nil {
+		return "", fmt.Errorf("Error saving kubeconfig to tmp file: %w", err)
+	}
+	c.cleanupFuncs = append(c.cleanupFuncs, func() error {
+		if err := os.Remove(f.Name()); err != nil {
+			return fmt.Errorf("Error removing temporary kubeconfig; consider removing %s manually: %w", f.Name(), err)
+		}
+		return nil
+	})
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return "", fmt.Errorf("Error writing kubeconfig to %s: %w", f.Name(), err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("Error closing kubeconfig after writing to %s: %w", f.Name(), err)
+	}
+	return f.Name(), nil
 }
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	targetspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubeBuildArgs(t *testing.T) {
+	t.Run("tcp target", func(t *testing.T) {
+		c := &Command{sessionAuthzData: &targetspb.SessionAuthorizationData{
+			Type:     "tcp",
+			Endpoint: "tcp://k8s.example.com:6443",
+		}}
+		c.flagKubeStyle = "kubectl"
+		c.flagKubeScheme = "https"
+		args, envs, err := c.kubeFlags.buildArgs(c, "50000", "127.0.0.1", "127.0.0.1:50000")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"--tls-server-name", "k8s.example.com", "--server", "https://127.0.0.1:50000"}, args)
+		assert.Empty(t, envs)
+	})
+
+	t.Run("kubernetes target", func(t *testing.T) {
+		c := &Command{sessionAuthzData: &targetspb.SessionAuthorizationData{
+			Type:     "kubernetes",
+			Endpoint: "kubernetes://k8s.example.com:443",
+		}}
+		c.flagKubeStyle = "kubectl"
+		c.flagKubeScheme = "https"
+		args, envs, err := c.kubeFlags.buildArgs(c, "50000", "127.0.0.1", "127.0.0.1:50000")
+		require.NoError(t, err)
+		assert.Empty(t, args)
+		require.Len(t, envs, 1)
+		require.True(t, strings.HasPrefix(envs[0], "KUBECONFIG="))
+		path := strings.TrimPrefix(envs[0], "KUBECONFIG=")
+
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		var kubeconfig struct {
+			CurrentContext string `json:"current-context"`
+			Clusters       []struct {
+				Name    string `json:"name"`
+				Cluster struct {
+					Server string `json:"server"`
+				} `json:"cluster"`
+			} `json:"clusters"`
+		}
+		require.NoError(t, json.Unmarshal(b, &kubeconfig))
+		assert.Equal(t, kubeconfigName, kubeconfig.CurrentContext)
+		require.Len(t, kubeconfig.Clusters, 1)
+		assert.Equal(t, "http://127.0.0.1:50000", kubeconfig.Clusters[0].Cluster.Server)
+
+		// The kubeconfig is removed when the command cleans up.
+		require.Len(t, c.cleanupFuncs, 1)
+		require.NoError(t, c.cleanupFuncs[0]())
+		_, err = os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
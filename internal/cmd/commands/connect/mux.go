@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/proxy"
+	"github.com/hashicorp/yamux"
+	"nhooyr.io/websocket"
+)
+
+// proxyConn returns a new connection of the session to the worker.
+//
+// The first connection dials a websocket offering both the multiplexed
+// globals.TcpProxyV2 and the globals.TcpProxyV1 subprotocols.  If the worker
+// chooses globals.TcpProxyV2, the session's handshake is sent once over the
+// websocket and every connection is a yamux stream over it, saving a websocket
+// and a handshake per connection.  The websocket is dialed again if it closes.
+// Workers which don't support multiplexing choose globals.TcpProxyV1, in which
+// case every connection dials its own websocket.
+func (c *Command) proxyConn(
+	workerAddr string,
+	transport *http.Transport,
+	tofuToken string,
+) (net.Conn, error) {
+	c.muxLock.Lock()
+	if c.muxSession != nil && c.muxSession.IsClosed() {
+		c.muxSession = nil
+	}
+	muxSession := c.muxSession
+	if muxSession == nil && !c.muxUnsupported {
+		// The lock is held while dialing so concurrent connections share a
+		// single websocket.
+		wsConn, negProto, err := c.getWsConn(c.proxyCtx, workerAddr, transport, globals.TcpProxyV2, globals.TcpProxyV1)
+		if err != nil {
+			c.muxLock.Unlock()
+			return nil, err
+		}
+		if negProto == globals.TcpProxyV1 {
+			c.muxUnsupported = true
+			c.muxLock.Unlock()
+			return c.websocketConn(wsConn, tofuToken)
+		}
+		muxSession, err = c.startMuxSession(wsConn, tofuToken)
+		if err != nil {
+			c.muxLock.Unlock()
+			return nil, err
+		}
+		c.muxSession = muxSession
+	}
+	c.muxLock.Unlock()
+
+	if muxSession == nil {
+		wsConn, _, err := c.getWsConn(c.proxyCtx, workerAddr, transport)
+		if err != nil {
+			return nil, err
+		}
+		return c.websocketConn(wsConn, tofuToken)
+	}
+	return c.openMuxStream(muxSession)
+}
+
+// websocketConn sends the session's handshake over a websocket negotiated
+// with the globals.TcpProxyV1 subprotocol and returns the websocket as the
+// connection.
+func (c *Command) websocketConn(wsConn *websocket.Conn, tofuToken string) (net.Conn, error) {
+	handshakeResult, err := c.proxyHandshake(wsConn, tofuToken)
+	if err != nil {
+		wsConn.Close(websocket.StatusNormalClosure, "")
+		return nil, err
+	}
+	if handshakeResult.GetConnectionsLeft() != -1 {
+		c.connsLeftCh <- handshakeResult.GetConnectionsLeft()
+	}
+
+	// Get a wrapped net.Conn so we can use io.Copy
+	return websocket.NetConn(c.proxyCtx, wsConn, websocket.MessageBinary), nil
+}
+
+// startMuxSession sends the session's handshake over a websocket negotiated
+// with the globals.TcpProxyV2 subprotocol and starts a yamux session over it.
+// The handshake result of the session doesn't carry the connections left,
+// which are sent with the handshake result of each stream.
+func (c *Command) startMuxSession(wsConn *websocket.Conn, tofuToken string) (*yamux.Session, error) {
+	if _, err := c.proxyHandshake(wsConn, tofuToken); err != nil {
+		wsConn.Close(websocket.StatusNormalClosure, "")
+		return nil, err
+	}
+	muxSession, err := yamux.Client(websocket.NetConn(c.proxyCtx, wsConn, websocket.MessageBinary), proxy.NewMuxConfig())
+	if err != nil {
+		wsConn.Close(websocket.StatusInternalError, "")
+		return nil, fmt.Errorf("error starting multiplexed session: %w", err)
+	}
+	return muxSession, nil
+}
+
+// openMuxStream opens a stream of the yamux session and reads its handshake
+// result.  The worker closes a stream it could not authorize a connection for
+// without sending a result.
+func (c *Command) openMuxStream(muxSession *yamux.Session) (net.Conn, error) {
+	stream, err := muxSession.OpenStream()
+	if err != nil {
+		return nil, fmt.Errorf("error opening multiplexed connection: %w", err)
+	}
+	var handshakeResult proxy.HandshakeResult
+	if err := proxy.ReadMessage(stream, &handshakeResult); err != nil {
+		stream.Close()
+		if errors.Is(err, io.EOF) {
+			// There's no reason to think we'd be able to authorize any more
+			// connections after the first has failed
+			c.connsLeftCh <- 0
+			return nil, errors.New("Unable to authorize connection")
+		}
+		return nil, fmt.Errorf("error reading handshake result: %w", err)
+	}
+	if handshakeResult.GetConnectionsLeft() != -1 {
+		c.connsLeftCh <- handshakeResult.GetConnectionsLeft()
+	}
+	return stream, nil
+}
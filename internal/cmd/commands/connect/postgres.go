@@ -66,10 +66,23 @@ func (p *postgresFlags) buildArgs(c *Command, port, ip, _ string, creds credenti
 		password = retCreds.usernamePassword[0].Password
 	}
 
+	if c.sessionAuthzData.GetType() == "postgres" {
+		// The worker authenticates to the database with the credentials
+		// injected into the session and accepts the client without a
+		// password, so none is handed to the client.
+		password = ""
+	}
+
 	switch p.flagPostgresStyle {
 	case "psql":
 		args = append(args, "-p", port, "-h", ip)
 
+		if c.sessionAuthzData.GetType() == "postgres" {
+			// The worker declines TLS from the client, the connection to the
+			// local proxy is already protected by the session's TLS.
+			envs = append(envs, "PGSSLMODE=disable")
+		}
+
 		if c.flagDbname != "" {
 			args = append(args, "-d", c.flagDbname)
 		}
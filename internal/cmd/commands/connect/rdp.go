@@ -5,6 +5,8 @@ package connect
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"runtime"
 	"strings"
 
@@ -23,8 +25,8 @@ func rdpOptions(c *Command, set *base.FlagSets) {
 		Name:       "style",
 		Target:     &c.flagRdpStyle,
 		EnvVar:     "BOUNDARY_CONNECT_RDP_STYLE",
-		Completion: complete.PredictSet("mstsc", "open"),
-		Usage:      `Specifies how the CLI will attempt to invoke an RDP client. This will also set a suitable default for -exec if a value was not specified. Currently-understood values are "mstsc", which is the default on Windows and launches the Windows client, and "open", which is the default on Mac and launches via an rdp:// URL.`,
+		Completion: complete.PredictSet("mstsc", "open", "xfreerdp", "remmina"),
+		Usage:      `Specifies how the CLI will attempt to invoke an RDP client. This will also set a suitable default for -exec if a value was not specified. Currently-understood values are "mstsc", which is the default on Windows and launches the Windows client, "open", which is the default on Mac and launches via an rdp:// URL, "xfreerdp", which is the default elsewhere, and "remmina". When a username/password credential is brokered, the client is given a temporary .rdp file with the local address and the brokered username and domain, which is removed when the command exits. The "xfreerdp" style is also passed the brokered password over stdin; other clients prompt for it.`,
 	})
 }
 
@@ -42,8 +44,7 @@ func (r *rdpFlags) defaultExec() string {
 		case "darwin":
 			r.flagRdpStyle = "open"
 		default:
-			// We may want to support rdesktop and/or xfreerdp at some point soon
-			r.flagRdpStyle = "mstsc"
+			r.flagRdpStyle = "xfreerdp"
 		}
 	}
 	if r.flagRdpStyle == "mstsc" {
@@ -52,13 +53,100 @@ func (r *rdpFlags) defaultExec() string {
 	return r.flagRdpStyle
 }
 
-func (r *rdpFlags) buildArgs(c *Command, port, ip, addr string) []string {
-	var args []string
+// buildArgs returns the arguments for the RDP client and, if it is not nil,
+// the reader to use as the client's stdin.
+func (r *rdpFlags) buildArgs(c *Command, port, ip, addr string, creds credentials) (args []string, stdin io.Reader, retCreds credentials, retErr error) {
+	retCreds = creds
+
+	var up *usernamePassword
+	if len(retCreds.usernamePassword) > 0 {
+		// For now just grab the first username password credential brokered
+		up = &retCreds.usernamePassword[0]
+	}
+
+	if up == nil {
+		switch r.flagRdpStyle {
+		case "mstsc.exe":
+			args = append(args, "/v", addr)
+		case "open":
+			args = append(args, "-n", "-W", fmt.Sprintf("rdp://full%saddress=s:%s", "%20", addr))
+		case "xfreerdp":
+			args = append(args, "/v:"+addr)
+		case "remmina":
+			args = append(args, "-c", "rdp://"+addr)
+		}
+		return args, nil, retCreds, nil
+	}
+
+	username, domain := splitRdpUsername(up.Username)
+	rdpFile, err := writeRdpFile(c, addr, username, domain)
+	if err != nil {
+		return nil, nil, credentials{}, err
+	}
+
 	switch r.flagRdpStyle {
 	case "mstsc.exe":
-		args = append(args, "/v", addr)
+		args = append(args, rdpFile)
 	case "open":
-		args = append(args, "-n", "-W", fmt.Sprintf("rdp://full%saddress=s:%s", "%20", addr))
+		args = append(args, "-n", "-W", rdpFile)
+	case "xfreerdp":
+		// With /from-stdin:force xfreerdp reads the credentials missing from
+		// the file from stdin before connecting: the domain, if there is
+		// none, and then the password.  As stdin is not a terminal, trust the
+		// certificate of the proxied port instead of prompting for it.
+		args = append(args, rdpFile, "/from-stdin:force", "/cert:tofu")
+		var in strings.Builder
+		if domain == "" {
+			in.WriteString("\n")
+		}
+		in.WriteString(up.Password + "\n")
+		stdin = strings.NewReader(in.String())
+
+		// Mark credential as consumed so that it is not printed to user
+		up.consumed = true
+	case "remmina":
+		args = append(args, "-c", rdpFile)
+	}
+	return args, stdin, retCreds, nil
+}
+
+// splitRdpUsername splits a down-level logon name, DOMAIN\user, into its user
+// and domain.  Other usernames, including user principal names, are returned
+// unchanged with no domain.
+func splitRdpUsername(username string) (user, domain string) {
+	if d, u, ok := strings.Cut(username, `\`); ok {
+		return u, d
+	}
+	return username, ""
+}
+
+// writeRdpFile writes an .rdp file connecting to addr as username in domain
+// to a temporary file which is removed when the command exits, and returns its
+// path.
+func writeRdpFile(c *Command, addr, username, domain string) (string, error) {
+	settings := []string{
+		"full address:s:" + addr,
+		"username:s:" + username,
+	}
+	if domain != "" {
+		settings = append(settings, "domain:s:"+domain)
+	}
+	f, err := os.CreateTemp("", "boundary-*.rdp")
+	if err != nil {
+		return "", fmt.Errorf("Error saving rdp file to tmp file: %w", err)
+	}
+	c.cleanupFuncs = append(c.cleanupFuncs, func() error {
+		if err := os.Remove(f.Name()); err != nil {
+			return fmt.Errorf("Error removing temporary rdp file; consider removing %s manually: %w", f.Name(), err)
+		}
+		return nil
+	})
+	if _, err := f.WriteString(strings.Join(settings, "\r\n") + "\r\n"); err != nil {
+		_ = f.Close()
+		return "", fmt.Errorf("Error writing rdp file to %s: %w", f.Name(), err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("Error closing rdp file after writing to %s: %w", f.Name(), err)
 	}
-	return args
+	return f.Name(), nil
 }
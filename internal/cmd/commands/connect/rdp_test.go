@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRdpBuildArgs(t *testing.T) {
+	t.Run("no credentials", func(t *testing.T) {
+		c := &Command{}
+		c.flagRdpStyle = "xfreerdp"
+		args, stdin, creds, err := c.rdpFlags.buildArgs(c, "50000", "127.0.0.1", "127.0.0.1:50000", credentials{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/v:127.0.0.1:50000"}, args)
+		assert.Nil(t, stdin)
+		assert.Empty(t, creds.unconsumedSessionCredentials())
+		assert.Empty(t, c.cleanupFuncs)
+	})
+
+	cases := []struct {
+		name         string
+		style        string
+		username     string
+		wantFile     string
+		wantStdin    string
+		wantConsumed bool
+	}{
+		{
+			name:         "xfreerdp",
+			style:        "xfreerdp",
+			username:     "alice",
+			wantFile:     "full address:s:127.0.0.1:50000\r\nusername:s:alice\r\n",
+			wantStdin:    "\npassw0rd\n",
+			wantConsumed: true,
+		},
+		{
+			name:         "xfreerdp with domain",
+			style:        "xfreerdp",
+			username:     `CORP\alice`,
+			wantFile:     "full address:s:127.0.0.1:50000\r\nusername:s:alice\r\ndomain:s:CORP\r\n",
+			wantStdin:    "passw0rd\n",
+			wantConsumed: true,
+		},
+		{
+			name:     "mstsc",
+			style:    "mstsc.exe",
+			username: "alice@corp.example.com",
+			wantFile: "full address:s:127.0.0.1:50000\r\nusername:s:alice@corp.example.com\r\n",
+		},
+		{
+			name:     "remmina",
+			style:    "remmina",
+			username: `CORP\alice`,
+			wantFile: "full address:s:127.0.0.1:50000\r\nusername:s:alice\r\ndomain:s:CORP\r\n",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Command{}
+			c.flagRdpStyle = tc.style
+			in := credentials{usernamePassword: []usernamePassword{{Username: tc.username, Password: "passw0rd"}}}
+			args, stdin, creds, err := c.rdpFlags.buildArgs(c, "50000", "127.0.0.1", "127.0.0.1:50000", in)
+			require.NoError(t, err)
+			require.NotEmpty(t, args)
+			assert.Equal(t, tc.wantConsumed, creds.usernamePassword[0].consumed)
+
+			var path string
+			for _, a := range args {
+				if _, err := os.Stat(a); err == nil {
+					path = a
+				}
+			}
+			require.NotEmpty(t, path)
+			b, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantFile, string(b))
+
+			if tc.wantStdin == "" {
+				assert.Nil(t, stdin)
+			} else {
+				require.NotNil(t, stdin)
+				got, err := io.ReadAll(stdin)
+				require.NoError(t, err)
+				assert.Equal(t, tc.wantStdin, string(got))
+			}
+
+			// The rdp file is removed when the command cleans up.
+			require.Len(t, c.cleanupFuncs, 1)
+			require.NoError(t, c.cleanupFuncs[0]())
+			_, err = os.Stat(path)
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+}
@@ -25,9 +25,9 @@ func sshOptions(c *Command, set *base.FlagSets) {
 		Name:       "style",
 		Target:     &c.flagSshStyle,
 		EnvVar:     "BOUNDARY_CONNECT_SSH_STYLE",
-		Completion: complete.PredictSet("ssh", "putty", "sshpass"),
+		Completion: complete.PredictSet("ssh", "putty", "sshpass", embeddedSshStyle),
 		Default:    "ssh",
-		Usage:      `Specifies how the CLI will attempt to invoke an SSH client. This will also set a suitable default for -exec if a value was not specified. Currently-understood values are "ssh" and "putty".`,
+		Usage:      `Specifies how the CLI will attempt to invoke an SSH client. This will also set a suitable default for -exec if a value was not specified. Currently-understood values are "ssh", "putty", "sshpass" and "embedded". The "embedded" style connects with an SSH client built into the CLI, keeping brokered private keys and certificates in memory, and ignores -exec.`,
 	})
 
 	f.StringVar(&base.StringVar{
@@ -37,10 +37,25 @@ func sshOptions(c *Command, set *base.FlagSets) {
 		Completion: complete.PredictNothing,
 		Usage:      `Specifies the username to pass through to the client`,
 	})
+
+	f.StringSliceVar(&base.StringSliceVar{
+		Name:       "local-forward",
+		Target:     &c.flagSshLocalForwards,
+		Completion: complete.PredictNothing,
+		Usage:      `Forwards a local port to a host and port reachable from the SSH server, in the form "[bind_address:]port:host:hostport". Can be specified multiple times. Only supported with the "embedded" style.`,
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "tty",
+		Target: &c.flagSshTty,
+		Usage:  `Requests a pseudo-terminal for the remote command even if one is given after "--". Only supported with the "embedded" style, which otherwise requests one only for interactive shells.`,
+	})
 }
 
 type sshFlags struct {
-	flagSshStyle string
+	flagSshStyle         string
+	flagSshLocalForwards []string
+	flagSshTty           bool
 }
 
 func (s *sshFlags) defaultExec() string {
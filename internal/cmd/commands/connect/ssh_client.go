@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/target"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"golang.org/x/term"
+)
+
+// embeddedSshStyle is the -style which connects with the ssh client built into
+// the CLI instead of invoking an external one.
+const embeddedSshStyle = "embedded"
+
+// embedded reports whether the ssh client built into the CLI is used.
+func (s *sshFlags) embedded() bool {
+	return strings.ToLower(s.flagSshStyle) == embeddedSshStyle
+}
+
+// sshLocalForward is a local port forwarded to an address reachable from the
+// ssh server.
+type sshLocalForward struct {
+	listenAddr string
+	remoteAddr string
+}
+
+// parseSshLocalForward parses a local forward in the form
+// "[bind_address:]port:host:hostport".  IPv6 addresses must be enclosed in
+// square brackets.  The bind address defaults to the loopback address.
+func parseSshLocalForward(spec string) (sshLocalForward, error) {
+	var parts []string
+	for rest := spec; rest != ""; {
+		var part string
+		if strings.HasPrefix(rest, "[") {
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return sshLocalForward{}, fmt.Errorf("invalid local forward %q: missing closing bracket", spec)
+			}
+			part, rest = rest[1:end], rest[end+1:]
+			if rest != "" && !strings.HasPrefix(rest, ":") {
+				return sshLocalForward{}, fmt.Errorf("invalid local forward %q", spec)
+			}
+			rest = strings.TrimPrefix(rest, ":")
+		} else {
+			part, rest, _ = strings.Cut(rest, ":")
+		}
+		parts = append(parts, part)
+	}
+	bindAddr := "127.0.0.1"
+	switch len(parts) {
+	case 3:
+	case 4:
+		bindAddr, parts = parts[0], parts[1:]
+		if bindAddr == "" {
+			bindAddr = "127.0.0.1"
+		}
+	default:
+		return sshLocalForward{}, fmt.Errorf(`invalid local forward %q: expected "[bind_address:]port:host:hostport"`, spec)
+	}
+	for _, port := range []string{parts[0], parts[2]} {
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return sshLocalForward{}, fmt.Errorf("invalid local forward %q: invalid port %q", spec, port)
+		}
+	}
+	if parts[1] == "" {
+		return sshLocalForward{}, fmt.Errorf("invalid local forward %q: missing host", spec)
+	}
+	return sshLocalForward{
+		listenAddr: net.JoinHostPort(bindAddr, parts[0]),
+		remoteAddr: net.JoinHostPort(parts[1], parts[2]),
+	}, nil
+}
+
+// sshAuth returns the username and the authentication methods of the
+// embedded ssh client.  For tcp targets the first brokered ssh certificate,
+// ssh private key or username password credential is used, in that order, and
+// marked as consumed.  The private key never leaves memory.  If stdin is a
+// terminal, the user is prompted for a password as a last resort.  Sessions to
+// ssh targets are authenticated by the worker with injected credentials, so no
+// authentication is needed.
+func (s *sshFlags) sshAuth(c *Command, creds credentials) (string, []ssh.AuthMethod, credentials, error) {
+	var username string
+	var methods []ssh.AuthMethod
+	retCreds := creds
+
+	if target.SubtypeFromId(c.sessionAuthzData.GetTargetId()) == "tcp" {
+		switch {
+		case len(retCreds.sshCertificate) > 0:
+			cred := retCreds.sshCertificate[0]
+			signer, err := ssh.ParsePrivateKey([]byte(cred.PrivateKey))
+			if err != nil {
+				return "", nil, credentials{}, fmt.Errorf("Error parsing ssh certificate private key: %w", err)
+			}
+			pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cred.Certificate))
+			if err != nil {
+				return "", nil, credentials{}, fmt.Errorf("Error parsing ssh certificate: %w", err)
+			}
+			cert, ok := pub.(*ssh.Certificate)
+			if !ok {
+				return "", nil, credentials{}, errors.New("Error parsing ssh certificate: not a certificate")
+			}
+			certSigner, err := ssh.NewCertSigner(cert, signer)
+			if err != nil {
+				return "", nil, credentials{}, fmt.Errorf("Error using ssh certificate: %w", err)
+			}
+			methods = append(methods, ssh.PublicKeys(certSigner))
+			username = cred.Username
+			cred.consumed = true
+			retCreds.sshCertificate[0] = cred
+
+		case len(retCreds.sshPrivateKey) > 0:
+			cred := retCreds.sshPrivateKey[0]
+			var signer ssh.Signer
+			var err error
+			if cred.Passphrase != "" {
+				signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(cred.PrivateKey), []byte(cred.Passphrase))
+			} else {
+				signer, err = ssh.ParsePrivateKey([]byte(cred.PrivateKey))
+			}
+			if err != nil {
+				return "", nil, credentials{}, fmt.Errorf("Error parsing ssh private key: %w", err)
+			}
+			methods = append(methods, ssh.PublicKeys(signer))
+			username = cred.Username
+			cred.consumed = true
+			retCreds.sshPrivateKey[0] = cred
+
+		case len(retCreds.usernamePassword) > 0:
+			cred := retCreds.usernamePassword[0]
+			password := cred.Password
+			methods = append(methods,
+				ssh.Password(password),
+				ssh.KeyboardInteractive(func(_, _ string, questions []string, _ []bool) ([]string, error) {
+					answers := make([]string, len(questions))
+					for i := range answers {
+						answers[i] = password
+					}
+					return answers, nil
+				}),
+			)
+			username = cred.Username
+			cred.consumed = true
+			retCreds.usernamePassword[0] = cred
+		}
+	}
+
+	switch {
+	case username != "":
+	case c.flagUsername != "":
+		username = c.flagUsername
+	default:
+		u, err := user.Current()
+		if err != nil {
+			return "", nil, credentials{}, fmt.Errorf("Error looking up the current user; specify -username instead: %w", err)
+		}
+		username = u.Username
+	}
+
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		methods = append(methods, ssh.PasswordCallback(func() (string, error) {
+			fmt.Fprintf(os.Stderr, "%s's password: ", username)
+			password, err := term.ReadPassword(int(os.Stdin.Fd()))
+			fmt.Fprintln(os.Stderr)
+			return string(password), err
+		}))
+	}
+	return username, methods, retCreds, nil
+}
+
+// sshHostKeyCallback returns how the embedded ssh client verifies the host key
+// of the server.  Workers present the session's key as the host key of ssh
+// targets, so it is pinned to the session certificate's public key.  The host
+// keys of tcp targets are verified against the user's known hosts under the
+// host ID, or the target ID if the target has no host, like the HostKeyAlias
+// the "ssh" style passes.
+func (s *sshFlags) sshHostKeyCallback(c *Command) (ssh.HostKeyCallback, error) {
+	switch c.sessionAuthzData.GetType() {
+	case "ssh":
+		cert, err := x509.ParseCertificate(c.sessionAuthzData.GetCertificate())
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing session certificate: %w", err)
+		}
+		key, err := ssh.NewPublicKey(cert.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading session certificate public key: %w", err)
+		}
+		return ssh.FixedHostKey(key), nil
+	default:
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("Error looking up home directory for known hosts: %w", err)
+		}
+		alias := c.sessionAuthzData.GetHostId()
+		if alias == "" {
+			alias = c.sessionAuthzData.GetTargetId()
+		}
+		return knownHostsCallback(filepath.Join(home, ".ssh", "known_hosts"), alias, os.Stderr)
+	}
+}
+
+// knownHostsCallback returns a host key callback verifying the host key of
+// alias against the known hosts file at path.  The key of a host which isn't
+// known yet is added to the file, and a notice written to w.  A key which
+// doesn't match the known one is rejected.
+func knownHostsCallback(path, alias string, w io.Writer) (ssh.HostKeyCallback, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("Error creating known hosts directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening known hosts file: %w", err)
+	}
+	f.Close()
+	check, err := knownhosts.New(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading known hosts file: %w", err)
+	}
+	// Known hosts are looked up with a port, which is omitted for the default
+	// one when they are written, like ssh does with HostKeyAlias.
+	hostname := net.JoinHostPort(alias, "22")
+	return func(_ string, remote net.Addr, key ssh.PublicKey) error {
+		err := check(hostname, remote, key)
+		var keyErr *knownhosts.KeyError
+		switch {
+		case err == nil:
+			return nil
+		case errors.As(err, &keyErr) && len(keyErr.Want) == 0:
+			f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+			if err != nil {
+				return fmt.Errorf("Error opening known hosts file: %w", err)
+			}
+			defer f.Close()
+			if _, err := fmt.Fprintln(f, knownhosts.Line([]string{hostname}, key)); err != nil {
+				return fmt.Errorf("Error adding host key to known hosts file: %w", err)
+			}
+			fmt.Fprintf(w, "Permanently added %q (%s) to the list of known hosts.\n", alias, key.Type())
+			return nil
+		case errors.As(err, &keyErr):
+			return fmt.Errorf("Host key for %q has changed and does not match %s; remove the old key if the change is expected", alias, path)
+		default:
+			return err
+		}
+	}, nil
+}
+
+// runEmbeddedSsh connects to the session's local listener with the ssh client
+// built into the CLI, forwards the requested local ports and runs the command
+// passed through after "--", or an interactive shell if there is none.  The
+// exit status of the remote command is returned by the connect command.
+func (c *Command) runEmbeddedSsh(passthroughArgs []string, addr string, creds credentials) {
+	username, auth, creds, err := c.sshFlags.sshAuth(c, creds)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Failed to collect ssh credentials: %w", err))
+		c.execCmdReturnValue.Store(int32(2))
+		return
+	}
+	if err := c.printCredentials(creds.unconsumedSessionCredentials()); err != nil {
+		c.PrintCliError(fmt.Errorf("Failed to print credentials: %w", err))
+		c.execCmdReturnValue.Store(int32(2))
+		return
+	}
+	hostKeyCallback, err := c.sshFlags.sshHostKeyCallback(c)
+	if err != nil {
+		c.PrintCliError(err)
+		c.execCmdReturnValue.Store(int32(2))
+		return
+	}
+	var forwards []sshLocalForward
+	for _, spec := range c.flagSshLocalForwards {
+		fwd, err := parseSshLocalForward(spec)
+		if err != nil {
+			c.PrintCliError(err)
+			c.execCmdReturnValue.Store(int32(2))
+			return
+		}
+		forwards = append(forwards, fwd)
+	}
+
+	client, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	})
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Failed to connect with ssh: %w", err))
+		c.execCmdReturnValue.Store(int32(255))
+		return
+	}
+	defer client.Close()
+
+	for _, fwd := range forwards {
+		l, err := net.Listen("tcp", fwd.listenAddr)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Failed to listen for local forward: %w", err))
+			c.execCmdReturnValue.Store(int32(2))
+			return
+		}
+		defer l.Close()
+		go forwardSshLocal(client, l, fwd.remoteAddr, c.PrintCliError)
+	}
+
+	code, err := runSshSession(client, strings.Join(passthroughArgs, " "), c.flagSshTty)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Failed to run ssh session: %w", err))
+	}
+	c.execCmdReturnValue.Store(int32(code))
+}
+
+// forwardSshLocal accepts connections on l until it is closed and forwards
+// each to remoteAddr through the ssh client.
+func forwardSshLocal(client *ssh.Client, l net.Listener, remoteAddr string, printErr func(error)) {
+	for {
+		local, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer local.Close()
+			remote, err := client.Dial("tcp", remoteAddr)
+			if err != nil {
+				printErr(fmt.Errorf("Failed to forward connection to %s: %w", remoteAddr, err))
+				return
+			}
+			defer remote.Close()
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				_, _ = io.Copy(remote, local)
+				closeWrite(remote)
+			}()
+			go func() {
+				defer wg.Done()
+				_, _ = io.Copy(local, remote)
+				closeWrite(local)
+			}()
+			wg.Wait()
+		}()
+	}
+}
+
+// closeWrite half-closes conn if it supports it, so the other end of a
+// forwarded connection sees EOF while the response is still read.
+func closeWrite(conn net.Conn) {
+	if cw, ok := conn.(interface{ CloseWrite() error }); ok {
+		_ = cw.CloseWrite()
+	}
+}
+
+// runSshSession runs command, or a shell if it is empty, in a new session of
+// the client attached to the standard streams and returns its exit status.  A
+// pseudo-terminal is requested for shells, or if tty is set, when stdin is a
+// terminal, which is put in raw mode for the duration of the session.
+func runSshSession(client *ssh.Client, command string, tty bool) (int, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return 255, err
+	}
+	defer session.Close()
+	session.Stdin = os.Stdin
+	session.Stdout = os.Stdout
+	session.Stderr = os.Stderr
+
+	fd := int(os.Stdin.Fd())
+	if (command == "" || tty) && term.IsTerminal(fd) {
+		width, height, err := term.GetSize(fd)
+		if err != nil {
+			width, height = 80, 24
+		}
+		termType := os.Getenv("TERM")
+		if termType == "" {
+			termType = "xterm-256color"
+		}
+		if err := session.RequestPty(termType, height, width, ssh.TerminalModes{}); err != nil {
+			return 255, fmt.Errorf("error requesting pseudo-terminal: %w", err)
+		}
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return 255, fmt.Errorf("error putting terminal in raw mode: %w", err)
+		}
+		defer term.Restore(fd, state)
+		stop := watchWindowSize(fd, session)
+		defer stop()
+	}
+
+	if command == "" {
+		err = session.Shell()
+	} else {
+		err = session.Start(command)
+	}
+	if err != nil {
+		return 255, err
+	}
+	if err := session.Wait(); err != nil {
+		var exitErr *ssh.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitStatus(), nil
+		}
+		return 255, err
+	}
+	return 0, nil
+}
@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	targetspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestParseSshLocalForward(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    sshLocalForward
+		wantErr bool
+	}{
+		{spec: "8080:db:5432", want: sshLocalForward{listenAddr: "127.0.0.1:8080", remoteAddr: "db:5432"}},
+		{spec: "0.0.0.0:8080:db:5432", want: sshLocalForward{listenAddr: "0.0.0.0:8080", remoteAddr: "db:5432"}},
+		{spec: ":8080:db:5432", want: sshLocalForward{listenAddr: "127.0.0.1:8080", remoteAddr: "db:5432"}},
+		{spec: "[::1]:8080:[fe80::1]:5432", want: sshLocalForward{listenAddr: "[::1]:8080", remoteAddr: "[fe80::1]:5432"}},
+		{spec: "8080:db", wantErr: true},
+		{spec: "8080::5432", wantErr: true},
+		{spec: "http:db:5432", wantErr: true},
+		{spec: "8080:db:70000", wantErr: true},
+		{spec: "[::1:8080:db:5432", wantErr: true},
+		{spec: "a:b:8080:db:5432", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := parseSshLocalForward(tt.spec)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKnownHostsCallback(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".ssh", "known_hosts")
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 43210}
+	key := testSshSigner(t).PublicKey()
+
+	var out bytes.Buffer
+	cb, err := knownHostsCallback(path, "hst_1234567890", &out)
+	require.NoError(t, err)
+	require.NoError(t, cb("127.0.0.1:43210", addr, key))
+	assert.Contains(t, out.String(), `Permanently added "hst_1234567890"`)
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("hst_1234567890 %s", ssh.MarshalAuthorizedKey(key)), string(contents))
+
+	// The known key is accepted from any local port.
+	out.Reset()
+	cb, err = knownHostsCallback(path, "hst_1234567890", &out)
+	require.NoError(t, err)
+	require.NoError(t, cb("127.0.0.1:50000", &net.TCPAddr{IP: addr.IP, Port: 50000}, key))
+	assert.Empty(t, out.String())
+
+	// A different key for the same host is rejected.
+	err = cb("127.0.0.1:43210", addr, testSshSigner(t).PublicKey())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has changed")
+
+	// Other hosts are added alongside.
+	cb, err = knownHostsCallback(path, "ttcp_1234567890", &out)
+	require.NoError(t, err)
+	require.NoError(t, cb("127.0.0.1:43210", addr, testSshSigner(t).PublicKey()))
+	assert.Contains(t, out.String(), `Permanently added "ttcp_1234567890"`)
+}
+
+func TestSshAuth(t *testing.T) {
+	ca := testSshSigner(t)
+	userKey, userKeyPem := testSshPrivateKey(t)
+	cert := &ssh.Certificate{
+		Key:             userKey.PublicKey(),
+		CertType:        ssh.UserCert,
+		KeyId:           "alice",
+		ValidPrincipals: []string{"alice"},
+		ValidBefore:     ssh.CertTimeInfinity,
+	}
+	require.NoError(t, cert.SignCert(rand.Reader, ca))
+
+	addr, echoAddr := testSshServer(t, ca.PublicKey(), userKey.PublicKey(), "bob", "secret")
+	c := &Command{
+		sshFlags:         sshFlags{flagSshStyle: embeddedSshStyle},
+		sessionAuthzData: &targetspb.SessionAuthorizationData{TargetId: "ttcp_1234567890", Type: "tcp"},
+	}
+
+	tests := []struct {
+		name         string
+		creds        credentials
+		flagUsername string
+		wantUsername string
+		wantConsumed func(credentials) bool
+		wantAuthErr  bool
+	}{
+		{
+			name: "ssh-certificate",
+			creds: credentials{sshCertificate: []sshCertificate{{
+				Username:    "alice",
+				PrivateKey:  userKeyPem,
+				Certificate: string(ssh.MarshalAuthorizedKey(cert)),
+			}}},
+			wantUsername: "alice",
+			wantConsumed: func(c credentials) bool { return c.sshCertificate[0].consumed },
+		},
+		{
+			name:         "ssh-private-key",
+			creds:        credentials{sshPrivateKey: []sshPrivateKey{{Username: "carol", PrivateKey: userKeyPem}}},
+			wantUsername: "carol",
+			wantConsumed: func(c credentials) bool { return c.sshPrivateKey[0].consumed },
+		},
+		{
+			name:         "username-password",
+			creds:        credentials{usernamePassword: []usernamePassword{{Username: "bob", Password: "secret"}}},
+			flagUsername: "ignored",
+			wantUsername: "bob",
+			wantConsumed: func(c credentials) bool { return c.usernamePassword[0].consumed },
+		},
+		{
+			name:         "wrong-password",
+			creds:        credentials{usernamePassword: []usernamePassword{{Username: "bob", Password: "wrong"}}},
+			wantUsername: "bob",
+			wantConsumed: func(c credentials) bool { return c.usernamePassword[0].consumed },
+			wantAuthErr:  true,
+		},
+		{
+			name:         "no-credentials",
+			flagUsername: "dave",
+			wantUsername: "dave",
+			wantConsumed: func(credentials) bool { return true },
+			wantAuthErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c.flagUsername = tt.flagUsername
+			username, auth, creds, err := c.sshFlags.sshAuth(c, tt.creds)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUsername, username)
+			assert.True(t, tt.wantConsumed(creds))
+			assert.Empty(t, creds.unconsumedSessionCredentials())
+
+			client, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+				User:            username,
+				Auth:            auth,
+				HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+			})
+			if tt.wantAuthErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer client.Close()
+
+			session, err := client.NewSession()
+			require.NoError(t, err)
+			out, err := session.CombinedOutput("whoami")
+			assert.Equal(t, username+": whoami\n", string(out))
+			var exitErr *ssh.ExitError
+			require.ErrorAs(t, err, &exitErr)
+			assert.Equal(t, 3, exitErr.ExitStatus())
+
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer l.Close()
+			go forwardSshLocal(client, l, echoAddr, func(err error) { t.Error(err) })
+			conn, err := net.Dial("tcp", l.Addr().String())
+			require.NoError(t, err)
+			defer conn.Close()
+			_, err = conn.Write([]byte("ping"))
+			require.NoError(t, err)
+			require.NoError(t, conn.(*net.TCPConn).CloseWrite())
+			got, err := io.ReadAll(conn)
+			require.NoError(t, err)
+			assert.Equal(t, "ping", string(got))
+		})
+	}
+
+	t.Run("ssh-target", func(t *testing.T) {
+		c := &Command{
+			sessionAuthzData: &targetspb.SessionAuthorizationData{TargetId: "tssh_1234567890", Type: "ssh"},
+			flagUsername:     "erin",
+		}
+		creds := credentials{sshPrivateKey: []sshPrivateKey{{Username: "carol", PrivateKey: userKeyPem}}}
+		username, auth, creds, err := c.sshFlags.sshAuth(c, creds)
+		require.NoError(t, err)
+		assert.Equal(t, "erin", username)
+		assert.Empty(t, auth)
+		assert.Len(t, creds.unconsumedSessionCredentials(), 1)
+	})
+
+	t.Run("invalid-certificate", func(t *testing.T) {
+		creds := credentials{sshCertificate: []sshCertificate{{
+			Username:    "alice",
+			PrivateKey:  userKeyPem,
+			Certificate: string(ssh.MarshalAuthorizedKey(userKey.PublicKey())),
+		}}}
+		_, _, _, err := c.sshFlags.sshAuth(c, creds)
+		assert.ErrorContains(t, err, "not a certificate")
+	})
+}
+
+func TestSshHostKeyCallback_SshTarget(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{SerialNumber: big.NewInt(1)}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	require.NoError(t, err)
+
+	c := &Command{sessionAuthzData: &targetspb.SessionAuthorizationData{Type: "ssh", Certificate: der}}
+	cb, err := c.sshFlags.sshHostKeyCallback(c)
+	require.NoError(t, err)
+
+	hostKey, err := ssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 43210}
+	assert.NoError(t, cb("127.0.0.1:43210", addr, hostKey.PublicKey()))
+	assert.Error(t, cb("127.0.0.1:43210", addr, testSshSigner(t).PublicKey()))
+}
+
+// testSshServer starts an ssh server accepting user certificates signed by
+// ca, the public key userKey and the password of username.  Every exec request
+// writes the username and the command and exits with status 3, and direct
+// tcpip channels are connected to the returned echo server's address.
+func testSshServer(t *testing.T, ca, userKey ssh.PublicKey, username, password string) (string, string) {
+	t.Helper()
+	checker := &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return bytes.Equal(auth.Marshal(), ca.Marshal())
+		},
+		UserKeyFallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if bytes.Equal(key.Marshal(), userKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unknown public key")
+		},
+	}
+	cfg := &ssh.ServerConfig{
+		PublicKeyCallback: checker.Authenticate,
+		PasswordCallback: func(conn ssh.ConnMetadata, p []byte) (*ssh.Permissions, error) {
+			if conn.User() == username && string(p) == password {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("invalid password")
+		},
+	}
+	cfg.AddHostKey(testSshSigner(t))
+
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { echo.Close() })
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go testServeSsh(conn, cfg)
+		}
+	}()
+	return l.Addr().String(), echo.Addr().String()
+}
+
+func testServeSsh(conn net.Conn, cfg *ssh.ServerConfig) {
+	sconn, chans, reqs, err := ssh.NewServerConn(conn, cfg)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	defer sconn.Close()
+	go ssh.DiscardRequests(reqs)
+	for newCh := range chans {
+		switch newCh.ChannelType() {
+		case "session":
+			ch, reqs, err := newCh.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer ch.Close()
+				for req := range reqs {
+					if req.Type != "exec" {
+						_ = req.Reply(false, nil)
+						continue
+					}
+					_ = req.Reply(true, nil)
+					var payload struct{ Command string }
+					_ = ssh.Unmarshal(req.Payload, &payload)
+					fmt.Fprintf(ch, "%s: %s\n", sconn.User(), payload.Command)
+					status := make([]byte, 4)
+					binary.BigEndian.PutUint32(status, 3)
+					_, _ = ch.SendRequest("exit-status", false, status)
+					return
+				}
+			}()
+		case "direct-tcpip":
+			var payload struct {
+				Host       string
+				Port       uint32
+				OriginHost string
+				OriginPort uint32
+			}
+			if err := ssh.Unmarshal(newCh.ExtraData(), &payload); err != nil {
+				_ = newCh.Reject(ssh.ConnectionFailed, err.Error())
+				continue
+			}
+			remote, err := net.Dial("tcp", net.JoinHostPort(payload.Host, fmt.Sprint(payload.Port)))
+			if err != nil {
+				_ = newCh.Reject(ssh.ConnectionFailed, err.Error())
+				continue
+			}
+			ch, reqs, err := newCh.Accept()
+			if err != nil {
+				remote.Close()
+				return
+			}
+			done := make(chan struct{})
+			go ssh.DiscardRequests(reqs)
+			go func() {
+				defer remote.Close()
+				_, _ = io.Copy(remote, ch)
+				_ = remote.(*net.TCPConn).CloseWrite()
+				<-done
+			}()
+			go func() {
+				defer ch.Close()
+				defer close(done)
+				_, _ = io.Copy(ch, remote)
+				_ = ch.CloseWrite()
+			}()
+		default:
+			_ = newCh.Reject(ssh.UnknownChannelType, "unsupported")
+		}
+	}
+}
+
+func testSshSigner(t *testing.T) ssh.Signer {
+	t.Helper()
+	signer, _ := testSshPrivateKey(t)
+	return signer
+}
+
+func testSshPrivateKey(t *testing.T) (ssh.Signer, string) {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+	return signer, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build windows
+// +build windows
+
+package connect
+
+import "golang.org/x/crypto/ssh"
+
+// watchWindowSize is a no-op on Windows, which has no signal for terminal
+// size changes.
+func watchWindowSize(int, *ssh.Session) func() {
+	return func() {}
+}
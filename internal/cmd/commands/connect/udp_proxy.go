@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/proxy"
+)
+
+// udpFlowQueueSize is the number of datagrams queued for a flow while its
+// connection to the worker is established or busy.  Datagrams received while
+// the queue is full are dropped.
+const udpFlowQueueSize = 64
+
+// udpFlow is the connection to the worker carrying the datagrams of a single
+// source address on the local udp listener.
+type udpFlow struct {
+	datagrams chan []byte
+}
+
+// runUdpListener reads datagrams from the local udp listener until it is
+// closed.  Every source address gets its own connection to the worker, which
+// counts against the session's connection limit, over which its datagrams are
+// framed with proxy.WriteDatagram.  Datagrams framed by the worker are sent
+// back to the source address.  A flow is removed when its connection closes,
+// for example when the worker closes it after it has been idle, and a later
+// datagram from the same address starts a new one.
+func (c *Command) runUdpListener(
+	workerAddr string,
+	transport *http.Transport,
+	tofuToken string,
+) {
+	var flowsMu sync.Mutex
+	flows := make(map[string]*udpFlow)
+
+	buf := make([]byte, proxy.MaxDatagramSize)
+	for {
+		n, addr, err := c.udpListener.ReadFromUDP(buf)
+		if err != nil {
+			select {
+			case <-c.proxyCtx.Done():
+				return
+			case <-c.Context.Done():
+				return
+			default:
+				// When this hits zero we trigger listener close so this
+				// isn't actually an error condition
+				if c.connectionsLeft.Load() == 0 {
+					return
+				}
+				c.PrintCliError(fmt.Errorf("Error reading datagram: %w", err))
+				continue
+			}
+		}
+		datagram := make([]byte, n)
+		copy(datagram, buf[:n])
+
+		key := addr.String()
+		flowsMu.Lock()
+		flow, ok := flows[key]
+		if !ok {
+			flow = &udpFlow{datagrams: make(chan []byte, udpFlowQueueSize)}
+			flows[key] = flow
+			c.connWg.Add(1)
+			go func() {
+				defer c.connWg.Done()
+				defer func() {
+					flowsMu.Lock()
+					delete(flows, key)
+					flowsMu.Unlock()
+				}()
+				netConn, err := c.proxyConn(workerAddr, transport, tofuToken)
+				if err != nil {
+					c.PrintCliError(err)
+					return
+				}
+				c.runUdpFlow(netConn, flow, addr)
+			}()
+		}
+		select {
+		case flow.datagrams <- datagram:
+		default:
+		}
+		flowsMu.Unlock()
+	}
+}
+
+// runUdpFlow relays the datagrams queued for the flow over netConn and the
+// datagrams framed by the worker back to addr until the connection closes.
+func (c *Command) runUdpFlow(
+	netConn net.Conn,
+	flow *udpFlow,
+	addr *net.UDPAddr,
+) {
+	done := make(chan struct{})
+
+	localWg := new(sync.WaitGroup)
+	localWg.Add(2)
+
+	go func() {
+		defer localWg.Done()
+		defer netConn.Close()
+		for {
+			select {
+			case <-done:
+				return
+			case d := <-flow.datagrams:
+				if err := proxy.WriteDatagram(netConn, d); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	go func() {
+		defer localWg.Done()
+		defer close(done)
+		buf := make([]byte, proxy.MaxDatagramSize)
+		for {
+			d, err := proxy.ReadDatagram(netConn, buf)
+			if err != nil {
+				return
+			}
+			if _, err := c.udpListener.WriteToUDP(d, addr); err != nil {
+				return
+			}
+		}
+	}()
+	localWg.Wait()
+}
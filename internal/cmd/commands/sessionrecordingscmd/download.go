@@ -9,9 +9,11 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/mitchellh/cli"
 	"github.com/mitchellh/go-wordwrap"
@@ -23,12 +25,18 @@ var (
 	_ cli.CommandAutocomplete = (*DownloadCommand)(nil)
 )
 
-const (
-	castExt = ".cast" // default download file extension (is overridden when an output file is specified)
-)
+// downloadExts are the default download file extensions for each mime type
+// (they are overridden when an output file is specified)
+var downloadExts = map[string]string{
+	api.AsciiCastMimeType: ".cast",
+	api.PcapngMimeType:    ".pcapng",
+	api.TextMimeType:      ".txt",
+}
 
 type DownloadCommand struct {
 	*base.Command
+
+	flagMimeType string
 }
 
 func (c *DownloadCommand) Synopsis() string {
@@ -43,6 +51,14 @@ func (c *DownloadCommand) Help() string {
 		"",
 		`    $ boundary session-recordings download -id chr_u6e9wJ8B8H`,
 		"",
+		"  Connection recordings of TCP sessions are downloaded as packet captures which can be opened with tools such as Wireshark. Example:",
+		"",
+		`    $ boundary session-recordings download -id cr_u6e9wJ8B8H`,
+		"",
+		"  A channel recording can also be downloaded as a plain text transcript. Example:",
+		"",
+		`    $ boundary session-recordings download -id chr_u6e9wJ8B8H -mime-type text/plain`,
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -59,9 +75,15 @@ func (c *DownloadCommand) Flags() *base.FlagSets {
 	f.StringVar(&base.StringVar{
 		Name:    "output",
 		Target:  &c.FlagOutputFile,
-		Usage:   "An optional output file for the download. If not provided the recording id will be used with a \".cast\" extension. Use \"-\" for stdout.",
+		Usage:   "An optional output file for the download. If not provided the recording id will be used with an extension for the mime type, such as \".cast\". Use \"-\" for stdout.",
 		Aliases: []string{"o"},
 	})
+	f.StringVar(&base.StringVar{
+		Name:       "mime-type",
+		Target:     &c.flagMimeType,
+		Usage:      `The mime type to download the recording as. Channel recordings can be downloaded as "application/x-asciicast" (the default) or "text/plain", and connection recordings as "application/x-pcapng" (the default).`,
+		Completion: complete.PredictSet(api.AsciiCastMimeType, api.PcapngMimeType, api.TextMimeType),
+	})
 	f.BoolVar(&base.BoolVar{
 		Name:    "no-clobber",
 		Target:  &c.FlagNoClobber,
@@ -92,6 +114,18 @@ func (c *DownloadCommand) Run(args []string) int {
 		c.PrintCliError(errors.New("ID must be provided via -id"))
 		return base.CommandUserError
 	}
+	// Connection recordings, which can also be looked up by the id of the
+	// session connection, are only available as packet captures.
+	mimeType := c.flagMimeType
+	switch {
+	case mimeType == "" && (strings.HasPrefix(c.FlagId, globals.ConnectionRecordingPrefix+"_") || strings.HasPrefix(c.FlagId, "sc_")):
+		mimeType = api.PcapngMimeType
+	case mimeType == "":
+		mimeType = api.AsciiCastMimeType
+	case downloadExts[mimeType] == "":
+		c.PrintCliError(fmt.Errorf("Unsupported mime type %q", mimeType))
+		return base.CommandUserError
+	}
 
 	client, err := c.Client()
 	if c.WrapperCleanupFunc != nil {
@@ -107,7 +141,7 @@ func (c *DownloadCommand) Run(args []string) int {
 	}
 
 	sClient := sessionrecordings.NewClient(client)
-	result, err := sClient.Download(c.Context, c.FlagId)
+	result, err := sClient.DownloadAs(c.Context, c.FlagId, mimeType)
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.PrintApiError(apiErr, "Error from controller when downloading session recording")
@@ -146,7 +180,7 @@ func (c *DownloadCommand) Run(args []string) int {
 		}
 		defer outFile.Close()
 	default:
-		fileName := getNextFileName(c.FlagId)
+		fileName := getNextFileName(c.FlagId, downloadExts[mimeType])
 		outFile, err = os.Create(fileName)
 		if err != nil {
 			c.PrintCliError(fmt.Errorf("Unable to create download file %q: %w", fileName, err))
@@ -162,13 +196,13 @@ func (c *DownloadCommand) Run(args []string) int {
 	return base.CommandSuccess
 }
 
-func getNextFileName(baseName string) string {
-	if _, err := os.Stat(baseName + castExt); os.IsNotExist(err) {
-		return baseName + castExt
+func getNextFileName(baseName, ext string) string {
+	if _, err := os.Stat(baseName + ext); os.IsNotExist(err) {
+		return baseName + ext
 	}
 	startIndex := 1
 	for {
-		fileName := baseName + castExt + "." + strconv.Itoa(startIndex)
+		fileName := baseName + ext + "." + strconv.Itoa(startIndex)
 		if _, err := os.Stat(fileName); os.IsNotExist(err) {
 			return fileName
 		}
@@ -37,6 +37,14 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary session-recordings download -id chr_1234567890`,
 			"",
+			"    Verify a downloaded session recording:",
+			"",
+			`      $ boundary session-recordings verify -path sr_1234567890.bsr`,
+			"",
+			"    Inspect a downloaded session recording:",
+			"",
+			`      $ boundary session-recordings inspect -path sr_1234567890.bsr`,
+			"",
 
 			"  Please see the sessions subcommand help for detailed usage information.",
 		})
@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sessionrecordingscmd
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	_ "github.com/hashicorp/boundary/internal/bsr/ssh"
+	_ "github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/storage/local"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	_ cli.Command             = (*VerifyCommand)(nil)
+	_ cli.CommandAutocomplete = (*VerifyCommand)(nil)
+	_ cli.Command             = (*InspectCommand)(nil)
+	_ cli.CommandAutocomplete = (*InspectCommand)(nil)
+)
+
+// bsrExt is the extension of the directory of a session recording.
+const bsrExt = ".bsr"
+
+// recordingFlags are the flags shared by the commands that work on a
+// session recording on the local filesystem.
+type recordingFlags struct {
+	flagPath          string
+	flagPublicKeyFile string
+}
+
+func (r *recordingFlags) addFlags(f *base.FlagSet) {
+	f.StringVar(&base.StringVar{
+		Name:       "path",
+		Target:     &r.flagPath,
+		Usage:      `The path to the directory of a session recording, such as "sr_1234567890.bsr".`,
+		Completion: complete.PredictDirs("*" + bsrExt),
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "public-key-file",
+		Target:     &r.flagPublicKeyFile,
+		Usage:      `An optional file containing the public key the session recording must be signed with. This can be a PEM encoded ed25519 public key, as printed by "boundary session-recordings inspect", or the "bsrKey.pub" file of a trusted copy of the recording. If not provided, the public key in the session recording is used, which only proves that the recording was not modified after it was signed.`,
+		Completion: complete.PredictFiles("*"),
+	})
+}
+
+// verify verifies the session recording at the path with the public key in
+// the public key file, if one was provided.
+func (r *recordingFlags) verify(c *base.Command) (*bsr.Verification, int) {
+	switch {
+	case r.flagPath == "":
+		c.PrintCliError(errors.New("Path must be provided via -path"))
+		return nil, base.CommandUserError
+	case !strings.HasSuffix(filepath.Clean(r.flagPath), bsrExt):
+		c.PrintCliError(fmt.Errorf("Path %q is not a session recording directory; expected a %q extension", r.flagPath, bsrExt))
+		return nil, base.CommandUserError
+	}
+
+	var pubKey ed25519.PublicKey
+	if r.flagPublicKeyFile != "" {
+		var err error
+		pubKey, err = readPublicKey(r.flagPublicKeyFile)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error reading public key file %q: %w", r.flagPublicKeyFile, err))
+			return nil, base.CommandUserError
+		}
+	}
+
+	dir, name := filepath.Split(filepath.Clean(r.flagPath))
+	if dir == "" {
+		dir = "."
+	}
+	fs, err := local.NewFS(c.Context, dir, local.WithReadOnly(true))
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error opening %q: %w", dir, err))
+		return nil, base.CommandCliError
+	}
+	v, err := bsr.VerifySession(c.Context, strings.TrimSuffix(name, bsrExt), fs, pubKey)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error opening session recording: %w", err))
+		return nil, base.CommandCliError
+	}
+	return v, base.CommandSuccess
+}
+
+// readPublicKey reads an ed25519 public key from a PEM encoded PKIX public key
+// or the bsrKey.pub file of a session recording.
+func readPublicKey(name string) (ed25519.PublicKey, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	if block, _ := pem.Decode(b); block != nil {
+		k, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, ok := k.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("expected an ed25519 public key, got %T", k)
+		}
+		return pubKey, nil
+	}
+	k := new(wrapping.KeyInfo)
+	if err := proto.Unmarshal(b, k); err != nil || k.GetKeyType() != wrapping.KeyType_Ed25519 {
+		return nil, errors.New("expected a PEM encoded ed25519 public key or a session recording bsrKey.pub file")
+	}
+	return ed25519.PublicKey(k.GetKey()), nil
+}
+
+// verificationJson is the json output of the verify and inspect commands.
+type verificationJson struct {
+	Verified bool `json:"verified"`
+	*bsr.Verification
+}
+
+func printVerificationJson(c *base.Command, v *bsr.Verification) bool {
+	b, err := json.Marshal(verificationJson{Verified: v.Verified(), Verification: v})
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error formatting as JSON: %w", err))
+		return false
+	}
+	c.UI.Output(string(b))
+	return true
+}
+
+// verificationProblems returns the problems found in the container and the
+// containers it contains, prefixed with the path of the container or file the
+// problem was found in.
+func verificationProblems(parent string, v *bsr.Verification) []string {
+	name := path.Join(parent, fmt.Sprintf("%s.%s", v.Id, v.Type))
+	if v.Type == bsr.SessionContainer {
+		name = path.Join(parent, v.Id+bsrExt)
+	}
+	var problems []string
+	for _, p := range v.Problems {
+		problems = append(problems, fmt.Sprintf("%s: %s", name, p))
+	}
+	for _, f := range v.Files {
+		for _, p := range f.Problems {
+			problems = append(problems, fmt.Sprintf("%s: %s", path.Join(name, f.Name), p))
+		}
+	}
+	for _, cv := range v.Connections {
+		problems = append(problems, verificationProblems(name, cv)...)
+	}
+	for _, cv := range v.Channels {
+		problems = append(problems, verificationProblems(name, cv)...)
+	}
+	return problems
+}
+
+type VerifyCommand struct {
+	*base.Command
+	recordingFlags
+}
+
+func (c *VerifyCommand) Synopsis() string {
+	return wordwrap.WrapString("Verify a downloaded session recording", base.TermWidth)
+}
+
+func (c *VerifyCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary session-recordings verify [args]",
+		"",
+		"  Verify the signatures and checksums of a session recording on the local filesystem, without contacting a controller. Truncated, corrupt or out of order recordings are also reported. Example:",
+		"",
+		`    $ boundary session-recordings verify -path sr_1234567890.bsr -public-key-file sr_1234567890.pem`,
+		"",
+		"  The command exits with a non-zero status if the session recording could not be verified.",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *VerifyCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetOutputFormat)
+	c.addFlags(set.NewFlagSet("Command Options"))
+	return set
+}
+
+func (c *VerifyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *VerifyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *VerifyCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	v, ret := c.verify(c.Command)
+	if v == nil {
+		return ret
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if !printVerificationJson(c.Command, v) {
+			return base.CommandCliError
+		}
+	default:
+		problems := verificationProblems("", v)
+		if len(problems) == 0 {
+			c.UI.Output(fmt.Sprintf("Session recording %s verified.", v.Id))
+			break
+		}
+		c.UI.Error(fmt.Sprintf("Session recording %s failed verification:", v.Id))
+		c.UI.Error(base.WrapSlice(2, problems))
+	}
+	if !v.Verified() {
+		return base.CommandCliError
+	}
+	return base.CommandSuccess
+}
+
+type InspectCommand struct {
+	*base.Command
+	recordingFlags
+}
+
+func (c *InspectCommand) Synopsis() string {
+	return wordwrap.WrapString("Inspect a downloaded session recording", base.TermWidth)
+}
+
+func (c *InspectCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary session-recordings inspect [args]",
+		"",
+		"  Print the session, connections and channels of a session recording on the local filesystem, with their summaries and the results of verifying them. Example:",
+		"",
+		`    $ boundary session-recordings inspect -path sr_1234567890.bsr`,
+		"",
+		"  The public key of the session recording is printed in PEM format, which can be recorded and later provided to \"boundary session-recordings verify\" with -public-key-file.",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *InspectCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetOutputFormat)
+	c.addFlags(set.NewFlagSet("Command Options"))
+	return set
+}
+
+func (c *InspectCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *InspectCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *InspectCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	v, ret := c.verify(c.Command)
+	if v == nil {
+		return ret
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if !printVerificationJson(c.Command, v) {
+			return base.CommandCliError
+		}
+	default:
+		c.UI.Output(printInspectTable(v))
+	}
+	return base.CommandSuccess
+}
+
+func printInspectTable(v *bsr.Verification) string {
+	output := []string{
+		"",
+		"Session Recording information:",
+		inspectField(2, "ID", v.Id),
+		inspectField(2, "Protocol", v.Protocol),
+		inspectField(2, "Verified", v.Verified()),
+	}
+	if sm := v.SessionMeta; sm != nil {
+		if sm.PublicId != "" {
+			output = append(output, inspectField(2, "Session ID", sm.PublicId))
+		}
+		if sm.User != nil {
+			output = append(output, inspectField(2, "User ID", sm.User.PublicId))
+		}
+		if sm.Target != nil {
+			output = append(output, inspectField(2, "Target ID", sm.Target.PublicId))
+		}
+		if sm.Worker != nil {
+			output = append(output, inspectField(2, "Worker ID", sm.Worker.PublicId))
+		}
+		if sm.Endpoint != "" {
+			output = append(output, inspectField(2, "Endpoint", sm.Endpoint))
+		}
+	}
+	output = append(output, inspectSummaryLines(2, v.Summary)...)
+	if len(v.PublicKey) > 0 {
+		if der, err := x509.MarshalPKIXPublicKey(v.PublicKey); err == nil {
+			p := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+			output = append(output,
+				"  Public Key:",
+				base.WrapSlice(4, strings.Split(strings.TrimSpace(string(p)), "\n")),
+			)
+		}
+	}
+	output = append(output, inspectContainerLines(2, v)...)
+	return base.WrapForHelpText(output)
+}
+
+// inspectField formats a field so that the values of fields at every
+// indentation line up.
+func inspectField(indent int, name string, value any) string {
+	return fmt.Sprintf("%s%-*s%v", strings.Repeat(" ", indent), 22-indent, name+":", value)
+}
+
+func inspectSummaryLines(indent int, s bsr.Summary) []string {
+	if s == nil {
+		return nil
+	}
+	var output []string
+	if !s.GetStartTime().IsZero() {
+		output = append(output, inspectField(indent, "Start Time", s.GetStartTime().Local().Format(time.RFC1123)))
+	}
+	if !s.GetEndTime().IsZero() {
+		output = append(output, inspectField(indent, "End Time", s.GetEndTime().Local().Format(time.RFC1123)))
+	}
+	switch s := s.(type) {
+	case bsr.SessionSummary:
+		output = append(output, inspectField(indent, "Connection Count", s.GetConnectionCount()))
+	case bsr.ConnectionSummary:
+		output = append(output,
+			inspectField(indent, "Channel Count", s.GetChannelCount()),
+			inspectField(indent, "Bytes Up", s.GetBytesUp()),
+			inspectField(indent, "Bytes Down", s.GetBytesDown()),
+		)
+	case bsr.ChannelSummary:
+		output = append(output,
+			inspectField(indent, "Bytes Up", s.GetBytesUp()),
+			inspectField(indent, "Bytes Down", s.GetBytesDown()),
+		)
+	}
+	return output
+}
+
+// inspectContainerLines returns the problems and files of a container,
+// followed by the containers it contains.
+func inspectContainerLines(indent int, v *bsr.Verification) []string {
+	prefix := strings.Repeat(" ", indent)
+	var output []string
+	if len(v.Problems) > 0 {
+		output = append(output,
+			prefix+"Problems:",
+			base.WrapSlice(indent+2, v.Problems),
+		)
+	}
+	if len(v.Files) > 0 {
+		output = append(output, prefix+"Files:")
+		for _, f := range v.Files {
+			line := prefix + "  " + f.Name
+			if f.Chunks > 0 {
+				line = fmt.Sprintf("%s (%d chunks, %s)", line, f.Chunks, f.End.Sub(f.Start))
+			}
+			output = append(output, line)
+			if len(f.Problems) > 0 {
+				output = append(output, base.WrapSlice(indent+4, f.Problems))
+			}
+		}
+	}
+
+	var containers []*bsr.Verification
+	containers = append(containers, v.Connections...)
+	containers = append(containers, v.Channels...)
+	for _, c := range containers {
+		output = append(output,
+			"",
+			prefix+strings.ToUpper(string(c.Type[:1]))+string(c.Type[1:])+":",
+			inspectField(indent+2, "ID", c.Id),
+			inspectField(indent+2, "Verified", c.Verified()),
+		)
+		if c.ChannelType != "" {
+			output = append(output, inspectField(indent+2, "Channel Type", c.ChannelType))
+		}
+		output = append(output, inspectSummaryLines(indent+2, c.Summary)...)
+		output = append(output, inspectContainerLines(indent+2, c)...)
+	}
+	return output
+}
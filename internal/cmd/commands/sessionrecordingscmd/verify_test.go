@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sessionrecordingscmd
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/storage/local"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRecording records a tcp session with a single connection to a temp dir
+// and returns the path of the session recording and its keys.
+func testRecording(t *testing.T) (string, *kms.Keys) {
+	t.Helper()
+	ctx := context.Background()
+
+	keys, err := kms.CreateKeys(ctx, kms.TestWrapper(t), "session")
+	require.NoError(t, err)
+	dir := t.TempDir()
+	f, err := local.NewFS(ctx, dir)
+	require.NoError(t, err)
+
+	s, err := bsr.NewSession(ctx, bsr.TestSessionRecordingMeta("sr_1234567890", tcp.Protocol), bsr.TestSessionMeta("session"), f, keys)
+	require.NoError(t, err)
+	require.NoError(t, s.EncodeSummary(ctx, &bsr.BaseSessionSummary{Id: "session", ConnectionCount: 1}))
+	c, err := s.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: "cr_1234567890"})
+	require.NoError(t, err)
+	require.NoError(t, c.EncodeSummary(ctx, &bsr.BaseConnectionSummary{Id: "cr_1234567890", BytesUp: 21, BytesDown: 9}))
+
+	for dir, data := range map[bsr.Direction]string{
+		bsr.Inbound:  "user@host:~$ ls -la\r\n",
+		bsr.Outbound: "total 0\r\n",
+	} {
+		w, err := c.NewMessagesWriter(ctx, dir)
+		require.NoError(t, err)
+		dw, err := tcp.NewDataWriter(ctx, w, dir, c.Compression(), bsr.AesGcmEncryption, "session", bsr.WithKeys(keys))
+		require.NoError(t, err)
+		_, err = dw.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, dw.Close())
+	}
+
+	require.NoError(t, c.Close(ctx))
+	require.NoError(t, s.Close(ctx))
+	return filepath.Join(dir, "sr_1234567890.bsr"), keys
+}
+
+// testPublicKeyFile writes the public key in PEM format to a temp file and
+// returns its path.
+func testPublicKeyFile(t *testing.T, pubKey ed25519.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pubKey)
+	require.NoError(t, err)
+	name := filepath.Join(t.TempDir(), "sr_1234567890.pem")
+	require.NoError(t, os.WriteFile(name, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+	return name
+}
+
+func testUi(format string) (*cli.MockUi, cli.Ui) {
+	ui := cli.NewMockUi()
+	return ui, &base.BoundaryUI{Ui: ui, Format: format}
+}
+
+func TestVerifyCommand(t *testing.T) {
+	recording, keys := testRecording(t)
+	keyFile := testPublicKeyFile(t, keys.PubKey.Key)
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherKeyFile := testPublicKeyFile(t, otherKey)
+
+	tampered, _ := testRecording(t)
+	summary := filepath.Join(tampered, "session-recording-summary.json")
+	b, err := os.ReadFile(summary)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(summary, append(b, ' '), 0o600))
+
+	cases := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantOutput string
+		wantErr    string
+	}{
+		{
+			name:       "verified",
+			args:       []string{"-path", recording},
+			wantCode:   base.CommandSuccess,
+			wantOutput: "Session recording sr_1234567890 verified.",
+		},
+		{
+			name:       "public key file",
+			args:       []string{"-path", recording, "-public-key-file", keyFile},
+			wantCode:   base.CommandSuccess,
+			wantOutput: "Session recording sr_1234567890 verified.",
+		},
+		{
+			name:     "other public key",
+			args:     []string{"-path", recording, "-public-key-file", otherKeyFile},
+			wantCode: base.CommandCliError,
+			wantErr:  "Session recording sr_1234567890 failed verification:",
+		},
+		{
+			name:     "tampered",
+			args:     []string{"-path", tampered},
+			wantCode: base.CommandCliError,
+			wantErr:  "sr_1234567890.bsr/session-recording-summary.json:",
+		},
+		{
+			name:     "missing path",
+			wantCode: base.CommandUserError,
+			wantErr:  "Path must be provided via -path",
+		},
+		{
+			name:     "not a recording",
+			args:     []string{"-path", t.TempDir()},
+			wantCode: base.CommandUserError,
+			wantErr:  "is not a session recording directory",
+		},
+		{
+			name:     "missing public key file",
+			args:     []string{"-path", recording, "-public-key-file", filepath.Join(t.TempDir(), "missing.pem")},
+			wantCode: base.CommandUserError,
+			wantErr:  "Error reading public key file",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			ui, bui := testUi("table")
+			cmd := &VerifyCommand{Command: base.NewCommand(bui)}
+
+			code := cmd.Run(tc.args)
+			assert.Equal(tc.wantCode, code, ui.ErrorWriter.String())
+			assert.Contains(ui.OutputWriter.String(), tc.wantOutput)
+			assert.Contains(ui.ErrorWriter.String(), tc.wantErr)
+		})
+	}
+}
+
+func TestVerifyCommand_Json(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	recording, _ := testRecording(t)
+	ui, bui := testUi("json")
+	cmd := &VerifyCommand{Command: base.NewCommand(bui)}
+
+	require.Equal(base.CommandSuccess, cmd.Run([]string{"-path", recording}), ui.ErrorWriter.String())
+	var got map[string]any
+	require.NoError(json.Unmarshal(ui.OutputWriter.Bytes(), &got))
+	assert.Equal(true, got["verified"])
+}
+
+func TestInspectCommand(t *testing.T) {
+	recording, keys := testRecording(t)
+	der, err := x509.MarshalPKIXPublicKey(ed25519.PublicKey(keys.PubKey.Key))
+	require.NoError(t, err)
+	pubKeyPem := strings.TrimSpace(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+
+	t.Run("table", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ui, bui := testUi("table")
+		cmd := &InspectCommand{Command: base.NewCommand(bui)}
+
+		require.Equal(base.CommandSuccess, cmd.Run([]string{"-path", recording}), ui.ErrorWriter.String())
+		out := ui.OutputWriter.String()
+		assert.Contains(out, "Session Recording information:")
+		assert.Contains(out, inspectField(2, "ID", "sr_1234567890"))
+		assert.Contains(out, inspectField(2, "Verified", true))
+		assert.Contains(out, inspectField(2, "Connection Count", 1))
+		assert.Contains(out, "  Connection:")
+		assert.Contains(out, inspectField(4, "ID", "cr_1234567890"))
+		assert.Contains(out, inspectField(4, "Bytes Up", 21))
+		for _, line := range strings.Split(pubKeyPem, "\n") {
+			assert.Contains(out, "    "+line)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ui, bui := testUi("json")
+		cmd := &InspectCommand{Command: base.NewCommand(bui)}
+
+		require.Equal(base.CommandSuccess, cmd.Run([]string{"-path", recording}), ui.ErrorWriter.String())
+		var got struct {
+			Verified    bool `json:"verified"`
+			Connections []struct {
+				Id string `json:"id"`
+			} `json:"connections"`
+		}
+		require.NoError(json.Unmarshal(ui.OutputWriter.Bytes(), &got))
+		assert.True(got.Verified)
+		require.Len(got.Connections, 1)
+		assert.Equal("cr_1234567890", got.Connections[0].Id)
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		ui, bui := testUi("table")
+		cmd := &InspectCommand{Command: base.NewCommand(bui)}
+
+		assert.Equal(t, base.CommandUserError, cmd.Run(nil))
+		assert.Contains(t, ui.ErrorWriter.String(), "Path must be provided via -path")
+	})
+}
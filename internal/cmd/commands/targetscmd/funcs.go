@@ -793,8 +793,18 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 var keySubstMap = map[string]string{
 	"default_port":             "Default Port",
 	"default_client_port":      "Default Client Port",
+	"bandwidth_limit":          "Bandwidth Limit",
+	"session_byte_quota":       "Session Byte Quota",
+	"terminate_tls":            "Terminate TLS",
+	"tls_server_name":          "TLS Server Name",
+	"tls_ca_certificates":      "TLS CA Certificates",
+	"tls_min_version":          "TLS Min Version",
+	"idle_timeout_seconds":     "Idle Timeout Seconds",
 	"enable_session_recording": "Enable Session Recording",
 	"storage_bucket_id":        "Storage Bucket ID",
+	"known_host_keys":          "Known Host Keys",
+	"recording_compression":    "Recording Compression",
+	"recording_encryption":     "Recording Encryption",
 }
 
 func exampleOutput() string {
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targetscmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraKubernetesActionsFlagsMapFunc = extraKubernetesActionsFlagsMapFuncImpl
+	extraKubernetesFlagsFunc = extraKubernetesFlagsFuncImpl
+	extraKubernetesFlagsHandlingFunc = extraKubernetesFlagsHandlingFuncImpl
+	extraKubernetesSynopsisFunc = extraKubernetesSynopsisFuncImpl
+}
+
+func extraKubernetesActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {
+			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
+			"egress-worker-filter", "ingress-worker-filter", "tls-server-name", "tls-ca-certificates",
+		},
+		"update": {
+			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
+			"worker-filter", "egress-worker-filter", "ingress-worker-filter", "tls-server-name", "tls-ca-certificates",
+		},
+	}
+}
+
+type extraKubernetesCmdVars struct {
+	flagDefaultPort            string
+	flagDefaultClientPort      string
+	flagSessionMaxSeconds      string
+	flagSessionConnectionLimit string
+	flagWorkerFilter           string
+	flagEgressWorkerFilter     string
+	flagIngressWorkerFilter    string
+	flagAddress                string
+	flagTlsServerName          string
+	flagTlsCaCertificates      string
+}
+
+func (c *KubernetesCommand) extraKubernetesHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets create kubernetes [options] [args]",
+			"",
+			"  Create a kubernetes-type target. Example:",
+			"",
+			`    $ boundary targets create kubernetes -name prodops-cluster -address k8s.example.com -tls-ca-certificates file://ca.pem -description "Production cluster for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets update kubernetes [options] [args]",
+			"",
+			"  Update a kubernetes-type target given its ID. Example:",
+			"",
+			`    $ boundary targets update kubernetes -id tkube_1234567890 -name "devops" -description "Production cluster for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraKubernetesFlagsFuncImpl(c *KubernetesCommand, set *base.FlagSets, f *base.FlagSet) {
+	fs := set.NewFlagSet("Kubernetes Target Options")
+
+	for _, name := range flagsKubernetesMap[c.Func] {
+		switch name {
+		case "address":
+			fs.StringVar(&base.StringVar{
+				Name:   "address",
+				Target: &c.flagAddress,
+				Usage:  "Optionally, a valid network address to connect to for this target. Can not be used alongside host sources.",
+			})
+		case "default-port":
+			fs.StringVar(&base.StringVar{
+				Name:   "default-port",
+				Target: &c.flagDefaultPort,
+				Usage:  "The default port to set on the target. If not set, 443 is used.",
+			})
+		case "default-client-port":
+			fs.StringVar(&base.StringVar{
+				Name:   "default-client-port",
+				Target: &c.flagDefaultClientPort,
+				Usage:  "The default client port to set on the target.",
+			})
+		case "session-max-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-seconds",
+				Target: &c.flagSessionMaxSeconds,
+				Usage:  `The maximum lifetime of the session, including all connections. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "session-connection-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-connection-limit",
+				Target: &c.flagSessionConnectionLimit,
+				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
+			})
+		case "worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  "Deprecated: use egress or ingress filters instead.",
+			})
+		case "egress-worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "egress-worker-filter",
+				Target: &c.flagEgressWorkerFilter,
+				Usage:  "A boolean expression to filter which egress workers can handle sessions for this target.",
+			})
+		case "ingress-worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "ingress-worker-filter",
+				Target: &c.flagIngressWorkerFilter,
+				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
+			})
+		case "tls-server-name":
+			fs.StringVar(&base.StringVar{
+				Name:   "tls-server-name",
+				Target: &c.flagTlsServerName,
+				Usage:  "The name used to verify the certificate of the Kubernetes API server. If not set, the endpoint's host is used.",
+			})
+		case "tls-ca-certificates":
+			fs.StringVar(&base.StringVar{
+				Name:   "tls-ca-certificates",
+				Target: &c.flagTlsCaCertificates,
+				Usage:  "A PEM bundle of the certificate authorities used to verify the certificate of the Kubernetes API server. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read. If not set, the worker's system certificate authorities are used.",
+			})
+		}
+	}
+}
+
+func extraKubernetesFlagsHandlingFuncImpl(c *KubernetesCommand, _ *base.FlagSets, opts *[]targets.Option) bool {
+	switch c.flagDefaultPort {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultKubernetesTargetDefaultPort())
+	default:
+		port, err := strconv.ParseUint(c.flagDefaultPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDefaultPort, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithKubernetesTargetDefaultPort(uint32(port)))
+	}
+
+	switch c.flagDefaultClientPort {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultKubernetesTargetDefaultClientPort())
+	default:
+		port, err := strconv.ParseUint(c.flagDefaultClientPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDefaultClientPort, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithKubernetesTargetDefaultClientPort(uint32(port)))
+	}
+
+	switch c.flagSessionMaxSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithSessionMaxSeconds(final))
+	}
+
+	switch c.flagSessionConnectionLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionConnectionLimit())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionConnectionLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionConnectionLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionConnectionLimit(int32(limit)))
+	}
+
+	switch c.flagWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse worker filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
+	}
+
+	switch c.flagEgressWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultEgressWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagEgressWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse egress filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithEgressWorkerFilter(c.flagEgressWorkerFilter))
+	}
+	switch c.flagIngressWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultIngressWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagIngressWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse ingress filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
+	}
+
+	switch c.flagAddress {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAddress())
+	default:
+		*opts = append(*opts, targets.WithAddress(c.flagAddress))
+	}
+
+	switch c.flagTlsServerName {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultKubernetesTargetTlsServerName())
+	default:
+		*opts = append(*opts, targets.WithKubernetesTargetTlsServerName(c.flagTlsServerName))
+	}
+
+	switch c.flagTlsCaCertificates {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultKubernetesTargetTlsCaCertificates())
+	default:
+		certs, err := parseutil.ParsePath(c.flagTlsCaCertificates)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagTlsCaCertificates, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithKubernetesTargetTlsCaCertificates(certs))
+	}
+
+	return true
+}
+
+func extraKubernetesSynopsisFuncImpl(_ *KubernetesCommand) string {
+	return "Create a kubernetes-type target"
+}
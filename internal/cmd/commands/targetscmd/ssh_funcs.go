@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 )
 
 func init() {
@@ -25,12 +26,12 @@ func extraSshActionsFlagsMapFuncImpl() map[string][]string {
 		"create": {
 			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
 			"egress-worker-filter", "ingress-worker-filter", "enable-session-recording",
-			"storage-bucket-id",
+			"storage-bucket-id", "known-host-keys", "recording-compression", "recording-encryption",
 		},
 		"update": {
 			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
 			"worker-filter", "egress-worker-filter", "ingress-worker-filter", "enable-session-recording",
-			"storage-bucket-id",
+			"storage-bucket-id", "known-host-keys", "recording-compression", "recording-encryption",
 		},
 	}
 }
@@ -46,6 +47,9 @@ type extraSshCmdVars struct {
 	flagAddress                string
 	flagStorageBucketId        string
 	flagEnableSessionRecording string
+	flagKnownHostKeys          string
+	flagRecordingCompression   string
+	flagRecordingEncryption    string
 }
 
 func (c *SshCommand) extraSshHelpFunc(helpMap map[string]func() string) string {
@@ -57,7 +61,7 @@ func (c *SshCommand) extraSshHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"  Create a ssh-type target. Example:",
 			"",
-			`    $ boundary targets create ssh -name prodops -description "Ssh target for ProdOps"`,
+			`    $ boundary targets create ssh -name prodops -known-host-keys file://known_host_keys -description "Ssh target for ProdOps"`,
 			"",
 			"",
 		})
@@ -141,6 +145,24 @@ func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagEnableSessionRecording,
 				Usage:  "A boolean indicating if session recording is enabled for this target.",
 			})
+		case "known-host-keys":
+			fs.StringVar(&base.StringVar{
+				Name:   "known-host-keys",
+				Target: &c.flagKnownHostKeys,
+				Usage:  "The ssh public keys, one per line in authorized_keys format, trusted as the host key of the target's endpoints. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read. Sessions can not be established until this is set.",
+			})
+		case "recording-compression":
+			fs.StringVar(&base.StringVar{
+				Name:   "recording-compression",
+				Target: &c.flagRecordingCompression,
+				Usage:  `The compression of the data of this target's session recordings: "none", "gzip" or "zstd". Defaults to "zstd".`,
+			})
+		case "recording-encryption":
+			fs.StringVar(&base.StringVar{
+				Name:   "recording-encryption",
+				Target: &c.flagRecordingEncryption,
+				Usage:  `The encryption of the data of this target's session recordings: "none" or "aes-gcm". Defaults to "aes-gcm".`,
+			})
 		}
 	}
 }
@@ -267,6 +289,35 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 		return false
 	}
 
+	switch c.flagKnownHostKeys {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSshTargetKnownHostKeys())
+	default:
+		keys, err := parseutil.ParsePath(c.flagKnownHostKeys)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKnownHostKeys, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSshTargetKnownHostKeys(keys))
+	}
+
+	switch c.flagRecordingCompression {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSshTargetRecordingCompression())
+	default:
+		*opts = append(*opts, targets.WithSshTargetRecordingCompression(c.flagRecordingCompression))
+	}
+
+	switch c.flagRecordingEncryption {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSshTargetRecordingEncryption())
+	default:
+		*opts = append(*opts, targets.WithSshTargetRecordingEncryption(c.flagRecordingEncryption))
+	}
+
 	return true
 }
 
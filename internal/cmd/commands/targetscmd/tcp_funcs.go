@@ -4,6 +4,7 @@
 package targetscmd
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 )
 
 func init() {
@@ -21,8 +23,8 @@ func init() {
 
 func extraTcpActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit", "egress-worker-filter", "ingress-worker-filter"},
-		"update": {"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit", "worker-filter", "egress-worker-filter", "ingress-worker-filter"},
+		"create": {"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit", "egress-worker-filter", "ingress-worker-filter", "bandwidth-limit", "session-byte-quota", "terminate-tls", "tls-server-name", "tls-ca-certificates", "tls-min-version", "idle-timeout"},
+		"update": {"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit", "worker-filter", "egress-worker-filter", "ingress-worker-filter", "bandwidth-limit", "session-byte-quota", "terminate-tls", "tls-server-name", "tls-ca-certificates", "tls-min-version", "idle-timeout"},
 	}
 }
 
@@ -35,6 +37,13 @@ type extraTcpCmdVars struct {
 	flagEgressWorkerFilter     string
 	flagIngressWorkerFilter    string
 	flagAddress                string
+	flagBandwidthLimit         string
+	flagSessionByteQuota       string
+	flagTerminateTls           string
+	flagTlsServerName          string
+	flagTlsCaCertificates      string
+	flagTlsMinVersion          string
+	flagIdleTimeout            string
 }
 
 func (c *TcpCommand) extraTcpHelpFunc(helpMap map[string]func() string) string {
@@ -118,6 +127,48 @@ func extraTcpFlagsFuncImpl(c *TcpCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIngressWorkerFilter,
 				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
 			})
+		case "bandwidth-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "bandwidth-limit",
+				Target: &c.flagBandwidthLimit,
+				Usage:  "The maximum rate, in bytes per second, of the data proxied by each connection to the target. If not set, the rate is not limited.",
+			})
+		case "session-byte-quota":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-byte-quota",
+				Target: &c.flagSessionByteQuota,
+				Usage:  "The maximum number of bytes proxied by all the connections of a session to the target. A connection which exceeds it is closed. If not set, the number of bytes is not limited.",
+			})
+		case "terminate-tls":
+			fs.StringVar(&base.StringVar{
+				Name:   "terminate-tls",
+				Target: &c.flagTerminateTls,
+				Usage:  "A boolean indicating if the worker terminates the TLS connections of clients with a certificate issued for the session and opens a new TLS connection to the target's endpoint.",
+			})
+		case "tls-server-name":
+			fs.StringVar(&base.StringVar{
+				Name:   "tls-server-name",
+				Target: &c.flagTlsServerName,
+				Usage:  "The name used to verify the certificate of the target's endpoint when the worker terminates TLS. If not set, the endpoint's host is used.",
+			})
+		case "tls-ca-certificates":
+			fs.StringVar(&base.StringVar{
+				Name:   "tls-ca-certificates",
+				Target: &c.flagTlsCaCertificates,
+				Usage:  "A PEM bundle of the certificate authorities used to verify the certificate of the target's endpoint when the worker terminates TLS. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read. If not set, the worker's system certificate authorities are used.",
+			})
+		case "tls-min-version":
+			fs.StringVar(&base.StringVar{
+				Name:   "tls-min-version",
+				Target: &c.flagTlsMinVersion,
+				Usage:  `The minimum TLS version, "1.2" or "1.3", accepted from the target's endpoint when the worker terminates TLS. If not set, TLS 1.2 is required.`,
+			})
+		case "idle-timeout":
+			fs.StringVar(&base.StringVar{
+				Name:   "idle-timeout",
+				Target: &c.flagIdleTimeout,
+				Usage:  `The time a connection to the target can go without data proxied in either direction before the worker closes it. Can be specified as an integer number of seconds or a duration string. If not set, idle connections are only closed when the session ends.`,
+			})
 		}
 	}
 }
@@ -149,6 +200,94 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithTcpTargetDefaultClientPort(uint32(port)))
 	}
 
+	switch c.flagBandwidthLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetBandwidthLimit())
+	default:
+		limit, err := strconv.ParseUint(c.flagBandwidthLimit, 10, 63)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagBandwidthLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithTcpTargetBandwidthLimit(limit))
+	}
+
+	switch c.flagSessionByteQuota {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetSessionByteQuota())
+	default:
+		quota, err := strconv.ParseUint(c.flagSessionByteQuota, 10, 63)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionByteQuota, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithTcpTargetSessionByteQuota(quota))
+	}
+
+	switch c.flagTerminateTls {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetTerminateTls())
+	case "false":
+		*opts = append(*opts, targets.WithTcpTargetTerminateTls(false))
+	case "true":
+		*opts = append(*opts, targets.WithTcpTargetTerminateTls(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for terminate-tls %v", c.flagTerminateTls))
+		return false
+	}
+
+	switch c.flagTlsServerName {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetTlsServerName())
+	default:
+		*opts = append(*opts, targets.WithTcpTargetTlsServerName(c.flagTlsServerName))
+	}
+
+	switch c.flagTlsCaCertificates {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetTlsCaCertificates())
+	default:
+		certs, err := parseutil.ParsePath(c.flagTlsCaCertificates)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagTlsCaCertificates, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithTcpTargetTlsCaCertificates(certs))
+	}
+
+	switch c.flagTlsMinVersion {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetTlsMinVersion())
+	default:
+		*opts = append(*opts, targets.WithTcpTargetTlsMinVersion(c.flagTlsMinVersion))
+	}
+
+	switch c.flagIdleTimeout {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetIdleTimeoutSeconds())
+	default:
+		var final uint32
+		secs, err := strconv.ParseUint(c.flagIdleTimeout, 10, 32)
+		if err == nil {
+			final = uint32(secs)
+		} else {
+			dur, err := time.ParseDuration(c.flagIdleTimeout)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagIdleTimeout, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithTcpTargetIdleTimeoutSeconds(final))
+	}
+
 	switch c.flagSessionMaxSeconds {
 	case "":
 	case "null":
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/url"
 	"os"
 	"reflect"
 	"strconv"
@@ -216,6 +217,12 @@ type Controller struct {
 
 	// License is the license used by HCP builds
 	License string `hcl:"license"`
+
+	// RecordingStoragePath represents the location a controller reads session
+	// recordings from. Storage buckets are read from directories within this
+	// path, which must be the network filesystem the workers write session
+	// recordings to. The path is created if it does not exist.
+	RecordingStoragePath string `hcl:"recording_storage_path"`
 }
 
 func (c *Controller) InitNameIfEmpty(ctx context.Context) error {
@@ -277,8 +284,9 @@ type Worker struct {
 	// AuthStoragePath represents the location a worker stores its node credentials, if set
 	AuthStoragePath string `hcl:"auth_storage_path"`
 
-	// RecordingStoragePath represents the location a worker caches session recordings before
-	// they are sync'ed to the corresponding storage bucket. The path must already exist.
+	// RecordingStoragePath represents the location a worker writes session recordings to.
+	// Storage buckets are stored in directories within this path, which can be a mounted
+	// network filesystem. The path is created if it does not exist.
 	RecordingStoragePath string `hcl:"recording_storage_path"`
 
 	// ControllerGeneratedActivationToken is a controller-generated activation
@@ -290,6 +298,31 @@ type Worker struct {
 	// method of using KMSes to authenticate. This should not be used when the
 	// controller version supports the new style.
 	UseDeprecatedKmsAuthMethod bool `hcl:"use_deprecated_kms_auth_method"`
+
+	// EgressProxy configures the proxy through which the worker dials the
+	// endpoints of targets. If not set endpoints are dialed directly.
+	EgressProxy *EgressProxy `hcl:"egress_proxy"`
+}
+
+// EgressProxy is the proxy through which a worker dials the endpoints of
+// targets.
+type EgressProxy struct {
+	// Url is the url of the proxy, either socks5://[user:password@]host:port
+	// for a SOCKS5 proxy or http://[user:password@]host:port for a proxy
+	// supporting HTTP CONNECT. It can be a path, env var, or direct value.
+	Url string `hcl:"url"`
+
+	// Targets override the proxy used for the endpoints of specific targets.
+	Targets []*EgressProxyTarget `hcl:"target"`
+}
+
+// EgressProxyTarget overrides the egress proxy for the endpoint of a target.
+type EgressProxyTarget struct {
+	TargetId string `hcl:",key"`
+
+	// Url is the url of the proxy used for the target, in the same format as
+	// EgressProxy.Url. If empty the target's endpoint is dialed directly.
+	Url string `hcl:"url"`
 }
 
 type Database struct {
@@ -863,6 +896,12 @@ func Parse(d string) (*Config, error) {
 		if err != nil {
 			return nil, fmt.Errorf("Failed to parse worker upstreams: %w", err)
 		}
+
+		if result.Worker.EgressProxy != nil {
+			if err := parseEgressProxy(result.Worker.EgressProxy); err != nil {
+				return nil, fmt.Errorf("Failed to parse worker egress proxy: %w", err)
+			}
+		}
 	}
 
 	// Now that we can have multiple KMSes for downstream workers, allow an
@@ -985,6 +1024,59 @@ func parseWorkerUpstreams(c *Config) ([]string, error) {
 	}
 }
 
+// parseEgressProxy resolves the urls of the worker's egress proxy and of its
+// target overrides, and validates them.
+func parseEgressProxy(p *EgressProxy) error {
+	var err error
+	p.Url, err = parseEgressProxyUrl(p.Url)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(p.Targets))
+	for _, t := range p.Targets {
+		if t.TargetId == "" {
+			return fmt.Errorf("target override is missing a target id")
+		}
+		if seen[t.TargetId] {
+			return fmt.Errorf("target %q is overridden more than once", t.TargetId)
+		}
+		seen[t.TargetId] = true
+		t.Url, err = parseEgressProxyUrl(t.Url)
+		if err != nil {
+			return fmt.Errorf("target %q: %w", t.TargetId, err)
+		}
+	}
+	return nil
+}
+
+func parseEgressProxyUrl(raw string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+	parsed, err := parseutil.ParsePath(raw)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		return "", fmt.Errorf("bad env var or file pointer: %w", err)
+	}
+	parsed = strings.TrimSpace(parsed)
+	u, err := url.Parse(parsed)
+	if err != nil {
+		// The url may contain credentials so it is not included in the error.
+		return "", fmt.Errorf("url is not valid")
+	}
+	switch u.Scheme {
+	case "socks5", "http":
+	default:
+		return "", fmt.Errorf("url scheme %q is not supported, it must be socks5 or http", u.Scheme)
+	}
+	if _, port, err := net.SplitHostPort(u.Host); err != nil || port == "" {
+		return "", fmt.Errorf("url must include a host and a port")
+	}
+	if u.Path != "" && u.Path != "/" {
+		return "", fmt.Errorf("url must not include a path")
+	}
+	return parsed, nil
+}
+
 func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 	// Decode the outside struct
 	var result event.EventerConfig
@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"net/url"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	intglobals "github.com/hashicorp/boundary/internal/globals"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/http"
+	"github.com/hashicorp/boundary/internal/target/kubernetes"
+	"github.com/hashicorp/boundary/internal/target/postgres"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// targetProtocolContext populates the protocol context for the subtype of the
+// target the session was authorized for.  tcp targets are a straight forward
+// proxy and only need one when the target limits the data proxied or
+// terminates TLS, otherwise nil is returned for them.
+func targetProtocolContext(
+	ctx context.Context,
+	sessionRepo *session.Repository,
+	targetRepoFn target.RepositoryFactory,
+	_ *server.Repository,
+	_ common.WorkerAuthRepoStorageFactory,
+	req *pbs.AuthorizeConnectionRequest,
+	_ []string,
+	_ string,
+	_ intglobals.ControllerExtension,
+) (*anypb.Any, error) {
+	const op = "handlers.targetProtocolContext"
+	sessInfo, _, err := sessionRepo.LookupSession(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if sessInfo == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "session not found")
+	}
+
+	var pc proto.Message
+	switch target.SubtypeFromId(sessInfo.TargetId) {
+	case ssh.Subtype:
+		if pc, err = sshProtocolContext(ctx, sessionRepo, targetRepoFn, sessInfo); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	case http.Subtype:
+		if pc, err = httpProtocolContext(ctx, sessionRepo, targetRepoFn, sessInfo); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	case postgres.Subtype:
+		if pc, err = postgresProtocolContext(ctx, sessionRepo, sessInfo); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	case kubernetes.Subtype:
+		if pc, err = kubernetesProtocolContext(ctx, sessionRepo, targetRepoFn, sessInfo); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	case tcp.Subtype:
+		tcpCtx, err := tcpProtocolContext(ctx, sessionRepo, targetRepoFn, sessInfo)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if tcpCtx == nil {
+			return nil, nil
+		}
+		pc = tcpCtx
+	default:
+		return nil, nil
+	}
+
+	ret, err := anypb.New(pc)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+// sshProtocolContext returns the credentials injected into the session, the
+// key the worker presents to the client as its ssh host key and the target's
+// known host keys the worker verifies the endpoint's host key against.
+func sshProtocolContext(ctx context.Context, sessionRepo *session.Repository, targetRepoFn target.RepositoryFactory, sessInfo *session.Session) (*pbs.SshProtocolContext, error) {
+	const op = "handlers.sshProtocolContext"
+	creds, err := injectedCredentials(ctx, sessionRepo, sessInfo)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(creds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "ssh sessions require an injected application credential")
+	}
+	if targetRepoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target repository")
+	}
+	targetRepo, err := targetRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	t, err := targetRepo.LookupTarget(ctx, sessInfo.TargetId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "target not found")
+	}
+	kt, ok := t.(target.KnownHostKeysTarget)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "target has no known host keys")
+	}
+	if len(sessInfo.CertificatePrivateKey) != ed25519.PrivateKeySize {
+		return nil, errors.New(ctx, errors.Internal, op, "session is missing its private key")
+	}
+	hostKey, err := x509.MarshalPKCS8PrivateKey(ed25519.PrivateKey(sessInfo.CertificatePrivateKey))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.SshProtocolContext{
+		InjectedCredentials: creds,
+		Pkcs8HostKey:        hostKey,
+		KnownHostKeys:       kt.GetKnownHostKeys(),
+	}, nil
+}
+
+// httpProtocolContext returns the credentials injected into the session, the
+// endpoint's host, whether the worker connects to it using TLS and the server
+// name and CA certificates used to verify the endpoint's certificate.
+func httpProtocolContext(ctx context.Context, sessionRepo *session.Repository, targetRepoFn target.RepositoryFactory, sessInfo *session.Session) (*pbs.HttpProtocolContext, error) {
+	const op = "handlers.httpProtocolContext"
+	creds, err := injectedCredentials(ctx, sessionRepo, sessInfo)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if targetRepoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target repository")
+	}
+	targetRepo, err := targetRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	t, err := targetRepo.LookupTarget(ctx, sessInfo.TargetId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "target not found")
+	}
+	et, ok := t.(target.TlsEnabledTarget)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "target has no tls settings")
+	}
+	endpoint, err := url.Parse(sessInfo.Endpoint)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse session endpoint"))
+	}
+	pc := &pbs.HttpProtocolContext{
+		InjectedCredentials: creds,
+		EnableTls:           et.GetEnableTls(),
+		Host:                endpoint.Host,
+	}
+	if vt, ok := t.(target.EndpointTlsTarget); ok {
+		pc.TlsServerName = vt.GetTlsServerName()
+		pc.TlsCaCertificates = vt.GetTlsCaCertificates()
+	}
+	return pc, nil
+}
+
+// postgresProtocolContext returns the credentials injected into the session
+// and the session's ids which the worker records when auditing queries.
+func postgresProtocolContext(ctx context.Context, sessionRepo *session.Repository, sessInfo *session.Session) (*pbs.PostgresProtocolContext, error) {
+	const op = "handlers.postgresProtocolContext"
+	creds, err := injectedCredentials(ctx, sessionRepo, sessInfo)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(creds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "postgres sessions require an injected application credential")
+	}
+	return &pbs.PostgresProtocolContext{
+		InjectedCredentials: creds,
+		SessionId:           sessInfo.PublicId,
+		UserId:              sessInfo.UserId,
+	}, nil
+}
+
+// kubernetesProtocolContext returns the credentials holding the service account
+// token injected into the session, the Kubernetes API server's host and the
+// target's settings used to verify the API server's certificate.
+func kubernetesProtocolContext(ctx context.Context, sessionRepo *session.Repository, targetRepoFn target.RepositoryFactory, sessInfo *session.Session) (*pbs.KubernetesProtocolContext, error) {
+	const op = "handlers.kubernetesProtocolContext"
+	creds, err := injectedCredentials(ctx, sessionRepo, sessInfo)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(creds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "kubernetes sessions require an injected application credential")
+	}
+	if targetRepoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target repository")
+	}
+	targetRepo, err := targetRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	t, err := targetRepo.LookupTarget(ctx, sessInfo.TargetId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "target not found")
+	}
+	et, ok := t.(target.EndpointTlsTarget)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "target has no endpoint tls settings")
+	}
+	endpoint, err := url.Parse(sessInfo.Endpoint)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse session endpoint"))
+	}
+	return &pbs.KubernetesProtocolContext{
+		InjectedCredentials: creds,
+		Host:                endpoint.Host,
+		TlsServerName:       et.GetTlsServerName(),
+		TlsCaCertificates:   et.GetTlsCaCertificates(),
+	}, nil
+}
+
+// tcpProtocolContext returns the target's bandwidth limit, session byte quota
+// and idle timeout along with the number of bytes the session's connections
+// have proxied so far.  When the target terminates TLS it also returns its TLS
+// settings, the injected credentials and a certificate issued for the
+// connection by the session's TLS certificate authority, which the worker
+// presents to the client.  The key of the certificate authority stays with the
+// controller.  nil is returned if the target neither limits the connections
+// nor terminates TLS.
+func tcpProtocolContext(ctx context.Context, sessionRepo *session.Repository, targetRepoFn target.RepositoryFactory, sessInfo *session.Session) (*pbs.TcpProtocolContext, error) {
+	const op = "handlers.tcpProtocolContext"
+	if targetRepoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target repository")
+	}
+	targetRepo, err := targetRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	t, err := targetRepo.LookupTarget(ctx, sessInfo.TargetId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "target not found")
+	}
+	lt, ok := t.(target.LimitedTarget)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "target has no connection limits")
+	}
+	tlst, ok := t.(target.TlsTerminatingTarget)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "target cannot terminate tls")
+	}
+	et, ok := t.(target.EndpointTlsTarget)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "target has no endpoint tls settings")
+	}
+	if lt.GetBandwidthLimit() == 0 && lt.GetSessionByteQuota() == 0 && lt.GetIdleTimeoutSeconds() == 0 && !tlst.GetTerminateTls() {
+		return nil, nil
+	}
+	// The bytes of the connections still open are the ones last reported by
+	// their workers.
+	var used uint64
+	for _, c := range sessInfo.Connections {
+		used += uint64(c.BytesUp) + uint64(c.BytesDown)
+	}
+	tcpCtx := &pbs.TcpProtocolContext{
+		SessionId:          sessInfo.PublicId,
+		BandwidthLimit:     lt.GetBandwidthLimit(),
+		SessionByteQuota:   lt.GetSessionByteQuota(),
+		SessionBytesUsed:   used,
+		IdleTimeoutSeconds: lt.GetIdleTimeoutSeconds(),
+	}
+	if !tlst.GetTerminateTls() {
+		return tcpCtx, nil
+	}
+
+	creds, err := injectedCredentials(ctx, sessionRepo, sessInfo)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ca, _, err := session.TlsCa(ctx, sessInfo)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cert, key, err := session.IssueTlsCertificate(ctx, sessInfo)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	endpoint, err := url.Parse(sessInfo.Endpoint)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse session endpoint"))
+	}
+	tcpCtx.TerminateTls = true
+	tcpCtx.Host = endpoint.Host
+	tcpCtx.TlsServerName = et.GetTlsServerName()
+	tcpCtx.TlsCaCertificates = et.GetTlsCaCertificates()
+	tcpCtx.TlsMinVersion = tlst.GetTlsMinVersion()
+	tcpCtx.InjectedCredentials = creds
+	tcpCtx.TlsCaCertificate = ca.Raw
+	tcpCtx.TlsCertificate = cert
+	tcpCtx.Pkcs8TlsKey = key
+	return tcpCtx, nil
+}
+
+// injectedCredentials returns the credentials stored with the session for the
+// worker to inject into the connection to the endpoint.
+func injectedCredentials(ctx context.Context, sessionRepo *session.Repository, sessInfo *session.Session) ([]*pbs.Credential, error) {
+	const op = "handlers.injectedCredentials"
+	creds, err := sessionRepo.ListSessionCredentials(ctx, sessInfo.ProjectId, sessInfo.PublicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var ret []*pbs.Credential
+	for _, c := range creds {
+		m := &pbs.Credential{}
+		if err := proto.Unmarshal(c, m); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal credential"))
+		}
+		ret = append(ret, m)
+	}
+	return ret, nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"context"
+
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/util"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// sessionRecording returns the session recording the worker uses to record
+// the session's connections, or nil if the session's target does not have
+// session recording enabled.  The bsr keys of a session's recording are
+// created, wrapped by the controller's bsr kms, the first time the session is
+// looked up and are stored with the recording, so every connection of the
+// session is recorded with the same keys.  The compression and encryption of
+// the recording are taken from the target at the same time.  If any repository
+// factory is nil no sessions are recorded.
+func sessionRecording(
+	ctx context.Context,
+	kmsCache *kms.Kms,
+	sessionRepo *session.Repository,
+	targetRepoFn target.RepositoryFactory,
+	storageBucketRepoFn common.PluginStorageBucketRepoFactory,
+	sessInfo *session.Session,
+) (*pbs.SessionRecording, error) {
+	const op = "handlers.sessionRecording"
+	if sessionRepo == nil || targetRepoFn == nil || storageBucketRepoFn == nil || sessInfo.TargetId == "" {
+		return nil, nil
+	}
+	recordingId, err := session.RecordingId(ctx, sessInfo.PublicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	bsrWrapper := kmsCache.GetExternalWrappers(ctx).Bsr()
+
+	stored, err := sessionRepo.LookupRecording(ctx, recordingId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if stored == nil {
+		targetRepo, err := targetRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		t, err := targetRepo.LookupTarget(ctx, sessInfo.TargetId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if t == nil || !t.GetEnableSessionRecording() {
+			return nil, nil
+		}
+		if util.IsNil(bsrWrapper) {
+			return nil, errors.New(ctx, errors.Internal, op, "no bsr kms is configured for session recording")
+		}
+		keys, err := bsrkms.CreateKeys(ctx, bsrWrapper, sessInfo.PublicId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create bsr keys"))
+		}
+		newRec := &session.Recording{
+			SessionId:       sessInfo.PublicId,
+			StorageBucketId: t.GetStorageBucketId(),
+		}
+		if rt, ok := t.(target.RecordingTarget); ok {
+			newRec.Compression = rt.GetRecordingCompression()
+			newRec.Encryption = rt.GetRecordingEncryption()
+		}
+		for _, f := range []struct {
+			to *[]byte
+			m  proto.Message
+		}{
+			{&newRec.PubKey, keys.PubKey},
+			{&newRec.PubKeySelfSignature, keys.PubKeySelfSignature},
+			{&newRec.PubKeyBsrSignature, keys.PubKeyBsrSignature},
+			{&newRec.WrappedBsrKey, keys.WrappedBsrKey},
+			{&newRec.WrappedPrivKey, keys.WrappedPrivKey},
+		} {
+			if *f.to, err = proto.Marshal(f.m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to marshal bsr keys"))
+			}
+		}
+		// If the session was looked up concurrently, the recording which
+		// was stored first is returned along with its keys.
+		if stored, err = sessionRepo.CreateRecording(ctx, newRec); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create session recording"))
+		}
+	}
+
+	sbRepo, err := storageBucketRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sb, err := sbRepo.LookupStorageBucket(ctx, stored.StorageBucketId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if sb == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "storage bucket for session recording not found")
+	}
+	bucket, err := sb.ToProto(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	// The worker writes the recording with the unwrapped keys.
+	if util.IsNil(bsrWrapper) {
+		return nil, errors.New(ctx, errors.Internal, op, "no bsr kms is configured for session recording")
+	}
+	wrappedBsrKey, wrappedPrivKey := &wrapping.KeyInfo{}, &wrapping.KeyInfo{}
+	if err := proto.Unmarshal(stored.WrappedBsrKey, wrappedBsrKey); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal wrapped bsr key"))
+	}
+	if err := proto.Unmarshal(stored.WrappedPrivKey, wrappedPrivKey); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal wrapped private key"))
+	}
+	unwrapped, err := bsrkms.NewKeyUnwrapCallbackFunc(ctx, bsrWrapper)(bsrkms.WrappedKeys{
+		WrappedBsrKey:  wrappedBsrKey,
+		WrappedPrivKey: wrappedPrivKey,
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unwrap bsr keys"))
+	}
+
+	rec := &pbs.SessionRecording{
+		RecordingId:         stored.PublicId,
+		StorageBucket:       bucket,
+		PubKey:              stored.PubKey,
+		PubKeySelfSignature: stored.PubKeySelfSignature,
+		PubKeyBsrSignature:  stored.PubKeyBsrSignature,
+		WrappedBsrKey:       stored.WrappedBsrKey,
+		WrappedPrivKey:      stored.WrappedPrivKey,
+		Compression:         stored.Compression,
+		Encryption:          stored.Encryption,
+	}
+	if rec.BsrKey, err = proto.Marshal(unwrapped.BsrKey); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to marshal bsr keys"))
+	}
+	if rec.PrivKey, err = proto.Marshal(unwrapped.PrivKey); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to marshal bsr keys"))
+	}
+	return rec, nil
+}
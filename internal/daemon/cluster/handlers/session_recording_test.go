@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/session"
+	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSessionRecording(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	bsrWrapper := bsrkms.TestWrapper(t)
+	require.NoError(t, kmsCache.AddExternalWrappers(ctx, kms.WithBsrWrapper(bsrWrapper)))
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	targetRepoFn := func(o ...target.Option) (*target.Repository, error) {
+		return target.NewRepository(ctx, rw, rw, kmsCache, o...)
+	}
+	storageBucketRepoFn := func() (*pluginstorage.Repository, error) {
+		return pluginstorage.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	sessionRepo, err := session.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	plg := plugin.TestPlugin(t, conn, "test", plugin.WithStorageFlag(true))
+	sb := pluginstorage.TestStorageBucket(t, conn, org.GetPublicId(), plg.GetPublicId(), "bucket", pluginstorage.WithBucketPrefix("prefix"))
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	newSession := func(tar target.Target) *session.Session {
+		return session.TestSession(t, conn, wrapper, session.ComposedOf{
+			UserId:      at.GetIamUserId(),
+			HostId:      h.GetPublicId(),
+			TargetId:    tar.GetPublicId(),
+			HostSetId:   hs.GetPublicId(),
+			AuthTokenId: at.GetPublicId(),
+			ProjectId:   prj.GetPublicId(),
+			Endpoint:    "tcp://127.0.0.1:22",
+		})
+	}
+	hostSources := target.WithHostSources([]string{hs.GetPublicId()})
+	recorded := newSession(ssh.TestTarget(ctx, t, conn, prj.GetPublicId(), "recorded", hostSources,
+		target.WithEnableSessionRecording(true), target.WithStorageBucketId(sb.GetPublicId())))
+	configured := newSession(ssh.TestTarget(ctx, t, conn, prj.GetPublicId(), "configured", hostSources,
+		target.WithEnableSessionRecording(true), target.WithStorageBucketId(sb.GetPublicId()),
+		target.WithRecordingCompression("gzip"), target.WithRecordingEncryption("none")))
+	notRecorded := newSession(ssh.TestTarget(ctx, t, conn, prj.GetPublicId(), "not recorded", hostSources))
+	tcpSession := newSession(tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "tcp", hostSources))
+
+	t.Run("recorded", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		rec, err := sessionRecording(ctx, kmsCache, sessionRepo, targetRepoFn, storageBucketRepoFn, recorded)
+		require.NoError(err)
+		require.NotNil(rec)
+		wantId, err := session.RecordingId(ctx, recorded.GetPublicId())
+		require.NoError(err)
+		assert.Equal(wantId, rec.GetRecordingId())
+		assert.Equal(sb.GetPublicId(), rec.GetStorageBucket().GetId())
+		assert.Equal("bucket", rec.GetStorageBucket().GetBucketName())
+		assert.Equal("prefix", rec.GetStorageBucket().GetBucketPrefix())
+		assert.Equal(session.DefaultRecordingCompression, rec.GetCompression())
+		assert.Equal(session.DefaultRecordingEncryption, rec.GetEncryption())
+
+		// The wrapped keys can be unwrapped with the bsr kms.
+		wrappedBsrKey, wrappedPrivKey := &wrapping.KeyInfo{}, &wrapping.KeyInfo{}
+		require.NoError(proto.Unmarshal(rec.GetWrappedBsrKey(), wrappedBsrKey))
+		require.NoError(proto.Unmarshal(rec.GetWrappedPrivKey(), wrappedPrivKey))
+		unwrapped, err := bsrkms.NewKeyUnwrapCallbackFunc(ctx, bsrWrapper)(bsrkms.WrappedKeys{
+			WrappedBsrKey:  wrappedBsrKey,
+			WrappedPrivKey: wrappedPrivKey,
+		})
+		require.NoError(err)
+		bsrKey := &wrapping.KeyInfo{}
+		require.NoError(proto.Unmarshal(rec.GetBsrKey(), bsrKey))
+		assert.True(proto.Equal(bsrKey, unwrapped.BsrKey))
+
+		// Later lookups of the session return the same keys, so all of its
+		// connections are recorded with them.
+		again, err := sessionRecording(ctx, kmsCache, sessionRepo, targetRepoFn, storageBucketRepoFn, recorded)
+		require.NoError(err)
+		assert.True(proto.Equal(rec, again))
+		stored, err := sessionRepo.LookupRecording(ctx, wantId)
+		require.NoError(err)
+		require.NotNil(stored)
+		assert.Equal(rec.GetPubKey(), stored.PubKey)
+		assert.Equal(rec.GetWrappedBsrKey(), stored.WrappedBsrKey)
+		assert.Equal(rec.GetWrappedPrivKey(), stored.WrappedPrivKey)
+	})
+	t.Run("configured", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		rec, err := sessionRecording(ctx, kmsCache, sessionRepo, targetRepoFn, storageBucketRepoFn, configured)
+		require.NoError(err)
+		require.NotNil(rec)
+		assert.Equal("gzip", rec.GetCompression())
+		assert.Equal("none", rec.GetEncryption())
+	})
+	for name, sess := range map[string]*session.Session{"not recorded": notRecorded, "tcp": tcpSession} {
+		t.Run(name, func(t *testing.T) {
+			rec, err := sessionRecording(ctx, kmsCache, sessionRepo, targetRepoFn, storageBucketRepoFn, sess)
+			require.NoError(t, err)
+			assert.Nil(t, rec)
+		})
+	}
+	t.Run("no repositories", func(t *testing.T) {
+		rec, err := sessionRecording(ctx, kmsCache, nil, nil, nil, recorded)
+		require.NoError(t, err)
+		assert.Nil(t, rec)
+	})
+	t.Run("no bsr kms", func(t *testing.T) {
+		rec, err := sessionRecording(ctx, kms.TestKms(t, conn, wrapper), sessionRepo, targetRepoFn, storageBucketRepoFn, recorded)
+		assert.Error(t, err)
+		assert.Nil(t, rec)
+	})
+}
@@ -19,13 +19,12 @@ import (
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/go-bexpr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/anypb"
 )
 
 type workerServiceServer struct {
@@ -36,6 +35,8 @@ type workerServiceServer struct {
 	workerAuthRepoFn    common.WorkerAuthRepoStorageFactory
 	sessionRepoFn       session.RepositoryFactory
 	connectionRepoFn    common.ConnectionRepoFactory
+	targetRepoFn        target.RepositoryFactory
+	storageBucketRepoFn common.PluginStorageBucketRepoFactory
 	downstreams         common.Downstreamers
 	updateTimes         *sync.Map
 	kms                 *kms.Kms
@@ -55,10 +56,9 @@ var (
 
 	// getProtocolContext populates the protocol specific context fields
 	// depending on the protocol used to for the boundary connection. Defaults
-	// to noProtocolContext since tcp connections are the only protocol schemes
-	// available in OSS and are a straight forward proxy with no additional
-	// fields needed.
-	getProtocolContext = noProtocolContext
+	// to targetProtocolContext which populates the context based on the
+	// subtype of the session's target.
+	getProtocolContext = targetProtocolContext
 )
 
 // singleHopConnectionRoute returns a route consisting of the singlehop worker (the root worker id)
@@ -71,6 +71,8 @@ func NewWorkerServiceServer(
 	workerAuthRepoFn common.WorkerAuthRepoStorageFactory,
 	sessionRepoFn session.RepositoryFactory,
 	connectionRepoFn common.ConnectionRepoFactory,
+	targetRepoFn target.RepositoryFactory,
+	storageBucketRepoFn common.PluginStorageBucketRepoFactory,
 	downstreams common.Downstreamers,
 	updateTimes *sync.Map,
 	kms *kms.Kms,
@@ -82,6 +84,8 @@ func NewWorkerServiceServer(
 		workerAuthRepoFn:    workerAuthRepoFn,
 		sessionRepoFn:       sessionRepoFn,
 		connectionRepoFn:    connectionRepoFn,
+		targetRepoFn:        targetRepoFn,
+		storageBucketRepoFn: storageBucketRepoFn,
 		downstreams:         downstreams,
 		updateTimes:         updateTimes,
 		kms:                 kms,
@@ -381,20 +385,6 @@ func egressFilterSelector(sessionInfo *session.Session) string {
 	return ""
 }
 
-// noProtocolContext doesn't provide any protocol context since tcp doesn't need any
-func noProtocolContext(
-	context.Context,
-	*session.Repository,
-	*server.Repository,
-	common.WorkerAuthRepoStorageFactory,
-	*pbs.AuthorizeConnectionRequest,
-	[]string,
-	string,
-	intglobals.ControllerExtension,
-) (*anypb.Any, error) {
-	return nil, nil
-}
-
 func lookupSessionWorkerFilter(ctx context.Context, sessionInfo *session.Session, authzSummary *session.AuthzSummary, ws *workerServiceServer,
 	req *pbs.LookupSessionRequest,
 ) error {
@@ -489,20 +479,15 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		return nil, err
 	}
 
-	creds, err := sessRepo.ListSessionCredentials(ctx, sessionInfo.ProjectId, sessionInfo.PublicId)
+	workerCreds, err := injectedCredentials(ctx, sessRepo, sessionInfo)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal,
 			fmt.Sprintf("Error retrieving session credentials: %s", err))
 	}
-	var workerCreds []*pbs.Credential
-	for _, c := range creds {
-		m := &pbs.Credential{}
-		err = proto.Unmarshal(c, m)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal,
-				fmt.Sprintf("Error unmarshaling credentials: %s", err))
-		}
-		workerCreds = append(workerCreds, m)
+
+	sessRecording, err := sessionRecording(ctx, ws.kms, sessRepo, ws.targetRepoFn, ws.storageBucketRepoFn, sessionInfo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error retrieving session recording: %v", err)
 	}
 
 	resp := &pbs.LookupSessionResponse{
@@ -511,18 +496,19 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 			Certificate: sessionInfo.Certificate,
 			PrivateKey:  sessionInfo.CertificatePrivateKey,
 		},
-		Status:          sessionInfo.States[0].Status.ProtoVal(),
-		Version:         sessionInfo.Version,
-		TofuToken:       string(sessionInfo.TofuToken),
-		Endpoint:        sessionInfo.Endpoint,
-		Expiration:      sessionInfo.ExpirationTime.Timestamp,
-		ConnectionLimit: sessionInfo.ConnectionLimit,
-		ConnectionsLeft: authzSummary.ConnectionLimit,
-		HostId:          sessionInfo.HostId,
-		HostSetId:       sessionInfo.HostSetId,
-		TargetId:        sessionInfo.TargetId,
-		UserId:          sessionInfo.UserId,
-		Credentials:     workerCreds,
+		Status:           sessionInfo.States[0].Status.ProtoVal(),
+		Version:          sessionInfo.Version,
+		TofuToken:        string(sessionInfo.TofuToken),
+		Endpoint:         sessionInfo.Endpoint,
+		Expiration:       sessionInfo.ExpirationTime.Timestamp,
+		ConnectionLimit:  sessionInfo.ConnectionLimit,
+		ConnectionsLeft:  authzSummary.ConnectionLimit,
+		HostId:           sessionInfo.HostId,
+		HostSetId:        sessionInfo.HostSetId,
+		TargetId:         sessionInfo.TargetId,
+		UserId:           sessionInfo.UserId,
+		Credentials:      workerCreds,
+		SessionRecording: sessRecording,
 	}
 	if resp.ConnectionsLeft != -1 {
 		resp.ConnectionsLeft -= int32(authzSummary.CurrentConnectionCount)
@@ -623,6 +609,11 @@ func (ws *workerServiceServer) AuthorizeConnection(ctx context.Context, req *pbs
 	if sessInfo == nil {
 		return nil, status.Errorf(codes.Internal, "Invalid session info in lookup session response")
 	}
+	// The connection recording of a recorded session is added before the
+	// worker records the connection, so it can be looked up by its id.
+	if err := sessionRepo.AddRecordingConnection(ctx, sessInfo.GetPublicId(), connectionInfo.GetPublicId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "error adding connection recording: %v", err)
+	}
 
 	route, err := connectionRouteFn(ctx, w, sessInfo, authzSummary, serversRepo, ws.downstreams)
 	if err != nil {
@@ -638,6 +629,7 @@ func (ws *workerServiceServer) AuthorizeConnection(ctx context.Context, req *pbs
 	if pc, err := getProtocolContext(
 		ctx,
 		sessionRepo,
+		ws.targetRepoFn,
 		serversRepo,
 		ws.workerAuthRepoFn,
 		req,
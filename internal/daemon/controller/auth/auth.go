@@ -498,6 +498,9 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 	// it's empty
 	userData.User.Id = util.Pointer(globals.AnonymousUserId)
 
+	// The grants of an API token, which limit the permissions of its user
+	var apiTokenGrants []string
+
 	// Validate the token and fetch the corresponding user ID
 	switch v.requestInfo.TokenFormat {
 	case uint32(AuthTokenTypeUnknown):
@@ -526,6 +529,9 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 			break
 		}
 		if at != nil {
+			if at.GetApiToken() {
+				apiTokenGrants = at.Grants()
+			}
 			userData.Account.Id = util.Pointer(at.GetAuthAccountId())
 			userData.User.Id = util.Pointer(at.GetIamUserId())
 			if *userData.User.Id == "" {
@@ -668,6 +674,36 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 	}
 
 	retAcl = perms.NewACL(parsedGrants...)
+
+	// The grants of an API token don't have a scope; they limit the grants
+	// of its user in every scope the user has grants in.
+	if len(apiTokenGrants) > 0 {
+		grantScopes := make(map[string]bool, len(grantTuples))
+		var limitGrants []perms.Grant
+		for _, pair := range grantTuples {
+			if grantScopes[pair.ScopeId] {
+				continue
+			}
+			grantScopes[pair.ScopeId] = true
+			for _, grant := range apiTokenGrants {
+				permsOpts := []perms.Option{
+					perms.WithUserId(*userData.User.Id),
+					perms.WithSkipFinalValidation(true),
+				}
+				if userData.Account.Id != nil {
+					permsOpts = append(permsOpts, perms.WithAccountId(*userData.Account.Id))
+				}
+				parsed, err := perms.Parse(ctx, pair.ScopeId, grant, permsOpts...)
+				if err != nil {
+					retErr = errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse api token grant %#v", grant)))
+					return
+				}
+				limitGrants = append(limitGrants, parsed)
+			}
+		}
+		retAcl = retAcl.Intersect(perms.NewACL(limitGrants...))
+	}
+
 	aclResults = retAcl.Allowed(*v.res, v.act, *userData.User.Id)
 	// We don't set authenticated above because setting this but not authorized
 	// is used for further permissions checks, such as during recursive listing.
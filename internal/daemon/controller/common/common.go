@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/scim"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
@@ -33,6 +34,7 @@ type (
 	ConnectionRepoFactory          func() (*session.ConnectionRepository, error)
 	WorkerAuthRepoStorageFactory   func() (*server.WorkerAuthRepositoryStorage, error)
 	PluginStorageBucketRepoFactory func() (*pluginstorage.Repository, error)
+	ScimRepoFactory                func() (*scim.Repository, error)
 )
 
 // Downstreamers provides at least a minimum interface that must be met by a
@@ -38,10 +38,12 @@ import (
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/scheduler/cleaner"
 	"github.com/hashicorp/boundary/internal/scheduler/job"
+	"github.com/hashicorp/boundary/internal/scim"
 	"github.com/hashicorp/boundary/internal/server"
 	serversjob "github.com/hashicorp/boundary/internal/server/job"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/snapshot"
+	"github.com/hashicorp/boundary/internal/storage"
 	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -85,6 +87,7 @@ var (
 	downstreamWorkersTickerFactory func(context.Context, string, string, common.Downstreamers, downstreamReceiver) (downstreamWorkersTicker, error)
 	commandClientFactory           func(context.Context, *Controller) error
 	extControllerFactory           func(ctx context.Context, c *Controller, r db.Reader, w db.Writer, kms *kms.Kms) (intglobals.ControllerExtension, error)
+	recordingStorageFactory        func(ctx context.Context, path string) (storage.RecordingStorage, error)
 )
 
 type Controller struct {
@@ -127,6 +130,7 @@ type Controller struct {
 	OidcRepoFn                common.OidcAuthRepoFactory
 	LdapRepoFn                common.LdapAuthRepoFactory
 	PasswordAuthRepoFn        common.PasswordAuthRepoFactory
+	ScimRepoFn                common.ScimRepoFactory
 	ServersRepoFn             common.ServersRepoFactory
 	SessionRepoFn             session.RepositoryFactory
 	ConnectionRepoFn          common.ConnectionRepoFactory
@@ -151,6 +155,10 @@ type Controller struct {
 
 	// ControllerExtension defines a std way to extend the controller
 	ControllerExtension intglobals.ControllerExtension
+
+	// RecordingStorage is used to read session recordings, it is nil when
+	// the controller is not configured with a recording storage path.
+	RecordingStorage storage.RecordingStorage
 }
 
 func New(ctx context.Context, conf *Config) (*Controller, error) {
@@ -407,6 +415,9 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.PasswordAuthRepoFn = func() (*password.Repository, error) {
 		return password.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.ScimRepoFn = func() (*scim.Repository, error) {
+		return scim.NewRepository(ctx, dbase, dbase, c.kms)
+	}
 	c.TargetRepoFn = func(o ...target.Option) (*target.Repository, error) {
 		return target.NewRepository(ctx, dbase, dbase, c.kms, o...)
 	}
@@ -422,6 +433,12 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.WorkerAuthRepoStorageFn = func() (*server.WorkerAuthRepositoryStorage, error) {
 		return server.NewRepositoryStorage(ctx, dbase, dbase, c.kms)
 	}
+	if path := c.conf.RawConfig.Controller.RecordingStoragePath; path != "" && recordingStorageFactory != nil {
+		c.RecordingStorage, err = recordingStorageFactory(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("error creating recording storage: %w", err)
+		}
+	}
 
 	// Check that credentials are available at startup, to avoid some harmless
 	// but nasty-looking errors
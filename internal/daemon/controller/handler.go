@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/hosts"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/managed_groups"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/roles"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/scim"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/scopes"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/session_recordings"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/sessions"
@@ -71,9 +72,14 @@ func createMuxWithEndpoints(c *Controller, props HandlerProperties) (http.Handle
 	if err := registerGrpcGatewayEndpoints(props.CancelCtx, grpcGwMux, gatewayDialOptions(c.apiGrpcServerListener)...); err != nil {
 		return nil, nil, err
 	}
+	scimHandler, err := scim.NewHandler(props.CancelCtx, c.ScimRepoFn, c.IamRepoFn, c.PasswordAuthRepoFn, c.OidcRepoFn)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	mux := http.NewServeMux()
 	mux.Handle("/v1/", grpcGwMux)
+	mux.Handle(scim.PathPrefix, scimHandler)
 	mux.Handle(uiPath, handleUi(c))
 
 	isUiRequest := func(req *http.Request) bool {
@@ -163,14 +169,14 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterAccountServiceServer(s, accts)
 	}
 	if _, ok := currentServices[services.AuthMethodService_ServiceDesc.ServiceName]; !ok {
-		authMethods, err := authmethods.NewService(c.baseContext, c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.IamRepoFn, c.AuthTokenRepoFn, c.LdapRepoFn)
+		authMethods, err := authmethods.NewService(c.baseContext, c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.IamRepoFn, c.AuthTokenRepoFn, c.LdapRepoFn, c.ScimRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create auth method handler service: %w", err)
 		}
 		services.RegisterAuthMethodServiceServer(s, authMethods)
 	}
 	if _, ok := currentServices[services.AuthTokenService_ServiceDesc.ServiceName]; !ok {
-		authtoks, err := authtokens.NewService(c.baseContext, c.AuthTokenRepoFn, c.IamRepoFn)
+		authtoks, err := authtokens.NewService(c.baseContext, c.AuthTokenRepoFn, c.IamRepoFn, c.kms)
 		if err != nil {
 			return fmt.Errorf("failed to create auth token handler service: %w", err)
 		}
@@ -206,8 +212,11 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		srs, err := session_recordings.NewServiceFn(
 			c.baseContext,
 			c.IamRepoFn,
+			c.SessionRepoFn,
+			c.PluginStorageBucketRepoFn,
 			c.workerStatusGracePeriod,
 			c.kms,
+			c.RecordingStorage,
 			c.ControllerExtension)
 		if err != nil {
 			return fmt.Errorf("failed to create session recording handler service: %w", err)
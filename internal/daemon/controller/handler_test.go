@@ -157,6 +157,11 @@ func TestHandleImplementedPaths(t *testing.T) {
 			// custom methods
 			"v1/accounts/someid:set-password",
 			"v1/accounts/someid:change-password",
+			"v1/accounts/someid:enroll-totp",
+			"v1/accounts/someid:confirm-totp",
+			"v1/accounts/someid:remove-totp",
+			"v1/accounts/someid:unlock",
+			"v1/auth-tokens/someid:refresh",
 			"v1/auth-methods/someid:authenticate",
 			"v1/groups/someid:add-members",
 			"v1/groups/someid:set-members",
@@ -46,6 +46,7 @@ const (
 	loginNameKey         = "login_name"
 	newPasswordField     = "new_password"
 	currentPasswordField = "current_password"
+	codeField            = "code"
 
 	// oidc field names
 	issuerField     = "attributes.issuer"
@@ -77,6 +78,10 @@ var (
 			action.Delete,
 			action.SetPassword,
 			action.ChangePassword,
+			action.EnrollTotp,
+			action.ConfirmTotp,
+			action.RemoveTotp,
+			action.Unlock,
 		},
 		oidc.Subtype: {
 			action.NoOp,
@@ -412,6 +417,144 @@ func (s Service) SetPassword(ctx context.Context, req *pbs.SetPasswordRequest) (
 	return &pbs.SetPasswordResponse{Item: item}, nil
 }
 
+// EnrollTotp implements the interface pbs.AccountServiceServer.
+func (s Service) EnrollTotp(ctx context.Context, req *pbs.EnrollTotpRequest) (*pbs.EnrollTotpResponse, error) {
+	const op = "accounts.(Service).EnrollTotp"
+
+	if err := validateEnrollTotpRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.EnrollTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	e, err := repo.EnrollTotp(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil, handlers.NotFoundErrorf("Account not found.")
+		case errors.Match(errors.T(errors.NotUnique), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "The account already has a confirmed TOTP enrollment; it must be removed before enrolling again.")
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.EnrollTotpResponse{
+		AccountId: e.AccountId,
+		Secret:    e.Secret,
+		Url:       e.Url,
+	}, nil
+}
+
+// ConfirmTotp implements the interface pbs.AccountServiceServer.
+func (s Service) ConfirmTotp(ctx context.Context, req *pbs.ConfirmTotpRequest) (*pbs.ConfirmTotpResponse, error) {
+	const op = "accounts.(Service).ConfirmTotp"
+
+	if err := validateConfirmTotpRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.ConfirmTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	recoveryCodes, err := repo.ConfirmTotp(ctx, authResults.Scope.GetId(), req.GetId(), req.GetCode())
+	if err != nil {
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil, handlers.NotFoundErrorf("No TOTP enrollment found for the account.")
+		case errors.Match(errors.T(errors.NotUnique), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{idField: "The TOTP enrollment of the account is already confirmed."})
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if recoveryCodes == nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{codeField: "Incorrect TOTP code."})
+	}
+	return &pbs.ConfirmTotpResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// RemoveTotp implements the interface pbs.AccountServiceServer.
+func (s Service) RemoveTotp(ctx context.Context, req *pbs.RemoveTotpRequest) (*pbs.RemoveTotpResponse, error) {
+	const op = "accounts.(Service).RemoveTotp"
+
+	if err := validateRemoveTotpRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.RemoveTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if _, err := repo.DeleteTotp(ctx, authResults.Scope.GetId(), req.GetId()); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.RemoveTotpResponse{}, nil
+}
+
+// Unlock implements the interface pbs.AccountServiceServer.
+func (s Service) Unlock(ctx context.Context, req *pbs.UnlockRequest) (*pbs.UnlockResponse, error) {
+	const op = "accounts.(Service).Unlock"
+
+	if err := validateUnlockRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Unlock)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if _, err := repo.UnlockAccount(ctx, authResults.Scope.GetId(), req.GetId()); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Account not found.")
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	acct, _, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, acct.GetPublicId())]).Strings()))
+	}
+
+	item, err := toProto(ctx, acct, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.UnlockResponse{Item: item}, nil
+}
+
 // getFromRepo returns the account and, if available, managed groups the account
 // belongs to within the auth method
 func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []string, error) {
@@ -508,6 +651,9 @@ func (s Service) createPwInRepo(ctx context.Context, am auth.AuthMethod, item *p
 	}
 	out, err := repo.CreateAccount(ctx, am.GetScopeId(), a, createOpts...)
 	if err != nil {
+		if apiErr := passwordPolicyError(err, "attributes.password"); apiErr != nil {
+			return nil, apiErr
+		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	if out == nil {
@@ -880,6 +1026,9 @@ func (s Service) changePasswordInRepo(ctx context.Context, scopeId, id string, v
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
 				map[string]string{"new_password": "New password equal to current password."})
 		}
+		if apiErr := passwordPolicyError(err, "new_password"); apiErr != nil {
+			return nil, apiErr
+		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	if out == nil {
@@ -904,11 +1053,34 @@ func (s Service) setPasswordInRepo(ctx context.Context, scopeId, id string, vers
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
 				map[string]string{"password": "Password is too short."})
 		}
+		if apiErr := passwordPolicyError(err, "password"); apiErr != nil {
+			return nil, apiErr
+		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	return out, nil
 }
 
+// passwordPolicyError returns an invalid argument error for field if err was
+// caused by a password which does not satisfy the password policy of the auth
+// method.  It returns nil for any other error.
+func passwordPolicyError(err error, field string) *handlers.ApiError {
+	var msg string
+	switch {
+	case errors.Match(errors.T(errors.PasswordTooShort), err):
+		msg = "Password is too short."
+	case errors.Match(errors.T(errors.PasswordTooSimple), err):
+		msg = "Password does not contain enough character classes or contains the login name."
+	case errors.Match(errors.T(errors.PasswordCommon), err):
+		msg = "Password is too common."
+	case errors.Match(errors.T(errors.PasswordReused), err):
+		msg = "Password was used previously."
+	default:
+		return nil
+	}
+	return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{field: msg})
+}
+
 func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (auth.AuthMethod, requestauth.VerifyResults) {
 	res := requestauth.VerifyResults{}
 	pwRepo, err := s.pwRepoFn()
@@ -1367,3 +1539,66 @@ func validateSetPasswordRequest(ctx context.Context, req *pbs.SetPasswordRequest
 	}
 	return nil
 }
+
+func validateEnrollTotpRequest(ctx context.Context, req *pbs.EnrollTotpRequest) error {
+	const op = "accounts.validateEnrollTotpRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateConfirmTotpRequest(ctx context.Context, req *pbs.ConfirmTotpRequest) error {
+	const op = "accounts.validateConfirmTotpRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if req.GetCode() == "" {
+		badFields[codeField] = "This is a required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateRemoveTotpRequest(ctx context.Context, req *pbs.RemoveTotpRequest) error {
+	const op = "accounts.validateRemoveTotpRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateUnlockRequest(ctx context.Context, req *pbs.UnlockRequest) error {
+	const op = "accounts.validateUnlockRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
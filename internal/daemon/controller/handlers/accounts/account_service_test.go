@@ -48,6 +48,10 @@ var (
 		action.Delete.String(),
 		action.SetPassword.String(),
 		action.ChangePassword.String(),
+		action.EnrollTotp.String(),
+		action.ConfirmTotp.String(),
+		action.RemoveTotp.String(),
+		action.Unlock.String(),
 	}
 	oidcAuthorizedActions = []string{
 		action.NoOp.String(),
@@ -3151,3 +3155,148 @@ func TestChangePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestTotp(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(ctx, rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn)
+	require.NoError(t, err, "Error when getting new auth_method service.")
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	acct := password.TestAccount(t, conn, am.GetPublicId(), "testusername")
+	authCtx := requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	t.Run("enroll", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := tested.EnrollTotp(authCtx, &pbs.EnrollTotpRequest{Id: acct.GetPublicId()})
+		require.NoError(err)
+		assert.Equal(acct.GetPublicId(), got.GetAccountId())
+		assert.NotEmpty(got.GetSecret())
+		assert.True(strings.HasPrefix(got.GetUrl(), "otpauth://totp/"))
+		assert.Contains(got.GetUrl(), got.GetSecret())
+	})
+	t.Run("confirm with wrong code", func(t *testing.T) {
+		got, err := tested.ConfirmTotp(authCtx, &pbs.ConfirmTotpRequest{Id: acct.GetPublicId(), Code: "000000"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		assert.Nil(t, got)
+	})
+	t.Run("remove", func(t *testing.T) {
+		_, err := tested.RemoveTotp(authCtx, &pbs.RemoveTotpRequest{Id: acct.GetPublicId()})
+		require.NoError(t, err)
+
+		got, err := tested.ConfirmTotp(authCtx, &pbs.ConfirmTotpRequest{Id: acct.GetPublicId(), Code: "123456"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.NotFoundError()), "got error %v", err)
+		assert.Nil(t, got)
+	})
+
+	badRequestCases := []struct {
+		name      string
+		accountId string
+		code      string
+	}{
+		{
+			name:      "empty account id",
+			accountId: "",
+			code:      "123456",
+		},
+		{
+			name:      "oidc account id",
+			accountId: globals.OidcAccountPrefix + "_1234567890",
+			code:      "123456",
+		},
+		{
+			name:      "empty code",
+			accountId: acct.GetPublicId(),
+			code:      "",
+		},
+	}
+	for _, tt := range badRequestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			got, err := tested.ConfirmTotp(authCtx, &pbs.ConfirmTotpRequest{Id: tt.accountId, Code: tt.code})
+			assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+			assert.Nil(got)
+		})
+	}
+}
+
+func TestUnlock(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(ctx, rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn)
+	require.NoError(t, err, "Error when getting new auth_method service.")
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	acct := password.TestAccount(t, conn, am.GetPublicId(), "testusername")
+	authCtx := requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := tested.Unlock(authCtx, &pbs.UnlockRequest{Id: acct.GetPublicId()})
+		require.NoError(err)
+		assert.Equal(acct.GetPublicId(), got.GetItem().GetId())
+		assert.Equal(acct.GetVersion(), got.GetItem().GetVersion())
+	})
+	t.Run("not found", func(t *testing.T) {
+		got, err := tested.Unlock(authCtx, &pbs.UnlockRequest{Id: globals.PasswordAccountPrefix + "_DoesntExis"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.NotFoundError()), "got error %v", err)
+		assert.Nil(t, got)
+	})
+
+	badRequestCases := []struct {
+		name      string
+		accountId string
+	}{
+		{
+			name:      "empty account id",
+			accountId: "",
+		},
+		{
+			name:      "oidc account id",
+			accountId: globals.OidcAccountPrefix + "_1234567890",
+		},
+	}
+	for _, tt := range badRequestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			got, err := tested.Unlock(authCtx, &pbs.UnlockRequest{Id: tt.accountId})
+			assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+			assert.Nil(got)
+		})
+	}
+}
@@ -89,12 +89,13 @@ type Service struct {
 	iamRepoFn  common.IamRepoFactory
 	atRepoFn   common.AuthTokenRepoFactory
 	ldapRepoFn common.LdapAuthRepoFactory
+	scimRepoFn common.ScimRepoFactory
 }
 
 var _ pbs.AuthMethodServiceServer = (*Service)(nil)
 
 // NewService returns a auth method service which handles auth method related requests to boundary.
-func NewService(ctx context.Context, kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoFn common.OidcAuthRepoFactory, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory, ldapRepoFn common.LdapAuthRepoFactory, opt ...handlers.Option) (Service, error) {
+func NewService(ctx context.Context, kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoFn common.OidcAuthRepoFactory, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory, ldapRepoFn common.LdapAuthRepoFactory, scimRepoFn common.ScimRepoFactory, opt ...handlers.Option) (Service, error) {
 	const op = "authmethods.NewService"
 	if kms == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
@@ -108,13 +109,16 @@ func NewService(ctx context.Context, kms *kms.Kms, pwRepoFn common.PasswordAuthR
 	if ldapRepoFn == nil {
 		return Service{}, fmt.Errorf("nil ldap repository provided")
 	}
+	if scimRepoFn == nil {
+		return Service{}, fmt.Errorf("nil scim repository provided")
+	}
 	if iamRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
 	}
 	if atRepoFn == nil {
 		return Service{}, fmt.Errorf("nil auth token repository provided")
 	}
-	s := Service{kms: kms, pwRepoFn: pwRepoFn, oidcRepoFn: oidcRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn, ldapRepoFn: ldapRepoFn}
+	s := Service{kms: kms, pwRepoFn: pwRepoFn, oidcRepoFn: oidcRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn, ldapRepoFn: ldapRepoFn, scimRepoFn: scimRepoFn}
 
 	return s, nil
 }
@@ -842,8 +846,15 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 		}
 		out.Attrs = &pb.AuthMethod_PasswordAuthMethodAttributes{
 			PasswordAuthMethodAttributes: &pb.PasswordAuthMethodAttributes{
-				MinLoginNameLength: i.GetMinLoginNameLength(),
-				MinPasswordLength:  i.GetMinPasswordLength(),
+				MinLoginNameLength:          i.GetMinLoginNameLength(),
+				MinPasswordLength:           i.GetMinPasswordLength(),
+				TotpRequired:                i.GetTotpRequired(),
+				MinPasswordCharacterClasses: i.GetMinPasswordCharacterClasses(),
+				DenyCommonPasswords:         i.GetDenyCommonPasswords(),
+				PasswordHistoryCount:        i.GetPasswordHistoryCount(),
+				MaxPasswordAgeDays:          i.GetMaxPasswordAgeDays(),
+				LockoutThreshold:            i.GetLockoutThreshold(),
+				LockoutDurationSeconds:      i.GetLockoutDurationSeconds(),
 			},
 		}
 	case *oidc.AuthMethod:
@@ -986,6 +997,9 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest
 		switch subtypes.SubtypeFromType(domain, req.GetItem().GetType()) {
 		case password.Subtype:
 			// Password attributes are not required when creating a password auth method.
+			if req.GetItem().GetPasswordAuthMethodAttributes().GetMinPasswordCharacterClasses() > 4 {
+				badFields[minPasswordCharacterClassesField] = "Must be between 0 and 4."
+			}
 		case oidc.Subtype:
 			attrs := req.GetItem().GetOidcAuthMethodsAttributes()
 			if attrs == nil {
@@ -1089,6 +1103,9 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAuthMethodRequest
 			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != password.Subtype {
 				badFields[typeField] = "Cannot modify the resource type."
 			}
+			if req.GetItem().GetPasswordAuthMethodAttributes().GetMinPasswordCharacterClasses() > 4 {
+				badFields[minPasswordCharacterClassesField] = "Must be between 0 and 4."
+			}
 		case oidc.Subtype:
 			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != oidc.Subtype {
 				badFields[typeField] = "Cannot modify the resource type."
@@ -63,6 +63,8 @@ func init() {
 		action.Delete,
 		action.ChangeState,
 		action.Authenticate,
+		action.CreateScimToken,
+		action.DeleteScimToken,
 	}
 }
 
@@ -22,9 +22,12 @@ import (
 
 const (
 	// password field names
-	loginNameField = "login_name"
-	passwordField  = "password"
-	loginCommand   = "login"
+	loginNameField                   = "login_name"
+	passwordField                    = "password"
+	loginCommand                     = "login"
+	minPasswordCharacterClassesField = "attributes.min_password_character_classes"
+	totpCodeField                    = "attributes.totp_code"
+	newPasswordField                 = "attributes.new_password"
 )
 
 var pwMaskManager handlers.MaskManager
@@ -41,6 +44,8 @@ func init() {
 		action.Update,
 		action.Delete,
 		action.Authenticate,
+		action.CreateScimToken,
+		action.DeleteScimToken,
 	}
 }
 
@@ -92,14 +97,14 @@ func (s Service) updatePwInRepo(ctx context.Context, scopeId, id string, mask []
 
 func (s Service) authenticatePassword(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
 	reqAttrs := req.GetPasswordLoginAttributes()
-	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs.LoginName, reqAttrs.Password)
+	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs.LoginName, reqAttrs.Password, reqAttrs.TotpCode, reqAttrs.NewPassword)
 	if err != nil {
 		return nil, err
 	}
 	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
 }
 
-func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw, totpCode, newPw string) (*pba.AuthToken, error) {
 	iamRepo, err := s.iamRepoFn()
 	if err != nil {
 		return nil, err
@@ -113,8 +118,39 @@ func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethod
 		return nil, err
 	}
 
-	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw)
-	if err != nil {
+	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw, password.WithTotpCode(totpCode), password.WithNewPassword(newPw))
+	switch {
+	case errors.Match(errors.T(errors.TotpCodeRequired), err):
+		return nil, handlers.InvalidArgumentErrorf("A TOTP code is required.", map[string]string{
+			totpCodeField: "A TOTP code or recovery code is required for this account.",
+		})
+	case errors.Match(errors.T(errors.PasswordExpired), err):
+		return nil, handlers.InvalidArgumentErrorf("The password has expired.", map[string]string{
+			newPasswordField: "A new password is required because the password of this account has expired.",
+		})
+	case errors.Match(errors.T(errors.PasswordTooShort), err):
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{
+			newPasswordField: "Password is too short.",
+		})
+	case errors.Match(errors.T(errors.PasswordTooSimple), err):
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{
+			newPasswordField: "Password is too simple.",
+		})
+	case errors.Match(errors.T(errors.PasswordCommon), err):
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{
+			newPasswordField: "Password is too common.",
+		})
+	case errors.Match(errors.T(errors.PasswordReused), err):
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{
+			newPasswordField: "Password was used previously.",
+		})
+	case errors.Match(errors.T(errors.PasswordsEqual), err):
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{
+			newPasswordField: "New password equal to current password.",
+		})
+	case errors.Match(errors.T(errors.TotpEnrollmentRequired), err):
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "The auth method requires TOTP but the account has no confirmed TOTP enrollment.")
+	case err != nil:
 		return nil, err
 	}
 	if acct == nil {
@@ -198,5 +234,12 @@ func toStoragePwAuthMethod(ctx context.Context, scopeId string, item *pb.AuthMet
 	if pwAttrs.GetMinPasswordLength() != 0 {
 		u.MinPasswordLength = pwAttrs.GetMinPasswordLength()
 	}
+	u.TotpRequired = pwAttrs.GetTotpRequired()
+	u.MinPasswordCharacterClasses = pwAttrs.GetMinPasswordCharacterClasses()
+	u.DenyCommonPasswords = pwAttrs.GetDenyCommonPasswords()
+	u.PasswordHistoryCount = pwAttrs.GetPasswordHistoryCount()
+	u.MaxPasswordAgeDays = pwAttrs.GetMaxPasswordAgeDays()
+	u.LockoutThreshold = pwAttrs.GetLockoutThreshold()
+	u.LockoutDurationSeconds = pwAttrs.GetLockoutDurationSeconds()
 	return u, nil
 }
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authmethods
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+// CreateScimToken implements the interface pbs.AuthMethodServiceServer.
+func (s Service) CreateScimToken(ctx context.Context, req *pbs.CreateScimTokenRequest) (*pbs.CreateScimTokenResponse, error) {
+	const op = "authmethods.(Service).CreateScimToken"
+	if err := validateScimTokenRequest(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.CreateScimToken)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.scimRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	tok, err := repo.CreateToken(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.CreateScimTokenResponse{
+		Id:           tok.GetPublicId(),
+		AuthMethodId: tok.GetAuthMethodId(),
+		Token:        tok.BearerToken(),
+		CreatedTime:  tok.GetCreateTime().GetTimestamp(),
+	}, nil
+}
+
+// DeleteScimToken implements the interface pbs.AuthMethodServiceServer.
+func (s Service) DeleteScimToken(ctx context.Context, req *pbs.DeleteScimTokenRequest) (*pbs.DeleteScimTokenResponse, error) {
+	const op = "authmethods.(Service).DeleteScimToken"
+	if err := validateScimTokenRequest(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DeleteScimToken)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.scimRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := repo.DeleteToken(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if rows == 0 {
+		return nil, handlers.NotFoundErrorf("Auth Method %q has no SCIM token.", req.GetId())
+	}
+	return &pbs.DeleteScimTokenResponse{}, nil
+}
+
+// validateScimTokenRequest validates the id of a request managing the SCIM
+// token of an auth method.  SCIM provisions users through password and OIDC
+// accounts only.
+func validateScimTokenRequest(ctx context.Context, id string) error {
+	switch subtypes.SubtypeFromId(domain, id) {
+	case password.Subtype, oidc.Subtype:
+	default:
+		return handlers.NotFoundErrorf("This endpoint is only available for the %q and %q Auth Method types.", password.Subtype.String(), oidc.Subtype.String())
+	}
+	if !handlers.ValidId(handlers.Id(id), globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix) {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", map[string]string{globals.IdField: "Invalid formatted identifier."})
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ package authtokens
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -22,6 +25,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
@@ -33,11 +37,13 @@ var (
 		action.ReadSelf,
 		action.Delete,
 		action.DeleteSelf,
+		action.RefreshSelf,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
 	// this collection
 	CollectionActions = action.ActionSet{
+		action.Create,
 		action.List,
 	}
 )
@@ -48,12 +54,13 @@ type Service struct {
 
 	repoFn    common.AuthTokenRepoFactory
 	iamRepoFn common.IamRepoFactory
+	kms       *kms.Kms
 }
 
 var _ pbs.AuthTokenServiceServer = (*Service)(nil)
 
 // NewService returns a user service which handles user related requests to boundary.
-func NewService(ctx context.Context, repo common.AuthTokenRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+func NewService(ctx context.Context, repo common.AuthTokenRepoFactory, iamRepoFn common.IamRepoFactory, kms *kms.Kms) (Service, error) {
 	const op = "authtoken.NewService"
 	if repo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing auth token repository")
@@ -61,7 +68,10 @@ func NewService(ctx context.Context, repo common.AuthTokenRepoFactory, iamRepoFn
 	if iamRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
 	}
-	return Service{repoFn: repo, iamRepoFn: iamRepoFn}, nil
+	if kms == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+	return Service{repoFn: repo, iamRepoFn: iamRepoFn, kms: kms}, nil
 }
 
 // ListAuthTokens implements the interface pbs.AuthTokenServiceServer.
@@ -198,6 +208,49 @@ func (s Service) GetAuthToken(ctx context.Context, req *pbs.GetAuthTokenRequest)
 	return &pbs.GetAuthTokenResponse{Item: item}, nil
 }
 
+// CreateAuthToken implements the interface pbs.AuthTokenServiceServer.
+func (s Service) CreateAuthToken(ctx context.Context, req *pbs.CreateAuthTokenRequest) (*pbs.CreateAuthTokenResponse, error) {
+	const op = "authtokens.(Service).CreateAuthToken"
+
+	if err := validateCreateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	at, err := s.createInRepo(ctx, authResults, req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+	token, err := authtoken.EncryptToken(ctx, s.kms, at.GetScopeId(), at.GetPublicId(), at.GetToken())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, at.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, at, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	// The token value is only ever returned when the API token is created.
+	item.Token = at.GetPublicId() + "_" + token
+	return &pbs.CreateAuthTokenResponse{Item: item, Uri: fmt.Sprintf("auth-tokens/%s", item.GetId())}, nil
+}
+
 // DeleteAuthToken implements the interface pbs.AuthTokenServiceServer.
 func (s Service) DeleteAuthToken(ctx context.Context, req *pbs.DeleteAuthTokenRequest) (*pbs.DeleteAuthTokenResponse, error) {
 	if err := validateDeleteRequest(req); err != nil {
@@ -229,6 +282,54 @@ func (s Service) DeleteAuthToken(ctx context.Context, req *pbs.DeleteAuthTokenRe
 	return nil, nil
 }
 
+// RefreshAuthToken implements the interface pbs.AuthTokenServiceServer.
+func (s Service) RefreshAuthToken(ctx context.Context, req *pbs.RefreshAuthTokenRequest) (*pbs.RefreshAuthTokenResponse, error) {
+	const op = "authtokens.(Service).RefreshAuthToken"
+
+	if err := validateRefreshRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RefreshSelf)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	// The new token value is returned to the caller, so only the auth token
+	// used to make the request can be refreshed.
+	if req.GetId() != authResults.AuthTokenId {
+		return nil, handlers.ForbiddenError()
+	}
+
+	at, err := s.refreshInRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	token, err := authtoken.EncryptToken(ctx, s.kms, at.GetScopeId(), at.GetPublicId(), at.GetToken())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, at.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, at, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	item.Token = at.GetPublicId() + "_" + token
+	return &pbs.RefreshAuthTokenResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*authtoken.AuthToken, error) {
 	const op = "authtokens.(Service).getFromRepo"
 	repo, err := s.repoFn()
@@ -245,6 +346,56 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*authtoken.AuthTok
 	return at, nil
 }
 
+func (s Service) createInRepo(ctx context.Context, authResults auth.VerifyResults, item *pb.AuthToken) (*authtoken.AuthToken, error) {
+	const op = "authtokens.(Service).createInRepo"
+	if authResults.UserData.Account.Id == nil || *authResults.UserData.Account.Id == "" || authResults.AuthTokenId == "" {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "API tokens can only be created by users authenticated with an account.")
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	requestToken, err := repo.LookupAuthToken(ctx, authResults.AuthTokenId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if requestToken == nil {
+		return nil, handlers.UnauthenticatedError()
+	}
+	if requestToken.GetApiToken() {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "API tokens cannot be used to create API tokens.")
+	}
+	if requestToken.GetScopeId() != item.GetScopeId() {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{globals.ScopeIdField: "Must be the scope of the account of the requesting user."})
+	}
+
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	u, _, err := iamRepo.LookupUser(ctx, authResults.UserId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var opts []authtoken.Option
+	if item.GetDescription() != nil {
+		opts = append(opts, authtoken.WithDescription(item.GetDescription().GetValue()))
+	}
+	if item.GetExpirationTime() != nil {
+		opts = append(opts, authtoken.WithExpirationTime(item.GetExpirationTime().AsTime()))
+	}
+	if len(item.GetGrantStrings()) > 0 {
+		opts = append(opts, authtoken.WithGrants(item.GetGrantStrings()))
+	}
+	out, err := repo.CreateApiToken(ctx, u, *authResults.UserData.Account.Id, item.GetName().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create api token"))
+	}
+	return out, nil
+}
+
 func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	const op = "authtokens.(Service).deleteFromRepo"
 	repo, err := s.repoFn()
@@ -261,6 +412,22 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
+func (s Service) refreshInRepo(ctx context.Context, id string) (*authtoken.AuthToken, error) {
+	const op = "authtokens.(Service).refreshInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	at, err := repo.RefreshAuthToken(ctx, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("AuthToken %q doesn't exist or has expired.", id)
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to refresh auth token"))
+	}
+	return at, nil
+}
+
 func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*authtoken.AuthToken, error) {
 	repo, err := s.repoFn()
 	_ = repo
@@ -353,6 +520,18 @@ func toProto(ctx context.Context, in *authtoken.AuthToken, opt ...handlers.Optio
 	if outputFields.Has(globals.ExpirationTimeField) {
 		out.ExpirationTime = in.GetExpirationTime().GetTimestamp()
 	}
+	if outputFields.Has(globals.NameField) && in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if outputFields.Has(globals.ApiTokenField) {
+		out.ApiToken = in.GetApiToken()
+	}
+	if outputFields.Has(globals.GrantStringsField) {
+		out.GrantStrings = in.Grants()
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -372,10 +551,102 @@ func validateGetRequest(req *pbs.GetAuthTokenRequest) error {
 	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.AuthTokenPrefix)
 }
 
+func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthTokenRequest) error {
+	item := req.GetItem()
+	if item == nil {
+		return handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"item": "This field is required."})
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(item.GetScopeId()), scope.Org.Prefix()) &&
+		item.GetScopeId() != scope.Global.String() {
+		badFields[globals.ScopeIdField] = "This field must be 'global' or a valid org scope id."
+	}
+	if item.GetId() != "" {
+		badFields[globals.IdField] = "This is a read only field."
+	}
+	if item.GetToken() != "" {
+		badFields["token"] = "This is a read only field."
+	}
+	if item.GetUserId() != "" {
+		badFields[globals.UserIdField] = "This is a read only field."
+	}
+	if item.GetAuthMethodId() != "" {
+		badFields[globals.AuthMethodIdField] = "This is a read only field."
+	}
+	if item.GetAccountId() != "" {
+		badFields[globals.AccountIdField] = "This is a read only field."
+	}
+	if item.GetCreatedTime() != nil {
+		badFields[globals.CreatedTimeField] = "This is a read only field."
+	}
+	if item.GetUpdatedTime() != nil {
+		badFields[globals.UpdatedTimeField] = "This is a read only field."
+	}
+	if item.GetApproximateLastUsedTime() != nil {
+		badFields[globals.ApproximateLastUsedTimeField] = "This is a read only field."
+	}
+	if item.GetApiToken() {
+		badFields[globals.ApiTokenField] = "This is a read only field."
+	}
+	if item.GetExpirationTime() != nil && !item.GetExpirationTime().AsTime().After(time.Now()) {
+		badFields[globals.ExpirationTimeField] = "Must be in the future."
+	}
+	name := strings.TrimSpace(item.GetName().GetValue())
+	switch {
+	case name == "":
+		badFields[globals.NameField] = "This field is required."
+	case !handlers.ValidNameDescription(name):
+		badFields[globals.NameField] = "Name contains unprintable characters."
+	default:
+		item.GetName().Value = name
+	}
+	if item.GetDescription() != nil {
+		description := strings.TrimSpace(item.GetDescription().GetValue())
+		switch {
+		case description == "":
+			badFields[globals.DescriptionField] = "Cannot set empty string as description."
+		case !handlers.ValidNameDescription(description):
+			badFields[globals.DescriptionField] = "Description contains unprintable characters."
+		default:
+			item.GetDescription().Value = description
+		}
+	}
+	for _, v := range item.GetGrantStrings() {
+		if len(v) == 0 {
+			badFields[globals.GrantStringsField] = "Grant strings must not be empty."
+			break
+		}
+		if _, err := perms.Parse(ctx, "p_anything", v); err != nil {
+			badFields[globals.GrantStringsField] = fmt.Sprintf("Improperly formatted grant %q.", v)
+			break
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
 func validateDeleteRequest(req *pbs.DeleteAuthTokenRequest) error {
 	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.AuthTokenPrefix)
 }
 
+func validateRefreshRequest(ctx context.Context, req *pbs.RefreshAuthTokenRequest) error {
+	const op = "authtokens.validateRefreshRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.AuthTokenPrefix) {
+		badFields[globals.IdField] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(ctx context.Context, req *pbs.ListAuthTokensRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
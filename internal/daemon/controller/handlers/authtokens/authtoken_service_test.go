@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
@@ -28,12 +29,14 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "read:self", "delete", "delete:self"}
+var testAuthorizedActions = []string{"no-op", "read", "read:self", "delete", "delete:self", "refresh:self"}
 
 func TestGetSelf(t *testing.T) {
 	ctx := context.Background()
@@ -52,7 +55,7 @@ func TestGetSelf(t *testing.T) {
 		return server.NewRepository(ctx, rw, rw, kms)
 	}
 
-	a, err := authtokens.NewService(ctx, tokenRepoFn, iamRepoFn)
+	a, err := authtokens.NewService(ctx, tokenRepoFn, iamRepoFn, kms)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -113,7 +116,7 @@ func TestGetSelf(t *testing.T) {
 			require.NotNil(got)
 			assert.Equal(tc.token.GetPublicId(), got.GetItem().GetId())
 			// Ensure we didn't simply have e.g. read on all tokens
-			assert.Equal([]string{"read:self", "delete:self"}, got.Item.GetAuthorizedActions())
+			assert.Equal([]string{"read:self", "delete:self", "refresh:self"}, got.Item.GetAuthorizedActions())
 		})
 	}
 }
@@ -131,7 +134,7 @@ func TestGet(t *testing.T) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
 
-	s, err := authtokens.NewService(ctx, repoFn, iamRepoFn)
+	s, err := authtokens.NewService(ctx, repoFn, iamRepoFn, kms)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -236,7 +239,7 @@ func TestList_Self(t *testing.T) {
 		},
 	}
 
-	a, err := authtokens.NewService(testCtx, tokenRepoFn, iamRepoFn)
+	a, err := authtokens.NewService(testCtx, tokenRepoFn, iamRepoFn, kms)
 	require.NoError(t, err)
 
 	for _, tc := range cases {
@@ -258,7 +261,7 @@ func TestList_Self(t *testing.T) {
 			require.Len(got.Items, 1)
 			assert.Equal(got.Items[0].GetId(), tc.requester.GetPublicId())
 			// Ensure we didn't simply have e.g. read on all tokens
-			assert.Equal(got.Items[0].GetAuthorizedActions(), []string{"read:self", "delete:self"})
+			assert.Equal(got.Items[0].GetAuthorizedActions(), []string{"read:self", "delete:self", "refresh:self"})
 		})
 	}
 }
@@ -390,7 +393,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := authtokens.NewService(context.Background(), repoFn, iamRepoFn)
+			s, err := authtokens.NewService(context.Background(), repoFn, iamRepoFn, kms)
 			assert, require := assert.New(t), require.New(t)
 			require.NoError(err, "Couldn't create new user service.")
 
@@ -419,6 +422,236 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestCreate(t *testing.T) {
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(testCtx, rw, rw, kms)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(testCtx, rw, rw, kms)
+	}
+
+	a, err := authtokens.NewService(testCtx, tokenRepoFn, iamRepoFn, kms)
+	require.NoError(t, err, "Couldn't create new auth token service.")
+
+	o, _ := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	r := iam.TestRole(t, conn, o.GetPublicId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "type=auth-token;actions=create")
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+
+	tokenRepo, err := tokenRepoFn()
+	require.NoError(t, err)
+	u, _, err := iamRepo.LookupUser(testCtx, at.GetIamUserId())
+	require.NoError(t, err)
+	apiToken, err := tokenRepo.CreateApiToken(testCtx, u, at.GetAuthAccountId(), "existing")
+	require.NoError(t, err)
+
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	cases := []struct {
+		name  string
+		token *authtoken.AuthToken
+		item  *pb.AuthToken
+		err   error
+	}{
+		{
+			name:  "valid",
+			token: at,
+			item: &pb.AuthToken{
+				ScopeId:        o.GetPublicId(),
+				Name:           wrapperspb.String("automation"),
+				Description:    wrapperspb.String("desc"),
+				ExpirationTime: timestamppb.New(expiration),
+				GrantStrings:   []string{"id=*;type=target;actions=read,list"},
+			},
+		},
+		{
+			name:  "missing name",
+			token: at,
+			item: &pb.AuthToken{
+				ScopeId: o.GetPublicId(),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:  "expiration in the past",
+			token: at,
+			item: &pb.AuthToken{
+				ScopeId:        o.GetPublicId(),
+				Name:           wrapperspb.String("expired"),
+				ExpirationTime: timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:  "invalid grant",
+			token: at,
+			item: &pb.AuthToken{
+				ScopeId:      o.GetPublicId(),
+				Name:         wrapperspb.String("invalid grant"),
+				GrantStrings: []string{"id=*;actions=invalid"},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:  "api token creating api token",
+			token: apiToken,
+			item: &pb.AuthToken{
+				ScopeId: o.GetPublicId(),
+				Name:    wrapperspb.String("from api token"),
+			},
+			err: handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "API tokens cannot be used to create API tokens."),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require, assert := require.New(t), assert.New(t)
+			// Setup the auth request information
+			req := httptest.NewRequest("POST", "http://127.0.0.1/v1/auth-tokens", nil)
+			requestInfo := authpb.RequestInfo{
+				Path:        req.URL.Path,
+				Method:      req.Method,
+				TokenFormat: uint32(auth.AuthTokenTypeBearer),
+				PublicId:    tc.token.GetPublicId(),
+				Token:       tc.token.GetToken(),
+			}
+
+			ctx := auth.NewVerifierContext(testCtx, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+			ctx = context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+			got, err := a.CreateAuthToken(ctx, &pbs.CreateAuthTokenRequest{Item: tc.item})
+			if tc.err != nil {
+				require.Error(err)
+				assert.True(errors.Is(err, tc.err), "CreateAuthToken(%+v) got error %v, wanted %v", tc.item, err, tc.err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			item := got.GetItem()
+			assert.Equal(fmt.Sprintf("auth-tokens/%s", item.GetId()), got.GetUri())
+			assert.NotEmpty(item.GetToken())
+			assert.True(item.GetApiToken())
+			assert.Equal(at.GetIamUserId(), item.GetUserId())
+			assert.Equal(at.GetAuthAccountId(), item.GetAccountId())
+			assert.Equal(tc.item.GetName().GetValue(), item.GetName().GetValue())
+			assert.Equal(tc.item.GetDescription().GetValue(), item.GetDescription().GetValue())
+			assert.True(expiration.Equal(item.GetExpirationTime().AsTime()))
+			assert.Equal(tc.item.GetGrantStrings(), item.GetGrantStrings())
+		})
+	}
+}
+
+func TestRefreshSelf(t *testing.T) {
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(testCtx, rw, rw, kms)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(testCtx, rw, rw, kms)
+	}
+
+	a, err := authtokens.NewService(testCtx, tokenRepoFn, iamRepoFn, kms)
+	require.NoError(t, err, "Couldn't create new auth token service.")
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	at1 := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	at2 := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+
+	cases := []struct {
+		name      string
+		token     *authtoken.AuthToken
+		refreshId string
+		err       error
+	}{
+		{
+			name:      "invalid id",
+			token:     at1,
+			refreshId: "invalid_id",
+			err:       handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:      "at1 refresh at2",
+			token:     at1,
+			refreshId: at2.GetPublicId(),
+			err:       handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+		{
+			name:      "at2 refresh at1",
+			token:     at2,
+			refreshId: at1.GetPublicId(),
+			err:       handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+		{
+			name:      "at1 refresh self",
+			token:     at1,
+			refreshId: at1.GetPublicId(),
+		},
+		{
+			name:      "at2 refresh self",
+			token:     at2,
+			refreshId: at2.GetPublicId(),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require, assert := require.New(t), assert.New(t)
+			// Setup the auth request information
+			req := httptest.NewRequest("POST", fmt.Sprintf("http://127.0.0.1/v1/auth-tokens/%s:refresh", tc.refreshId), nil)
+			requestInfo := authpb.RequestInfo{
+				Path:        req.URL.Path,
+				Method:      req.Method,
+				TokenFormat: uint32(auth.AuthTokenTypeBearer),
+				PublicId:    tc.token.GetPublicId(),
+				Token:       tc.token.GetToken(),
+			}
+
+			ctx := auth.NewVerifierContext(testCtx, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+			ctx = context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+			got, err := a.RefreshAuthToken(ctx, &pbs.RefreshAuthTokenRequest{Id: tc.refreshId})
+			if tc.err != nil {
+				require.Error(err)
+				assert.True(errors.Is(err, tc.err), "RefreshAuthToken(%q) got error %v, wanted %v", tc.refreshId, err, tc.err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			item := got.GetItem()
+			assert.Equal(tc.token.GetPublicId(), item.GetId())
+			assert.NotEmpty(item.GetToken())
+			assert.True(tc.token.GetExpirationTime().GetTimestamp().AsTime().Equal(item.GetExpirationTime().AsTime()))
+
+			// The token value used to make the request is no longer valid.
+			tokenRepo, err := tokenRepoFn()
+			require.NoError(err)
+			validated, err := tokenRepo.ValidateToken(testCtx, tc.token.GetPublicId(), tc.token.GetToken())
+			require.NoError(err)
+			assert.Nil(validated)
+		})
+	}
+}
+
 func TestDeleteSelf(t *testing.T) {
 	testCtx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -438,7 +671,7 @@ func TestDeleteSelf(t *testing.T) {
 		return server.NewRepository(testCtx, rw, rw, kms)
 	}
 
-	a, err := authtokens.NewService(testCtx, tokenRepoFn, iamRepoFn)
+	a, err := authtokens.NewService(testCtx, tokenRepoFn, iamRepoFn, kms)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -528,7 +761,7 @@ func TestDelete(t *testing.T) {
 	org, _ := iam.TestScopes(t, iamRepo)
 	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
 
-	s, err := authtokens.NewService(ctx, repoFn, iamRepoFn)
+	s, err := authtokens.NewService(ctx, repoFn, iamRepoFn, kms)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -593,7 +826,7 @@ func TestDelete_twice(t *testing.T) {
 	org, _ := iam.TestScopes(t, iamRepo)
 	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
 
-	s, err := authtokens.NewService(ctx, repoFn, iamRepoFn)
+	s, err := authtokens.NewService(ctx, repoFn, iamRepoFn, kms)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteAuthTokenRequest{
 		Id: at.GetPublicId(),
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// redactedPassword replaces the passwords in the request bodies written to
+// the audit events.
+const redactedPassword = "[REDACTED: password]"
+
+// auditWriter is the http.ResponseWriter of a SCIM request.  It keeps the
+// status and body of the response so they can be written to the request's
+// audit event once the request has been handled.
+type auditWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *auditWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write implements http.ResponseWriter.
+func (w *auditWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// writeResponseAudit writes the status and body of the response to the audit
+// event of the request.
+func (w *auditWriter) writeResponseAudit(ctx context.Context) {
+	const op = "scim.(auditWriter).writeResponseAudit"
+	resp := &event.Response{StatusCode: w.status}
+	if w.body.Len() > 0 {
+		var body any
+		if err := json.Unmarshal(w.body.Bytes(), &body); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to decode scim response for audit"))
+		} else if resp.Details, err = auditDetails(body); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to convert scim response for audit"))
+		}
+	}
+	if err := event.WriteAudit(ctx, op, event.WithResponse(resp)); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write scim response audit"))
+	}
+}
+
+// writeRequestAudit writes the method, path and decoded body of the request
+// to its audit event.  The body may be nil.  Passwords in the body are
+// redacted.
+func writeRequestAudit(ctx context.Context, r *http.Request, body any) error {
+	const op = "scim.writeRequestAudit"
+	req := &event.Request{
+		Operation: r.Method,
+		Endpoint:  r.URL.Path,
+	}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		var v any
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		if req.Details, err = auditDetails(redactPasswords(v)); err != nil {
+			return err
+		}
+	}
+	return event.WriteAudit(ctx, op, event.WithRequest(req))
+}
+
+// auditDetails returns the JSON object v as the details of an audit event.
+// Any other JSON value is wrapped in an object as its "value".
+func auditDetails(v any) (*structpb.Struct, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		m = map[string]any{"value": v}
+	}
+	return structpb.NewStruct(m)
+}
+
+// redactPasswords replaces the password attributes in the decoded JSON value
+// v, and the values of the patch operations which replace them, with
+// redactedPassword.
+func redactPasswords(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		ret := make(map[string]any, len(v))
+		for k, e := range v {
+			ret[k] = redactPasswords(e)
+			if strings.EqualFold(k, "password") && e != nil {
+				ret[k] = redactedPassword
+			}
+		}
+		if path, ok := v["path"].(string); ok && strings.EqualFold(attrPath(strings.TrimSpace(path))[0], "password") {
+			if _, ok := v["value"]; ok {
+				ret["value"] = redactedPassword
+			}
+		}
+		return ret
+	case []any:
+		ret := make([]any, len(v))
+		for i, e := range v {
+			ret[i] = redactPasswords(e)
+		}
+		return ret
+	}
+	return v
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactPasswords(t *testing.T) {
+	tests := []struct {
+		name string
+		in   any
+		want any
+	}{
+		{
+			name: "user",
+			in:   map[string]any{"userName": "alice", "Password": "hunter22", "active": true},
+			want: map[string]any{"userName": "alice", "Password": redactedPassword, "active": true},
+		},
+		{
+			name: "patch",
+			in: map[string]any{"Operations": []any{
+				map[string]any{"op": "replace", "path": "password", "value": "hunter22"},
+				map[string]any{"op": "replace", "path": "urn:ietf:params:scim:schemas:core:2.0:User:password", "value": "hunter22"},
+				map[string]any{"op": "replace", "value": map[string]any{"password": "hunter22", "active": false}},
+				map[string]any{"op": "replace", "path": "displayName", "value": "Alice"},
+			}},
+			want: map[string]any{"Operations": []any{
+				map[string]any{"op": "replace", "path": "password", "value": redactedPassword},
+				map[string]any{"op": "replace", "path": "urn:ietf:params:scim:schemas:core:2.0:User:password", "value": redactedPassword},
+				map[string]any{"op": "replace", "value": map[string]any{"password": redactedPassword, "active": false}},
+				map[string]any{"op": "replace", "path": "displayName", "value": "Alice"},
+			}},
+		},
+		{
+			name: "no password",
+			in:   map[string]any{"displayName": "admins", "password": nil},
+			want: map[string]any{"displayName": "admins", "password": nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, redactPasswords(tt.in))
+		})
+	}
+}
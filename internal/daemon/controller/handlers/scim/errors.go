@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+)
+
+// The scimType values of SCIM error responses, see RFC 7644 section 3.12.
+const (
+	invalidFilterType = "invalidFilter"
+	uniquenessType    = "uniqueness"
+	mutabilityType    = "mutability"
+	invalidSyntaxType = "invalidSyntax"
+	invalidPathType   = "invalidPath"
+	noTargetType      = "noTarget"
+	invalidValueType  = "invalidValue"
+)
+
+// apiError is an error returned to the SCIM client.
+type apiError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func newError(status int, scimType, format string, a ...any) *apiError {
+	return &apiError{
+		status:   status,
+		scimType: scimType,
+		detail:   fmt.Sprintf(format, a...),
+	}
+}
+
+func (e *apiError) Error() string {
+	return e.detail
+}
+
+var (
+	notFoundError        = newError(http.StatusNotFound, "", "Resource not found.")
+	unauthenticatedError = newError(http.StatusUnauthorized, "", "A valid SCIM token is required.")
+)
+
+// writeError writes err to the response as a SCIM error.  Errors from the
+// repositories are translated to the status the SCIM protocol expects for
+// them, and are otherwise reported as internal errors.
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	const op = "scim.writeError"
+	var e *apiError
+	switch {
+	case stderrors.As(err, &e):
+	case errors.IsNotFoundError(err):
+		e = notFoundError
+	case errors.IsUniqueError(err):
+		e = newError(http.StatusConflict, uniquenessType, "The resource conflicts with an existing one.")
+	case errors.IsConflictError(err):
+		e = newError(http.StatusConflict, "", "The resource cannot be changed: %v.", err)
+	case errors.Match(errors.T(errors.InvalidParameter), err),
+		errors.Match(errors.T(errors.TooShort), err),
+		errors.Match(errors.T(errors.PasswordTooShort), err),
+		errors.Match(errors.T(errors.PasswordTooSimple), err),
+		errors.Match(errors.T(errors.PasswordCommon), err),
+		errors.Match(errors.T(errors.PasswordReused), err),
+		errors.IsCheckConstraintError(err):
+		e = newError(http.StatusBadRequest, invalidValueType, "Invalid value: %v.", err)
+	default:
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error handling scim request"))
+		e = newError(http.StatusInternalServerError, "", "An internal error occurred.")
+	}
+	body := map[string]any{
+		"schemas": []string{errorSchema},
+		"status":  strconv.Itoa(e.status),
+		"detail":  e.detail,
+	}
+	if e.scimType != "" {
+		body["scimType"] = e.scimType
+	}
+	if e.status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="boundary-scim"`)
+	}
+	writeJson(w, e.status, body)
+}
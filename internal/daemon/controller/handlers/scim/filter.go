@@ -0,0 +1,445 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A filter selects the resources, or the values of a multi-valued attribute,
+// it matches.  Filters are evaluated against the JSON representation of a
+// resource, as described in RFC 7644 section 3.4.2.2.
+type filter interface {
+	match(res map[string]any) bool
+}
+
+// parseFilter parses a SCIM filter expression.
+func parseFilter(s string) (filter, error) {
+	p, err := newFilterParser(s)
+	if err != nil {
+		return nil, err
+	}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q in filter", p.peek().text)
+	}
+	return f, nil
+}
+
+type tokenKind int
+
+const (
+	wordToken tokenKind = iota
+	stringToken
+	punctToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+}
+
+func newFilterParser(s string) (*filterParser, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.IndexByte("()[]", c) >= 0:
+			tokens = append(tokens, token{kind: punctToken, text: string(c)})
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(s) && s[end] != '"'; end++ {
+				if s[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(s) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+			var str string
+			if err := json.Unmarshal([]byte(s[i:end+1]), &str); err != nil {
+				return nil, fmt.Errorf("invalid string %s in filter", s[i:end+1])
+			}
+			tokens = append(tokens, token{kind: stringToken, text: str})
+			i = end + 1
+		default:
+			end := i
+			for ; end < len(s) && strings.IndexByte(" \t\n\r()[]\"", s[end]) < 0; end++ {
+			}
+			tokens = append(tokens, token{kind: wordToken, text: s[i:end]})
+			i = end
+		}
+	}
+	return &filterParser{tokens: tokens}, nil
+}
+
+func (p *filterParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *filterParser) peek() token {
+	if p.done() {
+		return token{kind: punctToken}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+// peekKeyword reports whether the next token is the keyword kw.
+func (p *filterParser) peekKeyword(kw string) bool {
+	t := p.peek()
+	return t.kind == wordToken && strings.EqualFold(t.text, kw)
+}
+
+func (p *filterParser) expect(punct string) error {
+	if t := p.next(); t.kind != punctToken || t.text != punct {
+		if t.text == "" {
+			return fmt.Errorf("expected %q at end of filter", punct)
+		}
+		return fmt.Errorf("expected %q in filter, found %q", punct, t.text)
+	}
+	return nil
+}
+
+func (p *filterParser) parseOr() (filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (filter, error) {
+	if !p.peekKeyword("not") {
+		return p.parseAtom()
+	}
+	p.next()
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return notFilter{f}, nil
+}
+
+func (p *filterParser) parseAtom() (filter, error) {
+	t := p.next()
+	switch {
+	case t.kind == punctToken && t.text == "(":
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case t.kind != wordToken:
+		if t.text == "" {
+			return nil, fmt.Errorf("unexpected end of filter")
+		}
+		return nil, fmt.Errorf("expected an attribute in filter, found %q", t.text)
+	}
+
+	path := attrPath(t.text)
+	if p.peek().kind == punctToken && p.peek().text == "[" {
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return valuePathFilter{attr: path[0], filter: f}, nil
+	}
+
+	opTok := p.next()
+	if opTok.kind != wordToken {
+		return nil, fmt.Errorf("expected an operator after %q in filter", t.text)
+	}
+	op := strings.ToLower(opTok.text)
+	switch op {
+	case "pr":
+		return attrFilter{path: path, op: op}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unsupported operator %q in filter", opTok.text)
+	}
+
+	var value any
+	switch v := p.next(); v.kind {
+	case stringToken:
+		value = v.text
+	case wordToken:
+		switch strings.ToLower(v.text) {
+		case "true":
+			value = true
+		case "false":
+			value = false
+		case "null":
+			value = nil
+		default:
+			n, err := strconv.ParseFloat(v.text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q in filter", v.text)
+			}
+			value = n
+		}
+	default:
+		return nil, fmt.Errorf("expected a value after %q in filter", opTok.text)
+	}
+	return attrFilter{path: path, op: op, value: value}, nil
+}
+
+// attrPath splits an attribute path into its attribute and sub-attribute
+// names, removing any schema URN prefix.
+func attrPath(s string) []string {
+	if strings.HasPrefix(strings.ToLower(s), "urn:") {
+		if i := strings.LastIndexByte(s, ':'); i >= 0 {
+			s = s[i+1:]
+		}
+	}
+	return strings.SplitN(s, ".", 2)
+}
+
+type orFilter struct{ left, right filter }
+
+func (f orFilter) match(res map[string]any) bool {
+	return f.left.match(res) || f.right.match(res)
+}
+
+type andFilter struct{ left, right filter }
+
+func (f andFilter) match(res map[string]any) bool {
+	return f.left.match(res) && f.right.match(res)
+}
+
+type notFilter struct{ filter filter }
+
+func (f notFilter) match(res map[string]any) bool {
+	return !f.filter.match(res)
+}
+
+// valuePathFilter matches resources where any value of the multi-valued
+// complex attribute matches its filter, e.g. emails[type eq "work"].
+type valuePathFilter struct {
+	attr   string
+	filter filter
+}
+
+func (f valuePathFilter) match(res map[string]any) bool {
+	vals, _ := getAttr(res, f.attr).([]any)
+	for _, v := range vals {
+		if m, ok := v.(map[string]any); ok && f.filter.match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+type attrFilter struct {
+	path  []string
+	op    string
+	value any
+}
+
+func (f attrFilter) match(res map[string]any) bool {
+	vals := attrValues(res, f.path)
+	switch f.op {
+	case "pr":
+		for _, v := range vals {
+			if present(v) {
+				return true
+			}
+		}
+		return false
+	case "ne":
+		return !(attrFilter{path: f.path, op: "eq", value: f.value}).match(res)
+	}
+	if f.value == nil {
+		// Only "eq null" is meaningful, and it matches absent attributes.
+		return f.op == "eq" && len(vals) == 0
+	}
+	for _, v := range vals {
+		if compare(f.op, v, f.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// attrValues returns the values of the attribute path in the resource.  The
+// values of multi-valued attributes are flattened, and a multi-valued complex
+// attribute without a sub-attribute yields the "value" sub-attribute of its
+// values.
+func attrValues(res map[string]any, path []string) []any {
+	v := getAttr(res, path[0])
+	if v == nil {
+		return nil
+	}
+	elems, multi := v.([]any)
+	if !multi {
+		elems = []any{v}
+	}
+	var ret []any
+	for _, e := range elems {
+		m, isComplex := e.(map[string]any)
+		switch {
+		case len(path) > 1:
+			if isComplex {
+				if sv := getAttr(m, path[1]); sv != nil {
+					ret = append(ret, sv)
+				}
+			}
+		case isComplex && multi:
+			if sv := getAttr(m, "value"); sv != nil {
+				ret = append(ret, sv)
+			}
+		default:
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
+func present(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	}
+	return true
+}
+
+// compare reports whether the comparison of the attribute value with the
+// filter value holds.  Strings are compared case-insensitively.
+func compare(op string, attr, value any) bool {
+	switch value := value.(type) {
+	case string:
+		a, ok := attr.(string)
+		if !ok {
+			return false
+		}
+		a, value = strings.ToLower(a), strings.ToLower(value)
+		switch op {
+		case "eq":
+			return a == value
+		case "co":
+			return strings.Contains(a, value)
+		case "sw":
+			return strings.HasPrefix(a, value)
+		case "ew":
+			return strings.HasSuffix(a, value)
+		case "gt":
+			return a > value
+		case "ge":
+			return a >= value
+		case "lt":
+			return a < value
+		case "le":
+			return a <= value
+		}
+	case bool:
+		a, ok := attr.(bool)
+		return ok && op == "eq" && a == value
+	case float64:
+		var a float64
+		switch n := attr.(type) {
+		case float64:
+			a = n
+		case int:
+			a = float64(n)
+		case uint32:
+			a = float64(n)
+		default:
+			return false
+		}
+		switch op {
+		case "eq":
+			return a == value
+		case "gt":
+			return a > value
+		case "ge":
+			return a >= value
+		case "lt":
+			return a < value
+		case "le":
+			return a <= value
+		}
+	}
+	return false
+}
+
+// attrKey returns the key of the attribute in the resource.  Attribute names
+// are case-insensitive, so this is the existing key matching name if there is
+// one, and name otherwise.
+func attrKey(res map[string]any, name string) string {
+	if _, ok := res[name]; ok {
+		return name
+	}
+	for k := range res {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
+
+// getAttr returns the value of the attribute in the resource, or nil.
+func getAttr(res map[string]any, name string) any {
+	return res[attrKey(res, name)]
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	res := map[string]any{
+		"schemas":     []any{userSchema},
+		"id":          "u_1234567890",
+		"userName":    "Alice",
+		"displayName": "Alice Smith",
+		"active":      true,
+		"emails": []any{
+			map[string]any{"value": "alice@example.com", "type": "work", "primary": true},
+			map[string]any{"value": "alice@home.example", "type": "home"},
+		},
+		"name": map[string]any{"formatted": "Alice Smith", "givenName": "Alice"},
+		"meta": map[string]any{"version": float64(3)},
+	}
+
+	tests := []struct {
+		name    string
+		filter  string
+		want    bool
+		wantErr string
+	}{
+		{name: "eq", filter: `userName eq "alice"`, want: true},
+		{name: "eq-mismatch", filter: `userName eq "bob"`, want: false},
+		{name: "case-insensitive-attr", filter: `USERNAME Eq "ALICE"`, want: true},
+		{name: "urn-prefix", filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice"`, want: true},
+		{name: "ne", filter: `userName ne "bob"`, want: true},
+		{name: "co", filter: `displayName co "smi"`, want: true},
+		{name: "sw", filter: `displayName sw "alice"`, want: true},
+		{name: "ew", filter: `displayName ew "smith"`, want: true},
+		{name: "pr", filter: `displayName pr`, want: true},
+		{name: "pr-absent", filter: `externalId pr`, want: false},
+		{name: "eq-null", filter: `externalId eq null`, want: true},
+		{name: "bool", filter: `active eq true`, want: true},
+		{name: "number", filter: `meta.version gt 2`, want: true},
+		{name: "sub-attribute", filter: `name.givenName eq "Alice"`, want: true},
+		{name: "multi-valued", filter: `emails eq "alice@home.example"`, want: true},
+		{name: "multi-valued-sub-attribute", filter: `emails.type eq "home"`, want: true},
+		{name: "value-path", filter: `emails[type eq "work" and value co "example.com"]`, want: true},
+		{name: "value-path-mismatch", filter: `emails[type eq "other"]`, want: false},
+		{name: "and", filter: `userName eq "alice" and active eq false`, want: false},
+		{name: "or", filter: `userName eq "bob" or active eq true`, want: true},
+		{name: "not", filter: `not (userName eq "bob")`, want: true},
+		{name: "precedence", filter: `userName eq "bob" and active eq true or displayName pr`, want: true},
+		{name: "parens", filter: `userName eq "bob" and (active eq true or displayName pr)`, want: false},
+		{name: "escaped-string", filter: `displayName ne "a \"quoted\" name"`, want: true},
+		{name: "unterminated-string", filter: `userName eq "alice`, wantErr: "unterminated string"},
+		{name: "unknown-operator", filter: `userName is "alice"`, wantErr: "unsupported operator"},
+		{name: "missing-value", filter: `userName eq`, wantErr: "expected a value"},
+		{name: "missing-paren", filter: `(userName eq "alice"`, wantErr: `expected ")"`},
+		{name: "trailing", filter: `userName eq "alice" )`, wantErr: "unexpected"},
+		{name: "empty", filter: ``, wantErr: "unexpected end of filter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			f, err := parseFilter(tt.filter)
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, f.match(res))
+		})
+	}
+}
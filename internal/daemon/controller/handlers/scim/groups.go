@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+// groupInput holds the attributes of a SCIM Group provided by a client.
+type groupInput struct {
+	displayName string
+	members     []string
+}
+
+// groups is the Group resource type.
+type groups struct {
+	*request
+}
+
+var _ resourceType = groups{}
+
+func (g groups) list(ctx context.Context) ([]map[string]any, error) {
+	const op = "scim.(groups).list"
+	iamRepo, err := g.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	all, err := iamRepo.ListGroups(ctx, []string{g.scopeId}, iam.WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := make([]map[string]any, 0, len(all))
+	for _, grp := range all {
+		members, err := iamRepo.ListGroupMembers(ctx, grp.GetPublicId())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ret = append(ret, g.resource(grp, members))
+	}
+	return ret, nil
+}
+
+func (g groups) get(ctx context.Context, id string) (map[string]any, error) {
+	grp, members, err := g.lookup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return g.resource(grp, members), nil
+}
+
+func (g groups) create(ctx context.Context, res map[string]any) (map[string]any, error) {
+	const op = "scim.(groups).create"
+	in, err := parseGroup(res)
+	if err != nil {
+		return nil, err
+	}
+	iamRepo, err := g.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	grp, err := iam.NewGroup(ctx, g.scopeId, iam.WithName(in.displayName))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if grp, err = iamRepo.CreateGroup(ctx, grp); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(in.members) > 0 {
+		if _, _, err := iamRepo.SetGroupMembers(ctx, grp.GetPublicId(), grp.GetVersion(), in.members); err != nil {
+			_, _ = iamRepo.DeleteGroup(ctx, grp.GetPublicId())
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	grp, members, err := iamRepo.LookupGroup(ctx, grp.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return g.resource(grp, members), nil
+}
+
+func (g groups) replace(ctx context.Context, id string, res map[string]any) (map[string]any, error) {
+	const op = "scim.(groups).replace"
+	grp, members, err := g.lookup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	in, err := parseGroup(res)
+	if err != nil {
+		return nil, err
+	}
+	iamRepo, err := g.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if in.displayName != grp.GetName() {
+		upd, err := iam.NewGroup(ctx, g.scopeId, iam.WithName(in.displayName))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		upd.PublicId = id
+		if grp, _, _, err = iamRepo.UpdateGroup(ctx, upd, grp.GetVersion(), []string{"Name"}); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if !sameMembers(members, in.members) {
+		if _, _, err := iamRepo.SetGroupMembers(ctx, id, grp.GetVersion(), in.members); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if grp, members, err = iamRepo.LookupGroup(ctx, id); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return g.resource(grp, members), nil
+}
+
+func (g groups) delete(ctx context.Context, id string) error {
+	const op = "scim.(groups).delete"
+	if _, _, err := g.lookup(ctx, id); err != nil {
+		return err
+	}
+	iamRepo, err := g.iamRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := iamRepo.DeleteGroup(ctx, id); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// lookup returns the group of the token's scope with the id and its members.
+func (g groups) lookup(ctx context.Context, id string) (*iam.Group, []*iam.GroupMember, error) {
+	const op = "scim.(groups).lookup"
+	iamRepo, err := g.iamRepoFn()
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	grp, members, err := iamRepo.LookupGroup(ctx, id)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if grp == nil || grp.GetScopeId() != g.scopeId {
+		return nil, nil, notFoundError
+	}
+	return grp, members, nil
+}
+
+// resource returns the JSON representation of the group.
+func (g groups) resource(grp *iam.Group, members []*iam.GroupMember) map[string]any {
+	res := map[string]any{
+		"schemas":     []any{groupSchema},
+		"id":          grp.GetPublicId(),
+		"displayName": grp.GetName(),
+		"meta":        g.meta("Group", "/Groups", grp.GetPublicId(), grp.GetCreateTime(), grp.GetUpdateTime(), grp.GetVersion()),
+	}
+	if len(members) > 0 {
+		vals := make([]any, 0, len(members))
+		for _, m := range members {
+			vals = append(vals, map[string]any{
+				"value": m.GetMemberId(),
+				"type":  "User",
+				"$ref":  g.baseUrl + "/Users/" + m.GetMemberId(),
+			})
+		}
+		res["members"] = vals
+	}
+	return res
+}
+
+// sameMembers reports whether the group members are the users with the ids.
+func sameMembers(members []*iam.GroupMember, ids []string) bool {
+	if len(members) != len(ids) {
+		return false
+	}
+	cur := make([]string, 0, len(members))
+	for _, m := range members {
+		cur = append(cur, m.GetMemberId())
+	}
+	want := append([]string(nil), ids...)
+	sort.Strings(cur)
+	sort.Strings(want)
+	for i := range cur {
+		if cur[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseGroup returns the attributes of the JSON representation of a SCIM
+// Group which are mapped to groups.  Only users can be members of groups.
+func parseGroup(res map[string]any) (*groupInput, error) {
+	in := &groupInput{}
+	switch v := getAttr(res, "displayName").(type) {
+	case nil:
+	case string:
+		in.displayName = strings.TrimSpace(v)
+	default:
+		return nil, newError(http.StatusBadRequest, invalidValueType, "The displayName attribute must be a string.")
+	}
+	if in.displayName == "" {
+		return nil, newError(http.StatusBadRequest, invalidValueType, "The displayName attribute is required.")
+	}
+
+	switch v := getAttr(res, "members").(type) {
+	case nil:
+	case []any:
+		seen := make(map[string]bool, len(v))
+		for _, e := range v {
+			m, ok := e.(map[string]any)
+			if !ok {
+				return nil, newError(http.StatusBadRequest, invalidValueType, "The members attribute must contain objects.")
+			}
+			id, _ := getAttr(m, "value").(string)
+			if !strings.HasPrefix(id, globals.UserPrefix+"_") {
+				return nil, newError(http.StatusBadRequest, invalidValueType, "Group member %q is not a user.", id)
+			}
+			if !seen[id] {
+				seen[id] = true
+				in.members = append(in.members, id)
+			}
+		}
+	default:
+		return nil, newError(http.StatusBadRequest, invalidValueType, "The members attribute must be an array.")
+	}
+	return in, nil
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// A patchOp is an operation of a PATCH request, as described in RFC 7644
+// section 3.5.2.
+type patchOp struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+type patchRequest struct {
+	Schemas    []string  `json:"schemas"`
+	Operations []patchOp `json:"Operations"`
+}
+
+// A patchPath is the target of a patch operation: an attribute, optionally
+// with a filter selecting some of its values, and a sub-attribute, e.g.
+// emails[type eq "work"].value.
+type patchPath struct {
+	attr    string
+	filter  filter
+	subAttr string
+}
+
+func parsePatchPath(s string) (*patchPath, error) {
+	p := &patchPath{}
+	rest := s
+	if i := strings.IndexByte(s, '['); i >= 0 {
+		j := strings.LastIndexByte(s, ']')
+		if j < i {
+			return nil, newError(http.StatusBadRequest, invalidPathType, "Invalid path %q.", s)
+		}
+		f, err := parseFilter(s[i+1 : j])
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, invalidPathType, "Invalid path %q: %v.", s, err)
+		}
+		p.filter = f
+		rest = s[:i]
+		if sub := s[j+1:]; sub != "" {
+			if !strings.HasPrefix(sub, ".") || len(sub) == 1 {
+				return nil, newError(http.StatusBadRequest, invalidPathType, "Invalid path %q.", s)
+			}
+			p.subAttr = sub[1:]
+		}
+	}
+	path := attrPath(rest)
+	if path[0] == "" {
+		return nil, newError(http.StatusBadRequest, invalidPathType, "Invalid path %q.", s)
+	}
+	p.attr = path[0]
+	if len(path) > 1 {
+		if p.filter != nil {
+			return nil, newError(http.StatusBadRequest, invalidPathType, "Invalid path %q.", s)
+		}
+		p.subAttr = path[1]
+	}
+	return p, nil
+}
+
+// applyPatch applies the operations to the JSON representation of a resource.
+func applyPatch(res map[string]any, ops []patchOp) error {
+	for _, o := range ops {
+		op := strings.ToLower(o.Op)
+		switch op {
+		case "add", "replace", "remove":
+		default:
+			return newError(http.StatusBadRequest, invalidSyntaxType, "Unsupported patch operation %q.", o.Op)
+		}
+		if o.Path == "" {
+			if op == "remove" {
+				return newError(http.StatusBadRequest, noTargetType, "A remove operation requires a path.")
+			}
+			values, ok := o.Value.(map[string]any)
+			if !ok {
+				return newError(http.StatusBadRequest, invalidValueType, "The value of a patch operation without a path must be an object.")
+			}
+			// Each attribute of the value is added or replaced as if it were
+			// the path of the operation.
+			for k, v := range values {
+				p, err := parsePatchPath(k)
+				if err != nil {
+					return err
+				}
+				if err := applyPatchOp(res, op, p, v); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		p, err := parsePatchPath(o.Path)
+		if err != nil {
+			return err
+		}
+		if err := applyPatchOp(res, op, p, o.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyPatchOp(res map[string]any, op string, p *patchPath, value any) error {
+	key := attrKey(res, p.attr)
+
+	if p.filter != nil {
+		elems, _ := res[key].([]any)
+		var kept []any
+		var matched bool
+		for _, e := range elems {
+			m, ok := e.(map[string]any)
+			if !ok || !p.filter.match(m) {
+				kept = append(kept, e)
+				continue
+			}
+			matched = true
+			switch {
+			case op == "remove" && p.subAttr == "":
+				continue
+			case op == "remove":
+				delete(m, attrKey(m, p.subAttr))
+			case p.subAttr == "":
+				v, ok := value.(map[string]any)
+				if !ok {
+					return newError(http.StatusBadRequest, invalidValueType, "The value for %q must be an object.", p.attr)
+				}
+				e = v
+			default:
+				m[attrKey(m, p.subAttr)] = value
+			}
+			kept = append(kept, e)
+		}
+		if !matched {
+			return newError(http.StatusBadRequest, noTargetType, "No value of %q matches the path filter.", p.attr)
+		}
+		res[key] = kept
+		return nil
+	}
+
+	if p.subAttr != "" {
+		m, ok := res[key].(map[string]any)
+		if !ok {
+			if op == "remove" {
+				return nil
+			}
+			m = make(map[string]any)
+			res[key] = m
+		}
+		if op == "remove" {
+			delete(m, attrKey(m, p.subAttr))
+		} else {
+			m[attrKey(m, p.subAttr)] = value
+		}
+		return nil
+	}
+
+	existing, multi := res[key].([]any)
+	switch op {
+	case "remove":
+		if vals, ok := value.([]any); ok && multi {
+			// Some identity providers remove values of a multi-valued
+			// attribute by listing them as the value of the operation.
+			res[key] = removeValues(existing, vals)
+			return nil
+		}
+		delete(res, key)
+	case "add":
+		switch {
+		case multi:
+			vals, ok := value.([]any)
+			if !ok {
+				vals = []any{value}
+			}
+			res[key] = addValues(existing, vals)
+		default:
+			cur, curOk := res[key].(map[string]any)
+			add, addOk := value.(map[string]any)
+			if curOk && addOk {
+				for k, v := range add {
+					cur[attrKey(cur, k)] = v
+				}
+				return nil
+			}
+			res[key] = value
+		}
+	case "replace":
+		res[key] = value
+	}
+	return nil
+}
+
+// addValues appends the values not already in the multi-valued attribute.
+func addValues(existing, vals []any) []any {
+	ret := existing
+	for _, v := range vals {
+		if indexValue(ret, v) < 0 {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+// removeValues removes the values from the multi-valued attribute.
+func removeValues(existing, vals []any) []any {
+	var ret []any
+	for _, e := range existing {
+		if indexValue(vals, e) < 0 {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
+// indexValue returns the index of v in vals, or -1.  The values of complex
+// attributes are identified by their "value" sub-attribute.
+func indexValue(vals []any, v any) int {
+	for i, e := range vals {
+		em, eok := e.(map[string]any)
+		vm, vok := v.(map[string]any)
+		if eok && vok {
+			if ev := getAttr(em, "value"); ev != nil && reflect.DeepEqual(ev, getAttr(vm, "value")) {
+				return i
+			}
+			continue
+		}
+		if reflect.DeepEqual(e, v) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPatch(t *testing.T) {
+	newUser := func() map[string]any {
+		return map[string]any{
+			"userName":    "alice",
+			"displayName": "Alice",
+			"active":      true,
+			"emails": []any{
+				map[string]any{"value": "alice@example.com", "type": "work"},
+			},
+			"name": map[string]any{"formatted": "Alice Smith"},
+		}
+	}
+	newGroup := func() map[string]any {
+		return map[string]any{
+			"displayName": "admins",
+			"members": []any{
+				map[string]any{"value": "u_1"},
+				map[string]any{"value": "u_2"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		res       map[string]any
+		ops       []patchOp
+		want      map[string]any
+		wantError string
+	}{
+		{
+			name: "replace",
+			res:  newUser(),
+			ops:  []patchOp{{Op: "Replace", Path: "displayName", Value: "Alice S."}},
+			want: func() map[string]any {
+				u := newUser()
+				u["displayName"] = "Alice S."
+				return u
+			}(),
+		},
+		{
+			name: "replace-case-insensitive-path",
+			res:  newUser(),
+			ops:  []patchOp{{Op: "replace", Path: "ACTIVE", Value: false}},
+			want: func() map[string]any {
+				u := newUser()
+				u["active"] = false
+				return u
+			}(),
+		},
+		{
+			name: "replace-without-path",
+			res:  newUser(),
+			ops:  []patchOp{{Op: "replace", Value: map[string]any{"active": false, "name.formatted": "Alice Jones"}}},
+			want: func() map[string]any {
+				u := newUser()
+				u["active"] = false
+				u["name"] = map[string]any{"formatted": "Alice Jones"}
+				return u
+			}(),
+		},
+		{
+			name: "remove",
+			res:  newUser(),
+			ops:  []patchOp{{Op: "remove", Path: "displayName"}},
+			want: func() map[string]any {
+				u := newUser()
+				delete(u, "displayName")
+				return u
+			}(),
+		},
+		{
+			name: "replace-filtered-sub-attribute",
+			res:  newUser(),
+			ops:  []patchOp{{Op: "replace", Path: `emails[type eq "work"].value`, Value: "alice@new.example"}},
+			want: func() map[string]any {
+				u := newUser()
+				u["emails"] = []any{map[string]any{"value": "alice@new.example", "type": "work"}}
+				return u
+			}(),
+		},
+		{
+			name:      "filter-without-match",
+			res:       newUser(),
+			ops:       []patchOp{{Op: "replace", Path: `emails[type eq "home"].value`, Value: "x"}},
+			wantError: "No value",
+		},
+		{
+			name: "add-members",
+			res:  newGroup(),
+			ops: []patchOp{{Op: "add", Path: "members", Value: []any{
+				map[string]any{"value": "u_2"},
+				map[string]any{"value": "u_3"},
+			}}},
+			want: func() map[string]any {
+				g := newGroup()
+				g["members"] = append(g["members"].([]any), map[string]any{"value": "u_3"})
+				return g
+			}(),
+		},
+		{
+			name: "remove-filtered-member",
+			res:  newGroup(),
+			ops:  []patchOp{{Op: "remove", Path: `members[value eq "u_1"]`}},
+			want: func() map[string]any {
+				g := newGroup()
+				g["members"] = []any{map[string]any{"value": "u_2"}}
+				return g
+			}(),
+		},
+		{
+			name: "remove-listed-members",
+			res:  newGroup(),
+			ops:  []patchOp{{Op: "remove", Path: "members", Value: []any{map[string]any{"value": "u_2"}}}},
+			want: func() map[string]any {
+				g := newGroup()
+				g["members"] = []any{map[string]any{"value": "u_1"}}
+				return g
+			}(),
+		},
+		{
+			name:      "unsupported-op",
+			res:       newUser(),
+			ops:       []patchOp{{Op: "move", Path: "displayName"}},
+			wantError: "Unsupported patch operation",
+		},
+		{
+			name:      "remove-without-path",
+			res:       newUser(),
+			ops:       []patchOp{{Op: "remove"}},
+			wantError: "requires a path",
+		},
+		{
+			name:      "invalid-path",
+			res:       newUser(),
+			ops:       []patchOp{{Op: "replace", Path: `emails[type eq`, Value: "x"}},
+			wantError: "Invalid path",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			err := applyPatch(tt.res, tt.ops)
+			if tt.wantError != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantError)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, tt.res)
+		})
+	}
+}
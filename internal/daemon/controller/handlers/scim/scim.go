@@ -0,0 +1,493 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package scim serves the SCIM 2.0 API (RFC 7643 and RFC 7644) through which
+// the identity provider of a password or OIDC auth method provisions users,
+// their accounts in the auth method, and groups in the auth method's scope.
+//
+// Requests are authenticated with the auth method's SCIM token, which is
+// created through the create-scim-token action of the auth method.  A SCIM
+// User is a user of the token's scope with an account in the token's auth
+// method; its userName is the name of the user and, for password auth methods,
+// the login name of its account.  Deactivating or deleting a SCIM User deletes
+// its account if the account was created through the SCIM API and otherwise
+// removes the account from the user; the user is deleted if it was created
+// through the SCIM API and has no other accounts.  A SCIM Group is a group of
+// the token's scope.
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+)
+
+// PathPrefix is the path the controller serves the SCIM API under.
+const PathPrefix = "/scim/v2/"
+
+const (
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	resourceTypeSchema          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	contentType = "application/scim+json"
+
+	// maxResults is the maximum number of resources returned by a list.
+	maxResults = 1000
+)
+
+// Handler is the http.Handler of the SCIM API.
+type Handler struct {
+	scimRepoFn common.ScimRepoFactory
+	iamRepoFn  common.IamRepoFactory
+	pwRepoFn   common.PasswordAuthRepoFactory
+	oidcRepoFn common.OidcAuthRepoFactory
+}
+
+// NewHandler returns the handler of the SCIM API.
+func NewHandler(ctx context.Context, scimRepoFn common.ScimRepoFactory, iamRepoFn common.IamRepoFactory, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoFn common.OidcAuthRepoFactory) (*Handler, error) {
+	const op = "scim.NewHandler"
+	switch {
+	case scimRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scim repository")
+	case iamRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	case pwRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password repository")
+	case oidcRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository")
+	}
+	return &Handler{
+		scimRepoFn: scimRepoFn,
+		iamRepoFn:  iamRepoFn,
+		pwRepoFn:   pwRepoFn,
+		oidcRepoFn: oidcRepoFn,
+	}, nil
+}
+
+// A resourceType manages the resources of one SCIM resource type in their
+// JSON representation.
+type resourceType interface {
+	list(ctx context.Context) ([]map[string]any, error)
+	get(ctx context.Context, id string) (map[string]any, error)
+	create(ctx context.Context, res map[string]any) (map[string]any, error)
+	replace(ctx context.Context, id string, res map[string]any) (map[string]any, error)
+	delete(ctx context.Context, id string) error
+}
+
+// request holds the scope and auth method of the token authenticating a
+// request.
+type request struct {
+	*Handler
+	tokenId      string
+	scopeId      string
+	authMethodId string
+	baseUrl      string
+}
+
+// ServeHTTP implements http.Handler.  The SCIM API is not served through the
+// grpc gateway, so the request, the token authenticating it and the response
+// are written to the request's audit event here.
+func (h *Handler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	const op = "scim.(Handler).ServeHTTP"
+	ctx := r.Context()
+	w := &auditWriter{ResponseWriter: rw}
+	defer w.writeResponseAudit(ctx)
+	// The body of the request is added to the audit event once it has been
+	// decoded.
+	if err := writeRequestAudit(ctx, r, nil); err != nil {
+		writeError(ctx, w, errors.Wrap(ctx, err, op, errors.WithMsg("unable to write request audit")))
+		return
+	}
+
+	req, err := h.authenticate(ctx, r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	defer func() {
+		if err := event.WriteObservation(ctx, op, event.WithDetails(
+			"scim_token_id", req.tokenId,
+			"scope_id", req.scopeId,
+			"auth_method_id", req.authMethodId,
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", w.status,
+		)); err != nil {
+			event.WriteError(ctx, op, err)
+		}
+	}()
+
+	segs := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, PathPrefix), "/"), "/")
+	var id string
+	switch len(segs) {
+	case 1:
+	case 2:
+		id = segs[1]
+	default:
+		writeError(ctx, w, notFoundError)
+		return
+	}
+
+	switch segs[0] {
+	case "ServiceProviderConfig":
+		if id != "" {
+			writeError(ctx, w, notFoundError)
+			return
+		}
+		if r.Method != http.MethodGet {
+			writeError(ctx, w, methodNotAllowed(r))
+			return
+		}
+		writeJson(w, http.StatusOK, req.serviceProviderConfig())
+	case "ResourceTypes":
+		if r.Method != http.MethodGet {
+			writeError(ctx, w, methodNotAllowed(r))
+			return
+		}
+		req.serveResourceTypes(ctx, w, id)
+	case "Users":
+		req.serve(ctx, w, r, users{req}, id)
+	case "Groups":
+		req.serve(ctx, w, r, groups{req}, id)
+	default:
+		writeError(ctx, w, notFoundError)
+	}
+}
+
+// authenticate validates the SCIM token of the request and writes it to the
+// request's audit event.
+func (h *Handler) authenticate(ctx context.Context, r *http.Request) (*request, error) {
+	const op = "scim.(Handler).authenticate"
+	scheme, token, ok := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return nil, unauthenticatedError
+	}
+	repo, err := h.scimRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	tok, err := repo.ValidateToken(ctx, strings.TrimSpace(token))
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil {
+		return nil, unauthenticatedError
+	}
+	if err := event.WriteAudit(ctx, op, event.WithAuth(&event.Auth{AuthTokenId: tok.GetPublicId()})); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to write auth audit"))
+	}
+	proto := "http"
+	if r.TLS != nil {
+		proto = "https"
+	}
+	return &request{
+		Handler:      h,
+		tokenId:      tok.GetPublicId(),
+		scopeId:      tok.GetScopeId(),
+		authMethodId: tok.GetAuthMethodId(),
+		baseUrl:      fmt.Sprintf("%s://%s%s", proto, r.Host, strings.TrimSuffix(PathPrefix, "/")),
+	}, nil
+}
+
+// serve handles the requests for the resources of a resource type.
+func (r *request) serve(ctx context.Context, w http.ResponseWriter, req *http.Request, rt resourceType, id string) {
+	attrs, excluded := req.URL.Query().Get("attributes"), req.URL.Query().Get("excludedAttributes")
+	switch {
+	case id == "" && req.Method == http.MethodGet:
+		r.serveList(ctx, w, req, rt)
+	case id == "" && req.Method == http.MethodPost:
+		var in map[string]any
+		if err := decodeBody(ctx, req, &in); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		res, err := rt.create(ctx, in)
+		if err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		w.Header().Set("Location", location(res))
+		writeJson(w, http.StatusCreated, project(res, attrs, excluded))
+	case id == "":
+		writeError(ctx, w, methodNotAllowed(req))
+	case req.Method == http.MethodGet:
+		res, err := rt.get(ctx, id)
+		if err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		writeJson(w, http.StatusOK, project(res, attrs, excluded))
+	case req.Method == http.MethodPut:
+		var in map[string]any
+		if err := decodeBody(ctx, req, &in); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		res, err := rt.replace(ctx, id, in)
+		if err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		writeJson(w, http.StatusOK, project(res, attrs, excluded))
+	case req.Method == http.MethodPatch:
+		var in patchRequest
+		if err := decodeBody(ctx, req, &in); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		res, err := rt.get(ctx, id)
+		if err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		if err := applyPatch(res, in.Operations); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		if res, err = rt.replace(ctx, id, res); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		writeJson(w, http.StatusOK, project(res, attrs, excluded))
+	case req.Method == http.MethodDelete:
+		if err := rt.delete(ctx, id); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(ctx, w, methodNotAllowed(req))
+	}
+}
+
+// serveList lists the resources matching the request's filter, paginated by
+// its startIndex and count.
+func (r *request) serveList(ctx context.Context, w http.ResponseWriter, req *http.Request, rt resourceType) {
+	q := req.URL.Query()
+	var f filter
+	if s := q.Get("filter"); s != "" {
+		var err error
+		if f, err = parseFilter(s); err != nil {
+			writeError(ctx, w, newError(http.StatusBadRequest, invalidFilterType, "Invalid filter: %v.", err))
+			return
+		}
+	}
+	startIndex, count := 1, maxResults
+	if s := q.Get("startIndex"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			writeError(ctx, w, newError(http.StatusBadRequest, invalidValueType, "Invalid startIndex %q.", s))
+			return
+		}
+		if i > 1 {
+			startIndex = i
+		}
+	}
+	if s := q.Get("count"); s != "" {
+		c, err := strconv.Atoi(s)
+		if err != nil {
+			writeError(ctx, w, newError(http.StatusBadRequest, invalidValueType, "Invalid count %q.", s))
+			return
+		}
+		switch {
+		case c < 0:
+			count = 0
+		case c < maxResults:
+			count = c
+		}
+	}
+
+	all, err := rt.list(ctx)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i]["id"].(string) < all[j]["id"].(string)
+	})
+	var matched []map[string]any
+	for _, res := range all {
+		if f == nil || f.match(res) {
+			matched = append(matched, res)
+		}
+	}
+
+	page := []any{}
+	attrs, excluded := q.Get("attributes"), q.Get("excludedAttributes")
+	for i := startIndex - 1; i < len(matched) && len(page) < count; i++ {
+		page = append(page, project(matched[i], attrs, excluded))
+	}
+	writeJson(w, http.StatusOK, map[string]any{
+		"schemas":      []string{listResponseSchema},
+		"totalResults": len(matched),
+		"startIndex":   startIndex,
+		"itemsPerPage": len(page),
+		"Resources":    page,
+	})
+}
+
+func (r *request) serviceProviderConfig() map[string]any {
+	return map[string]any{
+		"schemas": []string{serviceProviderConfigSchema},
+		"patch":   map[string]any{"supported": true},
+		"bulk": map[string]any{
+			"supported":      false,
+			"maxOperations":  0,
+			"maxPayloadSize": 0,
+		},
+		"filter": map[string]any{
+			"supported":  true,
+			"maxResults": maxResults,
+		},
+		"changePassword": map[string]any{"supported": strings.HasPrefix(r.authMethodId, globals.PasswordAuthMethodPrefix+"_")},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": false},
+		"authenticationSchemes": []any{
+			map[string]any{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication with the SCIM token of the auth method.",
+				"primary":     true,
+			},
+		},
+		"meta": map[string]any{
+			"resourceType": "ServiceProviderConfig",
+			"location":     r.baseUrl + "/ServiceProviderConfig",
+		},
+	}
+}
+
+func (r *request) serveResourceTypes(ctx context.Context, w http.ResponseWriter, id string) {
+	types := map[string]map[string]any{}
+	for _, t := range []struct{ name, endpoint, schema string }{
+		{"User", "/Users", userSchema},
+		{"Group", "/Groups", groupSchema},
+	} {
+		types[t.name] = map[string]any{
+			"schemas":  []string{resourceTypeSchema},
+			"id":       t.name,
+			"name":     t.name,
+			"endpoint": t.endpoint,
+			"schema":   t.schema,
+			"meta": map[string]any{
+				"resourceType": "ResourceType",
+				"location":     r.baseUrl + "/ResourceTypes/" + t.name,
+			},
+		}
+	}
+	if id != "" {
+		rt, ok := types[id]
+		if !ok {
+			writeError(ctx, w, notFoundError)
+			return
+		}
+		writeJson(w, http.StatusOK, rt)
+		return
+	}
+	writeJson(w, http.StatusOK, map[string]any{
+		"schemas":      []string{listResponseSchema},
+		"totalResults": len(types),
+		"startIndex":   1,
+		"itemsPerPage": len(types),
+		"Resources":    []any{types["User"], types["Group"]},
+	})
+}
+
+// meta returns the meta attribute of a resource.
+func (r *request) meta(resourceType, endpoint, id string, created, updated *timestamp.Timestamp, version uint32) map[string]any {
+	return map[string]any{
+		"resourceType": resourceType,
+		"created":      created.GetTimestamp().AsTime().Format(time.RFC3339),
+		"lastModified": updated.GetTimestamp().AsTime().Format(time.RFC3339),
+		"location":     r.baseUrl + endpoint + "/" + id,
+		"version":      fmt.Sprintf("W/%q", strconv.FormatUint(uint64(version), 10)),
+	}
+}
+
+// location returns the location in the meta attribute of the resource.
+func location(res map[string]any) string {
+	meta, _ := res["meta"].(map[string]any)
+	loc, _ := meta["location"].(string)
+	return loc
+}
+
+// project returns the resource with only the requested attributes, or
+// without the excluded ones.  Both are comma separated lists of top-level
+// attributes; the id and schemas attributes are always returned.
+func project(res map[string]any, attrs, excluded string) map[string]any {
+	split := func(s string) []string {
+		var ret []string
+		for _, a := range strings.Split(s, ",") {
+			if a = strings.TrimSpace(a); a != "" {
+				ret = append(ret, attrPath(a)[0])
+			}
+		}
+		return ret
+	}
+	switch {
+	case attrs != "":
+		ret := map[string]any{"id": res["id"], "schemas": res["schemas"]}
+		for _, a := range split(attrs) {
+			k := attrKey(res, a)
+			if v, ok := res[k]; ok {
+				ret[k] = v
+			}
+		}
+		return ret
+	case excluded != "":
+		ret := make(map[string]any, len(res))
+		for k, v := range res {
+			ret[k] = v
+		}
+		for _, a := range split(excluded) {
+			if k := attrKey(ret, a); k != "id" && k != "schemas" {
+				delete(ret, k)
+			}
+		}
+		return ret
+	}
+	return res
+}
+
+func methodNotAllowed(r *http.Request) *apiError {
+	return newError(http.StatusMethodNotAllowed, "", "Method %s is not supported for %s.", r.Method, r.URL.Path)
+}
+
+// decodeBody decodes the JSON body of the request, bounded by the maximum
+// request size of the listener, and writes it to the request's audit event.
+func decodeBody(ctx context.Context, r *http.Request, v any) error {
+	const op = "scim.decodeBody"
+	var body io.Reader = r.Body
+	if max, ok := ctx.Value(globals.ContextMaxRequestSizeTypeKey).(int64); ok && max > 0 {
+		body = io.LimitReader(r.Body, max)
+	}
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, invalidSyntaxType, "Unable to parse the request body: %v.", err)
+	}
+	if err := writeRequestAudit(ctx, r, v); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write request audit"))
+	}
+	return nil
+}
+
+func writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
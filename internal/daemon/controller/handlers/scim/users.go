@@ -0,0 +1,552 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/scim"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+// account is the account of a SCIM user in the token's auth method.
+type account struct {
+	id      string
+	version uint32
+
+	// loginName is set for password accounts.
+	loginName string
+
+	// subject, email and fullName are set for OIDC accounts.
+	subject  string
+	email    string
+	fullName string
+}
+
+// scimUser is a user of the token's scope with an account in the token's
+// auth method.
+type scimUser struct {
+	user    *iam.User
+	account *account
+}
+
+// userInput holds the attributes of a SCIM User provided by a client.
+type userInput struct {
+	userName    string
+	displayName string
+	externalId  string
+	password    string
+	email       string
+	fullName    string
+	active      bool
+}
+
+// users is the User resource type.
+type users struct {
+	*request
+}
+
+var _ resourceType = users{}
+
+func (u users) list(ctx context.Context) ([]map[string]any, error) {
+	const op = "scim.(users).list"
+	accts, err := u.listAccounts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	iamRepo, err := u.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	all, err := iamRepo.ListUsers(ctx, []string{u.scopeId}, iam.WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var ret []map[string]any
+	for _, usr := range all {
+		if a, ok := accts[usr.GetPublicId()]; ok {
+			ret = append(ret, u.resource(&scimUser{user: usr, account: a}))
+		}
+	}
+	return ret, nil
+}
+
+func (u users) get(ctx context.Context, id string) (map[string]any, error) {
+	su, err := u.lookup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return u.resource(su), nil
+}
+
+func (u users) create(ctx context.Context, res map[string]any) (map[string]any, error) {
+	const op = "scim.(users).create"
+	in, err := parseUser(res)
+	if err != nil {
+		return nil, err
+	}
+	if !in.active {
+		return nil, newError(http.StatusBadRequest, invalidValueType, "Users must be active when they are created.")
+	}
+	iamRepo, err := u.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	usr, err := iam.NewUser(ctx, u.scopeId, iam.WithName(in.userName), iam.WithDescription(in.displayName))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if usr, err = iamRepo.CreateUser(ctx, usr); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	// The user and its account are created by different repositories, so
+	// undo the creation of the user if its account can't be added.  Both
+	// were just created, so undoing it can't delete anything the SCIM API
+	// didn't provision.
+	acct, err := u.createAccount(ctx, in)
+	if err == nil {
+		if _, err = iamRepo.AddUserAccounts(ctx, usr.GetPublicId(), usr.GetVersion(), []string{acct.id}); err == nil {
+			var scimRepo *scim.Repository
+			if scimRepo, err = u.scimRepoFn(); err == nil {
+				err = scimRepo.AddProvisioned(ctx, u.authMethodId, usr.GetPublicId(), acct.id)
+			}
+		}
+		if err != nil {
+			_ = u.deleteAccount(ctx, acct.id)
+		}
+	}
+	if err != nil {
+		_, _ = iamRepo.DeleteUser(ctx, usr.GetPublicId())
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	if usr, _, err = iamRepo.LookupUser(ctx, usr.GetPublicId()); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return u.resource(&scimUser{user: usr, account: acct}), nil
+}
+
+func (u users) replace(ctx context.Context, id string, res map[string]any) (map[string]any, error) {
+	const op = "scim.(users).replace"
+	su, err := u.lookup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	in, err := parseUser(res)
+	if err != nil {
+		return nil, err
+	}
+	if !in.active {
+		// Deactivated users are deleted, so they can no longer authenticate.
+		if err := u.deleteUser(ctx, su); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ret := u.resource(su)
+		ret["active"] = false
+		return ret, nil
+	}
+
+	if in.userName != su.user.GetName() || in.displayName != su.user.GetDescription() {
+		iamRepo, err := u.iamRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		upd, err := iam.NewUser(ctx, u.scopeId, iam.WithName(in.userName), iam.WithDescription(in.displayName))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		upd.PublicId = id
+		if su.user, _, _, err = iamRepo.UpdateUser(ctx, upd, su.user.GetVersion(), []string{"Name", "Description"}); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	switch subtypes.SubtypeFromId(auth.Domain, u.authMethodId) {
+	case password.Subtype:
+		pwRepo, err := u.pwRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if loginName := strings.ToLower(in.userName); loginName != su.account.loginName {
+			upd, err := password.NewAccount(ctx, u.authMethodId, password.WithLoginName(loginName))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			upd.PublicId = su.account.id
+			upd, _, err = pwRepo.UpdateAccount(ctx, u.scopeId, upd, su.account.version, []string{"LoginName"})
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			su.account.loginName, su.account.version = upd.GetLoginName(), upd.GetVersion()
+		}
+		if in.password != "" {
+			upd, err := pwRepo.SetPassword(ctx, u.scopeId, su.account.id, in.password, su.account.version)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			su.account.version = upd.GetVersion()
+		}
+	case oidc.Subtype:
+		if in.externalId != "" && in.externalId != su.account.subject {
+			return nil, newError(http.StatusBadRequest, mutabilityType, "The externalId of a user is the subject of its OIDC account and cannot be changed.")
+		}
+	}
+	return u.resource(su), nil
+}
+
+func (u users) delete(ctx context.Context, id string) error {
+	su, err := u.lookup(ctx, id)
+	if err != nil {
+		return err
+	}
+	return u.deleteUser(ctx, su)
+}
+
+// lookup returns the SCIM user with the id.
+func (u users) lookup(ctx context.Context, id string) (*scimUser, error) {
+	const op = "scim.(users).lookup"
+	iamRepo, err := u.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	usr, acctIds, err := iamRepo.LookupUser(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if usr == nil || usr.GetScopeId() != u.scopeId {
+		return nil, notFoundError
+	}
+	scimRepo, err := u.scimRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	owners, err := scimRepo.AccountUsers(ctx, u.authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, acctId := range acctIds {
+		if owners[acctId] != id {
+			continue
+		}
+		a, err := u.lookupAccount(ctx, acctId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a != nil {
+			return &scimUser{user: usr, account: a}, nil
+		}
+	}
+	return nil, notFoundError
+}
+
+// deleteUser removes the SCIM user.  Its account is deleted if it was created
+// through the SCIM API, and is otherwise only removed from the user.  The user
+// is deleted if it was created through the SCIM API and has no other
+// accounts, so users and accounts managed by an administrator are kept.
+func (u users) deleteUser(ctx context.Context, su *scimUser) error {
+	const op = "scim.(users).deleteUser"
+	scimRepo, err := u.scimRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	userProvisioned, acctProvisioned, err := scimRepo.Provisioned(ctx, u.authMethodId, su.user.GetPublicId(), su.account.id)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	iamRepo, err := u.iamRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if acctProvisioned {
+		if err := u.deleteAccount(ctx, su.account.id); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	} else {
+		if _, err := iamRepo.DeleteUserAccounts(ctx, su.user.GetPublicId(), su.user.GetVersion(), []string{su.account.id}); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if !userProvisioned {
+		return nil
+	}
+	usr, acctIds, err := iamRepo.LookupUser(ctx, su.user.GetPublicId())
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if usr == nil || len(acctIds) > 0 {
+		return nil
+	}
+	if _, err := iamRepo.DeleteUser(ctx, usr.GetPublicId()); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// listAccounts returns the accounts of the auth method which have a user,
+// keyed by the user's id.
+func (u users) listAccounts(ctx context.Context) (map[string]*account, error) {
+	const op = "scim.(users).listAccounts"
+	scimRepo, err := u.scimRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	owners, err := scimRepo.AccountUsers(ctx, u.authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := make(map[string]*account, len(owners))
+	add := func(a *account) {
+		userId, ok := owners[a.id]
+		if !ok {
+			return
+		}
+		// A user with several accounts in the auth method is represented by
+		// the first of them.
+		if cur, ok := ret[userId]; !ok || a.id < cur.id {
+			ret[userId] = a
+		}
+	}
+	switch subtypes.SubtypeFromId(auth.Domain, u.authMethodId) {
+	case password.Subtype:
+		repo, err := u.pwRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		accts, err := repo.ListAccounts(ctx, u.authMethodId, password.WithLimit(-1))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, a := range accts {
+			add(passwordAccount(a))
+		}
+	case oidc.Subtype:
+		repo, err := u.oidcRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		accts, err := repo.ListAccounts(ctx, u.authMethodId, oidc.WithLimit(-1))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, a := range accts {
+			add(oidcAccount(a))
+		}
+	}
+	return ret, nil
+}
+
+// lookupAccount returns the account of the auth method with the id, or nil
+// if it doesn't exist.
+func (u users) lookupAccount(ctx context.Context, id string) (*account, error) {
+	const op = "scim.(users).lookupAccount"
+	switch subtypes.SubtypeFromId(auth.Domain, u.authMethodId) {
+	case password.Subtype:
+		repo, err := u.pwRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil || a == nil {
+			return nil, err
+		}
+		return passwordAccount(a), nil
+	case oidc.Subtype:
+		repo, err := u.oidcRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil || a == nil {
+			return nil, err
+		}
+		return oidcAccount(a), nil
+	}
+	return nil, errors.New(ctx, errors.InvalidParameter, op, "unsupported auth method type")
+}
+
+// createAccount creates the account of a new SCIM user.  The login name of
+// password accounts is the lowercase userName, and the subject of OIDC
+// accounts is the externalId or, if there is none, the userName.
+func (u users) createAccount(ctx context.Context, in *userInput) (*account, error) {
+	const op = "scim.(users).createAccount"
+	switch subtypes.SubtypeFromId(auth.Domain, u.authMethodId) {
+	case password.Subtype:
+		repo, err := u.pwRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		a, err := password.NewAccount(ctx, u.authMethodId, password.WithLoginName(strings.ToLower(in.userName)))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		var opts []password.Option
+		if in.password != "" {
+			opts = append(opts, password.WithPassword(in.password))
+		}
+		if a, err = repo.CreateAccount(ctx, u.scopeId, a, opts...); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return passwordAccount(a), nil
+	case oidc.Subtype:
+		repo, err := u.oidcRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		subject := in.externalId
+		if subject == "" {
+			subject = in.userName
+		}
+		a, err := oidc.NewAccount(ctx, u.authMethodId, subject, oidc.WithEmail(in.email), oidc.WithFullName(in.fullName))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a, err = repo.CreateAccount(ctx, u.scopeId, a); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return oidcAccount(a), nil
+	}
+	return nil, errors.New(ctx, errors.InvalidParameter, op, "unsupported auth method type")
+}
+
+func (u users) deleteAccount(ctx context.Context, id string) error {
+	const op = "scim.(users).deleteAccount"
+	var err error
+	switch subtypes.SubtypeFromId(auth.Domain, u.authMethodId) {
+	case password.Subtype:
+		var repo *password.Repository
+		if repo, err = u.pwRepoFn(); err == nil {
+			_, err = repo.DeleteAccount(ctx, u.scopeId, id)
+		}
+	case oidc.Subtype:
+		var repo *oidc.Repository
+		if repo, err = u.oidcRepoFn(); err == nil {
+			_, err = repo.DeleteAccount(ctx, u.scopeId, id)
+		}
+	}
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// resource returns the JSON representation of the SCIM user.
+func (u users) resource(su *scimUser) map[string]any {
+	usr := su.user
+	res := map[string]any{
+		"schemas":  []any{userSchema},
+		"id":       usr.GetPublicId(),
+		"userName": usr.GetName(),
+		"active":   true,
+		"meta":     u.meta("User", "/Users", usr.GetPublicId(), usr.GetCreateTime(), usr.GetUpdateTime(), usr.GetVersion()),
+	}
+	if usr.GetDescription() != "" {
+		res["displayName"] = usr.GetDescription()
+	}
+	if su.account.subject != "" {
+		res["externalId"] = su.account.subject
+	}
+	if su.account.email != "" {
+		res["emails"] = []any{map[string]any{"value": su.account.email, "primary": true}}
+	}
+	if su.account.fullName != "" {
+		res["name"] = map[string]any{"formatted": su.account.fullName}
+	}
+	return res
+}
+
+func passwordAccount(a *password.Account) *account {
+	return &account{
+		id:        a.GetPublicId(),
+		version:   a.GetVersion(),
+		loginName: a.GetLoginName(),
+	}
+}
+
+func oidcAccount(a *oidc.Account) *account {
+	return &account{
+		id:       a.GetPublicId(),
+		version:  a.GetVersion(),
+		subject:  a.GetSubject(),
+		email:    a.GetEmail(),
+		fullName: a.GetFullName(),
+	}
+}
+
+// parseUser returns the attributes of the JSON representation of a SCIM User
+// which are mapped to users and their accounts.
+func parseUser(res map[string]any) (*userInput, error) {
+	in := &userInput{active: true}
+	for name, target := range map[string]*string{
+		"userName":    &in.userName,
+		"displayName": &in.displayName,
+		"externalId":  &in.externalId,
+		"password":    &in.password,
+	} {
+		switch v := getAttr(res, name).(type) {
+		case nil:
+		case string:
+			*target = strings.TrimSpace(v)
+		default:
+			return nil, newError(http.StatusBadRequest, invalidValueType, "The %s attribute must be a string.", name)
+		}
+	}
+	if in.userName == "" {
+		return nil, newError(http.StatusBadRequest, invalidValueType, "The userName attribute is required.")
+	}
+
+	// Some identity providers send booleans as strings.
+	switch v := getAttr(res, "active").(type) {
+	case nil:
+	case bool:
+		in.active = v
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, invalidValueType, "The active attribute must be a boolean.")
+		}
+		in.active = b
+	default:
+		return nil, newError(http.StatusBadRequest, invalidValueType, "The active attribute must be a boolean.")
+	}
+
+	// The primary email, or the first one if none is primary.
+	emails, _ := getAttr(res, "emails").([]any)
+	for _, e := range emails {
+		m, ok := e.(map[string]any)
+		if !ok {
+			continue
+		}
+		v, _ := getAttr(m, "value").(string)
+		primary, _ := getAttr(m, "primary").(bool)
+		if in.email == "" || primary {
+			in.email = v
+		}
+		if primary {
+			break
+		}
+	}
+
+	if name, ok := getAttr(res, "name").(map[string]any); ok {
+		in.fullName, _ = getAttr(name, "formatted").(string)
+		if in.fullName == "" {
+			var parts []string
+			for _, p := range []string{"givenName", "middleName", "familyName"} {
+				if s, _ := getAttr(name, p).(string); s != "" {
+					parts = append(parts, s)
+				}
+			}
+			in.fullName = strings.Join(parts, " ")
+		}
+	}
+	return in, nil
+}
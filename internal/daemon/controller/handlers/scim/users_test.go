@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/scim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsers_Delete(t *testing.T) {
+	// Not parallel: the test disables the system eventer.
+	event.TestWithoutEventing(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	ams := password.TestAuthMethods(t, conn, org.GetPublicId(), 2)
+	am, otherAm := ams[0], ams[1]
+
+	scimRepoFn := func() (*scim.Repository, error) { return scim.NewRepository(ctx, rw, rw, kmsCache) }
+	iamRepoFn := func() (*iam.Repository, error) { return iamRepo, nil }
+	pwRepoFn := func() (*password.Repository, error) { return password.NewRepository(ctx, rw, rw, kmsCache) }
+	oidcRepoFn := func() (*oidc.Repository, error) { return oidc.NewRepository(ctx, rw, rw, kmsCache) }
+	h, err := NewHandler(ctx, scimRepoFn, iamRepoFn, pwRepoFn, oidcRepoFn)
+	require.NoError(t, err)
+	scimRepo, err := scimRepoFn()
+	require.NoError(t, err)
+	tok, err := scimRepo.CreateToken(ctx, org.GetPublicId(), am.GetPublicId())
+	require.NoError(t, err)
+	pwRepo, err := pwRepoFn()
+	require.NoError(t, err)
+
+	do := func(t *testing.T, method, path, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		r := httptest.NewRequest(method, PathPrefix+path, strings.NewReader(body))
+		r.Header.Set("Authorization", "Bearer "+tok.BearerToken())
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+	create := func(t *testing.T, userName string) (string, string) {
+		t.Helper()
+		w := do(t, http.MethodPost, "Users", `{"userName": "`+userName+`"}`)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var res map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		userId := res["id"].(string)
+		_, acctIds, err := iamRepo.LookupUser(ctx, userId)
+		require.NoError(t, err)
+		require.Len(t, acctIds, 1)
+		return userId, acctIds[0]
+	}
+
+	t.Run("provisioned", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		userId, acctId := create(t, "alice")
+		w := do(t, http.MethodDelete, "Users/"+userId, "")
+		require.Equal(http.StatusNoContent, w.Code, w.Body.String())
+
+		usr, _, err := iamRepo.LookupUser(ctx, userId)
+		require.NoError(err)
+		assert.Nil(usr)
+		acct, err := pwRepo.LookupAccount(ctx, acctId)
+		require.NoError(err)
+		assert.Nil(acct)
+	})
+
+	t.Run("provisioned-with-other-accounts", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		userId, acctId := create(t, "bob")
+		other := password.TestAccount(t, conn, otherAm.GetPublicId(), "bob")
+		usr, _, err := iamRepo.LookupUser(ctx, userId)
+		require.NoError(err)
+		_, err = iamRepo.AddUserAccounts(ctx, userId, usr.GetVersion(), []string{other.GetPublicId()})
+		require.NoError(err)
+
+		w := do(t, http.MethodDelete, "Users/"+userId, "")
+		require.Equal(http.StatusNoContent, w.Code, w.Body.String())
+
+		// The user is kept with its other account.
+		usr, acctIds, err := iamRepo.LookupUser(ctx, userId)
+		require.NoError(err)
+		require.NotNil(usr)
+		assert.Equal([]string{other.GetPublicId()}, acctIds)
+		acct, err := pwRepo.LookupAccount(ctx, acctId)
+		require.NoError(err)
+		assert.Nil(acct)
+	})
+
+	t.Run("not-provisioned", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct := password.TestAccount(t, conn, am.GetPublicId(), "carol")
+		usr := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithAccountIds(acct.GetPublicId()))
+
+		w := do(t, http.MethodDelete, "Users/"+usr.GetPublicId(), "")
+		require.Equal(http.StatusNoContent, w.Code, w.Body.String())
+
+		// Neither the user nor the account is deleted, the account is only
+		// removed from the user.
+		got, acctIds, err := iamRepo.LookupUser(ctx, usr.GetPublicId())
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Empty(acctIds)
+		gotAcct, err := pwRepo.LookupAccount(ctx, acct.GetPublicId())
+		require.NoError(err)
+		assert.NotNil(gotAcct)
+
+		w = do(t, http.MethodGet, "Users/"+usr.GetPublicId(), "")
+		assert.Equal(http.StatusNotFound, w.Code)
+	})
+}
@@ -80,6 +80,7 @@ var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
 	},
 	"auth-tokens": {
 		Values: []*structpb.Value{
+			structpb.NewStringValue("create"),
 			structpb.NewStringValue("list"),
 		},
 	},
@@ -142,6 +143,7 @@ var orgAuthorizedCollectionActions = map[string]*structpb.ListValue{
 	},
 	"auth-tokens": {
 		Values: []*structpb.Value{
+			structpb.NewStringValue("create"),
 			structpb.NewStringValue("list"),
 		},
 	},
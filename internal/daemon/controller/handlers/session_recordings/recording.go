@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session_recordings
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/storage"
+	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
+)
+
+// Session recording states, as documented on the api resource.
+const (
+	stateAvailable = "available"
+	stateUnknown   = "unknown"
+)
+
+// recording is a session recording read from a storage bucket.  If the
+// recording could not be opened, session is nil and err explains why.
+type recording struct {
+	id      string
+	bucket  *pluginstorage.StorageBucket
+	session *bsr.Session
+	// connections are the recording's connections, sorted by id.
+	connections []*bsr.Connection
+	// channels are the channels of each of the connections, keyed by the
+	// connection recording id and sorted by channel id.
+	channels map[string][]*bsr.Channel
+	err      error
+}
+
+// state returns the state of the recording.  The checksums of a recording
+// are only signed once the worker closes it, so recordings which are still in
+// progress can't be opened and their state is unknown.
+func (r *recording) state() string {
+	if r.session != nil && !r.session.Summary.GetEndTime().IsZero() {
+		return stateAvailable
+	}
+	return stateUnknown
+}
+
+// channel returns the channel with the given id along with the id of the
+// connection it belongs to, or nil if the recording has no such channel.
+func (r *recording) channel(id string) (string, *bsr.Channel) {
+	for connId, chs := range r.channels {
+		for _, ch := range chs {
+			if ch.Meta.Id == id {
+				return connId, ch
+			}
+		}
+	}
+	return "", nil
+}
+
+// hasConnection reports whether the recording contains the connection
+// recording with the given id.
+func (r *recording) hasConnection(id string) bool {
+	return r.session != nil && slices.Contains(r.session.Meta.Connections(), id)
+}
+
+// close closes the containers of the recording.
+func (r *recording) close(ctx context.Context) {
+	const op = "session_recordings.(recording).close"
+	if r.session == nil {
+		return
+	}
+	for _, chs := range r.channels {
+		for _, ch := range chs {
+			if err := ch.Close(ctx); err != nil {
+				event.WriteError(ctx, op, err)
+			}
+		}
+	}
+	for _, c := range r.connections {
+		if err := c.Close(ctx); err != nil {
+			event.WriteError(ctx, op, err)
+		}
+	}
+	if err := r.session.Close(ctx); err != nil {
+		event.WriteError(ctx, op, err)
+	}
+}
+
+// recordingReader reads session recordings from the storage buckets of a
+// recording storage, unwrapping their keys with the controller's bsr kms.
+type recordingReader struct {
+	recordingStorage storage.RecordingStorage
+	keyUnwrapFn      bsrkms.KeyUnwrapCallbackFunc
+	// bucketFss are the FSs the storage buckets' recordings are read from,
+	// keyed by storage bucket id.
+	bucketFss map[string]storage.FS
+}
+
+// bucketFs returns the FS the session recordings in the storage bucket are
+// read from.
+func (rr *recordingReader) bucketFs(ctx context.Context, sb *pluginstorage.StorageBucket) (storage.FS, error) {
+	const op = "session_recordings.(recordingReader).bucketFs"
+	if bucketFs, ok := rr.bucketFss[sb.GetPublicId()]; ok {
+		return bucketFs, nil
+	}
+	bucket, err := sb.ToProto(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	bucketFs, err := rr.recordingStorage.NewRemoteFS(ctx, bucket)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read storage bucket"))
+	}
+	rr.bucketFss[sb.GetPublicId()] = bucketFs
+	return bucketFs, nil
+}
+
+// open opens the session recording with the given id from the storage bucket
+// it was recorded to, along with all of its connections and channels.
+// Failing to open the recording is not an error, it is reported by the
+// recording's state instead.  The caller must close the returned recording.
+func (rr *recordingReader) open(ctx context.Context, sb *pluginstorage.StorageBucket, id string) *recording {
+	rec := &recording{
+		id:       id,
+		bucket:   sb,
+		channels: make(map[string][]*bsr.Channel),
+	}
+	bucketFs, err := rr.bucketFs(ctx, sb)
+	if err != nil {
+		rec.err = err
+		return rec
+	}
+	bs, err := bsr.OpenSession(ctx, id, bucketFs, rr.keyUnwrapFn)
+	if err != nil {
+		rec.err = err
+		return rec
+	}
+	rec.session = bs
+	for _, connId := range bs.Meta.Connections() {
+		c, err := bs.OpenConnection(ctx, connId)
+		if err != nil {
+			rec.err = err
+			rec.close(ctx)
+			rec.session, rec.connections, rec.channels = nil, nil, nil
+			return rec
+		}
+		rec.connections = append(rec.connections, c)
+		for _, chId := range c.Meta.Channels() {
+			ch, err := c.OpenChannel(ctx, chId)
+			if err != nil {
+				rec.err = err
+				rec.close(ctx)
+				rec.session, rec.connections, rec.channels = nil, nil, nil
+				return rec
+			}
+			rec.channels[connId] = append(rec.channels[connId], ch)
+		}
+	}
+	return rec
+}
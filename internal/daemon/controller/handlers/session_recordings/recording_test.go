@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session_recordings
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/convert"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/bsr/ssh"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/hashicorp/boundary/internal/storage/local"
+	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
+	"github.com/hashicorp/boundary/internal/storage/plugin/store"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gssh "golang.org/x/crypto/ssh"
+)
+
+// testRecording is an ssh session recording with a single connection and
+// shell channel.
+type testRecording struct {
+	id, connectionId, channelId string
+}
+
+// writeTestRecording writes an ssh session recording of the session, with a
+// recording of the connection, to the storage bucket.  If closed is false the
+// recording is left in progress.
+func writeTestRecording(t *testing.T, rs storage.RecordingStorage, sb *pluginstorage.StorageBucket, wrapper wrapping.Wrapper, sessionId, connectionId string, closed bool) testRecording {
+	t.Helper()
+	ctx := context.Background()
+	require := require.New(t)
+
+	bucket, err := sb.ToProto(ctx)
+	require.NoError(err)
+	fs, err := rs.NewSyncingFS(ctx, bucket)
+	require.NoError(err)
+	keys, err := bsrkms.CreateKeys(ctx, wrapper, sessionId)
+	require.NoError(err)
+
+	rec := testRecording{}
+	rec.id, err = session.RecordingId(ctx, sessionId)
+	require.NoError(err)
+	rec.connectionId, err = session.ConnectionRecordingId(ctx, connectionId)
+	require.NoError(err)
+	rec.channelId, err = bsr.NewChannelId()
+	require.NoError(err)
+
+	start := time.Now()
+	bs, err := bsr.NewSession(ctx, &bsr.SessionRecordingMeta{Id: rec.id, Protocol: ssh.Protocol}, &bsr.SessionMeta{
+		PublicId: sessionId,
+		Endpoint: "ssh://127.0.0.1:22",
+		User:     &bsr.User{PublicId: "u_1234567890"},
+		Target:   &bsr.Target{PublicId: "tssh_1234567890", Scope: bsr.Scope{PublicId: "p_1234567890", Type: "project"}},
+		Worker:   &bsr.Worker{PublicId: "w_1234567890"},
+	}, fs, keys, bsr.WithSupportsMultiplex(true))
+	require.NoError(err)
+	if !closed {
+		return rec
+	}
+	conn, err := bs.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: rec.connectionId})
+	require.NoError(err)
+	ch, err := conn.NewChannel(ctx, &bsr.ChannelRecordingMeta{Id: rec.channelId, Type: "session"})
+	require.NoError(err)
+
+	ts := bsr.NewTimestamp(start)
+	pty, err := ssh.NewPtyRequest(ctx, bsr.Inbound, ts, &gssh.Request{Type: ssh.PtyRequestType, Payload: gssh.Marshal(struct {
+		Term                string
+		Columns, Rows, W, H uint32
+		Modes               string
+	}{"xterm", 80, 24, 0, 0, ""})})
+	require.NoError(err)
+	shell, err := ssh.NewShellRequest(ctx, bsr.Inbound, ts, &gssh.Request{Type: ssh.ShellRequestType})
+	require.NoError(err)
+	reqs, err := ch.NewRequestsWriter(ctx, bsr.Inbound)
+	require.NoError(err)
+	writeTestChunks(t, reqs, bsr.Inbound, sessionId, pty, shell)
+	for _, m := range []struct {
+		dir  bsr.Direction
+		data string
+	}{
+		{bsr.Inbound, "echo hello\r"},
+		{bsr.Outbound, "hello\r\n"},
+	} {
+		data, err := ssh.NewDataChunk(ctx, m.dir, ts, []byte(m.data))
+		require.NoError(err)
+		msgs, err := ch.NewMessagesWriter(ctx, m.dir)
+		require.NoError(err)
+		writeTestChunks(t, msgs, m.dir, sessionId, data)
+	}
+
+	end := time.Now()
+	require.NoError(ch.EncodeSummary(ctx, &ssh.ChannelSummary{
+		ChannelSummary: &bsr.BaseChannelSummary{
+			Id:                    rec.channelId,
+			ConnectionRecordingId: rec.connectionId,
+			StartTime:             start,
+			EndTime:               end,
+			BytesUp:               11,
+			BytesDown:             7,
+			ChannelType:           "session",
+		},
+		SessionProgram: ssh.Shell,
+	}))
+	require.NoError(ch.Close(ctx))
+	require.NoError(conn.EncodeSummary(ctx, &bsr.BaseConnectionSummary{
+		Id:           rec.connectionId,
+		ChannelCount: 1,
+		StartTime:    start,
+		EndTime:      end,
+		BytesUp:      11,
+		BytesDown:    7,
+	}))
+	require.NoError(conn.Close(ctx))
+	require.NoError(bs.EncodeSummary(ctx, &bsr.BaseSessionSummary{
+		Id:              rec.id,
+		ConnectionCount: 1,
+		StartTime:       start,
+		EndTime:         end,
+	}))
+	require.NoError(bs.Close(ctx))
+	return rec
+}
+
+// writeTestChunks writes an ssh chunk file containing the chunks to w and
+// closes it if it is an io.Closer.
+func writeTestChunks(t *testing.T, w io.Writer, dir bsr.Direction, sessionId string, chunks ...bsr.Chunk) {
+	t.Helper()
+	ctx := context.Background()
+	require := require.New(t)
+
+	ts := bsr.NewTimestamp(time.Now())
+	h, err := bsr.NewHeader(ctx, ssh.Protocol, dir, ts, bsr.NoCompression, bsr.NoEncryption, sessionId)
+	require.NoError(err)
+	end, err := bsr.NewEnd(ctx, ssh.Protocol, dir, ts)
+	require.NoError(err)
+
+	_, err = w.Write(bsr.Magic.Bytes())
+	require.NoError(err)
+	enc, err := bsr.NewChunkEncoder(ctx, w, bsr.NoCompression, bsr.NoEncryption)
+	require.NoError(err)
+	for _, c := range append(append([]bsr.Chunk{h}, chunks...), end) {
+		_, err := enc.Encode(ctx, c)
+		require.NoError(err)
+	}
+	require.NoError(enc.Close())
+}
+
+func testStorageBucket(id, name string) *pluginstorage.StorageBucket {
+	return &pluginstorage.StorageBucket{
+		StorageBucket: &store.StorageBucket{
+			PublicId:   id,
+			ScopeId:    "o_1234567890",
+			PluginId:   "pl_1234567890",
+			BucketName: name,
+		},
+	}
+}
+
+func TestRecordingReader(t *testing.T) {
+	ctx := context.Background()
+	wrapper := bsrkms.TestWrapper(t)
+	rs, err := local.NewRecordingStorage(ctx, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	empty := testStorageBucket("sb_empty", "empty")
+	sb := testStorageBucket("sb_1234567890", "bucket")
+	available := writeTestRecording(t, rs, sb, wrapper, "s_available", "sc_available", true)
+	started := writeTestRecording(t, rs, sb, wrapper, "s_started", "sc_started", false)
+	// A recording whose keys were wrapped by another kms can't be opened.
+	unknown := writeTestRecording(t, rs, sb, bsrkms.TestWrapper(t), "s_unknown", "sc_unknown", true)
+
+	rr := &recordingReader{
+		recordingStorage: rs,
+		keyUnwrapFn:      bsrkms.NewKeyUnwrapCallbackFunc(ctx, wrapper),
+		bucketFss:        make(map[string]storage.FS),
+	}
+
+	cases := []struct {
+		name      string
+		bucket    *pluginstorage.StorageBucket
+		id        string
+		wantState string
+	}{
+		{"available", sb, available.id, stateAvailable},
+		// Recordings in progress can't be opened yet.
+		{"in progress", sb, started.id, stateUnknown},
+		{"unknown", sb, unknown.id, stateUnknown},
+		{"missing", sb, "sr_missing", stateUnknown},
+		// Nothing has been recorded to the empty bucket yet.
+		{"empty bucket", empty, available.id, stateUnknown},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			rec := rr.open(ctx, tc.bucket, tc.id)
+			defer rec.close(ctx)
+			assert.Equal(tc.id, rec.id)
+			assert.Equal(tc.bucket, rec.bucket)
+			assert.Equal(tc.wantState, rec.state())
+			if tc.wantState != stateAvailable {
+				assert.Error(rec.err)
+				assert.False(rec.hasConnection(available.connectionId))
+			}
+		})
+	}
+
+	t.Run("to proto", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		rec := rr.open(ctx, sb, available.id)
+		defer rec.close(ctx)
+		assert.True(rec.hasConnection(available.connectionId))
+
+		connId, ch := rec.channel(available.channelId)
+		require.NotNil(ch)
+		assert.Equal(available.connectionId, connId)
+
+		outputFields := (&perms.OutputFields{}).AddFields([]string{"*"})
+		got, err := toProto(ctx, rec, handlers.WithOutputFields(outputFields))
+		require.NoError(err)
+		assert.Equal(available.id, got.GetId())
+		assert.Equal("s_available", got.GetSessionId())
+		assert.Equal(sb.GetPublicId(), got.GetStorageBucketId())
+		assert.Equal(stateAvailable, got.GetState())
+		assert.Empty(got.GetErrorDetails())
+		assert.Equal("ssh", got.GetType())
+		assert.Equal("ssh://127.0.0.1:22", got.GetEndpoint())
+		assert.Equal(uint64(7), got.GetBytesDown())
+		assert.NotNil(got.GetDuration())
+		assert.Equal("u_1234567890", got.GetCreateTimeValues().GetUser().GetId())
+		assert.Equal("tssh_1234567890", got.GetCreateTimeValues().GetTarget().GetId())
+		assert.Equal("p_1234567890", got.GetCreateTimeValues().GetTarget().GetScope().GetId())
+		require.Len(got.GetConnectionRecordings(), 1)
+		cr := got.GetConnectionRecordings()[0]
+		assert.Equal(available.connectionId, cr.GetId())
+		require.Len(cr.GetChannelRecordings(), 1)
+		assert.Equal(available.channelId, cr.GetChannelRecordings()[0].GetId())
+		assert.Empty(cr.GetMimeTypes())
+		assert.Equal([]string{asciicastMimeType, transcriptMimeType}, cr.GetChannelRecordings()[0].GetMimeTypes())
+
+		// The shell channel can be converted to an asciicast.
+		tmp, err := rs.CreateTemp(ctx, "test-*.cast")
+		require.NoError(err)
+		r, err := convert.ToAsciicast(ctx, rec.session, tmp, connId, convert.WithChannelId(ch.Meta.Id))
+		require.NoError(err)
+		defer r.Close()
+		cast, err := io.ReadAll(r)
+		require.NoError(err)
+		assert.Contains(string(cast), `hello\r\n`)
+
+		// It can also be converted to a transcript of both directions.
+		tmp, err = rs.CreateTemp(ctx, "test-*.txt")
+		require.NoError(err)
+		r, err = convert.ToTranscript(ctx, rec.session, tmp, connId, convert.WithChannelId(ch.Meta.Id))
+		require.NoError(err)
+		defer r.Close()
+		transcript, err := io.ReadAll(r)
+		require.NoError(err)
+		assert.Regexp(`(?m)^\S+ # shell\n\S+ > echo hello\\r\n\S+ < hello\\r$`, string(transcript))
+
+		// Only the output fields are set.
+		got, err = toProto(ctx, rec, handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{globals.IdField})))
+		require.NoError(err)
+		assert.Equal(available.id, got.GetId())
+		assert.Zero(got.GetBytesDown())
+		assert.Empty(got.GetConnectionRecordings())
+	})
+	t.Run("unknown to proto", func(t *testing.T) {
+		rec := rr.open(ctx, sb, unknown.id)
+		defer rec.close(ctx)
+		got, err := toProto(ctx, rec, handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{"*"})))
+		require.NoError(t, err)
+		assert.Equal(t, stateUnknown, got.GetState())
+		assert.NotEmpty(t, got.GetErrorDetails())
+		assert.Empty(t, got.GetType())
+	})
+}
+
+func TestRecordingId(t *testing.T) {
+	ctx := context.Background()
+	for in, want := range map[string]string{
+		"s_1234567890":   "sr_1234567890",
+		"sr_1234567890":  "sr_1234567890",
+		"sc_1234567890":  "cr_1234567890",
+		"cr_1234567890":  "cr_1234567890",
+		"chr_1234567890": "chr_1234567890",
+	} {
+		got, err := recordingId(ctx, in)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, in)
+	}
+}
+
+func TestValidateDownloadRequest(t *testing.T) {
+	for _, req := range []*pbs.DownloadRequest{
+		{Id: "chr_1234567890"},
+		{Id: "sr_1234567890", MimeType: asciicastMimeType},
+		{Id: "sc_1234567890"},
+		{Id: "cr_1234567890", MimeType: pcapngMimeType},
+		{Id: "chr_1234567890", MimeType: transcriptMimeType},
+	} {
+		assert.NoError(t, validateDownloadRequest(req), req.GetId())
+	}
+	for _, req := range []*pbs.DownloadRequest{
+		{},
+		{Id: "sb_1234567890"},
+		{Id: "chr_1234567890", MimeType: "application/json"},
+	} {
+		assert.Error(t, validateDownloadRequest(req), req.GetId())
+	}
+}
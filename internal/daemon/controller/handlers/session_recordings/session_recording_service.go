@@ -5,17 +5,62 @@ package session_recordings
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
 	"sync/atomic"
+	"time"
 
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/convert"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/bsr/ssh"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	intglobals "github.com/hashicorp/boundary/internal/globals"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/storage"
+	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
 	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/session_recordings"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// Mime types of the formats recordings can be downloaded in.
+	asciicastMimeType  = "application/x-asciicast"
+	pcapngMimeType     = "application/x-pcapng"
+	transcriptMimeType = "text/plain"
+
+	// downloadChunkSize is the maximum size of the http bodies a download is
+	// streamed in.
+	downloadChunkSize = 64 * 1024
+)
+
+// downloadExtensions are the file extensions of the temporary files
+// recordings are converted to, by mime type.
+var downloadExtensions = map[string]string{
+	asciicastMimeType:  ".cast",
+	pcapngMimeType:     ".pcapng",
+	transcriptMimeType: ".txt",
+}
+
 var (
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -32,33 +77,673 @@ var (
 	}
 )
 
-// NewServiceFn returns a storage bucket service which is not implemented in OSS
+// NewServiceFn returns a session recording service which reads session
+// recordings from the controller's recording storage.
 var NewServiceFn = func(ctx context.Context,
 	iamRepoFn common.IamRepoFactory,
+	sessionRepoFn session.RepositoryFactory,
+	pluginStorageBucketRepoFn common.PluginStorageBucketRepoFactory,
 	workerStatusGracePeriod *atomic.Int64,
 	kms *kms.Kms,
+	recordingStorage storage.RecordingStorage,
 	controllerExt intglobals.ControllerExtension,
 ) (pbs.SessionRecordingServiceServer, error) {
-	return Service{}, nil
+	return NewService(ctx, iamRepoFn, sessionRepoFn, pluginStorageBucketRepoFn, kms, recordingStorage)
 }
 
+// Service handles request as described by the pbs.SessionRecordingServiceServer interface.
 type Service struct {
-	pbs.UnimplementedSessionRecordingServiceServer
+	pbs.UnsafeSessionRecordingServiceServer
+
+	iamRepoFn           common.IamRepoFactory
+	sessionRepoFn       session.RepositoryFactory
+	storageBucketRepoFn common.PluginStorageBucketRepoFactory
+	kms                 *kms.Kms
+	recordingStorage    storage.RecordingStorage
 }
 
 var _ pbs.SessionRecordingServiceServer = (*Service)(nil)
 
+// NewService returns a session recording service which handles session
+// recording related requests to boundary.  Session recordings are looked up in
+// the session repository and read from the storage buckets of the recording
+// storage, which may be nil if the controller is not configured to read
+// session recordings.
+func NewService(ctx context.Context,
+	iamRepoFn common.IamRepoFactory,
+	sessionRepoFn session.RepositoryFactory,
+	storageBucketRepoFn common.PluginStorageBucketRepoFactory,
+	kms *kms.Kms,
+	recordingStorage storage.RecordingStorage,
+) (Service, error) {
+	const op = "session_recordings.NewService"
+	switch {
+	case iamRepoFn == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	case sessionRepoFn == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing session repository")
+	case storageBucketRepoFn == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing storage bucket repository")
+	case kms == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+	return Service{
+		iamRepoFn:           iamRepoFn,
+		sessionRepoFn:       sessionRepoFn,
+		storageBucketRepoFn: storageBucketRepoFn,
+		kms:                 kms,
+		recordingStorage:    recordingStorage,
+	}, nil
+}
+
 // GetSessionRecording implements the interface pbs.SessionRecordingServiceServer.
-func (s Service) GetSessionRecording(context.Context, *pbs.GetSessionRecordingRequest) (*pbs.GetSessionRecordingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "session recordings are an Enterprise-only feature")
+func (s Service) GetSessionRecording(ctx context.Context, req *pbs.GetSessionRecordingRequest) (*pbs.GetSessionRecordingResponse, error) {
+	const op = "session_recordings.(Service).GetSessionRecording"
+
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	id, err := recordingId(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	rec, authResults := s.authResult(ctx, id, action.Read)
+	if rec != nil {
+		defer rec.close(ctx)
+	}
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, rec.id, IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, rec, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.GetSessionRecordingResponse{Item: item}, nil
 }
 
 // ListSessionRecordings implements the interface pbs.SessionRecordingServiceServer.
-func (s Service) ListSessionRecordings(context.Context, *pbs.ListSessionRecordingsRequest) (*pbs.ListSessionRecordingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "session recordings are an Enterprise-only feature")
+func (s Service) ListSessionRecordings(ctx context.Context, req *pbs.ListSessionRecordingsRequest) (*pbs.ListSessionRecordingsResponse, error) {
+	const op = "session_recordings.(Service).ListSessionRecordings"
+
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	var scopeIds map[string]*scopes.ScopeInfo
+	var err error
+	if !req.GetRecursive() {
+		scopeIds = map[string]*scopes.ScopeInfo{authResults.Scope.Id: authResults.Scope}
+	} else {
+		scopeIds, err = authResults.ScopesAuthorizedForList(ctx, req.GetScopeId(), resource.SessionRecording)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// If no scopes match, return an empty response
+	if len(scopeIds) == 0 {
+		return &pbs.ListSessionRecordingsResponse{}, nil
+	}
+	if s.recordingStorage == nil {
+		return nil, noRecordingStorageError()
+	}
+
+	repo, err := s.storageBucketRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ids := make([]string, 0, len(scopeIds))
+	for id := range scopeIds {
+		ids = append(ids, id)
+	}
+	buckets, err := repo.ListStorageBuckets(ctx, ids, pluginstorage.WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(buckets) == 0 {
+		return &pbs.ListSessionRecordingsResponse{}, nil
+	}
+	bucketsById := make(map[string]*pluginstorage.StorageBucket, len(buckets))
+	bucketIds := make([]string, 0, len(buckets))
+	for _, sb := range buckets {
+		bucketsById[sb.GetPublicId()] = sb
+		bucketIds = append(bucketIds, sb.GetPublicId())
+	}
+	sessionRepo, err := s.sessionRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	recs, err := sessionRepo.ListRecordings(ctx, bucketIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	// Only the recordings the caller is authorized to list are opened, each
+	// from the storage bucket it was recorded to.
+	rr := s.recordingReader(ctx)
+	finalItems := make([]*pb.SessionRecording, 0, len(recs))
+	res := perms.Resource{
+		Type: resource.SessionRecording,
+	}
+	for _, r := range recs {
+		sb := bucketsById[r.StorageBucketId]
+		res.Id = r.PublicId
+		res.ScopeId = sb.GetScopeId()
+		authorizedActions := authResults.FetchActionSetForId(ctx, r.PublicId, IdActions, auth.WithResource(&res)).Strings()
+		if len(authorizedActions) == 0 {
+			continue
+		}
+
+		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+		outputOpts := make([]handlers.Option, 0, 3)
+		outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+		if outputFields.Has(globals.ScopeField) {
+			outputOpts = append(outputOpts, handlers.WithScope(scopeIds[sb.GetScopeId()]))
+		}
+		if outputFields.Has(globals.AuthorizedActionsField) {
+			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+		}
+
+		rec := rr.open(ctx, sb, r.PublicId)
+		item, err := toProto(ctx, rec, outputOpts...)
+		rec.close(ctx)
+		if err != nil {
+			return nil, err
+		}
+		finalItems = append(finalItems, item)
+	}
+	// Show the most recent recordings first.
+	slices.SortStableFunc(finalItems, func(a, b *pb.SessionRecording) int {
+		return b.GetStartTime().AsTime().Compare(a.GetStartTime().AsTime())
+	})
+
+	return &pbs.ListSessionRecordingsResponse{Items: finalItems}, nil
 }
 
 // Download implements the interface pbs.SessionRecordingServiceServer.
-func (s Service) Download(*pbs.DownloadRequest, pbs.SessionRecordingService_DownloadServer) error {
-	return status.Errorf(codes.Unimplemented, "session recordings are an Enterprise-only feature")
+func (s Service) Download(req *pbs.DownloadRequest, stream pbs.SessionRecordingService_DownloadServer) error {
+	const op = "session_recordings.(Service).Download"
+	ctx := stream.Context()
+
+	if err := validateDownloadRequest(req); err != nil {
+		return err
+	}
+	id, err := recordingId(ctx, req.GetId())
+	if err != nil {
+		return err
+	}
+	rec, authResults := s.authResult(ctx, id, action.Download)
+	if rec != nil {
+		defer rec.close(ctx)
+	}
+	if authResults.Error != nil {
+		return authResults.Error
+	}
+
+	if rec.session == nil {
+		return handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, fmt.Sprintf("This recording can't be read: %v", rec.err))
+	}
+	var connId string
+	var mimeTypes []string
+	var convertOpts []convert.Option
+	if rec.hasConnection(id) {
+		connId = id
+		mimeTypes = connectionMimeTypes(rec.session.Meta.Protocol)
+	} else {
+		var ch *bsr.Channel
+		connId, ch = rec.channel(id)
+		if ch == nil {
+			return handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"id": "Only connection and channel recordings can be downloaded."})
+		}
+		mimeTypes = channelMimeTypes(ch)
+		convertOpts = append(convertOpts, convert.WithChannelId(id))
+	}
+	if len(mimeTypes) == 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"id": "This recording can't be downloaded."})
+	}
+	mimeType := req.GetMimeType()
+	switch {
+	case mimeType == "":
+		mimeType = mimeTypes[0]
+	case !slices.Contains(mimeTypes, mimeType):
+		return handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"mime_type": fmt.Sprintf("This recording can only be downloaded as %s.", strings.Join(mimeTypes, ", "))})
+	}
+
+	tmp, err := s.recordingStorage.CreateTemp(ctx, id+"-*"+downloadExtensions[mimeType])
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create temporary file"))
+	}
+	var r io.ReadCloser
+	switch mimeType {
+	case asciicastMimeType:
+		r, err = convert.ToAsciicast(ctx, rec.session, tmp, connId, convertOpts...)
+	case transcriptMimeType:
+		r, err = convert.ToTranscript(ctx, rec.session, tmp, connId, convertOpts...)
+	case pcapngMimeType:
+		r, err = convert.ToPcapng(ctx, rec.session, tmp, connId, convertOpts...)
+	}
+	if err != nil {
+		_ = tmp.Close()
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert recording"))
+	}
+	defer r.Close()
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&httpbody.HttpBody{
+				ContentType: mimeType,
+				Data:        buf[:n],
+			}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to read converted recording"))
+		}
+	}
+}
+
+// recordingReader returns a reader of the session recordings in the
+// controller's recording storage.
+func (s Service) recordingReader(ctx context.Context) *recordingReader {
+	return &recordingReader{
+		recordingStorage: s.recordingStorage,
+		keyUnwrapFn:      bsrkms.NewKeyUnwrapCallbackFunc(ctx, s.kms.GetExternalWrappers(ctx).Bsr()),
+		bucketFss:        make(map[string]storage.FS),
+	}
+}
+
+// authResult verifies the action on the session recording containing the
+// resource with the given id, or on the scope with the given id when listing.
+// The session recording is looked up in the session repository and the action
+// is verified against the scope of the storage bucket it was recorded to.
+// Only if the action is authorized is the recording opened and returned, in
+// which case it must be closed by the caller.
+func (s Service) authResult(ctx context.Context, id string, a action.Type) (*recording, auth.VerifyResults) {
+	res := auth.VerifyResults{}
+
+	var parentId string
+	var sb *pluginstorage.StorageBucket
+	var recId string
+	opts := []auth.Option{auth.WithType(resource.SessionRecording), auth.WithAction(a)}
+	switch a {
+	case action.List:
+		parentId = id
+		iamRepo, err := s.iamRepoFn()
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		scp, err := iamRepo.LookupScope(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if scp == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+	case action.Read, action.Download:
+		if s.recordingStorage == nil {
+			res.Error = noRecordingStorageError()
+			return nil, res
+		}
+		sessionRepo, err := s.sessionRepoFn()
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		r, err := sessionRepo.LookupRecording(ctx, id)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if r == nil {
+			res.Error = handlers.NotFoundErrorf("Session recording for %q doesn't exist.", id)
+			return nil, res
+		}
+		sbRepo, err := s.storageBucketRepoFn()
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		sb, err = sbRepo.LookupStorageBucket(ctx, r.StorageBucketId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if sb == nil {
+			res.Error = handlers.NotFoundErrorf("Session recording for %q doesn't exist.", id)
+			return nil, res
+		}
+		recId = r.PublicId
+		parentId = sb.GetScopeId()
+		opts = append(opts, auth.WithId(recId))
+	default:
+		res.Error = stderrors.New("unsupported action")
+		return nil, res
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	res = auth.Verify(ctx, opts...)
+	if res.Error != nil || recId == "" {
+		return nil, res
+	}
+	return s.recordingReader(ctx).open(ctx, sb, recId), res
+}
+
+// noRecordingStorageError is returned when the controller can't read session
+// recordings.
+func noRecordingStorageError() error {
+	return handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "This controller is not configured with a recording storage path.")
+}
+
+// recordingId returns the id of the recorded resource for the id of a session
+// or connection.  All other ids are returned unchanged.
+func recordingId(ctx context.Context, id string) (string, error) {
+	var err error
+	switch {
+	case strings.HasPrefix(id, globals.SessionPrefix+"_"):
+		id, err = session.RecordingId(ctx, id)
+	case strings.HasPrefix(id, session.ConnectionPrefix+"_"):
+		id, err = session.ConnectionRecordingId(ctx, id)
+	}
+	if err != nil {
+		return "", handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"id": "Invalid formatted identifier."})
+	}
+	return id, nil
+}
+
+func toProto(ctx context.Context, in *recording, opt ...handlers.Option) (*pb.SessionRecording, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building session recording proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.SessionRecording{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.id
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.SessionIdField) {
+		sessionId, err := session.IdFromRecordingId(ctx, in.id)
+		if err != nil {
+			return nil, err
+		}
+		out.SessionId = sessionId
+	}
+	if outputFields.Has(globals.StorageBucketIdField) {
+		out.StorageBucketId = in.bucket.GetPublicId()
+	}
+	state := in.state()
+	if outputFields.Has(globals.StateField) {
+		out.State = state
+	}
+	if outputFields.Has(globals.ErrorDetailsField) && state == stateUnknown && in.err != nil {
+		out.ErrorDetails = in.err.Error()
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	if in.session == nil {
+		return &out, nil
+	}
+
+	bs := in.session
+	start, end := bs.Summary.GetStartTime(), bs.Summary.GetEndTime()
+	// Session recordings are not stored by the controller, so they were
+	// created when they were started and last updated when they ended.
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = timestamp(start)
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = timestamp(end)
+		if out.UpdatedTime == nil {
+			out.UpdatedTime = timestamp(start)
+		}
+	}
+	if outputFields.Has(globals.StartTimeField) {
+		out.StartTime = timestamp(start)
+	}
+	if outputFields.Has(globals.EndTimeField) {
+		out.EndTime = timestamp(end)
+	}
+	if outputFields.Has(globals.DurationField) {
+		out.Duration = duration(start, end)
+	}
+	if outputFields.Has(globals.TypeField) {
+		out.Type = protocolType(bs.Meta.Protocol)
+	}
+	if outputFields.Has(globals.EndpointField) && bs.SessionMeta != nil {
+		out.Endpoint = bs.SessionMeta.Endpoint
+	}
+
+	connections := make([]*pb.ConnectionRecording, 0, len(in.connections))
+	for _, c := range in.connections {
+		cr := connectionToProto(bs.Meta.Protocol, c, in.channels[c.Meta.Id])
+		out.BytesUp += cr.GetBytesUp()
+		out.BytesDown += cr.GetBytesDown()
+		connections = append(connections, cr)
+	}
+	if !outputFields.Has(globals.BytesUpField) {
+		out.BytesUp = 0
+	}
+	if !outputFields.Has(globals.BytesDownField) {
+		out.BytesDown = 0
+	}
+	if outputFields.Has(globals.ConnectionRecordingsField) && len(connections) > 0 {
+		out.ConnectionRecordings = connections
+	}
+	if outputFields.Has(globals.CreateTimeValues) && bs.SessionMeta != nil {
+		out.CreateTimeValues = valuesAtTime(bs.Meta.Protocol, bs.SessionMeta)
+	}
+	return &out, nil
+}
+
+func connectionToProto(p bsr.Protocol, c *bsr.Connection, channels []*bsr.Channel) *pb.ConnectionRecording {
+	start, end := c.Summary.GetStartTime(), c.Summary.GetEndTime()
+	out := &pb.ConnectionRecording{
+		Id:          c.Meta.Id,
+		BytesUp:     c.Summary.GetBytesUp(),
+		BytesDown:   c.Summary.GetBytesDown(),
+		CreatedTime: timestamp(start),
+		UpdatedTime: timestamp(end),
+		StartTime:   timestamp(start),
+		EndTime:     timestamp(end),
+		Duration:    duration(start, end),
+		MimeTypes:   connectionMimeTypes(p),
+	}
+	for _, ch := range channels {
+		start, end := ch.Summary.GetStartTime(), ch.Summary.GetEndTime()
+		cr := &pb.ChannelRecording{
+			Id:          ch.Meta.Id,
+			BytesUp:     ch.Summary.GetBytesUp(),
+			BytesDown:   ch.Summary.GetBytesDown(),
+			CreatedTime: timestamp(start),
+			UpdatedTime: timestamp(end),
+			StartTime:   timestamp(start),
+			EndTime:     timestamp(end),
+			Duration:    duration(start, end),
+			MimeTypes:   channelMimeTypes(ch),
+		}
+		out.ChannelRecordings = append(out.ChannelRecordings, cr)
+	}
+	return out
+}
+
+// connectionMimeTypes returns the mime types the connection recordings of a
+// session recorded with the protocol can be downloaded as.  Only raw tcp
+// connections can be downloaded, as a packet capture.
+func connectionMimeTypes(p bsr.Protocol) []string {
+	if p == tcp.Protocol {
+		return []string{pcapngMimeType}
+	}
+	return nil
+}
+
+// channelMimeTypes returns the mime types the channel recording can be
+// downloaded as, starting with the one it is downloaded as by default.  Only
+// channels running a shell or a command can be played back, while the data of
+// any program can be read as a transcript.
+func channelMimeTypes(ch *bsr.Channel) []string {
+	s, ok := ch.Summary.(*ssh.ChannelSummary)
+	if !ok {
+		return nil
+	}
+	switch s.SessionProgram {
+	case ssh.Shell, ssh.Exec:
+		return []string{asciicastMimeType, transcriptMimeType}
+	case ssh.Subsystem:
+		return []string{transcriptMimeType}
+	}
+	return nil
+}
+
+func valuesAtTime(p bsr.Protocol, in *bsr.SessionMeta) *pb.ValuesAtTime {
+	out := &pb.ValuesAtTime{}
+	if in.User != nil {
+		out.User = &pb.User{
+			Id:          in.User.PublicId,
+			Name:        in.User.Name,
+			Description: in.User.Description,
+			Scope:       scopeInfo(in.User.Scope),
+		}
+	}
+	if in.Target != nil {
+		out.Target = &pb.Target{
+			Id:                     in.Target.PublicId,
+			Name:                   in.Target.Name,
+			Description:            in.Target.Description,
+			Scope:                  scopeInfo(in.Target.Scope),
+			SessionMaxSeconds:      in.Target.SessionMaxSeconds,
+			SessionConnectionLimit: in.Target.SessionConnectionLimit,
+			WorkerFilter:           in.Target.WorkerFilter,
+			EgressWorkerFilter:     in.Target.EgressWorkerFilter,
+			IngressWorkerFilter:    in.Target.IngressWorkerFilter,
+			Type:                   protocolType(p),
+		}
+	}
+	return out
+}
+
+func scopeInfo(in bsr.Scope) *scopes.ScopeInfo {
+	if in.PublicId == "" {
+		return nil
+	}
+	return &scopes.ScopeInfo{
+		Id:            in.PublicId,
+		Type:          in.Type,
+		Name:          in.Name,
+		Description:   in.Description,
+		ParentScopeId: in.ParentId,
+	}
+}
+
+// protocolType returns the type of target whose sessions are recorded with
+// the protocol.
+func protocolType(p bsr.Protocol) string {
+	switch p {
+	case ssh.Protocol:
+		return "ssh"
+	case tcp.Protocol:
+		return "tcp"
+	default:
+		return ""
+	}
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func duration(start, end time.Time) *durationpb.Duration {
+	if start.IsZero() || end.IsZero() {
+		return nil
+	}
+	return durationpb.New(end.Sub(start))
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetSessionRecordingRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.SessionRecordingPrefix, globals.SessionPrefix)
+}
+
+func validateListRequest(req *pbs.ListSessionRecordingsRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() &&
+		!handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) {
+		badFields["scope_id"] = "This field must be 'global' or a valid org scope id."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateDownloadRequest(req *pbs.DownloadRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()),
+		globals.SessionPrefix,
+		globals.SessionRecordingPrefix,
+		session.ConnectionPrefix,
+		globals.ConnectionRecordingPrefix,
+		globals.ChannelRecordingPrefix,
+	) {
+		badFields["id"] = "Invalid formatted identifier."
+	}
+	switch req.GetMimeType() {
+	case "", asciicastMimeType, pcapngMimeType, transcriptMimeType:
+	default:
+		badFields["mime_type"] = fmt.Sprintf("The supported mime types are %q, %q and %q.", asciicastMimeType, pcapngMimeType, transcriptMimeType)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
 }
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session_recordings
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/storage/local"
+	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// testDownloadStream collects the http bodies sent by Download.
+type testDownloadStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	contentType string
+	body        bytes.Buffer
+}
+
+func (s *testDownloadStream) Context() context.Context { return s.ctx }
+
+func (s *testDownloadStream) Send(b *httpbody.HttpBody) error {
+	if s.contentType != "" && b.GetContentType() != s.contentType {
+		return handlers.ApiErrorWithCodeAndMessage(codes.Internal, "content type changed to %q", b.GetContentType())
+	}
+	s.contentType = b.GetContentType()
+	_, err := s.body.Write(b.GetData())
+	return err
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	bsrWrapper := bsrkms.TestWrapper(t)
+	require.NoError(t, kmsCache.AddExternalWrappers(ctx, kms.WithBsrWrapper(bsrWrapper)))
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessionRepoFn := func(o ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kmsCache, o...)
+	}
+	storageBucketRepoFn := func() (*pluginstorage.Repository, error) {
+		return pluginstorage.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	sessionRepo, err := sessionRepoFn()
+	require.NoError(t, err)
+	org, prj := iam.TestScopes(t, iamRepo)
+	otherOrg, otherPrj := iam.TestScopes(t, iamRepo)
+
+	rs, err := local.NewRecordingStorage(ctx, t.TempDir(), nil)
+	require.NoError(t, err)
+	plg := plugin.TestPlugin(t, conn, "test", plugin.WithStorageFlag(true))
+	sb := pluginstorage.TestStorageBucket(t, conn, org.GetPublicId(), plg.GetPublicId(), "bucket")
+	otherSb := pluginstorage.TestStorageBucket(t, conn, otherOrg.GetPublicId(), plg.GetPublicId(), "other")
+
+	// record creates a session of a recorded ssh target with a connection,
+	// and writes its recording to the storage bucket.  The recording and
+	// its connection and channel are added to the repository so they can be
+	// looked up by id.
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	record := func(prj *iam.Scope, sb *pluginstorage.StorageBucket, name string) testRecording {
+		hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+		hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+		h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+		static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+		tar := ssh.TestTarget(ctx, t, conn, prj.GetPublicId(), name, target.WithHostSources([]string{hs.GetPublicId()}),
+			target.WithEnableSessionRecording(true), target.WithStorageBucketId(sb.GetPublicId()))
+		sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+			UserId:      at.GetIamUserId(),
+			HostId:      h.GetPublicId(),
+			TargetId:    tar.GetPublicId(),
+			HostSetId:   hs.GetPublicId(),
+			AuthTokenId: at.GetPublicId(),
+			ProjectId:   prj.GetPublicId(),
+			Endpoint:    "tcp://127.0.0.1:22",
+		})
+		c := session.TestConnection(t, conn, sess.GetPublicId(), "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+		session.TestRecording(t, conn, sess.GetPublicId(), sb.GetPublicId())
+		require.NoError(t, sessionRepo.AddRecordingConnection(ctx, sess.GetPublicId(), c.GetPublicId()))
+		rec := writeTestRecording(t, rs, sb, bsrWrapper, sess.GetPublicId(), c.GetPublicId(), true)
+		_, err := rw.Exec(ctx, "insert into recording_channel (public_id, recording_connection_id) values (?, ?)",
+			[]any{rec.channelId, rec.connectionId})
+		require.NoError(t, err)
+		return rec
+	}
+	first := record(prj, sb, "first")
+	second := record(prj, sb, "second")
+	other := record(otherPrj, otherSb, "other")
+	firstSessionId, err := session.IdFromRecordingId(ctx, first.id)
+	require.NoError(t, err)
+
+	_, err = NewService(ctx, nil, sessionRepoFn, storageBucketRepoFn, kmsCache, rs)
+	assert.Error(t, err)
+	_, err = NewService(ctx, iamRepoFn, nil, storageBucketRepoFn, kmsCache, rs)
+	assert.Error(t, err)
+	_, err = NewService(ctx, iamRepoFn, sessionRepoFn, nil, kmsCache, rs)
+	assert.Error(t, err)
+	_, err = NewService(ctx, iamRepoFn, sessionRepoFn, storageBucketRepoFn, nil, rs)
+	assert.Error(t, err)
+	s, err := NewService(ctx, iamRepoFn, sessionRepoFn, storageBucketRepoFn, kmsCache, rs)
+	require.NoError(t, err)
+
+	t.Run("get", func(t *testing.T) {
+		for _, id := range []string{first.id, firstSessionId} {
+			got, err := s.GetSessionRecording(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), &pbs.GetSessionRecordingRequest{Id: id})
+			require.NoError(t, err, id)
+			assert.Equal(t, first.id, got.GetItem().GetId())
+			assert.Equal(t, org.GetPublicId(), got.GetItem().GetScope().GetId())
+			assert.Equal(t, sb.GetPublicId(), got.GetItem().GetStorageBucketId())
+			assert.Equal(t, stateAvailable, got.GetItem().GetState())
+			assert.Len(t, got.GetItem().GetConnectionRecordings(), 1)
+		}
+		_, err := s.GetSessionRecording(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), &pbs.GetSessionRecordingRequest{Id: "sr_doesntexist"})
+		assert.ErrorIs(t, err, handlers.ApiErrorWithCode(codes.NotFound))
+		_, err = s.GetSessionRecording(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), &pbs.GetSessionRecordingRequest{Id: "sb_1234567890"})
+		assert.Error(t, err)
+	})
+	t.Run("list", func(t *testing.T) {
+		got, err := s.ListSessionRecordings(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), &pbs.ListSessionRecordingsRequest{ScopeId: org.GetPublicId()})
+		require.NoError(t, err)
+		var ids []string
+		for _, item := range got.GetItems() {
+			ids = append(ids, item.GetId())
+		}
+		// The most recent recordings are listed first.
+		assert.Equal(t, []string{second.id, first.id}, ids)
+
+		got, err = s.ListSessionRecordings(auth.DisabledAuthTestContext(iamRepoFn, "global"), &pbs.ListSessionRecordingsRequest{ScopeId: "global", Recursive: true})
+		require.NoError(t, err)
+		ids = nil
+		for _, item := range got.GetItems() {
+			ids = append(ids, item.GetId())
+		}
+		assert.ElementsMatch(t, []string{first.id, second.id, other.id}, ids)
+
+		_, err = s.ListSessionRecordings(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), &pbs.ListSessionRecordingsRequest{ScopeId: "p_1234567890"})
+		assert.Error(t, err)
+	})
+	t.Run("download", func(t *testing.T) {
+		stream := &testDownloadStream{ctx: auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId())}
+		require.NoError(t, s.Download(&pbs.DownloadRequest{Id: first.channelId}, stream))
+		assert.Equal(t, asciicastMimeType, stream.contentType)
+		assert.Contains(t, stream.body.String(), `hello\r\n`)
+
+		stream = &testDownloadStream{ctx: auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId())}
+		require.NoError(t, s.Download(&pbs.DownloadRequest{Id: first.channelId, MimeType: transcriptMimeType}, stream))
+		assert.Equal(t, transcriptMimeType, stream.contentType)
+		assert.Contains(t, stream.body.String(), "> echo hello")
+
+		// Only connections of tcp sessions can be downloaded, and channels
+		// can't be downloaded as packet captures.
+		for _, req := range []*pbs.DownloadRequest{
+			{Id: first.id},
+			{Id: firstSessionId},
+			{Id: first.connectionId},
+			{Id: first.channelId, MimeType: pcapngMimeType},
+		} {
+			stream := &testDownloadStream{ctx: auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId())}
+			assert.ErrorIs(t, s.Download(req, stream), handlers.ApiErrorWithCode(codes.InvalidArgument), req.GetId())
+		}
+		stream = &testDownloadStream{ctx: auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId())}
+		err := s.Download(&pbs.DownloadRequest{Id: "chr_doesntexist"}, stream)
+		assert.ErrorIs(t, err, handlers.ApiErrorWithCode(codes.NotFound))
+	})
+	t.Run("no recording storage", func(t *testing.T) {
+		s, err := NewService(ctx, iamRepoFn, sessionRepoFn, storageBucketRepoFn, kmsCache, nil)
+		require.NoError(t, err)
+		_, err = s.GetSessionRecording(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), &pbs.GetSessionRecordingRequest{Id: first.id})
+		assert.Error(t, err)
+		_, err = s.ListSessionRecordings(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), &pbs.ListSessionRecordingsRequest{ScopeId: org.GetPublicId()})
+		assert.Error(t, err)
+	})
+}
@@ -58,7 +58,22 @@ func dynamicToWorkerCredential(ctx context.Context, cred credential.Dynamic) (se
 		}
 
 	default:
-		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
+		// Secrets of generic libraries, like the service account tokens of
+		// Vault's kubernetes secrets engine, are injected as json.
+		if cred.Library().CredentialType() != credential.UnspecifiedType {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
+		}
+		object, err := json.Marshal(cred.Secret())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling dynamic secret to json"))
+		}
+		workerCred = &serverpb.Credential{
+			Credential: &serverpb.Credential_Json{
+				Json: &serverpb.Json{
+					Object: object,
+				},
+			},
+		}
 	}
 
 	data, err := proto.Marshal(workerCred)
@@ -125,6 +140,18 @@ func dynamicToSessionCredential(ctx context.Context, cred credential.Dynamic) (*
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
 			}
 
+		case credential.SshCertificate:
+			credData, err = handlers.ProtoToStruct(
+				&pb.SshCertificateCredential{
+					Username:    c.Username(),
+					PrivateKey:  string(c.PrivateKey()),
+					Certificate: string(c.Certificate()),
+				},
+			)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
+			}
+
 		default:
 			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
 		}
@@ -174,6 +201,15 @@ func staticToWorkerCredential(ctx context.Context, cred credential.Static) (sess
 			},
 		}
 
+	case *credstatic.JsonCredential:
+		workerCred = &serverpb.Credential{
+			Credential: &serverpb.Credential_Json{
+				Json: &serverpb.Json{
+					Object: c.GetObject(),
+				},
+			},
+		}
+
 	default:
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
 	}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package http
+
+import (
+	"context"
+	"crypto/x509"
+	"math"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/http"
+	httpStore "github.com/hashicorp/boundary/internal/target/http/store"
+	"github.com/hashicorp/boundary/internal/target/store"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+)
+
+const (
+	defaultPortField       = "attributes.default_port"
+	defaultClientPortField = "attributes.default_client_port"
+	tlsServerNameField     = "attributes.tls_server_name"
+	tlsCaCertificatesField = "attributes.tls_ca_certificates"
+)
+
+type attribute struct {
+	*pb.HttpTargetAttributes
+}
+
+func (a *attribute) Options() []target.Option {
+	var opts []target.Option
+	if a.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(a.GetDefaultPort().GetValue()))
+	}
+	if a.GetDefaultClientPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultClientPort(a.GetDefaultClientPort().GetValue()))
+	}
+	if a.GetEnableTls().GetValue() {
+		opts = append(opts, target.WithEnableTls(true))
+	}
+	if a.GetTlsServerName().GetValue() != "" {
+		opts = append(opts, target.WithTlsServerName(a.GetTlsServerName().GetValue()))
+	}
+	if a.GetTlsCaCertificates().GetValue() != "" {
+		opts = append(opts, target.WithTlsCaCertificates(a.GetTlsCaCertificates().GetValue()))
+	}
+	return opts
+}
+
+// vetTls validates the settings used to verify the endpoint's certificate,
+// if they are set.
+func (a *attribute) vetTls(badFields map[string]string) {
+	if v := a.GetTlsServerName(); v != nil && strings.TrimSpace(v.GetValue()) == "" {
+		badFields[tlsServerNameField] = "This field cannot be set to empty."
+	}
+	if v := a.GetTlsCaCertificates(); v != nil && !x509.NewCertPool().AppendCertsFromPEM([]byte(v.GetValue())) {
+		badFields[tlsCaCertificatesField] = "This field must contain PEM encoded certificates."
+	}
+}
+
+func (a *attribute) Vet() map[string]string {
+	badFields := map[string]string{}
+	if a.GetDefaultPort() != nil {
+		if a.GetDefaultPort().GetValue() == 0 {
+			badFields[defaultPortField] = "This field cannot be set to zero."
+		}
+		if a.GetDefaultPort().GetValue() > math.MaxUint16 {
+			badFields[defaultPortField] = "Value is greater than maximum port number."
+		}
+	}
+	if a.GetDefaultClientPort() != nil {
+		if a.GetDefaultClientPort().GetValue() == 0 {
+			badFields[defaultClientPortField] = "This field cannot be set to zero."
+		}
+		if a.GetDefaultClientPort().GetValue() > math.MaxUint16 {
+			badFields[defaultClientPortField] = "Value is greater than maximum port number."
+		}
+	}
+	a.vetTls(badFields)
+	return badFields
+}
+
+func (a *attribute) VetForUpdate(p []string) map[string]string {
+	badFields := map[string]string{}
+	if handlers.MaskContains(p, defaultPortField) {
+		if a.GetDefaultPort() == nil {
+			badFields[defaultPortField] = "This field is required."
+		} else {
+			if a.GetDefaultPort().GetValue() == 0 {
+				badFields[defaultPortField] = "This cannot be set to zero."
+			}
+			if a.GetDefaultPort().GetValue() > math.MaxUint16 {
+				badFields[defaultPortField] = "Value is greater than maximum port number."
+			}
+		}
+	}
+	if handlers.MaskContains(p, defaultClientPortField) && a.GetDefaultClientPort() != nil {
+		if a.GetDefaultClientPort().GetValue() == 0 {
+			badFields[defaultClientPortField] = "This cannot be set to zero."
+		}
+		if a.GetDefaultClientPort().GetValue() > math.MaxUint16 {
+			badFields[defaultClientPortField] = "Value is greater than maximum port number."
+		}
+	}
+	a.vetTls(badFields)
+	return badFields
+}
+
+func newAttribute(m any) targets.Attributes {
+	a := &attribute{
+		&pb.HttpTargetAttributes{},
+	}
+	if httpAttr, ok := m.(*pb.Target_HttpTargetAttributes); ok {
+		a.HttpTargetAttributes = httpAttr.HttpTargetAttributes
+	}
+	return a
+}
+
+func setAttributes(t target.Target, out *pb.Target) error {
+	if t == nil {
+		return nil
+	}
+
+	attrs := &pb.Target_HttpTargetAttributes{
+		HttpTargetAttributes: &pb.HttpTargetAttributes{},
+	}
+	if t.GetDefaultPort() > 0 {
+		attrs.HttpTargetAttributes.DefaultPort = &wrappers.UInt32Value{Value: t.GetDefaultPort()}
+	}
+	if t.GetDefaultClientPort() > 0 {
+		attrs.HttpTargetAttributes.DefaultClientPort = &wrappers.UInt32Value{Value: t.GetDefaultClientPort()}
+	}
+	if et, ok := t.(target.TlsEnabledTarget); ok {
+		attrs.HttpTargetAttributes.EnableTls = &wrappers.BoolValue{Value: et.GetEnableTls()}
+	}
+	if et, ok := t.(target.EndpointTlsTarget); ok {
+		if et.GetTlsServerName() != "" {
+			attrs.HttpTargetAttributes.TlsServerName = &wrappers.StringValue{Value: et.GetTlsServerName()}
+		}
+		if et.GetTlsCaCertificates() != "" {
+			attrs.HttpTargetAttributes.TlsCaCertificates = &wrappers.StringValue{Value: et.GetTlsCaCertificates()}
+		}
+	}
+
+	out.Attrs = attrs
+	return nil
+}
+
+func noopSessionValidation(context.Context, *session.Session) error { return nil }
+
+func init() {
+	var maskManager handlers.MaskManager
+	var err error
+
+	if maskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&httpStore.Target{}, &store.TargetAddress{}},
+		handlers.MaskSource{&pb.Target{}, &pb.HttpTargetAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	targets.Register(http.Subtype, maskManager, newAttribute, setAttributes, noopSessionValidation)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/x509"
+	"math"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/kubernetes"
+	kubernetesStore "github.com/hashicorp/boundary/internal/target/kubernetes/store"
+	"github.com/hashicorp/boundary/internal/target/store"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+)
+
+const (
+	defaultPortField       = "attributes.default_port"
+	defaultClientPortField = "attributes.default_client_port"
+	tlsServerNameField     = "attributes.tls_server_name"
+	tlsCaCertificatesField = "attributes.tls_ca_certificates"
+)
+
+type attribute struct {
+	*pb.KubernetesTargetAttributes
+}
+
+func (a *attribute) Options() []target.Option {
+	var opts []target.Option
+	if a.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(a.GetDefaultPort().GetValue()))
+	}
+	if a.GetDefaultClientPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultClientPort(a.GetDefaultClientPort().GetValue()))
+	}
+	if a.GetTlsServerName().GetValue() != "" {
+		opts = append(opts, target.WithTlsServerName(a.GetTlsServerName().GetValue()))
+	}
+	if a.GetTlsCaCertificates().GetValue() != "" {
+		opts = append(opts, target.WithTlsCaCertificates(a.GetTlsCaCertificates().GetValue()))
+	}
+	return opts
+}
+
+// vetTls validates the settings used to verify the Kubernetes API server's
+// certificate, if they are set.
+func (a *attribute) vetTls(badFields map[string]string) {
+	if v := a.GetTlsServerName(); v != nil && strings.TrimSpace(v.GetValue()) == "" {
+		badFields[tlsServerNameField] = "This field cannot be set to empty."
+	}
+	if v := a.GetTlsCaCertificates(); v != nil && !x509.NewCertPool().AppendCertsFromPEM([]byte(v.GetValue())) {
+		badFields[tlsCaCertificatesField] = "This field must contain PEM encoded certificates."
+	}
+}
+
+func (a *attribute) Vet() map[string]string {
+	badFields := map[string]string{}
+	if a.GetDefaultPort() != nil {
+		if a.GetDefaultPort().GetValue() == 0 {
+			badFields[defaultPortField] = "This field cannot be set to zero."
+		}
+		if a.GetDefaultPort().GetValue() > math.MaxUint16 {
+			badFields[defaultPortField] = "Value is greater than maximum port number."
+		}
+	}
+	if a.GetDefaultClientPort() != nil {
+		if a.GetDefaultClientPort().GetValue() == 0 {
+			badFields[defaultClientPortField] = "This field cannot be set to zero."
+		}
+		if a.GetDefaultClientPort().GetValue() > math.MaxUint16 {
+			badFields[defaultClientPortField] = "Value is greater than maximum port number."
+		}
+	}
+	a.vetTls(badFields)
+	return badFields
+}
+
+func (a *attribute) VetForUpdate(p []string) map[string]string {
+	badFields := map[string]string{}
+	if handlers.MaskContains(p, defaultPortField) {
+		if a.GetDefaultPort() == nil {
+			badFields[defaultPortField] = "This field is required."
+		} else {
+			if a.GetDefaultPort().GetValue() == 0 {
+				badFields[defaultPortField] = "This cannot be set to zero."
+			}
+			if a.GetDefaultPort().GetValue() > math.MaxUint16 {
+				badFields[defaultPortField] = "Value is greater than maximum port number."
+			}
+		}
+	}
+	if handlers.MaskContains(p, defaultClientPortField) && a.GetDefaultClientPort() != nil {
+		if a.GetDefaultClientPort().GetValue() == 0 {
+			badFields[defaultClientPortField] = "This cannot be set to zero."
+		}
+		if a.GetDefaultClientPort().GetValue() > math.MaxUint16 {
+			badFields[defaultClientPortField] = "Value is greater than maximum port number."
+		}
+	}
+	a.vetTls(badFields)
+	return badFields
+}
+
+func newAttribute(m any) targets.Attributes {
+	a := &attribute{
+		&pb.KubernetesTargetAttributes{},
+	}
+	if kubeAttr, ok := m.(*pb.Target_KubernetesTargetAttributes); ok {
+		a.KubernetesTargetAttributes = kubeAttr.KubernetesTargetAttributes
+	}
+	return a
+}
+
+func setAttributes(t target.Target, out *pb.Target) error {
+	if t == nil {
+		return nil
+	}
+
+	attrs := &pb.Target_KubernetesTargetAttributes{
+		KubernetesTargetAttributes: &pb.KubernetesTargetAttributes{},
+	}
+	if t.GetDefaultPort() > 0 {
+		attrs.KubernetesTargetAttributes.DefaultPort = &wrappers.UInt32Value{Value: t.GetDefaultPort()}
+	}
+	if t.GetDefaultClientPort() > 0 {
+		attrs.KubernetesTargetAttributes.DefaultClientPort = &wrappers.UInt32Value{Value: t.GetDefaultClientPort()}
+	}
+	if et, ok := t.(target.EndpointTlsTarget); ok {
+		if et.GetTlsServerName() != "" {
+			attrs.KubernetesTargetAttributes.TlsServerName = &wrappers.StringValue{Value: et.GetTlsServerName()}
+		}
+		if et.GetTlsCaCertificates() != "" {
+			attrs.KubernetesTargetAttributes.TlsCaCertificates = &wrappers.StringValue{Value: et.GetTlsCaCertificates()}
+		}
+	}
+
+	out.Attrs = attrs
+	return nil
+}
+
+func noopSessionValidation(context.Context, *session.Session) error { return nil }
+
+func init() {
+	var maskManager handlers.MaskManager
+	var err error
+
+	if maskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&kubernetesStore.Target{}, &store.TargetAddress{}},
+		handlers.MaskSource{&pb.Target{}, &pb.KubernetesTargetAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	targets.Register(kubernetes.Subtype, maskManager, newAttribute, setAttributes, noopSessionValidation)
+}
@@ -1097,6 +1097,15 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		ConnectionLimit:   t.GetSessionConnectionLimit(),
 		DefaultClientPort: t.GetDefaultClientPort(),
 	}
+	if tlst, ok := t.(target.TlsTerminatingTarget); ok && tlst.GetTerminateTls() {
+		// The client trusts the session's TLS certificate authority when the
+		// worker terminates the TLS connections of the session.
+		ca, _, err := session.TlsCa(ctx, sess)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		sad.TlsCaCertificate = ca.Raw
+	}
 	marshaledSad, err := proto.Marshal(sad)
 	if err != nil {
 		return nil, err
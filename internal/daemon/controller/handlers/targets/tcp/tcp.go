@@ -5,7 +5,9 @@ package tcp
 
 import (
 	"context"
+	"crypto/x509"
 	"math"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
@@ -21,6 +23,12 @@ import (
 const (
 	defaultPortField       = "attributes.default_port"
 	defaultClientPortField = "attributes.default_client_port"
+	bandwidthLimitField    = "attributes.bandwidth_limit"
+	sessionByteQuotaField  = "attributes.session_byte_quota"
+	tlsServerNameField     = "attributes.tls_server_name"
+	tlsCaCertificatesField = "attributes.tls_ca_certificates"
+	tlsMinVersionField     = "attributes.tls_min_version"
+	idleTimeoutField       = "attributes.idle_timeout_seconds"
 )
 
 type attribute struct {
@@ -35,9 +43,76 @@ func (a *attribute) Options() []target.Option {
 	if a.GetDefaultClientPort().GetValue() != 0 {
 		opts = append(opts, target.WithDefaultClientPort(a.GetDefaultClientPort().GetValue()))
 	}
+	if a.GetBandwidthLimit().GetValue() != 0 {
+		opts = append(opts, target.WithBandwidthLimit(a.GetBandwidthLimit().GetValue()))
+	}
+	if a.GetSessionByteQuota().GetValue() != 0 {
+		opts = append(opts, target.WithSessionByteQuota(a.GetSessionByteQuota().GetValue()))
+	}
+	if a.GetTerminateTls().GetValue() {
+		opts = append(opts, target.WithTerminateTls(true))
+	}
+	if a.GetTlsServerName().GetValue() != "" {
+		opts = append(opts, target.WithTlsServerName(a.GetTlsServerName().GetValue()))
+	}
+	if a.GetTlsCaCertificates().GetValue() != "" {
+		opts = append(opts, target.WithTlsCaCertificates(a.GetTlsCaCertificates().GetValue()))
+	}
+	if a.GetTlsMinVersion().GetValue() != "" {
+		opts = append(opts, target.WithTlsMinVersion(a.GetTlsMinVersion().GetValue()))
+	}
+	if a.GetIdleTimeoutSeconds().GetValue() != 0 {
+		opts = append(opts, target.WithIdleTimeoutSeconds(a.GetIdleTimeoutSeconds().GetValue()))
+	}
 	return opts
 }
 
+// vetLimits validates the bandwidth limit and session byte quota, which are
+// stored as bigints, and the idle timeout, which is stored as an int, if they
+// are set.
+func (a *attribute) vetLimits(badFields map[string]string) {
+	for field, v := range map[string]*wrappers.UInt64Value{
+		bandwidthLimitField:   a.GetBandwidthLimit(),
+		sessionByteQuotaField: a.GetSessionByteQuota(),
+	} {
+		if v == nil {
+			continue
+		}
+		if v.GetValue() == 0 {
+			badFields[field] = "This field cannot be set to zero."
+		}
+		if v.GetValue() > math.MaxInt64 {
+			badFields[field] = "Value is too large."
+		}
+	}
+	if v := a.GetIdleTimeoutSeconds(); v != nil {
+		if v.GetValue() == 0 {
+			badFields[idleTimeoutField] = "This field cannot be set to zero."
+		}
+		if v.GetValue() > math.MaxInt32 {
+			badFields[idleTimeoutField] = "Value is too large."
+		}
+	}
+}
+
+// vetTls validates the TLS settings used when the worker terminates TLS, if
+// they are set.
+func (a *attribute) vetTls(badFields map[string]string) {
+	if v := a.GetTlsServerName(); v != nil && strings.TrimSpace(v.GetValue()) == "" {
+		badFields[tlsServerNameField] = "This field cannot be set to empty."
+	}
+	if v := a.GetTlsCaCertificates(); v != nil && !x509.NewCertPool().AppendCertsFromPEM([]byte(v.GetValue())) {
+		badFields[tlsCaCertificatesField] = "This field must contain PEM encoded certificates."
+	}
+	if v := a.GetTlsMinVersion(); v != nil {
+		switch v.GetValue() {
+		case "1.2", "1.3":
+		default:
+			badFields[tlsMinVersionField] = `This field must be "1.2" or "1.3".`
+		}
+	}
+}
+
 func (a *attribute) Vet() map[string]string {
 	badFields := map[string]string{}
 	if a.GetDefaultPort() == nil {
@@ -58,6 +133,8 @@ func (a *attribute) Vet() map[string]string {
 			badFields[defaultClientPortField] = "Value is greater than maximum port number."
 		}
 	}
+	a.vetLimits(badFields)
+	a.vetTls(badFields)
 	return badFields
 }
 
@@ -83,6 +160,8 @@ func (a *attribute) VetForUpdate(p []string) map[string]string {
 			badFields[defaultClientPortField] = "Value is greater than maximum port number."
 		}
 	}
+	a.vetLimits(badFields)
+	a.vetTls(badFields)
 	return badFields
 }
 
@@ -110,6 +189,33 @@ func setAttributes(t target.Target, out *pb.Target) error {
 	if t.GetDefaultClientPort() > 0 {
 		attrs.TcpTargetAttributes.DefaultClientPort = &wrappers.UInt32Value{Value: t.GetDefaultClientPort()}
 	}
+	if lt, ok := t.(target.LimitedTarget); ok {
+		if lt.GetBandwidthLimit() > 0 {
+			attrs.TcpTargetAttributes.BandwidthLimit = &wrappers.UInt64Value{Value: lt.GetBandwidthLimit()}
+		}
+		if lt.GetSessionByteQuota() > 0 {
+			attrs.TcpTargetAttributes.SessionByteQuota = &wrappers.UInt64Value{Value: lt.GetSessionByteQuota()}
+		}
+		if lt.GetIdleTimeoutSeconds() > 0 {
+			attrs.TcpTargetAttributes.IdleTimeoutSeconds = &wrappers.UInt32Value{Value: lt.GetIdleTimeoutSeconds()}
+		}
+	}
+	if tlst, ok := t.(target.TlsTerminatingTarget); ok {
+		if tlst.GetTerminateTls() {
+			attrs.TcpTargetAttributes.TerminateTls = &wrappers.BoolValue{Value: true}
+		}
+		if tlst.GetTlsMinVersion() != "" {
+			attrs.TcpTargetAttributes.TlsMinVersion = &wrappers.StringValue{Value: tlst.GetTlsMinVersion()}
+		}
+	}
+	if et, ok := t.(target.EndpointTlsTarget); ok {
+		if et.GetTlsServerName() != "" {
+			attrs.TcpTargetAttributes.TlsServerName = &wrappers.StringValue{Value: et.GetTlsServerName()}
+		}
+		if et.GetTlsCaCertificates() != "" {
+			attrs.TcpTargetAttributes.TlsCaCertificates = &wrappers.StringValue{Value: et.GetTlsCaCertificates()}
+		}
+	}
 
 	out.Attrs = attrs
 	return nil
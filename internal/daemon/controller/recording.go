@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/hashicorp/boundary/internal/storage/local"
+)
+
+func init() {
+	recordingStorageFactory = newLocalRecordingStorage
+}
+
+// newLocalRecordingStorage returns recording storage which reads the contents
+// of storage buckets from directories within the controller's recording
+// storage path, which is the network filesystem the workers write to.
+func newLocalRecordingStorage(ctx context.Context, path string) (storage.RecordingStorage, error) {
+	return local.NewRecordingStorage(ctx, path, nil)
+}
@@ -46,6 +46,8 @@ func registerControllerServerCoordinationService(ctx context.Context, c *Control
 		c.WorkerAuthRepoStorageFn,
 		c.SessionRepoFn,
 		c.ConnectionRepoFn,
+		c.TargetRepoFn,
+		c.PluginStorageBucketRepoFn,
 		c.downstreamWorkers,
 		c.workerStatusUpdateTimes,
 		c.kms,
@@ -73,6 +75,8 @@ func registerControllerSessionService(ctx context.Context, c *Controller, server
 		c.WorkerAuthRepoStorageFn,
 		c.SessionRepoFn,
 		c.ConnectionRepoFn,
+		c.TargetRepoFn,
+		c.PluginStorageBucketRepoFn,
 		c.downstreamWorkers,
 		c.workerStatusUpdateTimes,
 		c.kms,
@@ -4,10 +4,20 @@
 package worker
 
 import (
+	stderrors "errors"
 	"net"
 	"sync"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/session"
 )
 
+var _ proxy.ClosedReasonSetter = (*countingConn)(nil)
+
+// errHalfCloseUnsupported is returned by countingConn.CloseWrite when the
+// embedded conn can't be half-closed.
+var errHalfCloseUnsupported = stderrors.New("connection does not support half-close")
+
 // countingConn is a `net.Conn` implementation that records the bytes that go
 // across Read() and Write(). All other `net.Conn` function calls are a
 // pass-through to the underlying `net.Conn`, meaning it's also safe to call
@@ -17,6 +27,7 @@ type countingConn struct {
 
 	bytesRead    int64
 	bytesWritten int64
+	closedReason session.ClosedReason
 	// Use mutex for counters as net.Conn methods may be called concurrently
 	// https://github.com/golang/go/issues/27203#issuecomment-415854958
 	mu sync.Mutex
@@ -36,6 +47,20 @@ func (c *countingConn) BytesWritten() int64 {
 	return c.bytesWritten
 }
 
+// SetClosedReason records the reason the proxy handler closed the connection.
+func (c *countingConn) SetClosedReason(reason session.ClosedReason) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closedReason = reason
+}
+
+// ClosedReason reports the reason set by the proxy handler, if any.
+func (c *countingConn) ClosedReason() session.ClosedReason {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closedReason
+}
+
 // Read wraps the embedded conn's Read() and counts the number of bytes read
 // (the number of bytes the client sent to us).
 func (c *countingConn) Read(in []byte) (int, error) {
@@ -55,3 +80,14 @@ func (c *countingConn) Write(in []byte) (int, error) {
 	c.mu.Unlock()
 	return n, err
 }
+
+// CloseWrite closes the write half of the embedded conn, so the client sees
+// EOF while it can still send data.  It returns an error if the embedded conn
+// can't be half-closed.
+func (c *countingConn) CloseWrite() error {
+	cw, ok := c.Conn.(interface{ CloseWrite() error })
+	if !ok {
+		return errHalfCloseUnsupported
+	}
+	return cw.CloseWrite()
+}
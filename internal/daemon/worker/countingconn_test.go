@@ -9,6 +9,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/session"
 	"github.com/stretchr/testify/require"
 )
 
@@ -154,6 +155,14 @@ func TestCountingConnConcurrentCalls(t *testing.T) {
 	require.EqualValues(t, concurrentWrites*len(bytesToWrite), conn.BytesWritten())
 }
 
+func TestCountingConnClosedReason(t *testing.T) {
+	t.Parallel()
+	conn := &countingConn{Conn: &testNetConn{}}
+	require.Empty(t, conn.ClosedReason())
+	conn.SetClosedReason(session.ConnectionQuotaExceeded)
+	require.Equal(t, session.ConnectionQuotaExceeded, conn.ClosedReason())
+}
+
 type testNetConn struct {
 	net.Conn // So we don't have to implement the entire interface.
 
@@ -199,3 +208,27 @@ func (t *testNetConn) Close() error {
 
 	return nil
 }
+
+// testHalfCloseConn is a testNetConn which can be half-closed.
+type testHalfCloseConn struct {
+	testNetConn
+	closeWriteCalled bool
+}
+
+func (t *testHalfCloseConn) CloseWrite() error {
+	t.closeWriteCalled = true
+	return nil
+}
+
+func TestCountingConnCloseWrite(t *testing.T) {
+	t.Parallel()
+
+	hc := &testHalfCloseConn{}
+	cc := &countingConn{Conn: hc}
+	require.NoError(t, cc.CloseWrite())
+	require.True(t, hc.closeWriteCalled)
+	require.False(t, hc.closeCalled)
+
+	cc = &countingConn{Conn: &testNetConn{}}
+	require.ErrorIs(t, cc.CloseWrite(), errHalfCloseUnsupported)
+}
@@ -23,6 +23,7 @@ import (
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/proxy"
+	intsession "github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/nodeenrollment"
@@ -124,7 +125,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		}
 
 		opts := &websocket.AcceptOptions{
-			Subprotocols: []string{globals.TcpProxyV1},
+			Subprotocols: []string{globals.TcpProxyV1, globals.TcpProxyV2},
 		}
 		conn, err := websocket.Accept(wr, r, opts)
 		if err != nil {
@@ -197,132 +198,206 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			}
 		}
 
-		if w.LastStatusSuccess() == nil || w.LastStatusSuccess().WorkerId == "" {
-			event.WriteError(ctx, op, stderrors.New("worker id is empty"))
-			if err = conn.Close(websocket.StatusInternalError, "worker id is empty"); err != nil {
-				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
-			}
+		if conn.Subprotocol() == globals.TcpProxyV2 {
+			w.handleMultiplexedProxy(ctx, connCtx, conn, sess, sessionManager, clientAddr, userClientIp)
 			return
 		}
-		workerId := w.LastStatusSuccess().WorkerId
+		w.proxyConnection(ctx, connCtx, &websocketTransport{conn: conn}, sess, sessionManager, clientAddr, userClientIp)
+	}, nil
+}
 
-		var acResp *pbs.AuthorizeConnectionResponse
-		var connsLeft int32
-		acResp, connsLeft, err = sess.RequestAuthorizeConnection(ctx, workerId, connCancel)
-		if err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to authorize connection"))
-			if err = conn.Close(websocket.StatusInternalError, "unable to authorize connection"); err != nil {
-				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
-			}
-			return
+// proxyConnection authorizes a new connection of the session carried by t,
+// proxies it to the target's endpoint with the handler registered for the
+// endpoint's protocol, and reports it closed to the controller once done.
+// The connection is closed when parentCtx is done or the controller cancels
+// it.
+func (w *Worker) proxyConnection(
+	ctx, parentCtx context.Context,
+	t proxyTransport,
+	sess session.Session,
+	sessionManager session.Manager,
+	clientAddr *net.TCPAddr,
+	userClientIp string,
+) {
+	const op = "worker.(Worker).proxyConnection"
+	sessionId := sess.GetId()
+	connCtx, connCancel := context.WithCancel(parentCtx)
+	defer connCancel()
+
+	var err error
+	if w.LastStatusSuccess() == nil || w.LastStatusSuccess().WorkerId == "" {
+		event.WriteError(ctx, op, stderrors.New("worker id is empty"))
+		if err = t.close(websocket.StatusInternalError, "worker id is empty"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
 		}
-		event.WriteSysEvent(ctx, op, "connection successfully authorized", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
+		return
+	}
+	workerId := w.LastStatusSuccess().WorkerId
 
-		// Wrapping the client websocket with a `net.Conn` implementation that
-		// records the bytes that go across Read() and Write().
-		cc := &countingConn{Conn: websocket.NetConn(connCtx, conn, websocket.MessageBinary)}
-		err = sess.ApplyConnectionCounterCallbacks(acResp.GetConnectionId(), cc.BytesRead, cc.BytesWritten)
-		if err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to set counter callbacks for session connection"))
-			err = conn.Close(websocket.StatusInternalError, "unable to set counter callbacks for session connection")
-			if err != nil {
-				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
-			}
-			return
+	var acResp *pbs.AuthorizeConnectionResponse
+	var connsLeft int32
+	acResp, connsLeft, err = sess.RequestAuthorizeConnection(ctx, workerId, connCancel)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to authorize connection"))
+		if err = t.close(websocket.StatusInternalError, "unable to authorize connection"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
 		}
+		return
+	}
+	event.WriteSysEvent(ctx, op, "connection successfully authorized", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
 
-		defer func() {
-			ccd := map[string]*session.ConnectionCloseData{
-				acResp.GetConnectionId(): {
-					SessionId: sess.GetId(),
-					BytesUp:   cc.BytesRead(),
-					BytesDown: cc.BytesWritten(),
-				},
-			}
-			if sessionManager.RequestCloseConnections(ctx, ccd) {
-				event.WriteSysEvent(ctx, op, "connection closed", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
-			}
-		}()
-
-		handshakeResult := &proxy.HandshakeResult{
-			Expiration:      timestamppb.New(sess.GetExpiration()),
-			ConnectionLimit: sess.GetConnectionLimit(),
-			ConnectionsLeft: connsLeft,
-		}
-		if err := wspb.Write(connCtx, conn, handshakeResult); err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("error sending handshake result to client"))
-			if err = conn.Close(websocket.StatusProtocolError, "unable to send handshake result"); err != nil {
-				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
-			}
-			return
+	// Wrapping the client connection with a `net.Conn` implementation that
+	// records the bytes that go across Read() and Write().
+	cc := &countingConn{Conn: t.netConn(connCtx)}
+	err = sess.ApplyConnectionCounterCallbacks(acResp.GetConnectionId(), cc.BytesRead, cc.BytesWritten)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to set counter callbacks for session connection"))
+		err = t.close(websocket.StatusInternalError, "unable to set counter callbacks for session connection")
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
 		}
+		return
+	}
 
-		endpointUrl, err := url.Parse(sess.GetEndpoint())
-		if err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("worker failed to parse target endpoint", "endpoint", sess.GetEndpoint()))
-			if err = conn.Close(websocket.StatusProtocolError, "unable to parse endpoint"); err != nil {
-				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
-			}
-			return
+	// closeReason is reported to the controller when the connection is
+	// closed.  It is set when the proxy can not be established, or by the
+	// proxy handler when it closes the connection.
+	var closeReason intsession.ClosedReason
+	defer func() {
+		if closeReason == "" {
+			closeReason = cc.ClosedReason()
 		}
-		protocolCtx := acResp.GetProtocolContext()
-		if protocolCtx == nil {
-			// TODO: Remove this if block once pre v0.12.0 controllers are no longer supported.
-			if protocolCtx, err = GetProtocolContext(ctx, workerId, sess, endpointUrl.Scheme); err != nil {
-				conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get proxy context")
-				event.WriteError(ctx, op, err)
-				return
-			}
+		ccd := map[string]*session.ConnectionCloseData{
+			acResp.GetConnectionId(): {
+				SessionId: sess.GetId(),
+				BytesUp:   cc.BytesRead(),
+				BytesDown: cc.BytesWritten(),
+				Reason:    closeReason,
+			},
 		}
-
-		pDialer, err := proxyHandlers.GetEndpointDialer(ctx, endpointUrl.Host, workerId, acResp, w.downstreamReceiver)
-		if err != nil {
-			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get endpoint dialer")
-			event.WriteError(ctx, op, err)
-			return
+		if sessionManager.RequestCloseConnections(ctx, ccd) {
+			event.WriteSysEvent(ctx, op, "connection closed", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
 		}
+	}()
 
-		// Verify the protocol has a supported proxy before calling RequestAuthorizeConnection
-		handleProxyFn, err := proxyHandlers.GetHandler(workerId, acResp.GetProtocolContext())
-		if err != nil {
-			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get proxy handler")
-			event.WriteError(ctx, op, err)
-			return
+	handshakeResult := &proxy.HandshakeResult{
+		Expiration:      timestamppb.New(sess.GetExpiration()),
+		ConnectionLimit: sess.GetConnectionLimit(),
+		ConnectionsLeft: connsLeft,
+	}
+	if err := t.writeHandshakeResult(connCtx, handshakeResult); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error sending handshake result to client"))
+		if err = t.close(websocket.StatusProtocolError, "unable to send handshake result"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
 		}
-		decryptFn, err := w.credDecryptFn(ctx)
-		if err != nil {
-			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "error getting decryption function")
-			event.WriteError(ctx, op, err)
+		return
+	}
+
+	endpointUrl, err := url.Parse(sess.GetEndpoint())
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("worker failed to parse target endpoint", "endpoint", sess.GetEndpoint()))
+		if err = t.close(websocket.StatusProtocolError, "unable to parse endpoint"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
 		}
-		runProxy, err := handleProxyFn(ctx, ctx, decryptFn, cc, pDialer, acResp.GetConnectionId(), protocolCtx, w.recorderManager)
-		if err != nil {
-			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to setup proxying")
+		return
+	}
+	protocolCtx := acResp.GetProtocolContext()
+	if protocolCtx == nil {
+		// TODO: Remove this if block once pre v0.12.0 controllers are no longer supported.
+		if protocolCtx, err = GetProtocolContext(ctx, workerId, sess, endpointUrl.Scheme); err != nil {
+			t.close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get proxy context")
 			event.WriteError(ctx, op, err)
 			return
 		}
+	}
 
-		// We connect connection only after we have confirmed as much as we can
-		// that we can establish the proxy.
-		endpointAddr := pDialer.LastConnectionAddr()
-		connectionInfo := &pbs.ConnectConnectionRequest{
-			ConnectionId:       acResp.GetConnectionId(),
-			ClientTcpAddress:   clientAddr.IP.String(),
-			ClientTcpPort:      uint32(clientAddr.Port),
-			EndpointTcpAddress: endpointAddr.Ip(),
-			EndpointTcpPort:    endpointAddr.Port(),
-			Type:               endpointUrl.Scheme,
-			UserClientIp:       userClientIp,
-		}
-		if err = sess.RequestConnectConnection(ctx, connectionInfo); err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("error requesting connect connection", "session_id", sess.GetId(), "connection_id", acResp.GetConnectionId()))
-			if err = conn.Close(websocket.StatusInternalError, "unable to establish proxy"); err != nil {
-				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
-			}
-			return
+	var dialerOpts []proxyHandlers.Option
+	egressProxyUrl, err := w.egressProxyUrl(sess.GetTargetId())
+	if err != nil {
+		closeReason = intsession.ConnectionSystemError
+		t.close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get egress proxy")
+		event.WriteError(ctx, op, err, event.WithInfo("session_id", sessionId, "connection_id", acResp.GetConnectionId()))
+		return
+	}
+	if egressProxyUrl != nil {
+		dialerOpts = append(dialerOpts, proxyHandlers.WithEgressProxyUrl(egressProxyUrl))
+	}
+	pDialer, err := proxyHandlers.GetEndpointDialer(ctx, endpointUrl.Host, workerId, acResp, w.downstreamReceiver, dialerOpts...)
+	if err != nil {
+		t.close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get endpoint dialer")
+		event.WriteError(ctx, op, err)
+		return
+	}
+
+	// Verify the protocol has a supported proxy before calling RequestConnectConnection
+	handleProxyFn, err := proxyHandlers.GetHandler(workerId, endpointUrl.Scheme, protocolCtx)
+	if err != nil {
+		closeReason = intsession.ConnectionSystemError
+		t.close(proxyHandlers.WebsocketStatusProtocolSetupError, fmt.Sprintf("unable to get proxy handler for protocol %q", endpointUrl.Scheme))
+		event.WriteError(ctx, op, err, event.WithInfo("session_id", sessionId, "connection_id", acResp.GetConnectionId(), "protocol", endpointUrl.Scheme))
+		return
+	}
+	decryptFn, err := w.credDecryptFn(ctx)
+	if err != nil {
+		closeReason = intsession.ConnectionSystemError
+		t.close(proxyHandlers.WebsocketStatusProtocolSetupError, "error getting decryption function")
+		event.WriteError(ctx, op, err)
+		return
+	}
+	runProxy, err := handleProxyFn(ctx, ctx, decryptFn, cc, pDialer, acResp.GetConnectionId(), protocolCtx, w.recorderManager)
+	if err != nil {
+		closeReason = proxyHandlers.ClosedReasonForError(err)
+		t.close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to setup proxying")
+		event.WriteError(ctx, op, err)
+		return
+	}
+
+	// We connect connection only after we have confirmed as much as we can
+	// that we can establish the proxy.
+	endpointAddr := pDialer.LastConnectionAddr()
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       acResp.GetConnectionId(),
+		ClientTcpAddress:   clientAddr.IP.String(),
+		ClientTcpPort:      uint32(clientAddr.Port),
+		EndpointTcpAddress: endpointAddr.Ip(),
+		EndpointTcpPort:    endpointAddr.Port(),
+		Type:               endpointUrl.Scheme,
+		UserClientIp:       userClientIp,
+	}
+	if err = sess.RequestConnectConnection(ctx, connectionInfo); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error requesting connect connection", "session_id", sess.GetId(), "connection_id", acResp.GetConnectionId()))
+		if err = t.close(websocket.StatusInternalError, "unable to establish proxy"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
 		}
+		return
+	}
 
-		runProxy()
-	}, nil
+	runProxy()
+}
+
+// egressProxyUrl returns the url of the proxy through which the endpoint of the
+// target is dialed, or nil if it is dialed directly.
+func (w *Worker) egressProxyUrl(targetId string) (*url.URL, error) {
+	const op = "worker.(*Worker).egressProxyUrl"
+	if w.conf.RawConfig.Worker == nil || w.conf.RawConfig.Worker.EgressProxy == nil {
+		return nil, nil
+	}
+	p := w.conf.RawConfig.Worker.EgressProxy
+	rawUrl := p.Url
+	for _, t := range p.Targets {
+		if t.TargetId == targetId {
+			rawUrl = t.Url
+			break
+		}
+	}
+	if rawUrl == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, errors.New(w.baseContext, errors.InvalidParameter, op, "egress proxy url is not valid")
+	}
+	return u, nil
 }
 
 // credDecryptFn returns a DecryptFn if the worker is a pki worker with
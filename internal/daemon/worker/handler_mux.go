@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/proxy"
+	"github.com/hashicorp/yamux"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wspb"
+)
+
+// proxyTransport carries a single connection of a session from the client.
+type proxyTransport interface {
+	// netConn returns the connection to proxy, which is closed once ctx is
+	// done.
+	netConn(ctx context.Context) net.Conn
+	// writeHandshakeResult sends the result of the connection's handshake to
+	// the client.
+	writeHandshakeResult(ctx context.Context, r *proxy.HandshakeResult) error
+	// close closes the connection, reporting code and reason to the client
+	// when the transport supports it.
+	close(code websocket.StatusCode, reason string) error
+}
+
+// websocketTransport carries a connection over a whole websocket, as
+// negotiated with the globals.TcpProxyV1 subprotocol.
+type websocketTransport struct {
+	conn *websocket.Conn
+}
+
+func (t *websocketTransport) netConn(ctx context.Context) net.Conn {
+	return websocket.NetConn(ctx, t.conn, websocket.MessageBinary)
+}
+
+func (t *websocketTransport) writeHandshakeResult(ctx context.Context, r *proxy.HandshakeResult) error {
+	return wspb.Write(ctx, t.conn, r)
+}
+
+func (t *websocketTransport) close(code websocket.StatusCode, reason string) error {
+	return t.conn.Close(code, reason)
+}
+
+// streamTransport carries a connection over a yamux stream of a websocket
+// negotiated with the globals.TcpProxyV2 subprotocol.  Streams have no close
+// reason: a stream closed before its handshake result was sent tells the
+// client the connection could not be authorized.
+type streamTransport struct {
+	stream *yamux.Stream
+}
+
+func (t *streamTransport) netConn(ctx context.Context) net.Conn {
+	go func() {
+		<-ctx.Done()
+		_ = t.stream.Close()
+	}()
+	return &halfCloseStream{Stream: t.stream}
+}
+
+// halfCloseStream lets proxy handlers half-close a yamux stream.  Closing a
+// stream only closes its write half: data sent by the client is still read
+// until it closes the stream too.
+type halfCloseStream struct {
+	*yamux.Stream
+}
+
+func (s *halfCloseStream) CloseWrite() error {
+	return s.Stream.Close()
+}
+
+func (t *streamTransport) writeHandshakeResult(_ context.Context, r *proxy.HandshakeResult) error {
+	return proxy.WriteMessage(t.stream, r)
+}
+
+func (t *streamTransport) close(websocket.StatusCode, string) error {
+	return t.stream.Close()
+}
+
+// handleMultiplexedProxy proxies the connections of a session multiplexed as
+// yamux streams over conn, so a client opening many connections only pays for
+// the websocket and the session's handshake once.  Each stream is a
+// connection of the session, authorized and reported to the controller on its
+// own.  The handshake result sent over conn once the session is validated only
+// carries the session's expiration and connection limit; the connections left
+// are sent with the handshake result of each stream.
+func (w *Worker) handleMultiplexedProxy(
+	ctx, connCtx context.Context,
+	conn *websocket.Conn,
+	sess session.Session,
+	sessionManager session.Manager,
+	clientAddr *net.TCPAddr,
+	userClientIp string,
+) {
+	const op = "worker.(Worker).handleMultiplexedProxy"
+	handshakeResult := &proxy.HandshakeResult{
+		Expiration:      timestamppb.New(sess.GetExpiration()),
+		ConnectionLimit: sess.GetConnectionLimit(),
+	}
+	if err := wspb.Write(connCtx, conn, handshakeResult); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error sending handshake result to client"))
+		if err = conn.Close(websocket.StatusProtocolError, "unable to send handshake result"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+		}
+		return
+	}
+
+	muxSession, err := yamux.Server(websocket.NetConn(connCtx, conn, websocket.MessageBinary), proxy.NewMuxConfig())
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error starting multiplexed session"))
+		if err = conn.Close(websocket.StatusInternalError, "unable to start multiplexed session"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+		}
+		return
+	}
+	defer muxSession.Close()
+
+	streamWg := new(sync.WaitGroup)
+	for {
+		// Accepting fails once the client closes the websocket or the session
+		// expires, which also closes the open streams.
+		stream, err := muxSession.AcceptStream()
+		if err != nil {
+			break
+		}
+		streamWg.Add(1)
+		go func() {
+			defer streamWg.Done()
+			w.proxyConnection(ctx, connCtx, &streamTransport{stream: stream}, sess, sessionManager, clientAddr, userClientIp)
+		}()
+	}
+	streamWg.Wait()
+}
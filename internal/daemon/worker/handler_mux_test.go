@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/proxy"
+	"github.com/hashicorp/yamux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"nhooyr.io/websocket"
+)
+
+func TestStreamTransport(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	client, err := yamux.Client(clientConn, proxy.NewMuxConfig())
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	server, err := yamux.Server(serverConn, proxy.NewMuxConfig())
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+
+	openStream := func(t *testing.T) (*yamux.Stream, *streamTransport) {
+		t.Helper()
+		clientStream, err := client.OpenStream()
+		require.NoError(t, err)
+		serverStream, err := server.AcceptStream()
+		require.NoError(t, err)
+		return clientStream, &streamTransport{stream: serverStream}
+	}
+
+	t.Run("handshake result", func(t *testing.T) {
+		clientStream, tr := openStream(t)
+		defer clientStream.Close()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		want := &proxy.HandshakeResult{ConnectionLimit: 5, ConnectionsLeft: 3}
+		go func() {
+			assert.NoError(t, tr.writeHandshakeResult(ctx, want))
+			_, _ = tr.netConn(ctx).Write([]byte("data"))
+		}()
+		got := &proxy.HandshakeResult{}
+		require.NoError(t, proxy.ReadMessage(clientStream, got))
+		assert.True(t, proto.Equal(want, got))
+		buf := make([]byte, 4)
+		_, err := io.ReadFull(clientStream, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "data", string(buf))
+	})
+
+	t.Run("closed before result", func(t *testing.T) {
+		clientStream, tr := openStream(t)
+		defer clientStream.Close()
+		require.NoError(t, tr.close(websocket.StatusInternalError, "unable to authorize connection"))
+		err := proxy.ReadMessage(clientStream, &proxy.HandshakeResult{})
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("closed with context", func(t *testing.T) {
+		clientStream, tr := openStream(t)
+		defer clientStream.Close()
+		ctx, cancel := context.WithCancel(context.Background())
+		tr.netConn(ctx)
+		cancel()
+		require.NoError(t, clientStream.SetReadDeadline(time.Now().Add(5*time.Second)))
+		_, err := clientStream.Read(make([]byte, 1))
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
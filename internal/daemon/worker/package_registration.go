@@ -4,5 +4,10 @@
 package worker
 
 import (
+	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/http"
+	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/kubernetes"
+	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/postgres"
+	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/ssh"
 	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/tcp"
+	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/udp"
 )
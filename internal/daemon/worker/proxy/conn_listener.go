@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"net"
+	"sync"
+)
+
+// ConnListener is a net.Listener which returns its connection once and is
+// closed when the connection is.  It lets proxy handlers serve the client's
+// connection with an http.Server.
+type ConnListener struct {
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+	addr      net.Addr
+}
+
+// NewConnListener returns a ConnListener for conn.
+func NewConnListener(conn net.Conn) *ConnListener {
+	l := &ConnListener{
+		conns:  make(chan net.Conn, 1),
+		closed: make(chan struct{}),
+		addr:   conn.LocalAddr(),
+	}
+	l.conns <- &listenerConn{Conn: conn, l: l}
+	return l
+}
+
+func (l *ConnListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *ConnListener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *ConnListener) Addr() net.Addr {
+	return l.addr
+}
+
+// listenerConn closes its listener when it is closed.
+type listenerConn struct {
+	net.Conn
+	l *ConnListener
+}
+
+func (c *listenerConn) Close() error {
+	err := c.Conn.Close()
+	_ = c.l.Close()
+	return err
+}
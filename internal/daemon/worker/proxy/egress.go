@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/session"
+)
+
+// egressHandshakeTimeout bounds the time spent negotiating a connection with
+// an egress proxy when the context has no deadline.
+const egressHandshakeTimeout = 30 * time.Second
+
+// EgressProxyError is returned when an endpoint could not be reached through an
+// egress proxy.
+type EgressProxyError struct {
+	// Reason is the reason reported to the controller for the connection
+	// which could not be established.
+	Reason session.ClosedReason
+	Msg    string
+}
+
+// Error satisfies the error interface.
+func (e *EgressProxyError) Error() string {
+	return fmt.Sprintf("egress proxy: %s", e.Msg)
+}
+
+// ClosedReasonForError returns the reason reported to the controller for a
+// connection closed because of err: the reason of an EgressProxyError, a
+// network error for a net.Error, or an empty reason otherwise.
+func ClosedReasonForError(err error) session.ClosedReason {
+	var egressErr *EgressProxyError
+	var netErr net.Error
+	switch {
+	case stderrors.As(err, &egressErr):
+		return egressErr.Reason
+	case stderrors.As(err, &netErr):
+		return session.ConnectionNetworkError
+	default:
+		return ""
+	}
+}
+
+// egressConn is a connection to an endpoint tunneled through an egress proxy.
+// It provides the address of the endpoint through GetIp and GetPort so the
+// ProxyDialer records it instead of the proxy's.
+type egressConn struct {
+	net.Conn
+	// r is read from instead of the net.Conn when bytes sent by the endpoint
+	// were buffered while reading the proxy's response.
+	r    io.Reader
+	ip   string
+	port uint32
+}
+
+// Read satisfies the io.Reader interface.
+func (c *egressConn) Read(b []byte) (int, error) {
+	if c.r != nil {
+		return c.r.Read(b)
+	}
+	return c.Conn.Read(b)
+}
+
+// CloseWrite closes the write half of the connection to the egress proxy, which
+// relays the half-close to the endpoint.  An error is returned if the
+// connection to the proxy does not support half-close.
+func (c *egressConn) CloseWrite() error {
+	cw, ok := c.Conn.(interface{ CloseWrite() error })
+	if !ok {
+		return stderrors.New("egress proxy connection does not support half-close")
+	}
+	return cw.CloseWrite()
+}
+
+// GetIp returns the ip address of the endpoint.
+func (c *egressConn) GetIp() string {
+	return c.ip
+}
+
+// GetPort returns the port of the endpoint.
+func (c *egressConn) GetPort() uint32 {
+	return c.port
+}
+
+// egressDial dials the endpoint through the egress proxy at proxyUrl.  Host
+// names are resolved by the proxy, so when the endpoint's host is not an ip
+// address the address of the proxy is reported as the address of the
+// endpoint.
+func egressDial(ctx context.Context, proxyUrl *url.URL, endpoint string) (net.Conn, error) {
+	host, portStr, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint port %q: %w", portStr, err)
+	}
+
+	var dialer net.Dialer
+	proxyConn, err := dialer.DialContext(ctx, "tcp", proxyUrl.Host)
+	if err != nil {
+		return nil, &EgressProxyError{Reason: session.ConnectionNetworkError, Msg: fmt.Sprintf("unable to reach proxy: %s", err)}
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(egressHandshakeTimeout)
+	}
+	if err := proxyConn.SetDeadline(deadline); err != nil {
+		_ = proxyConn.Close()
+		return nil, err
+	}
+
+	conn := &egressConn{Conn: proxyConn}
+	switch proxyUrl.Scheme {
+	case "socks5":
+		err = socks5Connect(proxyConn, proxyUrl.User, host, uint16(port))
+	case "http":
+		conn.r, err = httpConnect(proxyConn, proxyUrl.User, endpoint)
+	default:
+		err = &EgressProxyError{Reason: session.ConnectionSystemError, Msg: fmt.Sprintf("unsupported proxy scheme %q", proxyUrl.Scheme)}
+	}
+	if err == nil {
+		err = proxyConn.SetDeadline(time.Time{})
+	}
+	if err != nil {
+		_ = proxyConn.Close()
+		var netErr net.Error
+		if stderrors.As(err, &netErr) || stderrors.Is(err, io.EOF) || stderrors.Is(err, io.ErrUnexpectedEOF) {
+			err = &EgressProxyError{Reason: session.ConnectionNetworkError, Msg: fmt.Sprintf("negotiating with proxy: %s", err)}
+		}
+		return nil, err
+	}
+
+	conn.ip, conn.port = host, uint32(port)
+	if net.ParseIP(host) == nil {
+		if addr, ok := proxyConn.RemoteAddr().(*net.TCPAddr); ok {
+			conn.ip, conn.port = addr.IP.String(), uint32(addr.Port)
+		}
+	}
+	return conn, nil
+}
+
+// SOCKS5 protocol values, see RFC 1928 and RFC 1929.
+const (
+	socks5Version          = 0x05
+	socks5AuthNone         = 0x00
+	socks5AuthUserPass     = 0x02
+	socks5AuthNoAcceptable = 0xff
+	socks5UserPassVersion  = 0x01
+	socks5CmdConnect       = 0x01
+	socks5AddrIPv4         = 0x01
+	socks5AddrDomain       = 0x03
+	socks5AddrIPv6         = 0x04
+)
+
+// socks5ReplyReasons maps the SOCKS5 reply codes to the reason reported for
+// the connection and a description.
+var socks5ReplyReasons = map[byte]struct {
+	reason session.ClosedReason
+	msg    string
+}{
+	0x01: {session.ConnectionSystemError, "general SOCKS server failure"},
+	0x02: {session.ConnectionSystemError, "connection not allowed by ruleset"},
+	0x03: {session.ConnectionNetworkError, "network unreachable"},
+	0x04: {session.ConnectionNetworkError, "host unreachable"},
+	0x05: {session.ConnectionNetworkError, "connection refused"},
+	0x06: {session.ConnectionNetworkError, "TTL expired"},
+	0x07: {session.ConnectionSystemError, "command not supported"},
+	0x08: {session.ConnectionSystemError, "address type not supported"},
+}
+
+// socks5Connect negotiates a connection to host and port with the SOCKS5
+// proxy at the other end of conn, authenticating with user if it is set.
+func socks5Connect(conn net.Conn, user *url.Userinfo, host string, port uint16) error {
+	methods := []byte{socks5AuthNone}
+	if user != nil {
+		methods = append(methods, socks5AuthUserPass)
+	}
+	if _, err := conn.Write(append([]byte{socks5Version, byte(len(methods))}, methods...)); err != nil {
+		return err
+	}
+	resp := make([]byte, 2)
+	if _, err := io.ReadFull(conn, resp); err != nil {
+		return err
+	}
+	if resp[0] != socks5Version {
+		return &EgressProxyError{Reason: session.ConnectionSystemError, Msg: fmt.Sprintf("unexpected SOCKS version %d", resp[0])}
+	}
+	switch resp[1] {
+	case socks5AuthNone:
+	case socks5AuthUserPass:
+		if user == nil {
+			return &EgressProxyError{Reason: session.ConnectionSystemError, Msg: "proxy requires authentication"}
+		}
+		if err := socks5Authenticate(conn, user); err != nil {
+			return err
+		}
+	case socks5AuthNoAcceptable:
+		return &EgressProxyError{Reason: session.ConnectionSystemError, Msg: "no acceptable authentication method"}
+	default:
+		return &EgressProxyError{Reason: session.ConnectionSystemError, Msg: fmt.Sprintf("unsupported authentication method %d", resp[1])}
+	}
+
+	req := []byte{socks5Version, socks5CmdConnect, 0x00}
+	switch ip := net.ParseIP(host); {
+	case ip == nil:
+		if len(host) > 255 {
+			return &EgressProxyError{Reason: session.ConnectionSystemError, Msg: "endpoint host name is too long"}
+		}
+		req = append(req, socks5AddrDomain, byte(len(host)))
+		req = append(req, host...)
+	case ip.To4() != nil:
+		req = append(req, socks5AddrIPv4)
+		req = append(req, ip.To4()...)
+	default:
+		req = append(req, socks5AddrIPv6)
+		req = append(req, ip.To16()...)
+	}
+	req = binary.BigEndian.AppendUint16(req, port)
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+
+	reply := make([]byte, 4)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return err
+	}
+	if reply[0] != socks5Version {
+		return &EgressProxyError{Reason: session.ConnectionSystemError, Msg: fmt.Sprintf("unexpected SOCKS version %d", reply[0])}
+	}
+	if reply[1] != 0x00 {
+		r, ok := socks5ReplyReasons[reply[1]]
+		if !ok {
+			return &EgressProxyError{Reason: session.ConnectionSystemError, Msg: fmt.Sprintf("unknown SOCKS reply %d", reply[1])}
+		}
+		return &EgressProxyError{Reason: r.reason, Msg: r.msg}
+	}
+	// Discard the bound address and port.
+	var addrLen int
+	switch reply[3] {
+	case socks5AddrIPv4:
+		addrLen = net.IPv4len
+	case socks5AddrIPv6:
+		addrLen = net.IPv6len
+	case socks5AddrDomain:
+		l := make([]byte, 1)
+		if _, err := io.ReadFull(conn, l); err != nil {
+			return err
+		}
+		addrLen = int(l[0])
+	default:
+		return &EgressProxyError{Reason: session.ConnectionSystemError, Msg: fmt.Sprintf("unknown SOCKS address type %d", reply[3])}
+	}
+	if _, err := io.ReadFull(conn, make([]byte, addrLen+2)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// socks5Authenticate performs the SOCKS5 username/password authentication.
+func socks5Authenticate(conn net.Conn, user *url.Userinfo) error {
+	username := user.Username()
+	password, _ := user.Password()
+	if len(username) == 0 || len(username) > 255 || len(password) > 255 {
+		return &EgressProxyError{Reason: session.ConnectionSystemError, Msg: "invalid proxy username or password length"}
+	}
+	req := []byte{socks5UserPassVersion, byte(len(username))}
+	req = append(req, username...)
+	req = append(req, byte(len(password)))
+	req = append(req, password...)
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+	resp := make([]byte, 2)
+	if _, err := io.ReadFull(conn, resp); err != nil {
+		return err
+	}
+	if resp[1] != 0x00 {
+		return &EgressProxyError{Reason: session.ConnectionSystemError, Msg: "proxy authentication failed"}
+	}
+	return nil
+}
+
+// httpConnect asks the HTTP proxy at the other end of conn to tunnel to
+// endpoint, authenticating with user if it is set.  If the proxy's response
+// was followed by bytes from the endpoint, the returned reader must be read
+// from instead of conn.
+func httpConnect(conn net.Conn, user *url.Userinfo, endpoint string) (io.Reader, error) {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: endpoint},
+		Host:   endpoint,
+		Header: make(http.Header),
+	}
+	if user != nil {
+		password, _ := user.Password()
+		req.SetBasicAuth(user.Username(), password)
+		req.Header.Set("Proxy-Authorization", req.Header.Get("Authorization"))
+		req.Header.Del("Authorization")
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+	case resp.StatusCode == http.StatusBadGateway,
+		resp.StatusCode == http.StatusServiceUnavailable,
+		resp.StatusCode == http.StatusGatewayTimeout:
+		return nil, &EgressProxyError{Reason: session.ConnectionNetworkError, Msg: fmt.Sprintf("proxy responded %s", resp.Status)}
+	case resp.StatusCode == http.StatusProxyAuthRequired:
+		return nil, &EgressProxyError{Reason: session.ConnectionSystemError, Msg: "proxy authentication failed"}
+	default:
+		return nil, &EgressProxyError{Reason: session.ConnectionSystemError, Msg: fmt.Sprintf("proxy responded %s", resp.Status)}
+	}
+	if br.Buffered() > 0 {
+		return io.MultiReader(io.LimitReader(br, int64(br.Buffered())), conn), nil
+	}
+	return nil, nil
+}
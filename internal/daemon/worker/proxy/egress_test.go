@@ -0,0 +1,455 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoServer starts a tcp server echoing back what it reads and returns its
+// address.
+func echoServer(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				_, _ = io.Copy(c, c)
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+// testSocks5Server is a minimal SOCKS5 server supporting the CONNECT command
+// and optionally username/password authentication.
+type testSocks5Server struct {
+	username, password string
+	// reply, if set, is sent instead of connecting to the requested address.
+	reply byte
+
+	// requested is the address of the last CONNECT request.
+	requested chan string
+}
+
+func (s *testSocks5Server) start(t *testing.T) string {
+	t.Helper()
+	s.requested = make(chan string, 10)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(c)
+		}
+	}()
+	return l.Addr().String()
+}
+
+func (s *testSocks5Server) serve(c net.Conn) {
+	defer c.Close()
+	hdr := make([]byte, 2)
+	if _, err := io.ReadFull(c, hdr); err != nil {
+		return
+	}
+	methods := make([]byte, hdr[1])
+	if _, err := io.ReadFull(c, methods); err != nil {
+		return
+	}
+	want := byte(socks5AuthNone)
+	if s.username != "" {
+		want = socks5AuthUserPass
+	}
+	accepted := byte(socks5AuthNoAcceptable)
+	for _, m := range methods {
+		if m == want {
+			accepted = want
+		}
+	}
+	if _, err := c.Write([]byte{socks5Version, accepted}); err != nil || accepted == socks5AuthNoAcceptable {
+		return
+	}
+	if accepted == socks5AuthUserPass {
+		readString := func() string {
+			l := make([]byte, 1)
+			_, _ = io.ReadFull(c, l)
+			b := make([]byte, l[0])
+			_, _ = io.ReadFull(c, b)
+			return string(b)
+		}
+		if _, err := io.ReadFull(c, make([]byte, 1)); err != nil {
+			return
+		}
+		u, p := readString(), readString()
+		if u != s.username || p != s.password {
+			_, _ = c.Write([]byte{socks5UserPassVersion, 0x01})
+			return
+		}
+		if _, err := c.Write([]byte{socks5UserPassVersion, 0x00}); err != nil {
+			return
+		}
+	}
+
+	req := make([]byte, 4)
+	if _, err := io.ReadFull(c, req); err != nil {
+		return
+	}
+	var host string
+	switch req[3] {
+	case socks5AddrIPv4:
+		b := make([]byte, net.IPv4len)
+		_, _ = io.ReadFull(c, b)
+		host = net.IP(b).String()
+	case socks5AddrIPv6:
+		b := make([]byte, net.IPv6len)
+		_, _ = io.ReadFull(c, b)
+		host = net.IP(b).String()
+	case socks5AddrDomain:
+		l := make([]byte, 1)
+		_, _ = io.ReadFull(c, l)
+		b := make([]byte, l[0])
+		_, _ = io.ReadFull(c, b)
+		host = string(b)
+		if host == "localhost" {
+			host = "127.0.0.1"
+		}
+	}
+	p := make([]byte, 2)
+	if _, err := io.ReadFull(c, p); err != nil {
+		return
+	}
+	addr := net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(p))))
+	s.requested <- addr
+
+	reply := []byte{socks5Version, s.reply, 0x00, socks5AddrIPv4, 0, 0, 0, 0, 0, 0}
+	if s.reply != 0x00 {
+		_, _ = c.Write(reply)
+		return
+	}
+	remote, err := net.Dial("tcp", addr)
+	if err != nil {
+		reply[1] = 0x05
+		_, _ = c.Write(reply)
+		return
+	}
+	defer remote.Close()
+	if _, err := c.Write(reply); err != nil {
+		return
+	}
+	go func() {
+		// The client's half-close is relayed to the endpoint.
+		_, _ = io.Copy(remote, c)
+		_ = remote.(*net.TCPConn).CloseWrite()
+	}()
+	_, _ = io.Copy(c, remote)
+}
+
+// testConnectServer starts an HTTP proxy supporting CONNECT which requires the
+// given basic auth credentials if username is set, and returns its address.
+func testConnectServer(t *testing.T, username, password string) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				req, err := http.ReadRequest(bufio.NewReader(c))
+				if err != nil {
+					return
+				}
+				if req.Method != http.MethodConnect {
+					fmt.Fprint(c, "HTTP/1.1 405 Method Not Allowed\r\n\r\n")
+					return
+				}
+				if username != "" {
+					r := &http.Request{Header: http.Header{"Authorization": req.Header.Values("Proxy-Authorization")}}
+					u, p, ok := r.BasicAuth()
+					if !ok || u != username || p != password {
+						fmt.Fprint(c, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+						return
+					}
+				}
+				remote, err := net.Dial("tcp", req.Host)
+				if err != nil {
+					fmt.Fprint(c, "HTTP/1.1 502 Bad Gateway\r\n\r\n")
+					return
+				}
+				defer remote.Close()
+				fmt.Fprint(c, "HTTP/1.1 200 Connection established\r\n\r\n")
+				go func() {
+					_, _ = io.Copy(remote, c)
+					_ = remote.(*net.TCPConn).CloseWrite()
+				}()
+				_, _ = io.Copy(c, remote)
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func assertEcho(t *testing.T, c net.Conn) {
+	t.Helper()
+	_, err := c.Write([]byte("ping"))
+	require.NoError(t, err)
+	b := make([]byte, 4)
+	_, err = io.ReadFull(c, b)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(b))
+}
+
+// closedPort returns an address on which nothing is listening.
+func closedPort(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+	return addr
+}
+
+func TestEgressDial_Socks5(t *testing.T) {
+	ctx := context.Background()
+	endpoint := echoServer(t)
+	_, endpointPort, err := net.SplitHostPort(endpoint)
+	require.NoError(t, err)
+
+	t.Run("no auth", func(t *testing.T) {
+		s := &testSocks5Server{}
+		proxyAddr := s.start(t)
+		c, err := egressDial(ctx, &url.URL{Scheme: "socks5", Host: proxyAddr}, endpoint)
+		require.NoError(t, err)
+		defer c.Close()
+		assert.Equal(t, endpoint, <-s.requested)
+		assertEcho(t, c)
+
+		g, ok := c.(portAndIpGetter)
+		require.True(t, ok)
+		assert.Equal(t, "127.0.0.1", g.GetIp())
+		assert.Equal(t, endpointPort, strconv.Itoa(int(g.GetPort())))
+	})
+
+	t.Run("auth", func(t *testing.T) {
+		s := &testSocks5Server{username: "user", password: "pass"}
+		proxyAddr := s.start(t)
+		c, err := egressDial(ctx, &url.URL{Scheme: "socks5", Host: proxyAddr, User: url.UserPassword("user", "pass")}, endpoint)
+		require.NoError(t, err)
+		defer c.Close()
+		assertEcho(t, c)
+	})
+
+	t.Run("host name", func(t *testing.T) {
+		s := &testSocks5Server{}
+		proxyAddr := s.start(t)
+		c, err := egressDial(ctx, &url.URL{Scheme: "socks5", Host: proxyAddr}, net.JoinHostPort("localhost", endpointPort))
+		require.NoError(t, err)
+		defer c.Close()
+		assert.Equal(t, endpoint, <-s.requested)
+		assertEcho(t, c)
+
+		// The proxy resolved the host name, so its address is reported.
+		g, ok := c.(portAndIpGetter)
+		require.True(t, ok)
+		assert.Equal(t, proxyAddr, net.JoinHostPort(g.GetIp(), strconv.Itoa(int(g.GetPort()))))
+	})
+
+	tests := []struct {
+		name       string
+		server     *testSocks5Server
+		user       *url.Userinfo
+		endpoint   string
+		wantReason session.ClosedReason
+	}{
+		{
+			name:       "bad password",
+			server:     &testSocks5Server{username: "user", password: "pass"},
+			user:       url.UserPassword("user", "wrong"),
+			endpoint:   endpoint,
+			wantReason: session.ConnectionSystemError,
+		},
+		{
+			name:       "missing auth",
+			server:     &testSocks5Server{username: "user", password: "pass"},
+			endpoint:   endpoint,
+			wantReason: session.ConnectionSystemError,
+		},
+		{
+			name:       "not allowed",
+			server:     &testSocks5Server{reply: 0x02},
+			endpoint:   endpoint,
+			wantReason: session.ConnectionSystemError,
+		},
+		{
+			name:       "host unreachable",
+			server:     &testSocks5Server{reply: 0x04},
+			endpoint:   endpoint,
+			wantReason: session.ConnectionNetworkError,
+		},
+		{
+			name:       "connection refused",
+			server:     &testSocks5Server{},
+			endpoint:   closedPort(t),
+			wantReason: session.ConnectionNetworkError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxyAddr := tt.server.start(t)
+			c, err := egressDial(ctx, &url.URL{Scheme: "socks5", Host: proxyAddr, User: tt.user}, tt.endpoint)
+			require.Error(t, err)
+			assert.Nil(t, c)
+			assert.Equal(t, tt.wantReason, ClosedReasonForError(err))
+		})
+	}
+}
+
+func TestEgressDial_HttpConnect(t *testing.T) {
+	ctx := context.Background()
+	endpoint := echoServer(t)
+
+	t.Run("success", func(t *testing.T) {
+		proxyAddr := testConnectServer(t, "user", "pass")
+		c, err := egressDial(ctx, &url.URL{Scheme: "http", Host: proxyAddr, User: url.UserPassword("user", "pass")}, endpoint)
+		require.NoError(t, err)
+		defer c.Close()
+		assertEcho(t, c)
+	})
+
+	t.Run("bad password", func(t *testing.T) {
+		proxyAddr := testConnectServer(t, "user", "pass")
+		_, err := egressDial(ctx, &url.URL{Scheme: "http", Host: proxyAddr, User: url.UserPassword("user", "wrong")}, endpoint)
+		require.Error(t, err)
+		assert.Equal(t, session.ConnectionSystemError, ClosedReasonForError(err))
+	})
+
+	t.Run("bad gateway", func(t *testing.T) {
+		proxyAddr := testConnectServer(t, "", "")
+		_, err := egressDial(ctx, &url.URL{Scheme: "http", Host: proxyAddr}, closedPort(t))
+		require.Error(t, err)
+		assert.Equal(t, session.ConnectionNetworkError, ClosedReasonForError(err))
+	})
+
+	t.Run("endpoint speaks first", func(t *testing.T) {
+		// The proxy's response and the endpoint's first bytes arrive together.
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer l.Close()
+		go func() {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer c.Close()
+			if _, err := http.ReadRequest(bufio.NewReader(c)); err != nil {
+				return
+			}
+			fmt.Fprint(c, "HTTP/1.1 200 OK\r\n\r\nbanner")
+			_, _ = io.Copy(io.Discard, c)
+		}()
+		c, err := egressDial(ctx, &url.URL{Scheme: "http", Host: l.Addr().String()}, endpoint)
+		require.NoError(t, err)
+		defer c.Close()
+		b := make([]byte, 6)
+		_, err = io.ReadFull(c, b)
+		require.NoError(t, err)
+		assert.Equal(t, "banner", string(b))
+	})
+}
+
+func TestEgressConn_CloseWrite(t *testing.T) {
+	ctx := context.Background()
+	endpoint := echoServer(t)
+	s := &testSocks5Server{}
+	socks5Addr := s.start(t)
+	connectAddr := testConnectServer(t, "", "")
+
+	for _, proxyUrl := range []*url.URL{
+		{Scheme: "socks5", Host: socks5Addr},
+		{Scheme: "http", Host: connectAddr},
+	} {
+		t.Run(proxyUrl.Scheme, func(t *testing.T) {
+			c, err := egressDial(ctx, proxyUrl, endpoint)
+			require.NoError(t, err)
+			defer c.Close()
+			cw, ok := c.(interface{ CloseWrite() error })
+			require.True(t, ok)
+
+			// The endpoint sees the half-close once it has read everything
+			// sent, and its reply is still received.
+			_, err = c.Write([]byte("ping"))
+			require.NoError(t, err)
+			require.NoError(t, cw.CloseWrite())
+			b, err := io.ReadAll(c)
+			require.NoError(t, err)
+			assert.Equal(t, "ping", string(b))
+
+			_, err = c.Write([]byte("pong"))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEgressDial_ProxyUnreachable(t *testing.T) {
+	_, err := egressDial(context.Background(), &url.URL{Scheme: "socks5", Host: closedPort(t)}, "127.0.0.1:22")
+	require.Error(t, err)
+	assert.Equal(t, session.ConnectionNetworkError, ClosedReasonForError(err))
+}
+
+func TestClosedReasonForError(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, session.ClosedReason(""), ClosedReasonForError(fmt.Errorf("test error")))
+	assert.Equal(t, session.ConnectionNetworkError, ClosedReasonForError(&net.OpError{Op: "dial", Err: fmt.Errorf("refused")}))
+	wrapped := errors.Wrap(ctx, &EgressProxyError{Reason: session.ConnectionSystemError, Msg: "test"}, "test.op")
+	assert.Equal(t, session.ConnectionSystemError, ClosedReasonForError(wrapped))
+}
+
+func TestDirectDialer_EgressProxy(t *testing.T) {
+	ctx := context.Background()
+	endpoint := echoServer(t)
+	s := &testSocks5Server{}
+	proxyAddr := s.start(t)
+
+	d, err := directDialer(ctx, endpoint, "", nil, nil, WithEgressProxyUrl(&url.URL{Scheme: "socks5", Host: proxyAddr}))
+	require.NoError(t, err)
+	c, err := d.Dial(ctx)
+	require.NoError(t, err)
+	defer c.Close()
+	assert.Equal(t, endpoint, <-s.requested)
+	assertEcho(t, c)
+
+	host, port, err := net.SplitHostPort(endpoint)
+	require.NoError(t, err)
+	assert.Equal(t, host, d.LastConnectionAddr().Ip())
+	assert.Equal(t, port, strconv.Itoa(int(d.LastConnectionAddr().Port())))
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package http provides the worker proxy handler for http targets.  The
+// handler parses the HTTP/1.1 and HTTP/2 requests sent by the client and adds
+// the credentials injected into the session to them before forwarding them to
+// the endpoint, so the client never has access to the credentials.
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// HandlerName is the protocol the http proxy handler is registered for.
+const HandlerName = "http"
+
+// TokenField is the field of a json credential holding the bearer token the
+// worker adds to the requests it forwards.
+const TokenField = "token"
+
+func init() {
+	if err := proxy.RegisterHandler(HandlerName, handleProxy); err != nil {
+		panic(err)
+	}
+	if err := proxy.RegisterProtocolContext(HandlerName, &pbs.HttpProtocolContext{}); err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy creates an http proxy between the incoming conn and the
+// connection created by the ProxyDialer.  The first connection to the endpoint
+// is dialed before handleProxy returns.  Any Authorization header sent by the
+// client is replaced with the injected credential found in the protocol
+// context: a json credential is sent as a bearer token and a username password
+// credential as basic auth.
+//
+// handleProxy returns a ProxyConnFn which serves the client's requests until
+// the incoming conn is closed.  Requests are forwarded over HTTP/1.1, using TLS
+// if it is enabled in the protocol context, and the endpoint is redialed if it
+// closes the connection between requests.  When TLS is enabled the endpoint's
+// certificate is verified against the server name and CA certificates in the
+// protocol context, defaulting to the endpoint's host and the system roots.
+func handleProxy(controlCtx context.Context, dataCtx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, pc *anypb.Any, _ proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+	const op = "http.handleProxy"
+	switch {
+	case conn == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "conn is nil")
+	case out == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "proxy dialer is nil")
+	case len(connId) == 0:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "connection id is empty")
+	case pc == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "protocol context is nil")
+	}
+	httpCtx := &pbs.HttpProtocolContext{}
+	if err := pc.UnmarshalTo(httpCtx); err != nil {
+		return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to unmarshal http protocol context"))
+	}
+	if httpCtx.GetHost() == "" {
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "protocol context is missing the endpoint host")
+	}
+	authorization, err := authorizationHeader(controlCtx, httpCtx.GetInjectedCredentials())
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+	endpoint := &url.URL{Scheme: "http", Host: httpCtx.GetHost()}
+	var tlsConf *tls.Config
+	if httpCtx.GetEnableTls() {
+		endpoint.Scheme = "https"
+		if tlsConf, err = endpointTlsConfig(controlCtx, httpCtx); err != nil {
+			return nil, errors.Wrap(controlCtx, err, op)
+		}
+	}
+
+	remoteConn, err := out.Dial(controlCtx)
+	if err != nil {
+		return nil, err
+	}
+	d := &endpointDialer{first: remoteConn, out: out}
+	transport := &http.Transport{
+		DialContext:         d.DialContext,
+		MaxIdleConnsPerHost: 1,
+		IdleConnTimeout:     90 * time.Second,
+		TLSClientConfig:     tlsConf,
+	}
+	rp := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(endpoint)
+			if authorization != "" {
+				r.Out.Header.Set("Authorization", authorization)
+			}
+		},
+		Transport: transport,
+		ErrorLog:  log.New(io.Discard, "", 0),
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("unable to forward request to endpoint", "connection_id", connId))
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+
+	return func() {
+		defer d.close()
+		defer transport.CloseIdleConnections()
+
+		l := proxy.NewConnListener(conn)
+		srv := &http.Server{
+			Handler:           h2c.NewHandler(rp, &http2.Server{}),
+			ReadHeaderTimeout: 10 * time.Second,
+			ErrorLog:          log.New(io.Discard, "", 0),
+			BaseContext:       func(net.Listener) context.Context { return dataCtx },
+		}
+		if err := srv.Serve(l); err != nil && err != net.ErrClosed {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error serving http connection", "connection_id", connId))
+		}
+	}, nil
+}
+
+// authorizationHeader returns the value of the Authorization header for the
+// injected credentials.  At most one credential can be injected.  If none is,
+// an empty string is returned and the client's Authorization header is
+// forwarded as is.
+func authorizationHeader(ctx context.Context, creds []*pbs.Credential) (string, error) {
+	const op = "http.authorizationHeader"
+	switch len(creds) {
+	case 0:
+		return "", nil
+	case 1:
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, "http sessions support a single injected credential")
+	}
+	switch c := creds[0].GetCredential().(type) {
+	case *pbs.Credential_UsernamePassword:
+		up := c.UsernamePassword.GetUsername() + ":" + c.UsernamePassword.GetPassword()
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(up)), nil
+	case *pbs.Credential_Json:
+		var object map[string]any
+		if err := json.Unmarshal(c.Json.GetObject(), &object); err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal json credential"))
+		}
+		token, ok := object[TokenField].(string)
+		if !ok || token == "" {
+			return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("json credential is missing a %q string field", TokenField))
+		}
+		return "Bearer " + token, nil
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T for http sessions", c))
+	}
+}
+
+// endpointTlsConfig returns the tls.Config used to verify the endpoint's
+// certificate.  The transport sets the server name from the endpoint's host
+// when the protocol context does not provide one.
+func endpointTlsConfig(ctx context.Context, httpCtx *pbs.HttpProtocolContext) (*tls.Config, error) {
+	const op = "http.endpointTlsConfig"
+	cfg := &tls.Config{
+		ServerName: httpCtx.GetTlsServerName(),
+		MinVersion: tls.VersionTLS12,
+	}
+	if httpCtx.GetTlsCaCertificates() != "" {
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM([]byte(httpCtx.GetTlsCaCertificates())) {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "tls ca certificates contain no valid PEM certificates")
+		}
+	}
+	return cfg, nil
+}
+
+// endpointDialer returns the connection dialed by handleProxy the first time
+// it is called and dials a new connection to the endpoint after that.
+type endpointDialer struct {
+	mu    sync.Mutex
+	first net.Conn
+	out   *proxy.ProxyDialer
+}
+
+func (d *endpointDialer) DialContext(ctx context.Context, _, _ string) (net.Conn, error) {
+	d.mu.Lock()
+	c := d.first
+	d.first = nil
+	d.mu.Unlock()
+	if c != nil {
+		return c, nil
+	}
+	return d.out.Dial(ctx)
+}
+
+// close closes the first connection if it was never used.
+func (d *endpointDialer) close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.first != nil {
+		_ = d.first.Close()
+		d.first = nil
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package http
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// testHandler writes the Authorization and Host headers of the request and
+// the protocol it was received with back in the response body.
+func testHandler(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(r.Header.Get("Authorization") + "|" + r.Host + "|" + r.Proto))
+}
+
+func testProtocolContext(t *testing.T, host string, creds ...*pbs.Credential) *anypb.Any {
+	t.Helper()
+	pc, err := anypb.New(&pbs.HttpProtocolContext{
+		InjectedCredentials: creds,
+		Host:                host,
+	})
+	require.NoError(t, err)
+	return pc
+}
+
+func jsonCredential(t *testing.T, object map[string]any) *pbs.Credential {
+	t.Helper()
+	b, err := json.Marshal(object)
+	require.NoError(t, err)
+	return &pbs.Credential{Credential: &pbs.Credential_Json{Json: &pbs.Json{Object: b}}}
+}
+
+func TestHandleProxy(t *testing.T) {
+	ctx := context.Background()
+
+	basic := &pbs.Credential{Credential: &pbs.Credential_UsernamePassword{
+		UsernamePassword: &pbs.UsernamePassword{Username: "alice", Password: "passw0rd"},
+	}}
+
+	cases := []struct {
+		name     string
+		creds    []*pbs.Credential
+		http2    bool
+		wantAuth string
+		wantErr  bool
+	}{
+		{
+			name:     "no credentials",
+			wantAuth: "client-auth",
+		},
+		{
+			name:     "username password",
+			creds:    []*pbs.Credential{basic},
+			wantAuth: "Basic YWxpY2U6cGFzc3cwcmQ=",
+		},
+		{
+			name:     "json token",
+			creds:    []*pbs.Credential{jsonCredential(t, map[string]any{"token": "s3cr3t"})},
+			wantAuth: "Bearer s3cr3t",
+		},
+		{
+			name:     "http2 client",
+			creds:    []*pbs.Credential{basic},
+			http2:    true,
+			wantAuth: "Basic YWxpY2U6cGFzc3cwcmQ=",
+		},
+		{
+			name:    "json missing token",
+			creds:   []*pbs.Credential{jsonCredential(t, map[string]any{"password": "s3cr3t"})},
+			wantErr: true,
+		},
+		{
+			name:    "multiple credentials",
+			creds:   []*pbs.Credential{basic, basic},
+			wantErr: true,
+		},
+		{
+			name: "unsupported credential",
+			creds: []*pbs.Credential{{Credential: &pbs.Credential_SshPrivateKey{
+				SshPrivateKey: &pbs.SshPrivateKey{Username: "alice", PrivateKey: "key"},
+			}}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			srv := httptest.NewServer(http.HandlerFunc(testHandler))
+			t.Cleanup(srv.Close)
+			u, err := url.Parse(srv.URL)
+			require.NoError(err)
+
+			dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+				return net.Dial("tcp", u.Host)
+			})
+			require.NoError(err)
+
+			clientSide, workerSide := net.Pipe()
+			t.Cleanup(func() {
+				clientSide.Close()
+				workerSide.Close()
+			})
+			fn, err := handleProxy(ctx, ctx, nil, workerSide, dialer, "conn_1234567890", testProtocolContext(t, u.Host, tc.creds...), nil)
+			if tc.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			go fn()
+
+			req, err := http.NewRequest(http.MethodGet, "http://localhost/", nil)
+			require.NoError(err)
+			req.Header.Set("Authorization", "client-auth")
+
+			var resp *http.Response
+			if tc.http2 {
+				tr := &http2.Transport{AllowHTTP: true}
+				cc, err := tr.NewClientConn(clientSide)
+				require.NoError(err)
+				resp, err = cc.RoundTrip(req)
+				require.NoError(err)
+			} else {
+				require.NoError(req.Write(clientSide))
+				resp, err = http.ReadResponse(bufio.NewReader(clientSide), req)
+				require.NoError(err)
+			}
+			defer resp.Body.Close()
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(err)
+			// Requests are always forwarded to the endpoint over HTTP/1.1 with
+			// the endpoint as the Host.
+			assert.Equal(tc.wantAuth+"|"+u.Host+"|HTTP/1.1", string(body))
+		})
+	}
+}
+
+func TestHandleProxy_Tls(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(testHandler))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	cases := []struct {
+		name        string
+		serverName  string
+		caCerts     string
+		wantStatus  int
+		wantErr     bool
+		wantErrText string
+	}{
+		{
+			name:       "verified",
+			serverName: "example.com",
+			caCerts:    caCert,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "host as server name",
+			caCerts:    caCert,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unknown ca",
+			serverName: "example.com",
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:       "wrong server name",
+			serverName: "boundary.test",
+			caCerts:    caCert,
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:        "invalid ca certificates",
+			caCerts:     "not a certificate",
+			wantErr:     true,
+			wantErrText: "tls ca certificates contain no valid PEM certificates",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+				return net.Dial("tcp", u.Host)
+			})
+			require.NoError(err)
+
+			clientSide, workerSide := net.Pipe()
+			t.Cleanup(func() {
+				clientSide.Close()
+				workerSide.Close()
+			})
+			pc, err := anypb.New(&pbs.HttpProtocolContext{
+				Host:              u.Host,
+				EnableTls:         true,
+				TlsServerName:     tc.serverName,
+				TlsCaCertificates: tc.caCerts,
+			})
+			require.NoError(err)
+			fn, err := handleProxy(ctx, ctx, nil, workerSide, dialer, "conn_1234567890", pc, nil)
+			if tc.wantErr {
+				require.Error(err)
+				assert.Contains(err.Error(), tc.wantErrText)
+				return
+			}
+			require.NoError(err)
+			go fn()
+
+			req, err := http.NewRequest(http.MethodGet, "http://localhost/", nil)
+			require.NoError(err)
+			require.NoError(req.Write(clientSide))
+			resp, err := http.ReadResponse(bufio.NewReader(clientSide), req)
+			require.NoError(err)
+			defer resp.Body.Close()
+			assert.Equal(tc.wantStatus, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package kubernetes provides the worker proxy handler for kubernetes targets.
+// The handler serves the Kubernetes API to the client over plain HTTP/1.1 and
+// forwards its requests to the API server over TLS with the service account
+// token injected into the session, so the client never has access to the
+// token.  Streaming requests, like exec and port-forward, are upgraded to
+// SPDY or websocket connections which are proxied as is.
+package kubernetes
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// HandlerName is the protocol the kubernetes proxy handler is registered for.
+const HandlerName = "kubernetes"
+
+// tokenFields are the fields of a json credential which can hold the service
+// account token, in the order they are looked up.  "token" is used by static
+// json credentials and "service_account_token" by Vault's kubernetes secrets
+// engine.
+var tokenFields = []string{"token", "service_account_token"}
+
+// impersonationHeaderPrefix is the prefix of the headers a client can use to
+// act as another user.  They are removed from the client's requests so it can
+// only act as the injected service account.
+const impersonationHeaderPrefix = "Impersonate-"
+
+func init() {
+	if err := proxy.RegisterHandler(HandlerName, handleProxy); err != nil {
+		panic(err)
+	}
+	if err := proxy.RegisterProtocolContext(HandlerName, &pbs.KubernetesProtocolContext{}); err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy creates a kubernetes API proxy between the incoming conn and the
+// connection created by the ProxyDialer.  The first connection to the API
+// server is dialed and its TLS handshake completed before handleProxy
+// returns.  Any Authorization and impersonation headers sent by the client are
+// replaced with the service account token found in the injected credential.
+//
+// handleProxy returns a ProxyConnFn which serves the client's requests until
+// the incoming conn is closed.  The API server is redialed if it closes the
+// connection between requests.
+func handleProxy(controlCtx context.Context, dataCtx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, pc *anypb.Any, _ proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+	const op = "kubernetes.handleProxy"
+	switch {
+	case conn == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "conn is nil")
+	case out == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "proxy dialer is nil")
+	case len(connId) == 0:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "connection id is empty")
+	case pc == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "protocol context is nil")
+	}
+	kubeCtx := &pbs.KubernetesProtocolContext{}
+	if err := pc.UnmarshalTo(kubeCtx); err != nil {
+		return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to unmarshal kubernetes protocol context"))
+	}
+	if kubeCtx.GetHost() == "" {
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "protocol context is missing the endpoint host")
+	}
+	token, err := serviceAccountToken(controlCtx, kubeCtx.GetInjectedCredentials())
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+	tlsConf, err := endpointTlsConfig(controlCtx, kubeCtx)
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+	endpoint := &url.URL{Scheme: "https", Host: kubeCtx.GetHost()}
+
+	d := &endpointDialer{out: out, tlsConf: tlsConf}
+	first, err := d.dial(controlCtx)
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+	d.first = first
+	transport := &http.Transport{
+		DialTLSContext:      d.DialTLSContext,
+		MaxIdleConnsPerHost: 1,
+		IdleConnTimeout:     90 * time.Second,
+		// Upgrades to streaming connections are only supported over HTTP/1.1.
+		ForceAttemptHTTP2: false,
+		TLSNextProto:      map[string]func(string, *tls.Conn) http.RoundTripper{},
+	}
+	rp := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(endpoint)
+			for h := range r.Out.Header {
+				if strings.HasPrefix(h, impersonationHeaderPrefix) {
+					r.Out.Header.Del(h)
+				}
+			}
+			r.Out.Header.Set("Authorization", "Bearer "+token)
+		},
+		Transport: transport,
+		ErrorLog:  log.New(io.Discard, "", 0),
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("unable to forward request to kubernetes api server", "connection_id", connId))
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+
+	return func() {
+		defer d.close()
+		defer transport.CloseIdleConnections()
+
+		l := proxy.NewConnListener(conn)
+		srv := &http.Server{
+			Handler:           rp,
+			ReadHeaderTimeout: 10 * time.Second,
+			ErrorLog:          log.New(io.Discard, "", 0),
+			BaseContext:       func(net.Listener) context.Context { return dataCtx },
+		}
+		if err := srv.Serve(l); err != nil && err != net.ErrClosed {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error serving kubernetes connection", "connection_id", connId))
+		}
+	}, nil
+}
+
+// serviceAccountToken returns the bearer token found in the injected
+// credentials.  Exactly one json credential must be injected and it must hold
+// the token in one of the tokenFields, either at its top level or nested in
+// the "data" field of a Vault KV v2 secret.
+func serviceAccountToken(ctx context.Context, creds []*pbs.Credential) (string, error) {
+	const op = "kubernetes.serviceAccountToken"
+	if len(creds) != 1 {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "kubernetes sessions require a single injected credential")
+	}
+	j := creds[0].GetJson()
+	if j == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T for kubernetes sessions", creds[0].GetCredential()))
+	}
+	var object map[string]any
+	if err := json.Unmarshal(j.GetObject(), &object); err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal json credential"))
+	}
+	objects := []map[string]any{object}
+	if data, ok := object["data"].(map[string]any); ok {
+		objects = append(objects, data)
+	}
+	for _, o := range objects {
+		for _, f := range tokenFields {
+			if token, ok := o[f].(string); ok && token != "" {
+				return token, nil
+			}
+		}
+	}
+	return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("json credential is missing one of the %q string fields", tokenFields))
+}
+
+// endpointTlsConfig returns the configuration of the TLS connections the
+// worker opens to the API server.  The API server's certificate is verified
+// for the protocol context's server name, or the endpoint's host if it isn't
+// set, against its certificate authorities, or the system's if none are set.
+func endpointTlsConfig(ctx context.Context, kubeCtx *pbs.KubernetesProtocolContext) (*tls.Config, error) {
+	const op = "kubernetes.endpointTlsConfig"
+	serverName := kubeCtx.GetTlsServerName()
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(kubeCtx.GetHost())
+		if err != nil {
+			host = kubeCtx.GetHost()
+		}
+		serverName = host
+	}
+	cfg := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"http/1.1"},
+	}
+	if kubeCtx.GetTlsCaCertificates() != "" {
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM([]byte(kubeCtx.GetTlsCaCertificates())) {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "tls ca certificates contain no valid PEM certificates")
+		}
+	}
+	return cfg, nil
+}
+
+// endpointDialer returns the connection dialed by handleProxy the first time
+// it is called and dials a new TLS connection to the API server after that.
+type endpointDialer struct {
+	mu      sync.Mutex
+	first   net.Conn
+	out     *proxy.ProxyDialer
+	tlsConf *tls.Config
+}
+
+func (d *endpointDialer) DialTLSContext(ctx context.Context, _, _ string) (net.Conn, error) {
+	d.mu.Lock()
+	c := d.first
+	d.first = nil
+	d.mu.Unlock()
+	if c != nil {
+		return c, nil
+	}
+	return d.dial(ctx)
+}
+
+// dial dials the API server and completes the TLS handshake.
+func (d *endpointDialer) dial(ctx context.Context) (net.Conn, error) {
+	const op = "kubernetes.(endpointDialer).dial"
+	c, err := d.out.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tc := tls.Client(c, d.tlsConf)
+	if err := tc.HandshakeContext(ctx); err != nil {
+		_ = c.Close()
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("tls handshake with kubernetes api server failed"))
+	}
+	return tc, nil
+}
+
+// close closes the first connection if it was never used.
+func (d *endpointDialer) close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.first != nil {
+		_ = d.first.Close()
+		d.first = nil
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// testApiServer is a fake kubernetes API server.  Regular requests get their
+// Authorization header and the names of their impersonation headers written
+// back in the response body.  Requests asking for a SPDY upgrade are switched
+// to a connection which echoes what is written to it.
+func testApiServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "" {
+			conn, brw, err := w.(http.Hijacker).Hijack()
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer conn.Close()
+			brw.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: " + r.Header.Get("Upgrade") + "\r\n\r\n")
+			brw.Flush()
+			io.Copy(conn, brw)
+			return
+		}
+		var impersonate []string
+		for h := range r.Header {
+			if strings.HasPrefix(h, impersonationHeaderPrefix) {
+				impersonate = append(impersonate, h)
+			}
+		}
+		w.Write([]byte(r.Header.Get("Authorization") + "|" + strings.Join(impersonate, ",")))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func testProtocolContext(t *testing.T, srv *httptest.Server, creds ...*pbs.Credential) *anypb.Any {
+	t.Helper()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	pc, err := anypb.New(&pbs.KubernetesProtocolContext{
+		InjectedCredentials: creds,
+		Host:                u.Host,
+		TlsServerName:       "example.com",
+		TlsCaCertificates:   string(ca),
+	})
+	require.NoError(t, err)
+	return pc
+}
+
+func jsonCredential(t *testing.T, object map[string]any) *pbs.Credential {
+	t.Helper()
+	b, err := json.Marshal(object)
+	require.NoError(t, err)
+	return &pbs.Credential{Credential: &pbs.Credential_Json{Json: &pbs.Json{Object: b}}}
+}
+
+// startProxy starts the kubernetes proxy for the protocol context and returns
+// the client side of the proxied connection.
+func startProxy(t *testing.T, srv *httptest.Server, pc *anypb.Any) (net.Conn, error) {
+	t.Helper()
+	ctx := context.Background()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", u.Host)
+	})
+	require.NoError(t, err)
+
+	clientSide, workerSide := net.Pipe()
+	t.Cleanup(func() {
+		clientSide.Close()
+		workerSide.Close()
+	})
+	fn, err := handleProxy(ctx, ctx, nil, workerSide, dialer, "conn_1234567890", pc, nil)
+	if err != nil {
+		return nil, err
+	}
+	go fn()
+	return clientSide, nil
+}
+
+func TestHandleProxy(t *testing.T) {
+	srv := testApiServer(t)
+
+	cases := []struct {
+		name      string
+		creds     []*pbs.Credential
+		wantToken string
+		wantErr   bool
+	}{
+		{
+			name:      "json token",
+			creds:     []*pbs.Credential{jsonCredential(t, map[string]any{"token": "s3cr3t"})},
+			wantToken: "s3cr3t",
+		},
+		{
+			name:      "vault service account token",
+			creds:     []*pbs.Credential{jsonCredential(t, map[string]any{"service_account_name": "sa", "service_account_token": "v4ult"})},
+			wantToken: "v4ult",
+		},
+		{
+			name:      "vault kv v2 token",
+			creds:     []*pbs.Credential{jsonCredential(t, map[string]any{"data": map[string]any{"token": "kv2"}})},
+			wantToken: "kv2",
+		},
+		{
+			name:    "no credentials",
+			wantErr: true,
+		},
+		{
+			name:    "json missing token",
+			creds:   []*pbs.Credential{jsonCredential(t, map[string]any{"password": "s3cr3t"})},
+			wantErr: true,
+		},
+		{
+			name: "unsupported credential",
+			creds: []*pbs.Credential{{Credential: &pbs.Credential_UsernamePassword{
+				UsernamePassword: &pbs.UsernamePassword{Username: "alice", Password: "passw0rd"},
+			}}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			clientSide, err := startProxy(t, srv, testProtocolContext(t, srv, tc.creds...))
+			if tc.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			req, err := http.NewRequest(http.MethodGet, "http://localhost/api/v1/pods", nil)
+			require.NoError(err)
+			req.Header.Set("Authorization", "Bearer client-token")
+			req.Header.Set("Impersonate-User", "admin")
+			req.Header.Set("Impersonate-Group", "system:masters")
+			require.NoError(req.Write(clientSide))
+			resp, err := http.ReadResponse(bufio.NewReader(clientSide), req)
+			require.NoError(err)
+			defer resp.Body.Close()
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(err)
+			// The injected token replaces the client's and the impersonation
+			// headers are never forwarded.
+			assert.Equal("Bearer "+tc.wantToken+"|", string(body))
+		})
+	}
+}
+
+func TestHandleProxy_Upgrade(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	srv := testApiServer(t)
+	clientSide, err := startProxy(t, srv, testProtocolContext(t, srv, jsonCredential(t, map[string]any{"token": "s3cr3t"})))
+	require.NoError(err)
+
+	req, err := http.NewRequest(http.MethodPost, "http://localhost/api/v1/namespaces/default/pods/web/exec?command=sh", nil)
+	require.NoError(err)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "SPDY/3.1")
+	require.NoError(req.Write(clientSide))
+	br := bufio.NewReader(clientSide)
+	resp, err := http.ReadResponse(br, req)
+	require.NoError(err)
+	assert.Equal(http.StatusSwitchingProtocols, resp.StatusCode)
+	assert.Equal("SPDY/3.1", resp.Header.Get("Upgrade"))
+
+	_, err = clientSide.Write([]byte("ping"))
+	require.NoError(err)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(br, buf)
+	require.NoError(err)
+	assert.Equal("ping", string(buf))
+}
+
+func TestHandleProxy_UntrustedApiServer(t *testing.T) {
+	srv := testApiServer(t)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	pc, err := anypb.New(&pbs.KubernetesProtocolContext{
+		InjectedCredentials: []*pbs.Credential{jsonCredential(t, map[string]any{"token": "s3cr3t"})},
+		Host:                u.Host,
+	})
+	require.NoError(t, err)
+	_, err = startProxy(t, srv, pc)
+	assert.Error(t, err)
+}
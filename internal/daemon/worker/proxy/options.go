@@ -5,6 +5,7 @@ package proxy
 
 import (
 	"net"
+	"net/url"
 
 	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 )
@@ -25,12 +26,16 @@ func GetOpts(opt ...Option) Options {
 type Options struct {
 	WithInjectedApplicationCredentials []*serverpb.Credential
 	WithPostConnectionHook             func(net.Conn)
+	WithEgressProxyUrl                 *url.URL
+	WithDialNetwork                    string
 }
 
 func getDefaultOptions() Options {
 	return Options{
 		WithInjectedApplicationCredentials: nil,
 		WithPostConnectionHook:             nil,
+		WithEgressProxyUrl:                 nil,
+		WithDialNetwork:                    "tcp",
 	}
 }
 
@@ -51,3 +56,20 @@ func WithPostConnectionHook(fn func(net.Conn)) Option {
 		o.WithPostConnectionHook = fn
 	}
 }
+
+// WithEgressProxyUrl provides an optional url of the proxy through which the
+// endpoint is dialed.  The supported schemes are socks5 and http, the latter
+// for proxies supporting HTTP CONNECT.
+func WithEgressProxyUrl(u *url.URL) Option {
+	return func(o *Options) {
+		o.WithEgressProxyUrl = u
+	}
+}
+
+// WithDialNetwork provides an optional network used when dialing the endpoint,
+// for example "udp".  It defaults to "tcp".
+func WithDialNetwork(network string) Option {
+	return func(o *Options) {
+		o.WithDialNetwork = network
+	}
+}
@@ -5,6 +5,7 @@ package proxy
 
 import (
 	"net"
+	"net/url"
 	"reflect"
 	"runtime"
 	"testing"
@@ -45,4 +46,21 @@ func Test_GetOpts(t *testing.T) {
 			runtime.FuncForPC(reflect.ValueOf(testOpts.WithPostConnectionHook).Pointer()).Name(),
 		)
 	})
+	t.Run("WithEgressProxyUrl", func(t *testing.T) {
+		assert := assert.New(t)
+		u := &url.URL{Scheme: "socks5", Host: "127.0.0.1:1080"}
+		opts := GetOpts(WithEgressProxyUrl(u))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithEgressProxyUrl = u
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDialNetwork", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithDialNetwork("udp"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithDialNetwork = "udp"
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package postgres provides the worker proxy handler for postgres targets.
+// The handler speaks the PostgreSQL frontend/backend protocol: it accepts the
+// client's startup without checking its password, authenticates to the
+// endpoint with the credentials injected into the session, so the client never
+// has access to them, and writes an audit event for every query sent by the
+// client.
+package postgres
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// HandlerName is the protocol the postgres proxy handler is registered for.
+const HandlerName = "postgres"
+
+func init() {
+	if err := proxy.RegisterHandler(HandlerName, handleProxy); err != nil {
+		panic(err)
+	}
+	if err := proxy.RegisterProtocolContext(HandlerName, &pbs.PostgresProtocolContext{}); err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy creates a postgres proxy between the incoming conn and the
+// connection created by the ProxyDialer.  The connection to the endpoint is
+// dialed before handleProxy returns.  The single username password credential
+// found in the protocol context is used to authenticate to the endpoint.
+//
+// handleProxy returns a ProxyConnFn which reads the client's startup message,
+// authenticates to the endpoint as the credential's user using SCRAM-SHA-256,
+// md5 or cleartext password authentication, and then proxies messages between
+// the client and the endpoint until either of them closes the connection.  The
+// client is not asked for a password.  Simple queries and the statements of
+// the extended query protocol are written as audit events.
+func handleProxy(controlCtx context.Context, dataCtx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, pc *anypb.Any, _ proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+	const op = "postgres.handleProxy"
+	switch {
+	case conn == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "conn is nil")
+	case out == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "proxy dialer is nil")
+	case len(connId) == 0:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "connection id is empty")
+	case pc == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "protocol context is nil")
+	}
+	pgCtx := &pbs.PostgresProtocolContext{}
+	if err := pc.UnmarshalTo(pgCtx); err != nil {
+		return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to unmarshal postgres protocol context"))
+	}
+	cred, err := usernamePassword(controlCtx, pgCtx.GetInjectedCredentials())
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+
+	remoteConn, err := out.Dial(controlCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		defer remoteConn.Close()
+		defer conn.Close()
+
+		clientReader := bufio.NewReader(conn)
+		params, err := readStartup(clientReader, conn)
+		if err != nil {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("unable to read client startup message", "connection_id", connId))
+			return
+		}
+		upstreamReader := bufio.NewReader(remoteConn)
+		if err := authenticate(upstreamReader, remoteConn, conn, params, cred); err != nil {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("unable to authenticate to endpoint", "connection_id", connId))
+			return
+		}
+		if _, err := conn.Write(authenticationOk()); err != nil {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("unable to write to client", "connection_id", connId))
+			return
+		}
+
+		a := &auditor{
+			connId:    connId,
+			sessionId: pgCtx.GetSessionId(),
+			userId:    pgCtx.GetUserId(),
+			user:      cred.GetUsername(),
+			database:  params["database"],
+			endpoint:  remoteConn.RemoteAddr().String(),
+		}
+		if a.database == "" {
+			a.database = cred.GetUsername()
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer remoteConn.Close()
+			if err := forwardFrontend(dataCtx, clientReader, remoteConn, a); err != nil && err != io.EOF {
+				event.WriteError(dataCtx, op, err, event.WithInfoMsg("error forwarding client messages", "connection_id", connId))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			defer conn.Close()
+			_, _ = io.Copy(conn, upstreamReader)
+		}()
+		wg.Wait()
+	}, nil
+}
+
+// usernamePassword returns the username password credential used to
+// authenticate to the endpoint.  Exactly one must be injected.
+func usernamePassword(ctx context.Context, creds []*pbs.Credential) (*pbs.UsernamePassword, error) {
+	const op = "postgres.usernamePassword"
+	if len(creds) != 1 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "postgres sessions require a single injected credential")
+	}
+	up := creds[0].GetUsernamePassword()
+	if up == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T for postgres sessions", creds[0].GetCredential()))
+	}
+	if up.GetUsername() == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "injected credential is missing a username")
+	}
+	return up, nil
+}
+
+// auditor writes audit events for the queries sent by a client.
+type auditor struct {
+	connId    string
+	sessionId string
+	userId    string
+	user      string
+	database  string
+	endpoint  string
+}
+
+// audit writes an audit event for a query.  The method is the name of the
+// message which carried the query.  The query text is classified as sensitive
+// and so is redacted unless the audit filter overrides say otherwise.
+func (a *auditor) audit(ctx context.Context, method, query string) error {
+	const op = "postgres.(auditor).audit"
+	return event.WriteAudit(ctx, op,
+		event.WithRequestInfo(&event.RequestInfo{
+			Id:       a.connId,
+			Method:   method,
+			Path:     a.database,
+			PublicId: a.sessionId,
+		}),
+		event.WithAuth(&event.Auth{
+			UserInfo: &event.UserInfo{UserId: a.userId},
+		}),
+		event.WithRequest(&event.Request{
+			Operation: method,
+			Endpoint:  a.endpoint,
+			Details: &pbs.PostgresQuery{
+				ConnectionId: a.connId,
+				Database:     a.database,
+				User:         a.user,
+				Query:        query,
+			},
+		}),
+		event.WithFlush(),
+	)
+}
+
+// forwardFrontend forwards the messages read from the client to w until the
+// client terminates the connection or an error occurs.  Query and Parse
+// messages are audited before they are forwarded; all other messages are
+// copied without being buffered.  Query and Parse messages longer than
+// maxQueryMessageLength are rejected.
+func forwardFrontend(ctx context.Context, r *bufio.Reader, w io.Writer, a *auditor) error {
+	const op = "postgres.forwardFrontend"
+	header := make([]byte, 5)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return err
+		}
+		typ := header[0]
+		length := int64(binary.BigEndian.Uint32(header[1:]))
+		if length < 4 {
+			return fmt.Errorf("%s: invalid length %d for message %q", op, length, typ)
+		}
+		switch typ {
+		case msgQuery, msgParse:
+			body, err := readMessageBody(r, header, maxQueryMessageLength)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			method, query := "Query", cstring(body)
+			if typ == msgParse {
+				method, query = "Parse", parseStatement(body)
+			}
+			if err := a.audit(ctx, method, query); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to audit query", "connection_id", a.connId))
+			}
+			if _, err := w.Write(append(header, body...)); err != nil {
+				return err
+			}
+		default:
+			if _, err := w.Write(header); err != nil {
+				return err
+			}
+			if _, err := io.CopyN(w, r, length-4); err != nil {
+				return err
+			}
+			if typ == msgTerminate {
+				return nil
+			}
+		}
+	}
+}
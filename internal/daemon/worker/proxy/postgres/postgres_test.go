@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/pbkdf2"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	testUser     = "alice"
+	testPassword = "passw0rd"
+)
+
+// testBackend starts a fake postgres server which authenticates the testUser
+// with testPassword using the given authentication method and answers every
+// query with a CommandComplete message.  The queries it receives are sent on
+// the returned channel.
+func testBackend(t *testing.T, method uint32) (net.Listener, <-chan string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	queries := make(chan string, 10)
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveBackend(t, c, method, queries)
+		}
+	}()
+	return l, queries
+}
+
+func serveBackend(t *testing.T, c net.Conn, method uint32, queries chan<- string) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	params, err := readStartup(r, c)
+	if err != nil {
+		return
+	}
+	if !backendAuthenticate(r, c, method, params["user"]) {
+		c.Write(errorResponse("28P01", "password authentication failed"))
+		return
+	}
+	c.Write(authenticationOk())
+	c.Write(message('S', []byte("server_version\x0015.3\x00")))
+	c.Write(message('Z', []byte{'I'}))
+	for {
+		typ, body, err := readMessage(r, 1<<20)
+		if err != nil {
+			return
+		}
+		switch typ {
+		case msgQuery:
+			queries <- cstring(body)
+			c.Write(message('C', []byte("SELECT 1\x00")))
+			c.Write(message('Z', []byte{'I'}))
+		case msgParse:
+			queries <- parseStatement(body)
+		case 'S':
+			c.Write(message('1', nil))
+			c.Write(message('Z', []byte{'I'}))
+		case msgTerminate:
+			return
+		}
+	}
+}
+
+func backendAuthenticate(r io.Reader, w io.Writer, method uint32, user string) bool {
+	if user != testUser {
+		return false
+	}
+	switch method {
+	case authOk:
+		return true
+	case authCleartextPassword:
+		w.Write(message(msgAuthentication, binary.BigEndian.AppendUint32(nil, method)))
+		_, body, err := readMessage(r, 1<<10)
+		return err == nil && cstring(body) == testPassword
+	case authMd5Password:
+		salt := []byte{1, 2, 3, 4}
+		w.Write(message(msgAuthentication, append(binary.BigEndian.AppendUint32(nil, method), salt...)))
+		_, body, err := readMessage(r, 1<<10)
+		return err == nil && cstring(body) == md5Password(testUser, testPassword, salt)
+	case authSasl:
+		return backendScram(r, w)
+	}
+	return false
+}
+
+// backendScram performs the server side of a SCRAM-SHA-256 exchange.
+func backendScram(r io.Reader, w io.Writer) bool {
+	w.Write(message(msgAuthentication, append(binary.BigEndian.AppendUint32(nil, authSasl), []byte(scramSha256+"\x00\x00")...)))
+	_, body, err := readMessage(r, 1<<10)
+	if err != nil || cstring(body) != scramSha256 {
+		return false
+	}
+	clientFirst := string(body[len(scramSha256)+5:])
+	clientFirstBare := strings.TrimPrefix(clientFirst, "n,,")
+	clientNonce := scramAttributes(clientFirstBare)["r"]
+
+	salt := []byte("0123456789abcdef")
+	serverFirst := "r=" + clientNonce + "server,s=" + base64.StdEncoding.EncodeToString(salt) + ",i=4096"
+	w.Write(message(msgAuthentication, append(binary.BigEndian.AppendUint32(nil, authSaslContinue), serverFirst...)))
+
+	_, body, err = readMessage(r, 1<<10)
+	if err != nil {
+		return false
+	}
+	clientFinal := string(body)
+	i := strings.LastIndex(clientFinal, ",p=")
+	if i < 0 {
+		return false
+	}
+	proof, err := base64.StdEncoding.DecodeString(clientFinal[i+3:])
+	if err != nil {
+		return false
+	}
+	saltedPassword := pbkdf2.Key([]byte(testPassword), salt, 4096, sha256.Size, sha256.New)
+	storedKey := sha256.Sum256(hmacSha256(saltedPassword, []byte("Client Key")))
+	authMessage := []byte(clientFirstBare + "," + serverFirst + "," + clientFinal[:i])
+	clientSignature := hmacSha256(storedKey[:], authMessage)
+	clientKey := make([]byte, len(proof))
+	for i := range proof {
+		clientKey[i] = proof[i] ^ clientSignature[i]
+	}
+	if got := sha256.Sum256(clientKey); !bytes.Equal(got[:], storedKey[:]) {
+		return false
+	}
+	serverSignature := hmacSha256(hmacSha256(saltedPassword, []byte("Server Key")), authMessage)
+	w.Write(message(msgAuthentication, append(binary.BigEndian.AppendUint32(nil, authSaslFinal), "v="+base64.StdEncoding.EncodeToString(serverSignature)...)))
+	return true
+}
+
+func testProtocolContext(t *testing.T, creds ...*pbs.Credential) *anypb.Any {
+	t.Helper()
+	pc, err := anypb.New(&pbs.PostgresProtocolContext{
+		InjectedCredentials: creds,
+		SessionId:           "s_1234567890",
+		UserId:              "u_1234567890",
+	})
+	require.NoError(t, err)
+	return pc
+}
+
+func usernamePasswordCredential(username, password string) *pbs.Credential {
+	return &pbs.Credential{Credential: &pbs.Credential_UsernamePassword{
+		UsernamePassword: &pbs.UsernamePassword{Username: username, Password: password},
+	}}
+}
+
+// readUntil reads messages from r until a message of type typ is read and
+// returns the types of the messages read.
+func readUntil(t *testing.T, r io.Reader, typ byte) []byte {
+	t.Helper()
+	var types []byte
+	for {
+		got, _, err := readMessage(r, 1<<20)
+		require.NoError(t, err)
+		types = append(types, got)
+		if got == typ || got == msgErrorResponse {
+			return types
+		}
+	}
+}
+
+func TestHandleProxy(t *testing.T) {
+	ctx := context.Background()
+
+	eventConfig := event.TestEventerConfig(t, "TestHandleProxy", event.TestWithAuditSink(t))
+	// Queries are sensitive, don't redact them so they can be checked.
+	for _, s := range eventConfig.EventerConfig.Sinks {
+		if s.AuditConfig != nil {
+			s.AuditConfig.FilterOverrides = event.AuditFilterOperations{event.SensitiveClassification: event.NoOperation}
+		}
+	}
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	require.NoError(t, event.InitSysEventer(testLogger, testLock, "TestHandleProxy", event.WithEventerConfig(&eventConfig.EventerConfig)))
+	t.Cleanup(func() { event.TestResetSystEventer(t) })
+
+	cases := []struct {
+		name           string
+		method         uint32
+		creds          []*pbs.Credential
+		wantErr        bool
+		wantAuthFailed bool
+	}{
+		{
+			name:   "trust",
+			method: authOk,
+			creds:  []*pbs.Credential{usernamePasswordCredential(testUser, testPassword)},
+		},
+		{
+			// The password would be sent unencrypted to the endpoint.
+			name:           "cleartext refused",
+			method:         authCleartextPassword,
+			creds:          []*pbs.Credential{usernamePasswordCredential(testUser, testPassword)},
+			wantAuthFailed: true,
+		},
+		{
+			name:   "md5",
+			method: authMd5Password,
+			creds:  []*pbs.Credential{usernamePasswordCredential(testUser, testPassword)},
+		},
+		{
+			name:   "scram-sha-256",
+			method: authSasl,
+			creds:  []*pbs.Credential{usernamePasswordCredential(testUser, testPassword)},
+		},
+		{
+			name:           "wrong password",
+			method:         authSasl,
+			creds:          []*pbs.Credential{usernamePasswordCredential(testUser, "wrong")},
+			wantAuthFailed: true,
+		},
+		{
+			name:           "wrong user",
+			method:         authMd5Password,
+			creds:          []*pbs.Credential{usernamePasswordCredential("bob", testPassword)},
+			wantAuthFailed: true,
+		},
+		{
+			name:    "no credentials",
+			method:  authOk,
+			wantErr: true,
+		},
+		{
+			name:   "unsupported credential",
+			method: authOk,
+			creds: []*pbs.Credential{{Credential: &pbs.Credential_SshPrivateKey{
+				SshPrivateKey: &pbs.SshPrivateKey{Username: testUser, PrivateKey: "key"},
+			}}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			l, queries := testBackend(t, tc.method)
+			dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+				return net.Dial("tcp", l.Addr().String())
+			})
+			require.NoError(err)
+
+			clientSide, workerSide := net.Pipe()
+			t.Cleanup(func() {
+				clientSide.Close()
+				workerSide.Close()
+			})
+			fn, err := handleProxy(ctx, ctx, nil, workerSide, dialer, "conn_1234567890", testProtocolContext(t, tc.creds...), nil)
+			if tc.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			go fn()
+
+			// The client asks for TLS, which is declined, and then starts up
+			// as a user of its choosing without being asked for a password.
+			client := bufio.NewReader(clientSide)
+			_, err = clientSide.Write(binary.BigEndian.AppendUint32([]byte{0, 0, 0, 8}, sslRequestCode))
+			require.NoError(err)
+			b, err := client.ReadByte()
+			require.NoError(err)
+			assert.Equal(byte('N'), b)
+			_, err = clientSide.Write(startupMessage(map[string]string{"user": "anyone", "database": "app"}))
+			require.NoError(err)
+
+			types := readUntil(t, client, 'Z')
+			if tc.wantAuthFailed {
+				assert.Equal([]byte{msgErrorResponse}, types)
+				return
+			}
+			assert.Equal([]byte{msgAuthentication, 'S', 'Z'}, types)
+
+			_ = os.WriteFile(eventConfig.AuditEvents.Name(), nil, 0o666) // clean out audit events from previous runs
+			_, err = clientSide.Write(message(msgQuery, []byte("select 1\x00")))
+			require.NoError(err)
+			assert.Equal([]byte{'C', 'Z'}, readUntil(t, client, 'Z'))
+			assert.Equal("select 1", <-queries)
+
+			_, err = clientSide.Write(message(msgParse, []byte("stmt\x00select $1\x00\x00\x00")))
+			require.NoError(err)
+			_, err = clientSide.Write(message('S', nil))
+			require.NoError(err)
+			assert.Equal([]byte{'1', 'Z'}, readUntil(t, client, 'Z'))
+			assert.Equal("select $1", <-queries)
+
+			_, err = clientSide.Write(message(msgTerminate, nil))
+			require.NoError(err)
+
+			audits, err := os.ReadFile(eventConfig.AuditEvents.Name())
+			require.NoError(err)
+			var got []map[string]any
+			dec := json.NewDecoder(bytes.NewReader(audits))
+			for dec.More() {
+				var e map[string]any
+				require.NoError(dec.Decode(&e))
+				got = append(got, e["data"].(map[string]any))
+			}
+			require.Len(got, 2)
+			for i, want := range []struct{ method, query string }{{"Query", "select 1"}, {"Parse", "select $1"}} {
+				reqInfo := got[i]["request_info"].(map[string]any)
+				assert.Equal("conn_1234567890", reqInfo["id"])
+				assert.Equal("s_1234567890", reqInfo["public_id"])
+				assert.Equal(want.method, reqInfo["method"])
+				assert.Equal("app", reqInfo["path"])
+				assert.Equal("u_1234567890", got[i]["auth"].(map[string]any)["user_info"].(map[string]any)["id"])
+				details := got[i]["request"].(map[string]any)["details"].(map[string]any)
+				assert.Equal(want.query, details["query"])
+				assert.Equal(testUser, details["user"])
+			}
+		})
+	}
+}
+
+func TestForwardFrontend_MessageLength(t *testing.T) {
+	ctx := context.Background()
+	a := &auditor{connId: "sc_1234567890"}
+	header := func(typ byte, length uint32) []byte {
+		return append([]byte{typ}, binary.BigEndian.AppendUint32(nil, length)...)
+	}
+
+	// A query longer than maxQueryMessageLength is rejected before its body
+	// is read, so nothing is allocated for it or forwarded.
+	for _, typ := range []byte{msgQuery, msgParse} {
+		var out bytes.Buffer
+		r := bufio.NewReader(bytes.NewReader(header(typ, maxQueryMessageLength+1)))
+		err := forwardFrontend(ctx, r, &out, a)
+		assert.ErrorContains(t, err, "invalid length")
+		assert.Zero(t, out.Len())
+	}
+
+	// Other messages are copied whatever their length.
+	data := bytes.Repeat([]byte("d"), maxAuthMessageLength)
+	in := append(header('d', uint32(len(data)+4)), data...)
+	in = append(in, header(msgTerminate, 4)...)
+	var out bytes.Buffer
+	require.NoError(t, forwardFrontend(ctx, bufio.NewReader(bytes.NewReader(in)), &out, a))
+	assert.Equal(t, in, out.Bytes())
+}
+
+func TestScramClient_IterationCount(t *testing.T) {
+	c, err := newScramClient(testPassword)
+	require.NoError(t, err)
+	salt := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef"))
+	for _, iter := range []string{"0", "-1", "x", "1048577"} {
+		_, err := c.clientFinal([]byte("r=" + c.clientNonce + "server,s=" + salt + ",i=" + iter))
+		assert.ErrorContains(t, err, "invalid scram iteration count", iter)
+	}
+	_, err = c.clientFinal([]byte("r=" + c.clientNonce + "server,s=" + salt + ",i=4096"))
+	assert.NoError(t, err)
+}
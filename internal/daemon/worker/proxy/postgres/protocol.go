@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	protocolVersion3  = 196608
+	cancelRequestCode = 80877102
+	sslRequestCode    = 80877103
+	gssEncRequestCode = 80877104
+
+	// maxStartupLength and maxAuthMessageLength bound the size of the
+	// messages read before the client is authenticated.
+	maxStartupLength     = 10000
+	maxAuthMessageLength = 1 << 16
+
+	// maxQueryMessageLength bounds the size of the Query and Parse messages,
+	// which are buffered so they can be audited.  Other messages are copied
+	// without being buffered.
+	maxQueryMessageLength = 1 << 24
+
+	// maxScramIterations bounds the iteration count the endpoint can ask the
+	// worker to hash the password with.  PostgreSQL uses 4096 by default.
+	maxScramIterations = 1 << 20
+
+	scramSha256 = "SCRAM-SHA-256"
+)
+
+// Message types of the frontend/backend protocol.
+const (
+	msgAuthentication    = 'R'
+	msgErrorResponse     = 'E'
+	msgNoticeResponse    = 'N'
+	msgNegotiateProtocol = 'v'
+	msgPassword          = 'p'
+	msgQuery             = 'Q'
+	msgParse             = 'P'
+	msgTerminate         = 'X'
+)
+
+// Authentication request codes.
+const (
+	authOk                = 0
+	authCleartextPassword = 3
+	authMd5Password       = 5
+	authSasl              = 10
+	authSaslContinue      = 11
+	authSaslFinal         = 12
+)
+
+// readStartup reads the client's startup message and returns its parameters.
+// SSL and GSSAPI encryption requests are declined by writing 'N' to w, since
+// the client's connection to the worker is already encrypted.
+func readStartup(r io.Reader, w io.Writer) (map[string]string, error) {
+	const op = "postgres.readStartup"
+	for {
+		var l [4]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		length := binary.BigEndian.Uint32(l[:])
+		if length < 8 || length > maxStartupLength {
+			return nil, fmt.Errorf("%s: invalid startup message length %d", op, length)
+		}
+		body := make([]byte, length-4)
+		if _, err := io.ReadFull(r, body); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		switch code := binary.BigEndian.Uint32(body); code {
+		case sslRequestCode, gssEncRequestCode:
+			if _, err := w.Write([]byte{'N'}); err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+		case cancelRequestCode:
+			return nil, fmt.Errorf("%s: cancel requests are not supported", op)
+		case protocolVersion3:
+			params := map[string]string{}
+			fields := bytes.Split(bytes.TrimRight(body[4:], "\x00"), []byte{0})
+			if len(fields)%2 != 0 {
+				return nil, fmt.Errorf("%s: malformed startup parameters", op)
+			}
+			for i := 0; i < len(fields); i += 2 {
+				params[string(fields[i])] = string(fields[i+1])
+			}
+			return params, nil
+		default:
+			_, _ = w.Write(errorResponse("08P01", fmt.Sprintf("unsupported frontend protocol %d.%d", code>>16, code&0xffff)))
+			return nil, fmt.Errorf("%s: unsupported protocol version %d", op, code)
+		}
+	}
+}
+
+// authenticate sends a startup message with the client's parameters to the
+// endpoint, replacing the user with the credential's username, and completes
+// the authentication requested by the endpoint using the credential's
+// password.  Cleartext password authentication is refused since the
+// connection to the endpoint is not encrypted, and sending the password would
+// expose it to anyone able to observe the connection.  Errors and notices sent
+// by the endpoint are forwarded to the client.
+func authenticate(r io.Reader, w io.Writer, client io.Writer, params map[string]string, cred *pbs.UsernamePassword) error {
+	const op = "postgres.authenticate"
+	startupParams := make(map[string]string, len(params))
+	for k, v := range params {
+		startupParams[k] = v
+	}
+	startupParams["user"] = cred.GetUsername()
+	if _, err := w.Write(startupMessage(startupParams)); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var scram *scramClient
+	for {
+		typ, body, err := readMessage(r, maxAuthMessageLength)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		switch typ {
+		case msgErrorResponse:
+			_, _ = client.Write(message(typ, body))
+			return fmt.Errorf("%s: endpoint returned an error: %s", op, errorMessage(body))
+		case msgNoticeResponse, msgNegotiateProtocol:
+			if _, err := client.Write(message(typ, body)); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			continue
+		case msgAuthentication:
+		default:
+			return authFailed(client, fmt.Errorf("%s: unexpected message %q during authentication", op, typ))
+		}
+		if len(body) < 4 {
+			return authFailed(client, fmt.Errorf("%s: malformed authentication request", op))
+		}
+		var resp []byte
+		switch code := binary.BigEndian.Uint32(body); code {
+		case authOk:
+			if scram != nil && !scram.verified {
+				return authFailed(client, fmt.Errorf("%s: endpoint did not send its scram signature", op))
+			}
+			return nil
+		case authCleartextPassword:
+			return authFailed(client, fmt.Errorf("%s: endpoint requested cleartext password authentication", op))
+		case authMd5Password:
+			if len(body) != 8 {
+				return authFailed(client, fmt.Errorf("%s: malformed md5 authentication request", op))
+			}
+			resp = message(msgPassword, append([]byte(md5Password(cred.GetUsername(), cred.GetPassword(), body[4:])), 0))
+		case authSasl:
+			if !mechanismSupported(body[4:], scramSha256) {
+				return authFailed(client, fmt.Errorf("%s: endpoint does not support %s", op, scramSha256))
+			}
+			if scram, err = newScramClient(cred.GetPassword()); err != nil {
+				return authFailed(client, fmt.Errorf("%s: %w", op, err))
+			}
+			data := scram.clientFirst()
+			b := append([]byte(scramSha256), 0)
+			b = binary.BigEndian.AppendUint32(b, uint32(len(data)))
+			resp = message(msgPassword, append(b, data...))
+		case authSaslContinue:
+			if scram == nil {
+				return authFailed(client, fmt.Errorf("%s: unexpected sasl continue request", op))
+			}
+			data, err := scram.clientFinal(body[4:])
+			if err != nil {
+				return authFailed(client, fmt.Errorf("%s: %w", op, err))
+			}
+			resp = message(msgPassword, data)
+		case authSaslFinal:
+			if scram == nil {
+				return authFailed(client, fmt.Errorf("%s: unexpected sasl final request", op))
+			}
+			if err := scram.verifyServerFinal(body[4:]); err != nil {
+				return authFailed(client, fmt.Errorf("%s: %w", op, err))
+			}
+			continue
+		default:
+			return authFailed(client, fmt.Errorf("%s: unsupported authentication method %d", op, code))
+		}
+		if _, err := w.Write(resp); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+}
+
+// authFailed tells the client that authenticating to the endpoint failed and
+// returns err.
+func authFailed(client io.Writer, err error) error {
+	_, _ = client.Write(errorResponse("28000", "unable to authenticate to the endpoint"))
+	return err
+}
+
+// readMessage reads a message with a type byte.  Messages longer than max
+// bytes are rejected.
+func readMessage(r io.Reader, max uint32) (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	body, err := readMessageBody(r, header[:], max)
+	if err != nil {
+		return 0, nil, err
+	}
+	return header[0], body, nil
+}
+
+// readMessageBody reads the body of the message with the given header.
+// Messages longer than max bytes are rejected before the body is allocated.
+func readMessageBody(r io.Reader, header []byte, max uint32) ([]byte, error) {
+	length := binary.BigEndian.Uint32(header[1:])
+	if length < 4 || length > max {
+		return nil, fmt.Errorf("invalid length %d for message %q", length, header[0])
+	}
+	body := make([]byte, length-4)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// message encodes a message with a type byte.
+func message(typ byte, body []byte) []byte {
+	b := make([]byte, 5, 5+len(body))
+	b[0] = typ
+	binary.BigEndian.PutUint32(b[1:], uint32(4+len(body)))
+	return append(b, body...)
+}
+
+func startupMessage(params map[string]string) []byte {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	b := binary.BigEndian.AppendUint32(make([]byte, 4), protocolVersion3)
+	for _, k := range keys {
+		b = append(append(b, k...), 0)
+		b = append(append(b, params[k]...), 0)
+	}
+	b = append(b, 0)
+	binary.BigEndian.PutUint32(b, uint32(len(b)))
+	return b
+}
+
+func authenticationOk() []byte {
+	return message(msgAuthentication, []byte{0, 0, 0, authOk})
+}
+
+// errorResponse encodes a fatal ErrorResponse with the given SQLSTATE code.
+func errorResponse(code, msg string) []byte {
+	var b []byte
+	for _, f := range []struct {
+		typ byte
+		val string
+	}{{'S', "FATAL"}, {'V', "FATAL"}, {'C', code}, {'M', msg}} {
+		b = append(append(append(b, f.typ), f.val...), 0)
+	}
+	return message(msgErrorResponse, append(b, 0))
+}
+
+// errorMessage returns the message field of an ErrorResponse body.
+func errorMessage(body []byte) string {
+	for len(body) > 1 {
+		field := cstring(body[1:])
+		if body[0] == 'M' {
+			return field
+		}
+		next := len(field) + 2
+		if next > len(body) {
+			break
+		}
+		body = body[next:]
+	}
+	return "unknown error"
+}
+
+// cstring returns b up to its first null byte.
+func cstring(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		return string(b[:i])
+	}
+	return string(b)
+}
+
+// parseStatement returns the query of a Parse message body, which follows
+// the name of the prepared statement.
+func parseStatement(body []byte) string {
+	i := bytes.IndexByte(body, 0)
+	if i < 0 {
+		return ""
+	}
+	return cstring(body[i+1:])
+}
+
+func mechanismSupported(body []byte, mechanism string) bool {
+	for _, m := range bytes.Split(bytes.TrimRight(body, "\x00"), []byte{0}) {
+		if string(m) == mechanism {
+			return true
+		}
+	}
+	return false
+}
+
+// md5Password returns the response to an md5 authentication request:
+// "md5" followed by md5(md5(password + username) + salt) in hex.
+func md5Password(username, password string, salt []byte) string {
+	inner := md5.Sum([]byte(password + username))
+	outer := md5.Sum(append([]byte(hex.EncodeToString(inner[:])), salt...))
+	return "md5" + hex.EncodeToString(outer[:])
+}
+
+// scramClient implements the client side of SCRAM-SHA-256 as used by
+// PostgreSQL (RFC 5802 and RFC 7677) without channel binding.  The username
+// is sent empty since the endpoint uses the user of the startup message.
+type scramClient struct {
+	password        string
+	clientNonce     string
+	clientFirstBare string
+	serverSignature []byte
+	verified        bool
+}
+
+func newScramClient(password string) (*scramClient, error) {
+	nonce := make([]byte, 18)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	c := &scramClient{
+		password:    password,
+		clientNonce: base64.StdEncoding.EncodeToString(nonce),
+	}
+	c.clientFirstBare = "n=,r=" + c.clientNonce
+	return c, nil
+}
+
+func (c *scramClient) clientFirst() []byte {
+	return []byte("n,," + c.clientFirstBare)
+}
+
+// clientFinal returns the client-final-message for the server-first-message.
+func (c *scramClient) clientFinal(serverFirst []byte) ([]byte, error) {
+	attrs := scramAttributes(string(serverFirst))
+	nonce, salt64, iter := attrs["r"], attrs["s"], attrs["i"]
+	if !strings.HasPrefix(nonce, c.clientNonce) || len(nonce) == len(c.clientNonce) {
+		return nil, stderrors.New("invalid scram server nonce")
+	}
+	salt, err := base64.StdEncoding.DecodeString(salt64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scram salt: %w", err)
+	}
+	iterations, err := strconv.Atoi(iter)
+	if err != nil || iterations < 1 || iterations > maxScramIterations {
+		return nil, fmt.Errorf("invalid scram iteration count %q", iter)
+	}
+
+	saltedPassword := pbkdf2.Key([]byte(c.password), salt, iterations, sha256.Size, sha256.New)
+	clientKey := hmacSha256(saltedPassword, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+	clientFinalWithoutProof := "c=biws,r=" + nonce
+	authMessage := []byte(c.clientFirstBare + "," + string(serverFirst) + "," + clientFinalWithoutProof)
+	clientSignature := hmacSha256(storedKey[:], authMessage)
+	proof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+	c.serverSignature = hmacSha256(hmacSha256(saltedPassword, []byte("Server Key")), authMessage)
+	return []byte(clientFinalWithoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof)), nil
+}
+
+// verifyServerFinal checks the server signature of the server-final-message.
+func (c *scramClient) verifyServerFinal(serverFinal []byte) error {
+	attrs := scramAttributes(string(serverFinal))
+	if e, ok := attrs["e"]; ok {
+		return fmt.Errorf("scram authentication failed: %s", e)
+	}
+	sig, err := base64.StdEncoding.DecodeString(attrs["v"])
+	if err != nil || c.serverSignature == nil || !hmac.Equal(sig, c.serverSignature) {
+		return stderrors.New("invalid scram server signature")
+	}
+	c.verified = true
+	return nil
+}
+
+func scramAttributes(s string) map[string]string {
+	attrs := map[string]string{}
+	for _, kv := range strings.Split(s, ",") {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			attrs[k] = v
+		}
+	}
+	return attrs
+}
+
+func hmacSha256(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
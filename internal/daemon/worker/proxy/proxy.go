@@ -6,9 +6,11 @@ package proxy
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"sync"
 
+	"github.com/hashicorp/boundary/internal/session"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
@@ -19,21 +21,41 @@ var (
 	// handlers is the map of registered handlers
 	handlers sync.Map
 
+	// protocolContexts is the map of protocol context message names to the
+	// protocol whose handler consumes them
+	protocolContexts sync.Map
+
 	// ErrUnknownProtocol specifies the provided protocol has no registered handler
 	ErrUnknownProtocol = errors.New("proxy: handler not found for protocol")
 
 	// ErrProtocolAlreadyRegistered specifies the provided protocol has already been registered
 	ErrProtocolAlreadyRegistered = errors.New("proxy: protocol already registered")
 
-	// GetHandler returns the handler registered for the provided worker and
-	// protocolContext. If a protocol cannot be determined or the protocol is
-	// not registered nil, ErrUnknownProtocol is returned.
-	GetHandler = tcpOnly
+	// ErrProtocolContextAlreadyRegistered specifies the provided protocol
+	// context message has already been registered
+	ErrProtocolContextAlreadyRegistered = errors.New("proxy: protocol context already registered")
+
+	// ErrProtocolContextMismatch specifies the provided protocol context was
+	// registered for a protocol other than the one requested
+	ErrProtocolContextMismatch = errors.New("proxy: protocol context does not match protocol")
+
+	// GetHandler returns the handler registered for the provided worker,
+	// protocol and protocolContext. If a protocol cannot be determined or the
+	// protocol is not registered nil, ErrUnknownProtocol is returned.
+	GetHandler = protocolHandler
 )
 
 // RecordingManager allows a handler for a protocol that supports recording.
 type RecordingManager any
 
+// ClosedReasonSetter is implemented by the client connections passed to a
+// Handler.  A Handler which closes a connection for a reason other than the
+// client or the endpoint closing it sets the reason, which is reported to the
+// controller when the connection is closed.
+type ClosedReasonSetter interface {
+	SetClosedReason(session.ClosedReason)
+}
+
 // DecryptFn decrypts the provided bytes into a proto.Message
 type DecryptFn func(ctx context.Context, from []byte, to proto.Message) error
 
@@ -56,11 +78,41 @@ func RegisterHandler(protocol string, handler Handler) error {
 	return nil
 }
 
-// tcpOnly returns only the TCP protocol.
-func tcpOnly(string, proto.Message) (Handler, error) {
-	handler, ok := handlers.Load(TcpHandlerName)
+// RegisterProtocolContext associates the message type of protocolCtx with the
+// provided protocol.  When a connection is authorized with a protocol context
+// of this type, the handler registered for protocol is used to proxy it.
+func RegisterProtocolContext(protocol string, protocolCtx proto.Message) error {
+	_, loaded := protocolContexts.LoadOrStore(protocolCtx.ProtoReflect().Descriptor().FullName(), protocol)
+	if loaded {
+		return ErrProtocolContextAlreadyRegistered
+	}
+	return nil
+}
+
+// protocolHandler returns the handler registered for the provided protocol.
+// The protocol is the subtype of the target the session was authorized for.
+// If protocolCtx is set and its message type was registered through
+// RegisterProtocolContext, the protocol it was registered for must match the
+// provided protocol, or an empty protocol is replaced by it.  If no protocol
+// can be determined the tcp handler is returned.
+func protocolHandler(_ string, protocol string, protocolCtx *anypb.Any) (Handler, error) {
+	if protocolCtx != nil {
+		name := protocolCtx.MessageName()
+		if p, ok := protocolContexts.Load(name); ok {
+			switch {
+			case protocol == "":
+				protocol = p.(string)
+			case protocol != p.(string):
+				return nil, fmt.Errorf("%w: %q is registered for %q, not %q", ErrProtocolContextMismatch, string(name), p.(string), protocol)
+			}
+		}
+	}
+	if protocol == "" {
+		protocol = TcpHandlerName
+	}
+	handler, ok := handlers.Load(protocol)
 	if !ok {
-		return nil, ErrUnknownProtocol
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProtocol, protocol)
 	}
 	return handler.(Handler), nil
 }
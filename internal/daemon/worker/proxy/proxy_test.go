@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestRegisterHandler(t *testing.T) {
@@ -38,22 +39,120 @@ func TestRegisterHandler(t *testing.T) {
 	require.NoError(err)
 }
 
-func TestAlwaysTcpGetHandler(t *testing.T) {
-	assert, require := assert.New(t), require.New(t)
+func TestRegisterProtocolContext(t *testing.T) {
+	require := require.New(t)
+	clearSyncMap(t, &protocolContexts)
+
+	require.NoError(RegisterProtocolContext("protocol", &wrapperspb.StringValue{}))
+
+	err := RegisterProtocolContext("new-protocol", &wrapperspb.StringValue{})
+	require.Error(err)
+	require.ErrorIs(err, ErrProtocolContextAlreadyRegistered)
+
+	require.NoError(RegisterProtocolContext("new-protocol", &wrapperspb.BytesValue{}))
+}
+
+func TestGetHandler(t *testing.T) {
 	fn := func(context.Context, context.Context, DecryptFn, net.Conn, *ProxyDialer, string, *anypb.Any, RecordingManager) (ProxyConnFn, error) {
 		return nil, nil
 	}
-	oldHandler := handlers
-	t.Cleanup(func() {
-		handlers = oldHandler
-	})
-	handlers = sync.Map{}
-	_, err := tcpOnly("wid", nil)
-	assert.ErrorIs(err, ErrUnknownProtocol)
+	clearSyncMap(t, &handlers)
+	clearSyncMap(t, &protocolContexts)
 
-	require.NoError(RegisterHandler("tcp", fn))
+	_, err := protocolHandler("wid", "", nil)
+	require.ErrorIs(t, err, ErrUnknownProtocol)
 
-	handler, err := tcpOnly("wid", nil)
-	require.NoError(err)
-	require.NotNil(handler)
+	require.NoError(t, RegisterHandler(TcpHandlerName, fn))
+	require.NoError(t, RegisterHandler("other", fn))
+	require.NoError(t, RegisterProtocolContext("other", &wrapperspb.StringValue{}))
+
+	otherCtx, err := anypb.New(&wrapperspb.StringValue{Value: "other"})
+	require.NoError(t, err)
+	unregisteredCtx, err := anypb.New(&wrapperspb.BytesValue{})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		protocol    string
+		protocolCtx *anypb.Any
+		wantErr     error
+	}{
+		{
+			name: "default to tcp",
+		},
+		{
+			name:     "tcp",
+			protocol: TcpHandlerName,
+		},
+		{
+			name:     "registered protocol",
+			protocol: "other",
+		},
+		{
+			name:        "protocol from context",
+			protocolCtx: otherCtx,
+		},
+		{
+			name:        "protocol matching context",
+			protocol:    "other",
+			protocolCtx: otherCtx,
+		},
+		{
+			name:        "unregistered context",
+			protocol:    TcpHandlerName,
+			protocolCtx: unregisteredCtx,
+		},
+		{
+			name:     "unknown protocol",
+			protocol: "unknown",
+			wantErr:  ErrUnknownProtocol,
+		},
+		{
+			name:        "unknown protocol with unregistered context",
+			protocol:    "unknown",
+			protocolCtx: unregisteredCtx,
+			wantErr:     ErrUnknownProtocol,
+		},
+		{
+			name:        "protocol not matching context",
+			protocol:    TcpHandlerName,
+			protocolCtx: otherCtx,
+			wantErr:     ErrProtocolContextMismatch,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			handler, err := GetHandler("wid", tc.protocol, tc.protocolCtx)
+			if tc.wantErr != nil {
+				require.Error(err)
+				assert.ErrorIs(err, tc.wantErr)
+				assert.Nil(handler)
+				return
+			}
+			require.NoError(err)
+			assert.NotNil(handler)
+		})
+	}
+}
+
+// clearSyncMap removes all entries from m and restores them when the test
+// completes. Copying a sync.Map is not allowed, so the entries are moved.
+func clearSyncMap(t *testing.T, m *sync.Map) {
+	t.Helper()
+	saved := make(map[any]any)
+	m.Range(func(k, v any) bool {
+		saved[k] = v
+		m.Delete(k)
+		return true
+	})
+	t.Cleanup(func() {
+		m.Range(func(k, _ any) bool {
+			m.Delete(k)
+			return true
+		})
+		for k, v := range saved {
+			m.Store(k, v)
+		}
+	})
 }
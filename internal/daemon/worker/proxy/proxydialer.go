@@ -10,6 +10,7 @@ import (
 	"sync/atomic"
 
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/session"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -17,19 +18,31 @@ import (
 // returns a net.Conn which reaches the provided endpoint.
 var GetEndpointDialer = directDialer
 
-// directDialer returns a ProxyDialer which tcp dials directly to the provided
-// endpoint.
-func directDialer(ctx context.Context, endpoint string, _ string, _ proto.Message, _ interface{}) (*ProxyDialer, error) {
+// directDialer returns a ProxyDialer which dials directly to the provided
+// endpoint, or through the egress proxy provided with WithEgressProxyUrl.  The
+// endpoint is dialed over tcp unless another network is passed to Dial with
+// WithDialNetwork.  Only tcp can be dialed through an egress proxy.
+func directDialer(ctx context.Context, endpoint string, _ string, _ proto.Message, _ interface{}, opt ...Option) (*ProxyDialer, error) {
 	const op = "proxy.directDialer"
 	if len(endpoint) == 0 {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "endpoint is empty")
 	}
+	egressProxyUrl := GetOpts(opt...).WithEgressProxyUrl
 	d, err := NewProxyDialer(ctx, func(opt ...Option) (net.Conn, error) {
-		remoteConn, err := net.Dial("tcp", endpoint)
+		opts := GetOpts(opt...)
+		var remoteConn net.Conn
+		var err error
+		switch {
+		case egressProxyUrl != nil && opts.WithDialNetwork != "tcp":
+			err = &EgressProxyError{Reason: session.ConnectionSystemError, Msg: fmt.Sprintf("unable to dial %s endpoints", opts.WithDialNetwork)}
+		case egressProxyUrl != nil:
+			remoteConn, err = egressDial(ctx, egressProxyUrl, endpoint)
+		default:
+			remoteConn, err = net.Dial(opts.WithDialNetwork, endpoint)
+		}
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		opts := GetOpts(opt...)
 		if opts.WithPostConnectionHook != nil {
 			opts.WithPostConnectionHook(remoteConn)
 		}
@@ -53,7 +66,7 @@ func (p *proxyAddr) Ip() string {
 	return p.ip
 }
 
-// Port returns the tcp port of the endpoint connected to
+// Port returns the port of the endpoint connected to
 func (p *proxyAddr) Port() uint32 {
 	return p.port
 }
@@ -108,6 +121,9 @@ func (d *ProxyDialer) Dial(ctx context.Context, opt ...Option) (net.Conn, error)
 		ip := addr.IP.String()
 		port := uint32(addr.Port)
 		d.latestAddr.Store(&proxyAddr{ip: ip, port: port})
+	case *net.UDPConn:
+		addr := v.RemoteAddr().(*net.UDPAddr)
+		d.latestAddr.Store(&proxyAddr{ip: addr.IP.String(), port: uint32(addr.Port)})
 	case portAndIpGetter:
 		d.latestAddr.Store(&proxyAddr{ip: v.GetIp(), port: v.GetPort()})
 	default:
@@ -7,8 +7,10 @@ import (
 	"context"
 	"errors"
 	"net"
+	"net/url"
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/session"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -62,4 +64,29 @@ func TestProxyDialer(t *testing.T) {
 		assert.Equal(t, tcpAddr.IP.String(), d.LastConnectionAddr().Ip())
 		assert.EqualValues(t, tcpAddr.Port, d.LastConnectionAddr().Port())
 	})
+
+	t.Run("Successful udp Dial", func(t *testing.T) {
+		pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer pc.Close()
+		d, err := directDialer(ctx, pc.LocalAddr().String(), "", nil, nil)
+		require.NoError(t, err)
+		c, err := d.Dial(ctx, WithDialNetwork("udp"))
+		require.NoError(t, err)
+		require.NotNil(t, c)
+		defer c.Close()
+
+		udpAddr := pc.LocalAddr().(*net.UDPAddr)
+		assert.Equal(t, udpAddr.IP.String(), d.LastConnectionAddr().Ip())
+		assert.EqualValues(t, udpAddr.Port, d.LastConnectionAddr().Port())
+	})
+
+	t.Run("udp Dial through egress proxy", func(t *testing.T) {
+		d, err := directDialer(ctx, "127.0.0.1:53", "", nil, nil, WithEgressProxyUrl(&url.URL{Scheme: "socks5", Host: "127.0.0.1:1080"}))
+		require.NoError(t, err)
+		c, err := d.Dial(ctx, WithDialNetwork("udp"))
+		require.Error(t, err)
+		assert.Nil(t, c)
+		assert.Equal(t, session.ConnectionSystemError, ClosedReasonForError(err))
+	})
 }
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"context"
+	"io"
+)
+
+// ConnectionRecorder records the data proxied for a single connection.
+type ConnectionRecorder interface {
+	// Inbound returns the writer for data sent from the client to the
+	// endpoint.
+	Inbound() io.Writer
+	// Outbound returns the writer for data sent from the endpoint to the
+	// client.
+	Outbound() io.Writer
+	// Close finishes the recording of the connection.  It must be called
+	// once the connection has been closed.
+	Close(ctx context.Context) error
+}
+
+// ConnectionRecordingManager is a RecordingManager which can record the raw
+// data of proxied connections.  Handlers for protocols whose data is not
+// interpreted by the worker, such as tcp, use it to record their connections.
+type ConnectionRecordingManager interface {
+	// NewConnectionRecorder returns a ConnectionRecorder for the connection
+	// or nil if the connection's session is not being recorded.  If an error
+	// is returned the connection must not be proxied.
+	NewConnectionRecorder(ctx context.Context, connectionId string) (ConnectionRecorder, error)
+}
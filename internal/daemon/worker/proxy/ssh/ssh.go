@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ssh provides the worker proxy handler for ssh targets.  The handler
+// terminates the client's ssh connection and opens a new ssh connection to the
+// endpoint, authenticating with the credentials injected into the session, so
+// the client never has access to them.  The endpoint must present one of the
+// target's known host keys before any credential is sent to it.
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// HandlerName is the protocol the ssh proxy handler is registered for.
+const HandlerName = "ssh"
+
+func init() {
+	if err := proxy.RegisterHandler(HandlerName, handleProxy); err != nil {
+		panic(err)
+	}
+	if err := proxy.RegisterProtocolContext(HandlerName, &pbs.SshProtocolContext{}); err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy creates an ssh proxy between the incoming conn and the
+// connection created by the ProxyDialer.  The ssh connection to the endpoint
+// is established and authenticated with the injected credentials found in the
+// protocol context before handleProxy returns.  An error is returned without
+// dialing the endpoint if the protocol context has no known host keys.
+//
+// If the RecordingManager is a proxy.ConnectionRecordingManager and the
+// connection's session is being recorded, the data and extended data of every
+// channel is written to the connection's recorder as it is proxied, inbound
+// from the client and outbound from the endpoint.  An error is returned if the
+// connection can't be recorded.
+//
+// handleProxy returns a ProxyConnFn which completes the ssh handshake with the
+// client and proxies channels and requests between the two ssh connections
+// until either of them is closed.
+func handleProxy(controlCtx context.Context, dataCtx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, pc *anypb.Any, rm proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+	const op = "ssh.handleProxy"
+	switch {
+	case conn == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "conn is nil")
+	case out == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "proxy dialer is nil")
+	case len(connId) == 0:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "connection id is empty")
+	case pc == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "protocol context is nil")
+	}
+	sshCtx := &pbs.SshProtocolContext{}
+	if err := pc.UnmarshalTo(sshCtx); err != nil {
+		return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to unmarshal ssh protocol context"))
+	}
+	serverConfig, err := newServerConfig(controlCtx, sshCtx.GetPkcs8HostKey())
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+	clientConfig, err := newClientConfig(controlCtx, connId, sshCtx.GetInjectedCredentials(), sshCtx.GetKnownHostKeys())
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+
+	var recorder proxy.ConnectionRecorder
+	if crm, ok := rm.(proxy.ConnectionRecordingManager); ok {
+		recorder, err = crm.NewConnectionRecorder(controlCtx, connId)
+		if err != nil {
+			return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to record connection"))
+		}
+	}
+
+	remoteConn, err := out.Dial(controlCtx)
+	if err != nil {
+		if recorder != nil {
+			_ = recorder.Close(controlCtx)
+		}
+		return nil, err
+	}
+	upstreamConn, upstreamChans, upstreamReqs, err := ssh.NewClientConn(remoteConn, remoteConn.RemoteAddr().String(), clientConfig)
+	if err != nil {
+		_ = remoteConn.Close()
+		if recorder != nil {
+			_ = recorder.Close(controlCtx)
+		}
+		return nil, errors.Wrap(controlCtx, err, op, errors.WithCode(errors.Unknown), errors.WithMsg("unable to establish ssh connection to endpoint"))
+	}
+
+	return func() {
+		var chRec *channelRecorder
+		if recorder != nil {
+			defer func() {
+				if err := recorder.Close(controlCtx); err != nil {
+					event.WriteError(dataCtx, op, err, event.WithInfoMsg("unable to close connection recording", "connection_id", connId))
+				}
+			}()
+			chRec = &channelRecorder{inbound: recorder.Inbound(), outbound: recorder.Outbound()}
+		}
+		defer upstreamConn.Close()
+		defer conn.Close()
+
+		clientConn, clientChans, clientReqs, err := ssh.NewServerConn(conn, serverConfig)
+		if err != nil {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("ssh handshake with client failed", "connection_id", connId))
+			return
+		}
+		defer clientConn.Close()
+
+		proxyConns(dataCtx, chRec, clientConn, clientChans, clientReqs, upstreamConn, upstreamChans, upstreamReqs)
+	}, nil
+}
+
+// channelRecorder writes the data of all channels of a recorded connection to
+// the connection's recorder.  Channels are proxied concurrently, so writes to
+// the recorder are serialized.  A nil channelRecorder records nothing.
+type channelRecorder struct {
+	mu       sync.Mutex
+	inbound  io.Writer
+	outbound io.Writer
+}
+
+// writer returns the writer for data read from the client if fromClient is
+// true, or from the endpoint otherwise.  It returns nil if r is nil.
+func (r *channelRecorder) writer(fromClient bool) io.Writer {
+	if r == nil {
+		return nil
+	}
+	w := r.outbound
+	if fromClient {
+		w = r.inbound
+	}
+	return writerFunc(func(p []byte) (int, error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return w.Write(p)
+	})
+}
+
+type writerFunc func([]byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
+
+// proxyConns proxies new channels and global requests in both directions
+// between the client and upstream ssh connections, recording the channels'
+// data with rec.  It blocks until either of the connections is closed, after
+// which both are closed.
+func proxyConns(ctx context.Context, rec *channelRecorder,
+	clientConn ssh.Conn, clientChans <-chan ssh.NewChannel, clientReqs <-chan *ssh.Request,
+	upstreamConn ssh.Conn, upstreamChans <-chan ssh.NewChannel, upstreamReqs <-chan *ssh.Request,
+) {
+	go forwardGlobalRequests(ctx, clientReqs, upstreamConn)
+	go forwardGlobalRequests(ctx, upstreamReqs, clientConn)
+	go forwardChannels(ctx, rec, false, upstreamChans, clientConn)
+	go forwardChannels(ctx, rec, true, clientChans, upstreamConn)
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_ = clientConn.Wait()
+		done <- struct{}{}
+	}()
+	go func() {
+		_ = upstreamConn.Wait()
+		done <- struct{}{}
+	}()
+	<-done
+	_ = clientConn.Close()
+	_ = upstreamConn.Close()
+}
+
+// forwardGlobalRequests sends each request received in reqs to dst and replies
+// with dst's response.
+func forwardGlobalRequests(ctx context.Context, reqs <-chan *ssh.Request, dst ssh.Conn) {
+	const op = "ssh.forwardGlobalRequests"
+	for req := range reqs {
+		ok, payload, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to forward global request", "request_type", req.Type))
+		}
+		if req.WantReply {
+			_ = req.Reply(ok, payload)
+		}
+	}
+}
+
+// forwardChannels opens a matching channel on dst for each new channel
+// received and proxies between the two.  fromClient reports whether the
+// channels are opened by the client.
+func forwardChannels(ctx context.Context, rec *channelRecorder, fromClient bool, chans <-chan ssh.NewChannel, dst ssh.Conn) {
+	for nc := range chans {
+		go proxyChannel(ctx, rec, fromClient, nc, dst)
+	}
+}
+
+// proxyChannel opens a channel on dst of the same type as nc, accepts nc and
+// proxies data, extended data and channel requests between the two channels
+// until either of them is closed.  If dst rejects the channel, nc is rejected
+// with the same reason.  fromClient reports whether nc was opened by the
+// client.
+func proxyChannel(ctx context.Context, rec *channelRecorder, fromClient bool, nc ssh.NewChannel, dst ssh.Conn) {
+	const op = "ssh.proxyChannel"
+	dstCh, dstReqs, err := dst.OpenChannel(nc.ChannelType(), nc.ExtraData())
+	if err != nil {
+		var openErr *ssh.OpenChannelError
+		if stderrors.As(err, &openErr) {
+			_ = nc.Reject(openErr.Reason, openErr.Message)
+			return
+		}
+		_ = nc.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	srcCh, srcReqs, err := nc.Accept()
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to accept channel", "channel_type", nc.ChannelType()))
+		_ = dstCh.Close()
+		return
+	}
+
+	// Whichever side closes its channel first, once everything it sent has
+	// been forwarded, the other side's channel is closed as well.
+	done := make(chan struct{}, 2)
+	go func() {
+		forwardChannel(ctx, srcCh, srcReqs, dstCh, rec.writer(fromClient))
+		done <- struct{}{}
+	}()
+	go func() {
+		forwardChannel(ctx, dstCh, dstReqs, srcCh, rec.writer(!fromClient))
+		done <- struct{}{}
+	}()
+	<-done
+	_ = srcCh.Close()
+	_ = dstCh.Close()
+}
+
+// forwardChannel copies data and extended data read from src to dst, and sends
+// the requests received on src to dst.  If rec is not nil, the data and
+// extended data are also written to it; a failure to record them ends the
+// copy.  It returns once src has been closed and everything received from it
+// has been forwarded.
+func forwardChannel(ctx context.Context, src ssh.Channel, srcReqs <-chan *ssh.Request, dst ssh.Channel, rec io.Writer) {
+	const op = "ssh.forwardChannel"
+	var data, extData io.Reader = src, src.Stderr()
+	if rec != nil {
+		data, extData = io.TeeReader(data, rec), io.TeeReader(extData, rec)
+	}
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(dst, data)
+	}()
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(dst.Stderr(), extData)
+	}()
+
+	for req := range srcReqs {
+		ok, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to forward channel request", "request_type", req.Type))
+		}
+		if req.WantReply {
+			_ = req.Reply(ok, nil)
+		}
+	}
+	wg.Wait()
+	_ = dst.CloseWrite()
+}
+
+// newServerConfig returns the configuration used to terminate the client's
+// ssh connection.  The client has already been authorized to use the session,
+// so no further client authentication is performed.
+func newServerConfig(ctx context.Context, pkcs8HostKey []byte) (*ssh.ServerConfig, error) {
+	const op = "ssh.newServerConfig"
+	if len(pkcs8HostKey) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host key")
+	}
+	hostKey, err := x509.ParsePKCS8PrivateKey(pkcs8HostKey)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse host key"))
+	}
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create host key signer"))
+	}
+	cfg := &ssh.ServerConfig{
+		NoClientAuth: true,
+	}
+	cfg.AddHostKey(signer)
+	return cfg, nil
+}
+
+// newClientConfig returns the configuration used to authenticate to the
+// endpoint with the provided injected credentials.  All credentials must be
+// for the same user.  The endpoint's host key must be one of knownHostKeys,
+// which holds ssh public keys in authorized_keys format, one per line.
+func newClientConfig(ctx context.Context, connId string, creds []*pbs.Credential, knownHostKeys string) (*ssh.ClientConfig, error) {
+	const op = "ssh.newClientConfig"
+	if len(creds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no injected credentials")
+	}
+	hostKeys, err := parseKnownHostKeys(ctx, knownHostKeys)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var username string
+	var signers []ssh.Signer
+	var auths []ssh.AuthMethod
+	setUsername := func(u string) error {
+		switch {
+		case u == "":
+			return errors.New(ctx, errors.InvalidParameter, op, "injected credential is missing a username")
+		case username != "" && username != u:
+			return errors.New(ctx, errors.InvalidParameter, op, "injected credentials are for different usernames")
+		}
+		username = u
+		return nil
+	}
+	for _, c := range creds {
+		switch cred := c.GetCredential().(type) {
+		case *pbs.Credential_UsernamePassword:
+			if err := setUsername(cred.UsernamePassword.GetUsername()); err != nil {
+				return nil, err
+			}
+			password := cred.UsernamePassword.GetPassword()
+			auths = append(auths,
+				ssh.Password(password),
+				ssh.KeyboardInteractive(func(_, _ string, questions []string, _ []bool) ([]string, error) {
+					answers := make([]string, len(questions))
+					for i := range questions {
+						answers[i] = password
+					}
+					return answers, nil
+				}),
+			)
+
+		case *pbs.Credential_SshPrivateKey:
+			if err := setUsername(cred.SshPrivateKey.GetUsername()); err != nil {
+				return nil, err
+			}
+			var signer ssh.Signer
+			var err error
+			if passphrase := cred.SshPrivateKey.GetPrivateKeyPassphrase(); passphrase != "" {
+				signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(cred.SshPrivateKey.GetPrivateKey()), []byte(passphrase))
+			} else {
+				signer, err = ssh.ParsePrivateKey([]byte(cred.SshPrivateKey.GetPrivateKey()))
+			}
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse ssh private key"))
+			}
+			signers = append(signers, signer)
+
+		case *pbs.Credential_SshCertificate:
+			if err := setUsername(cred.SshCertificate.GetUsername()); err != nil {
+				return nil, err
+			}
+			signer, err := ssh.ParsePrivateKey([]byte(cred.SshCertificate.GetPrivateKey()))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse ssh certificate private key"))
+			}
+			pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cred.SshCertificate.GetCertificate()))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse ssh certificate"))
+			}
+			cert, ok := pub.(*ssh.Certificate)
+			if !ok {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh certificate credential contains a %q key instead of a certificate", pub.Type()))
+			}
+			certSigner, err := ssh.NewCertSigner(cert, signer)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create ssh certificate signer"))
+			}
+			signers = append(signers, certSigner)
+
+		default:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported injected credential type %T", cred))
+		}
+	}
+	if len(signers) > 0 {
+		// Public key authentication is attempted before passwords.
+		auths = append([]ssh.AuthMethod{ssh.PublicKeys(signers...)}, auths...)
+	}
+
+	return &ssh.ClientConfig{
+		User:              username,
+		Auth:              auths,
+		HostKeyAlgorithms: hostKeyAlgorithms(hostKeys),
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			for _, k := range hostKeys {
+				if bytes.Equal(k.Marshal(), key.Marshal()) {
+					return nil
+				}
+			}
+			event.WriteSysEvent(ctx, op, "rejected unknown endpoint ssh host key",
+				"connection_id", connId,
+				"endpoint", remote.String(),
+				"host_key_type", key.Type(),
+				"host_key_fingerprint", ssh.FingerprintSHA256(key))
+			return fmt.Errorf("endpoint %s presented an unknown %s host key %s", remote, key.Type(), ssh.FingerprintSHA256(key))
+		},
+	}, nil
+}
+
+// parseKnownHostKeys parses the ssh public keys in authorized_keys format, one
+// per line, trusted as the endpoint's host key.  Blank lines and lines
+// starting with '#' are ignored.  At least one key is required.
+func parseKnownHostKeys(ctx context.Context, knownHostKeys string) ([]ssh.PublicKey, error) {
+	const op = "ssh.parseKnownHostKeys"
+	var keys []ssh.PublicKey
+	for _, line := range strings.Split(knownHostKeys, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse known host key"))
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no known host keys")
+	}
+	return keys, nil
+}
+
+// hostKeyAlgorithms returns the host key algorithms the endpoint may use to
+// present one of keys, so it is not asked for a host key of a type that is
+// not known.
+func hostKeyAlgorithms(keys []ssh.PublicKey) []string {
+	var algs []string
+	seen := make(map[string]bool)
+	for _, k := range keys {
+		kAlgs := []string{k.Type()}
+		if k.Type() == ssh.KeyAlgoRSA {
+			kAlgs = []string{ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA}
+		}
+		for _, a := range kAlgs {
+			if !seen[a] {
+				seen[a] = true
+				algs = append(algs, a)
+			}
+		}
+	}
+	return algs
+}
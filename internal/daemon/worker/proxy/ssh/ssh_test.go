@@ -0,0 +1,515 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	testUser     = "alice"
+	testPassword = "passw0rd"
+)
+
+// testEndpoint is an ssh server started by testServer.
+type testEndpoint struct {
+	net.Listener
+	// hostKey is the public key of the host key the server presents.
+	hostKey ssh.PublicKey
+	// authAttempts counts the password and public key authentication
+	// attempts made to the server.
+	authAttempts atomic.Int32
+}
+
+// testServer starts an ssh server which accepts the testUser with either
+// testPassword, the public key of userKey, or a certificate signed by caKey.
+// Exec requests are answered by writing the command back on stdout, writing
+// "err" on stderr and exiting with status 0.
+func testServer(t *testing.T, userKey, caKey ssh.PublicKey) *testEndpoint {
+	t.Helper()
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostPriv)
+	require.NoError(t, err)
+	e := &testEndpoint{hostKey: hostSigner.PublicKey()}
+
+	checker := &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return caKey != nil && bytes.Equal(auth.Marshal(), caKey.Marshal())
+		},
+		UserKeyFallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if conn.User() == testUser && userKey != nil && bytes.Equal(key.Marshal(), userKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, assert.AnError
+		},
+	}
+	cfg := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			e.authAttempts.Add(1)
+			if conn.User() == testUser && string(password) == testPassword {
+				return nil, nil
+			}
+			return nil, assert.AnError
+		},
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			e.authAttempts.Add(1)
+			return checker.Authenticate(conn, key)
+		},
+	}
+	cfg.AddHostKey(hostSigner)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	e.Listener = l
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, chans, reqs, err := ssh.NewServerConn(c, cfg)
+				if err != nil {
+					return
+				}
+				go ssh.DiscardRequests(reqs)
+				for nc := range chans {
+					if nc.ChannelType() != "session" {
+						nc.Reject(ssh.UnknownChannelType, "unsupported")
+						continue
+					}
+					ch, chReqs, err := nc.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						for req := range chReqs {
+							if req.Type != "exec" {
+								req.Reply(false, nil)
+								continue
+							}
+							req.Reply(true, nil)
+							cmdLen := binary.BigEndian.Uint32(req.Payload)
+							ch.Write(req.Payload[4 : 4+cmdLen])
+							ch.Stderr().Write([]byte("err"))
+							ch.SendRequest("exit-status", false, []byte{0, 0, 0, 0})
+							ch.Close()
+						}
+					}()
+				}
+			}()
+		}
+	}()
+	return e
+}
+
+func testHostKey(t *testing.T) ([]byte, ssh.PublicKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return der, sshPub
+}
+
+func testConnPair(t *testing.T) (net.Conn, net.Conn) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	accepted := make(chan net.Conn)
+	go func() {
+		c, _ := l.Accept()
+		accepted <- c
+	}()
+	c1, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	c2 := <-accepted
+	require.NotNil(t, c2)
+	return c1, c2
+}
+
+func testProtocolContext(t *testing.T, hostKey []byte, knownHostKeys string, creds ...*pbs.Credential) *anypb.Any {
+	t.Helper()
+	pc, err := anypb.New(&pbs.SshProtocolContext{
+		InjectedCredentials: creds,
+		Pkcs8HostKey:        hostKey,
+		KnownHostKeys:       knownHostKeys,
+	})
+	require.NoError(t, err)
+	return pc
+}
+
+func TestHandleProxy(t *testing.T) {
+	ctx := context.Background()
+
+	userPub, userPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	userSigner, err := ssh.NewSignerFromKey(userPriv)
+	require.NoError(t, err)
+	userDer, err := x509.MarshalPKCS8PrivateKey(userPriv)
+	require.NoError(t, err)
+	userPem := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: userDer}))
+	userSshPub, err := ssh.NewPublicKey(userPub)
+	require.NoError(t, err)
+
+	_, caPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	caSigner, err := ssh.NewSignerFromKey(caPriv)
+	require.NoError(t, err)
+	cert := &ssh.Certificate{
+		Key:             userSigner.PublicKey(),
+		CertType:        ssh.UserCert,
+		ValidPrincipals: []string{testUser},
+		ValidBefore:     ssh.CertTimeInfinity,
+	}
+	require.NoError(t, cert.SignCert(rand.Reader, caSigner))
+
+	hostKey, hostPub := testHostKey(t)
+	_, otherHostPub := testHostKey(t)
+
+	cases := []struct {
+		name           string
+		creds          []*pbs.Credential
+		unknownHostKey bool
+		wantErr        bool
+	}{
+		{
+			name: "username password",
+			creds: []*pbs.Credential{{Credential: &pbs.Credential_UsernamePassword{
+				UsernamePassword: &pbs.UsernamePassword{Username: testUser, Password: testPassword},
+			}}},
+		},
+		{
+			name: "private key",
+			creds: []*pbs.Credential{{Credential: &pbs.Credential_SshPrivateKey{
+				SshPrivateKey: &pbs.SshPrivateKey{Username: testUser, PrivateKey: userPem},
+			}}},
+		},
+		{
+			name: "certificate",
+			creds: []*pbs.Credential{{Credential: &pbs.Credential_SshCertificate{
+				SshCertificate: &pbs.SshCertificate{Username: testUser, PrivateKey: userPem, Certificate: string(ssh.MarshalAuthorizedKey(cert))},
+			}}},
+		},
+		{
+			name: "wrong password",
+			creds: []*pbs.Credential{{Credential: &pbs.Credential_UsernamePassword{
+				UsernamePassword: &pbs.UsernamePassword{Username: testUser, Password: "wrong"},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "wrong user",
+			creds: []*pbs.Credential{{Credential: &pbs.Credential_SshPrivateKey{
+				SshPrivateKey: &pbs.SshPrivateKey{Username: "bob", PrivateKey: userPem},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "unknown host key",
+			creds: []*pbs.Credential{{Credential: &pbs.Credential_UsernamePassword{
+				UsernamePassword: &pbs.UsernamePassword{Username: testUser, Password: testPassword},
+			}}},
+			unknownHostKey: true,
+			wantErr:        true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			e := testServer(t, userSshPub, caSigner.PublicKey())
+			dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+				return net.Dial("tcp", e.Addr().String())
+			})
+			require.NoError(err)
+			knownHostKeys := "# endpoint\n" + string(ssh.MarshalAuthorizedKey(otherHostPub))
+			if !tc.unknownHostKey {
+				knownHostKeys += string(ssh.MarshalAuthorizedKey(e.hostKey))
+			}
+
+			// net.Pipe is unbuffered and both sides of an ssh handshake write
+			// before reading, so a real connection is needed.
+			clientSide, workerSide := testConnPair(t)
+			t.Cleanup(func() {
+				clientSide.Close()
+				workerSide.Close()
+			})
+			fn, err := handleProxy(ctx, ctx, nil, workerSide, dialer, "conn_1234567890", testProtocolContext(t, hostKey, knownHostKeys, tc.creds...), nil)
+			if tc.wantErr {
+				assert.Error(err)
+				if tc.unknownHostKey {
+					// The credentials must not be sent to an endpoint which
+					// could not be verified.
+					assert.Zero(e.authAttempts.Load())
+				}
+				return
+			}
+			require.NoError(err)
+			go fn()
+
+			// The client doesn't need any credentials of its own.
+			sshConn, chans, reqs, err := ssh.NewClientConn(clientSide, "localhost", &ssh.ClientConfig{
+				User:            "anyone",
+				HostKeyCallback: ssh.FixedHostKey(hostPub),
+			})
+			require.NoError(err)
+			client := ssh.NewClient(sshConn, chans, reqs)
+			defer client.Close()
+
+			sess, err := client.NewSession()
+			require.NoError(err)
+			var stdout, stderr bytes.Buffer
+			sess.Stdout = &stdout
+			sess.Stderr = &stderr
+			require.NoError(sess.Run("whoami"))
+			assert.Equal("whoami", stdout.String())
+			assert.Equal("err", stderr.String())
+		})
+	}
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	ctx := context.Background()
+	c, _ := net.Pipe()
+	var dials atomic.Int32
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		dials.Add(1)
+		return nil, assert.AnError
+	})
+	require.NoError(t, err)
+	hostKey, hostPub := testHostKey(t)
+	knownHostKeys := string(ssh.MarshalAuthorizedKey(hostPub))
+	validCred := &pbs.Credential{Credential: &pbs.Credential_UsernamePassword{
+		UsernamePassword: &pbs.UsernamePassword{Username: testUser, Password: testPassword},
+	}}
+	wrongCtx, err := anypb.New(wrapperspb.String("not ssh"))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		conn        net.Conn
+		dialer      *proxy.ProxyDialer
+		connId      string
+		protocolCtx *anypb.Any
+		wantDial    bool
+	}{
+		{
+			name:        "nil connection",
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, hostKey, knownHostKeys, validCred),
+		},
+		{
+			name:        "nil dialer",
+			conn:        c,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, hostKey, knownHostKeys, validCred),
+		},
+		{
+			name:        "no connection id",
+			conn:        c,
+			dialer:      dialer,
+			protocolCtx: testProtocolContext(t, hostKey, knownHostKeys, validCred),
+		},
+		{
+			name:   "no protocol context",
+			conn:   c,
+			dialer: dialer,
+			connId: "someconnectionid",
+		},
+		{
+			name:        "wrong protocol context",
+			conn:        c,
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: wrongCtx,
+		},
+		{
+			name:        "no host key",
+			conn:        c,
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, nil, knownHostKeys, validCred),
+		},
+		{
+			name:        "no credentials",
+			conn:        c,
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, hostKey, knownHostKeys),
+		},
+		{
+			name:        "no known host keys",
+			conn:        c,
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, hostKey, "", validCred),
+		},
+		{
+			name:        "only comments in known host keys",
+			conn:        c,
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, hostKey, "# no keys\n\n", validCred),
+		},
+		{
+			name:        "malformed known host keys",
+			conn:        c,
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, hostKey, "not a key", validCred),
+		},
+		{
+			name:   "mismatched usernames",
+			conn:   c,
+			dialer: dialer,
+			connId: "someconnectionid",
+			protocolCtx: testProtocolContext(t, hostKey, knownHostKeys, validCred, &pbs.Credential{Credential: &pbs.Credential_UsernamePassword{
+				UsernamePassword: &pbs.UsernamePassword{Username: "bob", Password: testPassword},
+			}}),
+		},
+		{
+			name:        "dial failure",
+			conn:        c,
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, hostKey, knownHostKeys, validCred),
+			wantDial:    true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dials.Store(0)
+			fn, err := handleProxy(ctx, ctx, nil, tc.conn, tc.dialer, tc.connId, tc.protocolCtx, nil)
+			assert.Error(t, err)
+			assert.Nil(t, fn)
+			assert.Equal(t, tc.wantDial, dials.Load() > 0)
+		})
+	}
+}
+
+type testRecorder struct {
+	mu       sync.Mutex
+	inbound  bytes.Buffer
+	outbound bytes.Buffer
+	closed   bool
+}
+
+func (r *testRecorder) Inbound() io.Writer  { return writerFunc(r.writeTo(&r.inbound)) }
+func (r *testRecorder) Outbound() io.Writer { return writerFunc(r.writeTo(&r.outbound)) }
+
+func (r *testRecorder) writeTo(b *bytes.Buffer) func([]byte) (int, error) {
+	return func(p []byte) (int, error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return b.Write(p)
+	}
+}
+
+func (r *testRecorder) Close(context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	return nil
+}
+
+type testRecordingManager struct {
+	recorder *testRecorder
+	err      error
+}
+
+func (m *testRecordingManager) NewConnectionRecorder(context.Context, string) (proxy.ConnectionRecorder, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.recorder, nil
+}
+
+func TestHandleProxy_Recording(t *testing.T) {
+	ctx := context.Background()
+	require, assert := require.New(t), assert.New(t)
+
+	hostKey, hostPub := testHostKey(t)
+	e := testServer(t, nil, nil)
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", e.Addr().String())
+	})
+	require.NoError(err)
+	pc := testProtocolContext(t, hostKey, string(ssh.MarshalAuthorizedKey(e.hostKey)), &pbs.Credential{Credential: &pbs.Credential_UsernamePassword{
+		UsernamePassword: &pbs.UsernamePassword{Username: testUser, Password: testPassword},
+	}})
+
+	t.Run("error creating recorder", func(t *testing.T) {
+		c, _ := net.Pipe()
+		fn, err := handleProxy(ctx, ctx, nil, c, dialer, "someconnectionid", pc, &testRecordingManager{err: errors.New("recording error")})
+		assert.Error(err)
+		assert.Nil(fn)
+	})
+
+	t.Run("records channel data", func(t *testing.T) {
+		clientSide, workerSide := testConnPair(t)
+		t.Cleanup(func() {
+			clientSide.Close()
+			workerSide.Close()
+		})
+		rec := &testRecorder{}
+		fn, err := handleProxy(ctx, ctx, nil, workerSide, dialer, "someconnectionid", pc, &testRecordingManager{recorder: rec})
+		require.NoError(err)
+		require.NotNil(fn)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			fn()
+		}()
+
+		sshConn, chans, reqs, err := ssh.NewClientConn(clientSide, "localhost", &ssh.ClientConfig{
+			User:            "anyone",
+			HostKeyCallback: ssh.FixedHostKey(hostPub),
+		})
+		require.NoError(err)
+		client := ssh.NewClient(sshConn, chans, reqs)
+		sess, err := client.NewSession()
+		require.NoError(err)
+		out, err := sess.CombinedOutput("whoami")
+		require.NoError(err)
+		assert.Contains(string(out), "whoami")
+		require.NoError(client.Close())
+		<-done
+
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		// The command is sent in a channel request, which isn't recorded,
+		// while both the data and the extended data of the channel are.
+		assert.Empty(rec.inbound.String())
+		assert.Contains(rec.outbound.String(), "whoami")
+		assert.Contains(rec.outbound.String(), "err")
+		assert.Len(rec.outbound.String(), len("whoami")+len("err"))
+		assert.True(rec.closed)
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tcp
+
+import (
+	"io"
+	"time"
+)
+
+// idleTimer calls its function once no data has been proxied in either
+// direction of a connection for its timeout.
+type idleTimer struct {
+	timeout time.Duration
+	timer   *time.Timer
+}
+
+// newIdleTimer starts an idleTimer which calls fn after timeout unless the
+// timer is reset first.
+func newIdleTimer(timeout time.Duration, fn func()) *idleTimer {
+	return &idleTimer{
+		timeout: timeout,
+		timer:   time.AfterFunc(timeout, fn),
+	}
+}
+
+// reset restarts the timeout.  It has no effect once the timer is stopped.
+func (t *idleTimer) reset() {
+	if t.timer.Stop() {
+		t.timer.Reset(t.timeout)
+	}
+}
+
+// stop stops the timer.
+func (t *idleTimer) stop() {
+	t.timer.Stop()
+}
+
+// idleReader resets its idleTimer each time data is read.
+type idleReader struct {
+	r     io.Reader
+	timer *idleTimer
+}
+
+func (r *idleReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.timer.reset()
+	}
+	return n, err
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tcp
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/time/rate"
+)
+
+// maxBurst is the largest number of bytes a limitedReader reads at once when
+// the bandwidth is limited.  It matches the size of the buffer used by
+// io.Copy.
+const maxBurst = 32 * 1024
+
+// errQuotaExceeded is returned by a limitedReader once the session's byte
+// quota has been used.
+var errQuotaExceeded = stderrors.New("session byte quota exceeded")
+
+var (
+	quotasMu sync.Mutex
+	// quotas are the byte quotas of the sessions with connections being
+	// proxied by this worker, keyed by session id.
+	quotas = map[string]*sessionQuota{}
+)
+
+// sessionQuota is the byte quota shared by the connections of a session
+// proxied by this worker.
+type sessionQuota struct {
+	limit int64
+	used  atomic.Int64
+
+	// refs is the number of connections using the quota.  It is guarded by
+	// quotasMu.
+	refs int
+}
+
+// acquireQuota returns the byte quota of the session.  If no other connection
+// of the session is being proxied by this worker a quota is created which
+// starts with used bytes already used.  The returned function must be called
+// once the connection is closed.
+func acquireQuota(sessionId string, limit, used uint64) (*sessionQuota, func()) {
+	quotasMu.Lock()
+	defer quotasMu.Unlock()
+	q, ok := quotas[sessionId]
+	if !ok {
+		q = &sessionQuota{limit: clampInt64(limit)}
+		q.used.Store(clampInt64(used))
+		quotas[sessionId] = q
+	}
+	q.refs++
+	return q, func() {
+		quotasMu.Lock()
+		defer quotasMu.Unlock()
+		q.refs--
+		if q.refs == 0 {
+			delete(quotas, sessionId)
+		}
+	}
+}
+
+// remaining returns the number of bytes left in the quota.
+func (q *sessionQuota) remaining() int64 {
+	return q.limit - q.used.Load()
+}
+
+func clampInt64(v uint64) int64 {
+	if v > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(v)
+}
+
+// newLimiter returns a token bucket limiting the rate to bytesPerSecond, or nil
+// if bytesPerSecond is 0.
+func newLimiter(bytesPerSecond uint64) *rate.Limiter {
+	if bytesPerSecond == 0 {
+		return nil
+	}
+	burst := maxBurst
+	if bytesPerSecond < maxBurst {
+		burst = int(bytesPerSecond)
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), burst)
+}
+
+// limitedReader is an io.Reader which limits the rate of the bytes read to
+// the rate of its limiter and counts them against the session's byte quota.
+// Either the limiter or the quota can be nil.
+//
+// Each read is capped to the bytes left in the quota, so connections of the
+// same session reading concurrently can together exceed the quota by at most
+// the size of a read each.
+type limitedReader struct {
+	ctx     context.Context
+	r       io.Reader
+	limiter *rate.Limiter
+	quota   *sessionQuota
+}
+
+// Read reads from the underlying reader, waiting for the limiter to allow the
+// bytes read before returning them.  errQuotaExceeded is returned once the
+// quota has been used.
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return l.r.Read(p)
+	}
+	if l.limiter != nil && len(p) > l.limiter.Burst() {
+		p = p[:l.limiter.Burst()]
+	}
+	if l.quota != nil {
+		left := l.quota.remaining()
+		if left <= 0 {
+			return 0, errQuotaExceeded
+		}
+		if int64(len(p)) > left {
+			p = p[:left]
+		}
+	}
+	n, err := l.r.Read(p)
+	if n > 0 {
+		if l.quota != nil {
+			l.quota.used.Add(int64(n))
+		}
+		if l.limiter != nil {
+			if werr := l.limiter.WaitN(l.ctx, n); werr != nil && err == nil {
+				err = werr
+			}
+		}
+	}
+	return n, err
+}
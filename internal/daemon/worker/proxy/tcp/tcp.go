@@ -5,29 +5,64 @@ package tcp
 
 import (
 	"context"
+	"crypto/tls"
+	stderrors "errors"
 	"io"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
 	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/session"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
+// errHalfCloseUnsupported is returned by closeWrite for connections which
+// can't be half-closed.
+var errHalfCloseUnsupported = stderrors.New("connection does not support half-close")
+
 func init() {
 	err := proxy.RegisterHandler(proxy.TcpHandlerName, handleProxy)
 	if err != nil {
 		panic(err)
 	}
+	if err := proxy.RegisterProtocolContext(proxy.TcpHandlerName, &pbs.TcpProtocolContext{}); err != nil {
+		panic(err)
+	}
 }
 
 // handleProxy creates a tcp proxy between the incoming conn and the
-// connection created by the ProxyDialer.
+// connection created by the ProxyDialer.  If the RecordingManager is a
+// proxy.ConnectionRecordingManager and the connection's session is being
+// recorded, the data copied in each direction is also written to the
+// connection's recorder.
+//
+// If the protocol context is a TcpProtocolContext, the rate of the data copied
+// in both directions is limited to its bandwidth limit and the data is counted
+// against its session byte quota, which is shared by the connections of the
+// session proxied by this worker.  Once the quota is used the connection is
+// closed and, if conn is a proxy.ClosedReasonSetter, its closed reason is set
+// to session.ConnectionQuotaExceeded.  If the TcpProtocolContext has an idle
+// timeout, the connection is closed once no data has been copied in either
+// direction for that long and its closed reason is set to
+// session.ConnectionIdleTimeout.
+//
+// If the TcpProtocolContext terminates TLS, a TLS connection to the endpoint
+// is established before handleProxy returns and the client's TLS connection
+// is terminated with a certificate issued by the session's certificate once
+// the ProxyConnFn is called.  The data is then copied, and recorded, in the
+// clear between the two TLS connections.
 //
 // handleProxy returns a ProxyConnFn which starts the copy between the
-// connections and blocks until an error (EOF on happy path) is received on
-// either connection.
-func handleProxy(controlCtx context.Context, _ context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, _ *anypb.Any, _ proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+// connections and blocks until both directions are done.  When one side
+// closes its half of the connection, the other side's write half is closed so
+// protocols which half-close keep receiving data in the other direction.  If
+// the other side doesn't support half-closing, or a copy fails, both
+// connections are closed.
+func handleProxy(controlCtx context.Context, dataCtx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, pc *anypb.Any, rm proxy.RecordingManager) (proxy.ProxyConnFn, error) {
 	const op = "tcp.HandleProxy"
 	switch {
 	case conn == nil:
@@ -37,26 +72,157 @@ func handleProxy(controlCtx context.Context, _ context.Context, _ proxy.DecryptF
 	case len(connId) == 0:
 		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "connection id is empty")
 	}
+
+	tcpCtx := &pbs.TcpProtocolContext{}
+	if pc != nil && pc.MessageIs(tcpCtx) {
+		if err := pc.UnmarshalTo(tcpCtx); err != nil {
+			return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to unmarshal tcp protocol context"))
+		}
+	}
+
+	var endpointCfg, clientCfg *tls.Config
+	if tcpCtx.GetTerminateTls() {
+		var err error
+		if endpointCfg, err = endpointTlsConfig(controlCtx, tcpCtx); err != nil {
+			return nil, errors.Wrap(controlCtx, err, op)
+		}
+		if clientCfg, err = clientTlsConfig(controlCtx, tcpCtx); err != nil {
+			return nil, errors.Wrap(controlCtx, err, op)
+		}
+	}
+
+	var recorder proxy.ConnectionRecorder
+	if crm, ok := rm.(proxy.ConnectionRecordingManager); ok {
+		var err error
+		recorder, err = crm.NewConnectionRecorder(controlCtx, connId)
+		if err != nil {
+			return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to record connection"))
+		}
+	}
+
 	remoteConn, err := out.Dial(controlCtx)
 	if err != nil {
+		if recorder != nil {
+			_ = recorder.Close(controlCtx)
+		}
 		return nil, err
 	}
+	if endpointCfg != nil {
+		tlsConn := tls.Client(remoteConn, endpointCfg)
+		if err := tlsConn.HandshakeContext(controlCtx); err != nil {
+			_ = remoteConn.Close()
+			if recorder != nil {
+				_ = recorder.Close(controlCtx)
+			}
+			return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to establish tls connection to endpoint"))
+		}
+		writeEndpointTlsEvent(controlCtx, connId, tlsConn.ConnectionState())
+		remoteConn = tlsConn
+	}
 
 	return func() {
+		if recorder != nil {
+			defer func() {
+				if err := recorder.Close(controlCtx); err != nil {
+					event.WriteError(dataCtx, op, err, event.WithInfoMsg("unable to close connection recording", "connection_id", connId))
+				}
+			}()
+		}
+
+		// The connection's closed reason is still set on conn when its TLS
+		// is terminated.
+		clientConn := conn
+		if clientCfg != nil {
+			tlsConn := tls.Server(conn, clientCfg)
+			if err := tlsConn.HandshakeContext(dataCtx); err != nil {
+				event.WriteError(dataCtx, op, err, event.WithInfoMsg("unable to terminate client tls connection", "connection_id", connId))
+				_ = conn.Close()
+				_ = remoteConn.Close()
+				return
+			}
+			writeClientTlsEvent(dataCtx, connId, tlsConn.ConnectionState())
+			clientConn = tlsConn
+		}
+
+		var fromClient, fromEndpoint io.Reader = clientConn, remoteConn
+		if recorder != nil {
+			// A failure to record the data ends the copy, closing the
+			// connection.
+			fromClient = io.TeeReader(clientConn, recorder.Inbound())
+			fromEndpoint = io.TeeReader(remoteConn, recorder.Outbound())
+		}
+
+		if tcpCtx.GetBandwidthLimit() > 0 || tcpCtx.GetSessionByteQuota() > 0 {
+			var quota *sessionQuota
+			if tcpCtx.GetSessionByteQuota() > 0 {
+				var release func()
+				quota, release = acquireQuota(tcpCtx.GetSessionId(), tcpCtx.GetSessionByteQuota(), tcpCtx.GetSessionBytesUsed())
+				defer release()
+			}
+			// Both directions share the connection's bandwidth limit.
+			limiter := newLimiter(tcpCtx.GetBandwidthLimit())
+			fromClient = &limitedReader{ctx: dataCtx, r: fromClient, limiter: limiter, quota: quota}
+			fromEndpoint = &limitedReader{ctx: dataCtx, r: fromEndpoint, limiter: limiter, quota: quota}
+		}
+
+		closeConns := func() {
+			_ = clientConn.Close()
+			_ = remoteConn.Close()
+		}
+
+		var reasonOnce sync.Once
+		setClosedReason := func(reason session.ClosedReason, msg string) {
+			reasonOnce.Do(func() {
+				if crs, ok := conn.(proxy.ClosedReasonSetter); ok {
+					crs.SetClosedReason(reason)
+				}
+				event.WriteSysEvent(dataCtx, op, msg, "connection_id", connId, "session_id", tcpCtx.GetSessionId())
+			})
+		}
+
+		if tcpCtx.GetIdleTimeoutSeconds() > 0 {
+			timer := newIdleTimer(time.Duration(tcpCtx.GetIdleTimeoutSeconds())*time.Second, func() {
+				setClosedReason(session.ConnectionIdleTimeout, "closing connection, idle timeout reached")
+				closeConns()
+			})
+			defer timer.stop()
+			fromClient = &idleReader{r: fromClient, timer: timer}
+			fromEndpoint = &idleReader{r: fromEndpoint, timer: timer}
+		}
+
+		// copyData copies from src to dst and closes dst's write half once src
+		// is done.
+		copyData := func(dst net.Conn, src io.Reader) {
+			_, err := io.Copy(dst, src)
+			if stderrors.Is(err, errQuotaExceeded) {
+				setClosedReason(session.ConnectionQuotaExceeded, "closing connection, session byte quota exceeded")
+			}
+			if err != nil || closeWrite(dst) != nil {
+				closeConns()
+			}
+		}
+
 		connWg := new(sync.WaitGroup)
 		connWg.Add(2)
 		go func() {
 			defer connWg.Done()
-			_, _ = io.Copy(conn, remoteConn)
-			_ = conn.Close()
-			_ = remoteConn.Close()
+			copyData(clientConn, fromEndpoint)
 		}()
 		go func() {
 			defer connWg.Done()
-			_, _ = io.Copy(remoteConn, conn)
-			_ = remoteConn.Close()
-			_ = conn.Close()
+			copyData(remoteConn, fromClient)
 		}()
 		connWg.Wait()
+		closeConns()
 	}, nil
 }
+
+// closeWrite closes the write half of conn.  It returns an error if conn
+// doesn't support half-closing.
+func closeWrite(conn net.Conn) error {
+	cw, ok := conn.(interface{ CloseWrite() error })
+	if !ok {
+		return errHalfCloseUnsupported
+	}
+	return cw.CloseWrite()
+}
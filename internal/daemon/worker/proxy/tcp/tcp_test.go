@@ -4,10 +4,13 @@
 package tcp
 
 import (
+	"bytes"
 	"context"
 	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/x509"
+	"errors"
+	"io"
 	"math/big"
 	"net"
 	"sync"
@@ -17,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
 	"github.com/hashicorp/boundary/internal/daemon/worker/session"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	intsession "github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -225,3 +229,350 @@ func createTestCert(t *testing.T) ([]byte, ed25519.PublicKey, ed25519.PrivateKey
 
 	return certBytes, pub, priv
 }
+
+type testRecorder struct {
+	mu       sync.Mutex
+	inbound  bytes.Buffer
+	outbound bytes.Buffer
+	closed   bool
+}
+
+func (r *testRecorder) Inbound() io.Writer  { return writerFunc(r.writeTo(&r.inbound)) }
+func (r *testRecorder) Outbound() io.Writer { return writerFunc(r.writeTo(&r.outbound)) }
+
+func (r *testRecorder) writeTo(b *bytes.Buffer) func([]byte) (int, error) {
+	return func(p []byte) (int, error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return b.Write(p)
+	}
+}
+
+func (r *testRecorder) Close(context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	return nil
+}
+
+type writerFunc func([]byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
+
+type testRecordingManager struct {
+	recorder *testRecorder
+	err      error
+}
+
+func (m *testRecordingManager) NewConnectionRecorder(context.Context, string) (proxy.ConnectionRecorder, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.recorder, nil
+}
+
+func TestHandleProxy_Recording(t *testing.T) {
+	ctx := context.Background()
+	require, assert := require.New(t), assert.New(t)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() {
+		l.Close()
+	})
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		_, _ = io.Copy(c, c)
+	}()
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", l.Addr().String())
+	})
+	require.NoError(err)
+
+	t.Run("error creating recorder", func(t *testing.T) {
+		c, _ := net.Pipe()
+		fn, err := handleProxy(ctx, ctx, nil, c, dialer, "someconnectionid", nil, &testRecordingManager{err: errors.New("recording error")})
+		assert.Error(err)
+		assert.Nil(fn)
+	})
+
+	t.Run("records both directions", func(t *testing.T) {
+		clientConn, proxyConn := net.Pipe()
+		rec := &testRecorder{}
+		fn, err := handleProxy(ctx, ctx, nil, proxyConn, dialer, "someconnectionid", nil, &testRecordingManager{recorder: rec})
+		require.NoError(err)
+		require.NotNil(fn)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			fn()
+		}()
+
+		_, err = clientConn.Write([]byte("ping"))
+		require.NoError(err)
+		got := make([]byte, 4)
+		_, err = io.ReadFull(clientConn, got)
+		require.NoError(err)
+		assert.Equal("ping", string(got))
+		require.NoError(clientConn.Close())
+		<-done
+
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		assert.Equal("ping", rec.inbound.String())
+		assert.Equal("ping", rec.outbound.String())
+		assert.True(rec.closed)
+	})
+}
+
+type closedReasonConn struct {
+	net.Conn
+	mu     sync.Mutex
+	reason intsession.ClosedReason
+}
+
+func (c *closedReasonConn) SetClosedReason(r intsession.ClosedReason) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reason = r
+}
+
+func (c *closedReasonConn) closedReason() intsession.ClosedReason {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.reason
+}
+
+func TestHandleProxy_SessionByteQuota(t *testing.T) {
+	ctx := context.Background()
+	require, assert := require.New(t), assert.New(t)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() {
+		l.Close()
+	})
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				_, _ = io.Copy(c, c)
+			}()
+		}
+	}()
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", l.Addr().String())
+	})
+	require.NoError(err)
+
+	// 2 bytes were used by an earlier connection, leaving 8 for a "ping"
+	// sent to the endpoint and echoed back.
+	pc, err := anypb.New(&pbs.TcpProtocolContext{
+		SessionId:        "s_1234567890",
+		SessionByteQuota: 10,
+		SessionBytesUsed: 2,
+	})
+	require.NoError(err)
+
+	clientConn, proxyConn := net.Pipe()
+	crConn := &closedReasonConn{Conn: proxyConn}
+	fn, err := handleProxy(ctx, ctx, nil, crConn, dialer, "someconnectionid", pc, nil)
+	require.NoError(err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	_, err = clientConn.Write([]byte("ping"))
+	require.NoError(err)
+	got := make([]byte, 4)
+	_, err = io.ReadFull(clientConn, got)
+	require.NoError(err)
+	assert.Equal("ping", string(got))
+
+	// The quota is used up so the connection is closed.
+	_, err = clientConn.Read(got)
+	assert.Error(err)
+	<-done
+	assert.Equal(intsession.ConnectionQuotaExceeded, crConn.closedReason())
+
+	quotasMu.Lock()
+	defer quotasMu.Unlock()
+	assert.Empty(quotas)
+}
+
+func TestHandleProxy_BandwidthLimit(t *testing.T) {
+	ctx := context.Background()
+	require, assert := require.New(t), assert.New(t)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() {
+		l.Close()
+	})
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		_, _ = io.Copy(io.Discard, c)
+	}()
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", l.Addr().String())
+	})
+	require.NoError(err)
+
+	pc, err := anypb.New(&pbs.TcpProtocolContext{
+		SessionId:      "s_1234567890",
+		BandwidthLimit: 10_000,
+	})
+	require.NoError(err)
+
+	clientConn, proxyConn := net.Pipe()
+	crConn := &closedReasonConn{Conn: proxyConn}
+	fn, err := handleProxy(ctx, ctx, nil, crConn, dialer, "someconnectionid", pc, nil)
+	require.NoError(err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	// The first 10,000 bytes are allowed at once, the next 5,000 take half a
+	// second so the proxy only reads the last byte after that.
+	start := time.Now()
+	_, err = clientConn.Write(make([]byte, 15_000))
+	require.NoError(err)
+	_, err = clientConn.Write([]byte{0})
+	require.NoError(err)
+	assert.GreaterOrEqual(time.Since(start), 400*time.Millisecond)
+	require.NoError(clientConn.Close())
+	<-done
+	assert.Empty(crConn.closedReason())
+}
+
+func TestHandleProxy_IdleTimeout(t *testing.T) {
+	ctx := context.Background()
+	require, assert := require.New(t), assert.New(t)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() {
+		l.Close()
+	})
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		_, _ = io.Copy(c, c)
+	}()
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", l.Addr().String())
+	})
+	require.NoError(err)
+
+	pc, err := anypb.New(&pbs.TcpProtocolContext{
+		SessionId:          "s_1234567890",
+		IdleTimeoutSeconds: 1,
+	})
+	require.NoError(err)
+
+	clientConn, proxyConn := net.Pipe()
+	crConn := &closedReasonConn{Conn: proxyConn}
+	fn, err := handleProxy(ctx, ctx, nil, crConn, dialer, "someconnectionid", pc, nil)
+	require.NoError(err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	// Data proxied before the timeout keeps the connection open.
+	start := time.Now()
+	got := make([]byte, 4)
+	for i := 0; i < 3; i++ {
+		time.Sleep(500 * time.Millisecond)
+		_, err = clientConn.Write([]byte("ping"))
+		require.NoError(err)
+		_, err = io.ReadFull(clientConn, got)
+		require.NoError(err)
+		assert.Equal("ping", string(got))
+	}
+
+	// The connection is closed once it is idle for a second.
+	_, err = clientConn.Read(got)
+	assert.Error(err)
+	<-done
+	assert.GreaterOrEqual(time.Since(start), 2500*time.Millisecond)
+	assert.Equal(intsession.ConnectionIdleTimeout, crConn.closedReason())
+}
+
+func TestHandleProxy_HalfClose(t *testing.T) {
+	ctx := context.Background()
+	require, assert := require.New(t), assert.New(t)
+
+	// The endpoint only responds once the client is done sending.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() {
+		l.Close()
+	})
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		req, err := io.ReadAll(c)
+		if err != nil {
+			return
+		}
+		_, _ = c.Write(append([]byte("pong:"), req...))
+	}()
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", l.Addr().String())
+	})
+	require.NoError(err)
+
+	// The client's connection to the worker must support half-closing.
+	cl, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() {
+		cl.Close()
+	})
+	clientConn, err := net.Dial("tcp", cl.Addr().String())
+	require.NoError(err)
+	t.Cleanup(func() {
+		clientConn.Close()
+	})
+	proxyConn, err := cl.Accept()
+	require.NoError(err)
+
+	fn, err := handleProxy(ctx, ctx, nil, proxyConn, dialer, "someconnectionid", nil, nil)
+	require.NoError(err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	_, err = clientConn.Write([]byte("ping"))
+	require.NoError(err)
+	require.NoError(clientConn.(*net.TCPConn).CloseWrite())
+	got, err := io.ReadAll(clientConn)
+	require.NoError(err)
+	assert.Equal("pong:ping", string(got))
+	<-done
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tcp
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+)
+
+// tlsVersions maps the minimum TLS versions a tcp target can require from its
+// endpoints to their crypto/tls version.
+var tlsVersions = map[string]uint16{
+	"":    tls.VersionTLS12,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// tlsVersionName returns the name of a crypto/tls version for events.
+func tlsVersionName(v uint16) string {
+	switch v {
+	case tls.VersionTLS10:
+		return "1.0"
+	case tls.VersionTLS11:
+		return "1.1"
+	case tls.VersionTLS12:
+		return "1.2"
+	case tls.VersionTLS13:
+		return "1.3"
+	default:
+		return fmt.Sprintf("0x%04x", v)
+	}
+}
+
+// endpointTlsConfig returns the configuration of the TLS connection the
+// worker opens to the endpoint.  The endpoint's certificate is verified for
+// the protocol context's server name, or the endpoint's host if it isn't set,
+// against its certificate authorities, or the system's if none are set.  If a
+// json credential holding a "certificate" and a "private_key" is injected, it
+// is presented as the worker's client certificate.
+func endpointTlsConfig(ctx context.Context, tcpCtx *pbs.TcpProtocolContext) (*tls.Config, error) {
+	const op = "tcp.endpointTlsConfig"
+	minVersion, ok := tlsVersions[tcpCtx.GetTlsMinVersion()]
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported tls min version %q", tcpCtx.GetTlsMinVersion()))
+	}
+	serverName := tcpCtx.GetTlsServerName()
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(tcpCtx.GetHost())
+		if err != nil {
+			host = tcpCtx.GetHost()
+		}
+		serverName = host
+	}
+	if serverName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "protocol context is missing the endpoint host")
+	}
+	cfg := &tls.Config{
+		ServerName: serverName,
+		MinVersion: minVersion,
+	}
+	if tcpCtx.GetTlsCaCertificates() != "" {
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM([]byte(tcpCtx.GetTlsCaCertificates())) {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "tls ca certificates contain no valid PEM certificates")
+		}
+	}
+	cert, err := clientCertificate(ctx, tcpCtx.GetInjectedCredentials())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if cert != nil {
+		cfg.Certificates = []tls.Certificate{*cert}
+	}
+	return cfg, nil
+}
+
+// clientCertificate returns the certificate the worker presents to the
+// endpoint from the injected credentials.  At most one credential can be
+// injected and it must be a json credential holding the PEM encoded
+// "certificate" and "private_key".  If none is, nil is returned.
+func clientCertificate(ctx context.Context, creds []*pbs.Credential) (*tls.Certificate, error) {
+	const op = "tcp.clientCertificate"
+	switch len(creds) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "at most one injected application credential is supported")
+	}
+	j := creds[0].GetJson()
+	if j == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "injected application credential must be a json credential")
+	}
+	var object struct {
+		Certificate string `json:"certificate"`
+		PrivateKey  string `json:"private_key"`
+	}
+	if err := json.Unmarshal(j.GetObject(), &object); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal json credential"))
+	}
+	if object.Certificate == "" || object.PrivateKey == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, `json credential must contain a "certificate" and a "private_key"`)
+	}
+	cert, err := tls.X509KeyPair([]byte(object.Certificate), []byte(object.PrivateKey))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse client certificate"))
+	}
+	return &cert, nil
+}
+
+// clientTlsConfig returns the configuration the worker uses to terminate the
+// client's TLS connection.  The certificate presented to the client was issued
+// for the connection by the session's TLS certificate authority, which the
+// client already has and can trust.
+func clientTlsConfig(ctx context.Context, tcpCtx *pbs.TcpProtocolContext) (*tls.Config, error) {
+	const op = "tcp.clientTlsConfig"
+	if _, err := x509.ParseCertificate(tcpCtx.GetTlsCaCertificate()); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse tls ca certificate"))
+	}
+	if _, err := x509.ParseCertificate(tcpCtx.GetTlsCertificate()); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse tls certificate"))
+	}
+	key, err := x509.ParsePKCS8PrivateKey(tcpCtx.GetPkcs8TlsKey())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse tls key"))
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{tcpCtx.GetTlsCertificate(), tcpCtx.GetTlsCaCertificate()},
+			PrivateKey:  key,
+		}},
+	}, nil
+}
+
+// writeEndpointTlsEvent writes a system event with the details of the TLS
+// connection to the endpoint and of the certificate it presented.
+func writeEndpointTlsEvent(ctx context.Context, connId string, state tls.ConnectionState) {
+	const op = "tcp.writeEndpointTlsEvent"
+	args := []any{
+		"connection_id", connId,
+		"server_name", state.ServerName,
+		"tls_version", tlsVersionName(state.Version),
+		"cipher_suite", tls.CipherSuiteName(state.CipherSuite),
+	}
+	if len(state.PeerCertificates) > 0 {
+		leaf := state.PeerCertificates[0]
+		fingerprint := sha256.Sum256(leaf.Raw)
+		args = append(args,
+			"certificate_subject", leaf.Subject.String(),
+			"certificate_issuer", leaf.Issuer.String(),
+			"certificate_not_after", leaf.NotAfter.Format(time.RFC3339),
+			"certificate_sha256", hex.EncodeToString(fingerprint[:]),
+		)
+	}
+	event.WriteSysEvent(ctx, op, "tls connection to endpoint established", args...)
+}
+
+// writeClientTlsEvent writes a system event with the details of the client's
+// TLS connection terminated by the worker.
+func writeClientTlsEvent(ctx context.Context, connId string, state tls.ConnectionState) {
+	const op = "tcp.writeClientTlsEvent"
+	event.WriteSysEvent(ctx, op, "client tls connection terminated",
+		"connection_id", connId,
+		"server_name", state.ServerName,
+		"tls_version", tlsVersionName(state.Version),
+		"cipher_suite", tls.CipherSuiteName(state.CipherSuite),
+		"negotiated_protocol", state.NegotiatedProtocol,
+	)
+}
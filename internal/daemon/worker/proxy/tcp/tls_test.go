@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tcp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// testCa returns a certificate authority like the TLS certificate authority of
+// a session, its PKCS #8 encoded key and its PEM encoding.
+func testCa(t *testing.T) (*x509.Certificate, []byte, []byte) {
+	t.Helper()
+	der, _, priv := createTestCert(t)
+	ca, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	return ca, pkcs8, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// testServerCertificate returns a DER encoded server certificate issued by ca
+// for serverName and its PKCS #8 encoded key.
+func testServerCertificate(t *testing.T, ca *x509.Certificate, caKey []byte, serverName string) ([]byte, []byte) {
+	t.Helper()
+	signer, err := x509.ParsePKCS8PrivateKey(caKey)
+	require.NoError(t, err)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: serverName},
+		DNSNames:     []string{serverName},
+		NotBefore:    time.Now().Add(-1 * time.Minute),
+		NotAfter:     time.Now().Add(5 * time.Minute),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, signer)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return der, keyDer
+}
+
+// testTlsCertificate returns a server certificate issued by ca for
+// serverName.
+func testTlsCertificate(t *testing.T, ca *x509.Certificate, caKey []byte, serverName string) tls.Certificate {
+	t.Helper()
+	der, keyDer := testServerCertificate(t, ca, caKey, serverName)
+	key, err := x509.ParsePKCS8PrivateKey(keyDer)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der, ca.Raw}, PrivateKey: key}
+}
+
+// testClientCredential returns a json credential holding a client certificate
+// issued by ca.
+func testClientCredential(t *testing.T, ca *x509.Certificate, caKey []byte) *pbs.Credential {
+	t.Helper()
+	signer, err := x509.ParsePKCS8PrivateKey(caKey)
+	require.NoError(t, err)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "boundary-worker"},
+		NotBefore:    time.Now().Add(-1 * time.Minute),
+		NotAfter:     time.Now().Add(5 * time.Minute),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, signer)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	object, err := json.Marshal(map[string]string{
+		"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		"private_key": string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})),
+	})
+	require.NoError(t, err)
+	return &pbs.Credential{Credential: &pbs.Credential_Json{Json: &pbs.Json{Object: object}}}
+}
+
+func TestEndpointTlsConfig(t *testing.T) {
+	ctx := context.Background()
+	ca, caKey, caPem := testCa(t)
+
+	cases := []struct {
+		name           string
+		tcpCtx         *pbs.TcpProtocolContext
+		wantServerName string
+		wantMinVersion uint16
+		wantClientCert bool
+		wantErr        bool
+	}{
+		{
+			name:           "host",
+			tcpCtx:         &pbs.TcpProtocolContext{Host: "db.example.com:5432"},
+			wantServerName: "db.example.com",
+			wantMinVersion: tls.VersionTLS12,
+		},
+		{
+			name: "server name",
+			tcpCtx: &pbs.TcpProtocolContext{
+				Host:          "10.0.0.1:5432",
+				TlsServerName: "db.example.com",
+				TlsMinVersion: "1.3",
+			},
+			wantServerName: "db.example.com",
+			wantMinVersion: tls.VersionTLS13,
+		},
+		{
+			name: "client certificate",
+			tcpCtx: &pbs.TcpProtocolContext{
+				Host:                "db.example.com:5432",
+				TlsCaCertificates:   string(caPem),
+				InjectedCredentials: []*pbs.Credential{testClientCredential(t, ca, caKey)},
+			},
+			wantServerName: "db.example.com",
+			wantMinVersion: tls.VersionTLS12,
+			wantClientCert: true,
+		},
+		{
+			name:    "missing host",
+			tcpCtx:  &pbs.TcpProtocolContext{},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported min version",
+			tcpCtx:  &pbs.TcpProtocolContext{Host: "db.example.com:5432", TlsMinVersion: "1.1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid ca certificates",
+			tcpCtx:  &pbs.TcpProtocolContext{Host: "db.example.com:5432", TlsCaCertificates: "not a certificate"},
+			wantErr: true,
+		},
+		{
+			name: "not a json credential",
+			tcpCtx: &pbs.TcpProtocolContext{
+				Host: "db.example.com:5432",
+				InjectedCredentials: []*pbs.Credential{
+					{Credential: &pbs.Credential_UsernamePassword{UsernamePassword: &pbs.UsernamePassword{Username: "user", Password: "pass"}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "json credential without certificate",
+			tcpCtx: &pbs.TcpProtocolContext{
+				Host: "db.example.com:5432",
+				InjectedCredentials: []*pbs.Credential{
+					{Credential: &pbs.Credential_Json{Json: &pbs.Json{Object: []byte(`{"username":"user"}`)}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many credentials",
+			tcpCtx: &pbs.TcpProtocolContext{
+				Host:                "db.example.com:5432",
+				InjectedCredentials: []*pbs.Credential{testClientCredential(t, ca, caKey), testClientCredential(t, ca, caKey)},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := endpointTlsConfig(ctx, tc.tcpCtx)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, cfg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantServerName, cfg.ServerName)
+			assert.Equal(t, tc.wantMinVersion, cfg.MinVersion)
+			assert.Equal(t, tc.tcpCtx.GetTlsCaCertificates() != "", cfg.RootCAs != nil)
+			assert.Equal(t, tc.wantClientCert, len(cfg.Certificates) == 1)
+		})
+	}
+}
+
+func TestClientTlsConfig(t *testing.T) {
+	ctx := context.Background()
+	ca, caKey, _ := testCa(t)
+	cert, key := testServerCertificate(t, ca, caKey, "db.example.com")
+
+	cfg, err := clientTlsConfig(ctx, &pbs.TcpProtocolContext{TlsCaCertificate: ca.Raw, TlsCertificate: cert, Pkcs8TlsKey: key})
+	require.NoError(t, err)
+	require.Len(t, cfg.Certificates, 1)
+	assert.Equal(t, [][]byte{cert, ca.Raw}, cfg.Certificates[0].Certificate)
+
+	for name, tcpCtx := range map[string]*pbs.TcpProtocolContext{
+		"missing ca certificate": {TlsCertificate: cert, Pkcs8TlsKey: key},
+		"missing certificate":    {TlsCaCertificate: ca.Raw, Pkcs8TlsKey: key},
+		"missing key":            {TlsCaCertificate: ca.Raw, TlsCertificate: cert},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := clientTlsConfig(ctx, tcpCtx)
+			assert.Error(t, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestHandleProxy_TerminateTls(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sessionCa, sessionCaKey, sessionPem := testCa(t)
+	sessionCert, sessionKey := testServerCertificate(t, sessionCa, sessionCaKey, "localhost")
+	endpointCa, endpointCaKey, endpointCaPem := testCa(t)
+
+	// The endpoint requires a client certificate issued by its certificate
+	// authority and echoes the data it receives.
+	endpointCert := testTlsCertificate(t, endpointCa, endpointCaKey, "db.example.com")
+	clientCas := x509.NewCertPool()
+	clientCas.AddCert(endpointCa)
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{endpointCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCas,
+		MinVersion:   tls.VersionTLS13,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	clientCn := make(chan string, 1)
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		tlsConn := c.(*tls.Conn)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return
+		}
+		clientCn <- tlsConn.ConnectionState().PeerCertificates[0].Subject.CommonName
+		_, _ = io.Copy(c, c)
+	}()
+
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", l.Addr().String())
+	})
+	require.NoError(t, err)
+	pc, err := anypb.New(&pbs.TcpProtocolContext{
+		SessionId:           "s_1234567890",
+		TerminateTls:        true,
+		Host:                l.Addr().String(),
+		TlsServerName:       "db.example.com",
+		TlsCaCertificates:   string(endpointCaPem),
+		TlsMinVersion:       "1.3",
+		InjectedCredentials: []*pbs.Credential{testClientCredential(t, endpointCa, endpointCaKey)},
+		TlsCaCertificate:    sessionCa.Raw,
+		TlsCertificate:      sessionCert,
+		Pkcs8TlsKey:         sessionKey,
+	})
+	require.NoError(t, err)
+
+	clientConn, proxyConn := net.Pipe()
+	fn, err := handleProxy(ctx, ctx, nil, proxyConn, dialer, "someconnectionid", pc, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "boundary-worker", <-clientCn)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(sessionPem))
+	client := tls.Client(clientConn, &tls.Config{RootCAs: roots, ServerName: "localhost"})
+	_, err = client.Write([]byte("hello"))
+	require.NoError(t, err)
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(client, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(buf))
+
+	require.NoError(t, client.Close())
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("proxy did not return after the client closed the connection")
+	}
+}
+
+func TestHandleProxy_TerminateTlsUntrustedEndpoint(t *testing.T) {
+	ctx := context.Background()
+	sessionCa, sessionCaKey, _ := testCa(t)
+	sessionCert, sessionKey := testServerCertificate(t, sessionCa, sessionCaKey, "localhost")
+	endpointCa, endpointCaKey, _ := testCa(t)
+	_, _, otherCaPem := testCa(t)
+	endpointCert := testTlsCertificate(t, endpointCa, endpointCaKey, "db.example.com")
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{endpointCert}})
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		_ = c.(*tls.Conn).Handshake()
+	}()
+
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", l.Addr().String())
+	})
+	require.NoError(t, err)
+	pc, err := anypb.New(&pbs.TcpProtocolContext{
+		TerminateTls:      true,
+		Host:              l.Addr().String(),
+		TlsServerName:     "db.example.com",
+		TlsCaCertificates: string(otherCaPem),
+		TlsCaCertificate:  sessionCa.Raw,
+		TlsCertificate:    sessionCert,
+		Pkcs8TlsKey:       sessionKey,
+	})
+	require.NoError(t, err)
+
+	_, proxyConn := net.Pipe()
+	fn, err := handleProxy(ctx, ctx, nil, proxyConn, dialer, "someconnectionid", pc, nil)
+	assert.Error(t, err)
+	assert.Nil(t, fn)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package udp provides the worker proxy handler for udp targets.  Each proxied
+// connection carries the datagrams of a single client flow, that is of a
+// single source address on the client's local listener, framed with
+// proxy.WriteDatagram.  The handler relays them to and from a udp socket
+// connected to the endpoint.
+package udp
+
+import (
+	"context"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	dgram "github.com/hashicorp/boundary/internal/proxy"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// HandlerName is the protocol the udp proxy handler is registered for.
+const HandlerName = "udp"
+
+// IdleTimeout is how long a flow is kept open without a datagram being relayed
+// in either direction.
+var IdleTimeout = 2 * time.Minute
+
+func init() {
+	if err := proxy.RegisterHandler(HandlerName, handleProxy); err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy creates a udp relay between the incoming conn and a udp socket
+// connected to the endpoint through the ProxyDialer.  The socket is connected
+// before handleProxy returns.
+//
+// handleProxy returns a ProxyConnFn which relays the datagrams framed on conn
+// to the endpoint and the datagrams received from the endpoint back over conn
+// until either side fails or no datagram is relayed for IdleTimeout, in which
+// case both are closed.
+func handleProxy(controlCtx context.Context, dataCtx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, _ *anypb.Any, _ proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+	const op = "udp.handleProxy"
+	switch {
+	case conn == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "conn is nil")
+	case out == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "proxy dialer is nil")
+	case len(connId) == 0:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "connection id is empty")
+	}
+
+	remoteConn, err := out.Dial(controlCtx, proxy.WithDialNetwork("udp"))
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		var closeOnce sync.Once
+		closeBoth := func() {
+			closeOnce.Do(func() {
+				_ = conn.Close()
+				_ = remoteConn.Close()
+			})
+		}
+		idle := time.AfterFunc(IdleTimeout, func() {
+			event.WriteSysEvent(dataCtx, op, "closing idle udp flow", "connection_id", connId)
+			closeBoth()
+		})
+		defer idle.Stop()
+
+		connWg := new(sync.WaitGroup)
+		connWg.Add(2)
+		go func() {
+			defer connWg.Done()
+			defer closeBoth()
+			buf := make([]byte, dgram.MaxDatagramSize)
+			for {
+				d, err := dgram.ReadDatagram(conn, buf)
+				if err != nil {
+					return
+				}
+				idle.Reset(IdleTimeout)
+				// A datagram the endpoint refuses, for example because
+				// nothing listens on its port, is dropped as it would be
+				// without the relay.
+				if _, err := remoteConn.Write(d); err != nil && !isRefused(err) {
+					return
+				}
+			}
+		}()
+		go func() {
+			defer connWg.Done()
+			defer closeBoth()
+			buf := make([]byte, dgram.MaxDatagramSize)
+			for {
+				n, err := remoteConn.Read(buf)
+				if err != nil {
+					if isRefused(err) {
+						continue
+					}
+					return
+				}
+				idle.Reset(IdleTimeout)
+				if err := dgram.WriteDatagram(conn, buf[:n]); err != nil {
+					return
+				}
+			}
+		}()
+		connWg.Wait()
+	}, nil
+}
+
+// isRefused reports whether err is the connection refused error reported on a
+// connected udp socket after the endpoint answered a datagram with an ICMP port
+// unreachable message, which doesn't prevent relaying later datagrams.
+func isRefused(err error) bool {
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package udp
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	dgram "github.com/hashicorp/boundary/internal/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoServer starts a udp server sending every datagram back to its sender
+// with the prefix "echo: " and returns its address.
+func echoServer(t *testing.T) string {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { pc.Close() })
+	go func() {
+		buf := make([]byte, dgram.MaxDatagramSize)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = pc.WriteTo(append([]byte("echo: "), buf[:n]...), addr)
+		}
+	}()
+	return pc.LocalAddr().String()
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	ctx := context.Background()
+	c, _ := net.Pipe()
+	dialer, err := proxy.GetEndpointDialer(ctx, echoServer(t), "", nil, nil)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name      string
+		conn      net.Conn
+		dialer    *proxy.ProxyDialer
+		connId    string
+		wantError bool
+	}{
+		{
+			name:   "valid",
+			conn:   c,
+			dialer: dialer,
+			connId: "someconnectionid",
+		},
+		{
+			name:      "nil connection",
+			dialer:    dialer,
+			connId:    "someconnectionid",
+			wantError: true,
+		},
+		{
+			name:      "nil dialer",
+			conn:      c,
+			connId:    "someconnectionid",
+			wantError: true,
+		},
+		{
+			name:      "no connection id",
+			conn:      c,
+			dialer:    dialer,
+			wantError: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fn, err := handleProxy(ctx, ctx, nil, tc.conn, tc.dialer, tc.connId, nil, nil)
+			if tc.wantError {
+				assert.Error(t, err)
+				assert.Nil(t, fn)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, fn)
+		})
+	}
+}
+
+func TestHandleProxy(t *testing.T) {
+	ctx := context.Background()
+	endpoint := echoServer(t)
+	dialer, err := proxy.GetEndpointDialer(ctx, endpoint, "", nil, nil)
+	require.NoError(t, err)
+
+	client, conn := net.Pipe()
+	defer client.Close()
+	fn, err := handleProxy(ctx, ctx, nil, conn, dialer, "someconnectionid", nil, nil)
+	require.NoError(t, err)
+	addr := dialer.LastConnectionAddr()
+	assert.Equal(t, endpoint, net.JoinHostPort(addr.Ip(), strconv.Itoa(int(addr.Port()))))
+
+	done := make(chan struct{})
+	go func() {
+		fn()
+		close(done)
+	}()
+
+	buf := make([]byte, dgram.MaxDatagramSize)
+	for _, msg := range []string{"first", "second", ""} {
+		require.NoError(t, dgram.WriteDatagram(client, []byte(msg)))
+		got, err := dgram.ReadDatagram(client, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "echo: "+msg, string(got))
+	}
+
+	require.NoError(t, client.Close())
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("relay did not stop after the client closed the connection")
+	}
+}
+
+func TestHandleProxy_IdleTimeout(t *testing.T) {
+	ctx := context.Background()
+	oldTimeout := IdleTimeout
+	IdleTimeout = 100 * time.Millisecond
+	t.Cleanup(func() { IdleTimeout = oldTimeout })
+
+	dialer, err := proxy.GetEndpointDialer(ctx, echoServer(t), "", nil, nil)
+	require.NoError(t, err)
+	client, conn := net.Pipe()
+	defer client.Close()
+	fn, err := handleProxy(ctx, ctx, nil, conn, dialer, "someconnectionid", nil, nil)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		fn()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("idle flow was not closed")
+	}
+	_, err = dgram.ReadDatagram(client, make([]byte, dgram.MaxDatagramSize))
+	assert.Error(t, err)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/hashicorp/boundary/internal/storage/local"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+)
+
+func init() {
+	recordingStorageFactory = newLocalRecordingStorage
+	recorderManagerFactory = newRecorderManager
+}
+
+// newLocalRecordingStorage returns recording storage which writes the
+// contents of storage buckets to directories within the worker's recording
+// storage path, which can be a mounted network filesystem.
+func newLocalRecordingStorage(ctx context.Context, path string, plgClients map[string]plgpb.StoragePluginServiceClient, _ bool) (storage.RecordingStorage, error) {
+	return local.NewRecordingStorage(ctx, path, plgClients)
+}
+
+// newRecorderManager returns the recording manager used to record the
+// connections of sessions with session recording enabled.  The manager is
+// created even when the worker has no recording storage so that connections
+// for recorded sessions fail instead of being proxied without a recording.
+func newRecorderManager(w *Worker) (recorderManager, error) {
+	return recording.NewManager(
+		w.baseContext,
+		func() session.Manager { return w.sessionManager },
+		w.recordingFS,
+		func() string {
+			if s := w.LastStatusSuccess(); s != nil {
+				return s.GetWorkerId()
+			}
+			return ""
+		},
+	)
+}
+
+// recordingFS returns a storage.FS which writes recordings to the provided
+// storage bucket using the worker's recording storage.
+func (w *Worker) recordingFS(ctx context.Context, bucket *storagebuckets.StorageBucket) (storage.FS, error) {
+	const op = "worker.(Worker).recordingFS"
+	if w.RecordingStorage == nil {
+		return nil, errors.New(ctx, errors.Internal, op, "worker does not have recording storage configured")
+	}
+	return w.RecordingStorage.NewSyncingFS(ctx, bucket)
+}
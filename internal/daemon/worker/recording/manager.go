@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package recording provides the worker's session recording manager. The
+// manager records the connections of sessions whose targets have session
+// recording enabled into a bsr written to the session's storage bucket.
+package recording
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	"github.com/hashicorp/boundary/version"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// SessionManagerFn returns the worker's session manager.  It is called each
+// time a connection recorder is requested since the worker creates its
+// session manager after the recording manager.
+type SessionManagerFn func() session.Manager
+
+// FSFn returns the storage.FS used to write recordings to the provided
+// storage bucket.
+type FSFn func(context.Context, *storagebuckets.StorageBucket) (storage.FS, error)
+
+// WorkerIdFn returns the id of the worker the recordings are made on.
+type WorkerIdFn func() string
+
+// Manager records the tcp connections of sessions which have a session
+// recording.  A bsr session is created for a session when its first
+// connection is recorded and is closed once the controller reports the
+// session is no longer active and all of its recorded connections are
+// closed.  Manager is safe for concurrent use.
+type Manager struct {
+	sessionManagerFn SessionManagerFn
+	fsFn             FSFn
+	workerIdFn       WorkerIdFn
+
+	mu       sync.Mutex
+	sessions map[string]*sessionRecorder
+	shutdown bool
+}
+
+var _ proxy.ConnectionRecordingManager = (*Manager)(nil)
+
+// NewManager returns a Manager which looks up sessions using the session
+// manager returned by smFn and writes recordings to the storage.FS returned
+// by fsFn.
+func NewManager(ctx context.Context, smFn SessionManagerFn, fsFn FSFn, workerIdFn WorkerIdFn) (*Manager, error) {
+	const op = "recording.NewManager"
+	switch {
+	case smFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session manager function")
+	case fsFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing fs function")
+	case workerIdFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing worker id function")
+	}
+	return &Manager{
+		sessionManagerFn: smFn,
+		fsFn:             fsFn,
+		workerIdFn:       workerIdFn,
+		sessions:         make(map[string]*sessionRecorder),
+	}, nil
+}
+
+// NewConnectionRecorder returns a recorder for the provided connection.  If
+// the connection's session is not being recorded nil is returned.
+func (m *Manager) NewConnectionRecorder(ctx context.Context, connectionId string) (proxy.ConnectionRecorder, error) {
+	const op = "recording.(Manager).NewConnectionRecorder"
+	if connectionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+	}
+	sm := m.sessionManagerFn()
+	if sm == nil {
+		return nil, errors.New(ctx, errors.Internal, op, "session manager is not available")
+	}
+	var sess session.Session
+	sm.ForEachLocalSession(func(s session.Session) bool {
+		if _, ok := s.GetLocalConnections()[connectionId]; ok {
+			sess = s
+			return false
+		}
+		return true
+	})
+	if sess == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "no session found for connection")
+	}
+	rec := sess.GetSessionRecording()
+	if rec == nil {
+		return nil, nil
+	}
+
+	sr, err := m.sessionRecorder(ctx, sess, rec)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cr, err := sr.newConnectionRecorder(ctx, connectionId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return cr, nil
+}
+
+// sessionRecorder returns the recorder for the session, creating it if
+// needed.
+func (m *Manager) sessionRecorder(ctx context.Context, sess session.Session, rec *pbs.SessionRecording) (*sessionRecorder, error) {
+	const op = "recording.(Manager).sessionRecorder"
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.shutdown {
+		return nil, errors.New(ctx, errors.Internal, op, "recording manager is shut down")
+	}
+	if sr, ok := m.sessions[sess.GetId()]; ok {
+		return sr, nil
+	}
+
+	switch {
+	case rec.GetRecordingId() == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	case rec.GetStorageBucket() == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session recording storage bucket")
+	}
+
+	keys, err := keysFromProto(rec)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	compression, err := bsr.ParseCompression(rec.GetCompression())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	encryption, err := bsr.ParseEncryption(rec.GetEncryption())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	fs, err := m.fsFn(ctx, rec.GetStorageBucket())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get storage for recording"))
+	}
+	v := version.Get()
+	sessionMeta := &bsr.SessionMeta{
+		PublicId: sess.GetId(),
+		Endpoint: sess.GetEndpoint(),
+		User: &bsr.User{
+			PublicId: sess.GetUserId(),
+		},
+		Target: &bsr.Target{
+			PublicId:               sess.GetTargetId(),
+			EnableSessionRecording: true,
+			StorageBucketId:        rec.GetStorageBucket().GetId(),
+		},
+		Worker: &bsr.Worker{
+			PublicId: m.workerIdFn(),
+			Version:  v.VersionNumber(),
+			Sha:      v.Revision,
+		},
+	}
+	// The compression and encryption are those of the session's target when
+	// the controller created the recording.
+	bs, err := bsr.NewSession(ctx, &bsr.SessionRecordingMeta{Id: rec.GetRecordingId(), Protocol: tcp.Protocol}, sessionMeta, fs, keys,
+		bsr.WithCompression(compression), bsr.WithEncryption(encryption))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create bsr session"))
+	}
+
+	sessionId := sess.GetId()
+	sr := newSessionRecorder(sessionId, bs, keys, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.sessions, sessionId)
+	})
+	m.sessions[sessionId] = sr
+	return sr, nil
+}
+
+// SessionsManaged returns the ids of the sessions being recorded.
+func (m *Manager) SessionsManaged(_ context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ids := make([]string, 0, len(m.sessions))
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// ReauthorizeAllExcept closes the recordings of the provided sessions, which
+// the controller has reported are no longer active.  A session's recording is
+// closed once all of its recorded connections are closed.  The recordings of
+// all other sessions remain open.
+func (m *Manager) ReauthorizeAllExcept(ctx context.Context, closedSessions []string) error {
+	const op = "recording.(Manager).ReauthorizeAllExcept"
+	var toClose []*sessionRecorder
+	m.mu.Lock()
+	for _, id := range closedSessions {
+		if sr, ok := m.sessions[id]; ok {
+			toClose = append(toClose, sr)
+		}
+	}
+	m.mu.Unlock()
+
+	var retErr error
+	for _, sr := range toClose {
+		if err := sr.requestClose(ctx); err != nil {
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg("unable to close session recording")))
+		}
+	}
+	return retErr
+}
+
+// Shutdown closes all recordings.  No new connections can be recorded after
+// Shutdown is called.
+func (m *Manager) Shutdown(ctx context.Context) {
+	const op = "recording.(Manager).Shutdown"
+	m.mu.Lock()
+	m.shutdown = true
+	toClose := make([]*sessionRecorder, 0, len(m.sessions))
+	for _, sr := range m.sessions {
+		toClose = append(toClose, sr)
+	}
+	m.mu.Unlock()
+
+	for _, sr := range toClose {
+		if err := sr.closeAll(ctx); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to close session recording", "session_id", sr.id))
+		}
+	}
+}
+
+// keysFromProto unmarshals the bsr keys in the session recording.
+func keysFromProto(rec *pbs.SessionRecording) (*kms.Keys, error) {
+	keys := &kms.Keys{
+		PubKey:              &wrapping.KeyInfo{},
+		WrappedBsrKey:       &wrapping.KeyInfo{},
+		WrappedPrivKey:      &wrapping.KeyInfo{},
+		BsrKey:              &wrapping.KeyInfo{},
+		PrivKey:             &wrapping.KeyInfo{},
+		PubKeySelfSignature: &wrapping.SigInfo{},
+		PubKeyBsrSignature:  &wrapping.SigInfo{},
+	}
+	for _, f := range []struct {
+		b []byte
+		m proto.Message
+	}{
+		{rec.GetPubKey(), keys.PubKey},
+		{rec.GetWrappedBsrKey(), keys.WrappedBsrKey},
+		{rec.GetWrappedPrivKey(), keys.WrappedPrivKey},
+		{rec.GetBsrKey(), keys.BsrKey},
+		{rec.GetPrivKey(), keys.PrivKey},
+		{rec.GetPubKeySelfSignature(), keys.PubKeySelfSignature},
+		{rec.GetPubKeyBsrSignature(), keys.PubKeyBsrSignature},
+	} {
+		if err := proto.Unmarshal(f.b, f.m); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
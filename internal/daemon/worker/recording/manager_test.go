@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/hashicorp/boundary/internal/storage/local"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewManager(t *testing.T) {
+	ctx := context.Background()
+	smFn := func() session.Manager { return nil }
+	fsFn := func(context.Context, *storagebuckets.StorageBucket) (storage.FS, error) { return nil, nil }
+	idFn := func() string { return "w_1234567890" }
+
+	_, err := NewManager(ctx, nil, fsFn, idFn)
+	assert.Error(t, err)
+	_, err = NewManager(ctx, smFn, nil, idFn)
+	assert.Error(t, err)
+	_, err = NewManager(ctx, smFn, fsFn, nil)
+	assert.Error(t, err)
+	m, err := NewManager(ctx, smFn, fsFn, idFn)
+	require.NoError(t, err)
+	assert.NotNil(t, m)
+}
+
+func TestManager_RecordConnection(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	keys, err := kms.CreateKeys(ctx, kms.TestWrapper(t), "s_recorded")
+	require.NoError(err)
+	rec := testSessionRecording(t, keys)
+
+	mockSessionClient := pbs.NewMockSessionServiceClient()
+	mockSessionClient.LookupSessionFn = func(_ context.Context, req *pbs.LookupSessionRequest) (*pbs.LookupSessionResponse, error) {
+		resp := &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId:   req.GetSessionId(),
+				Certificate: createTestCert(t),
+			},
+			Version:    1,
+			Expiration: timestamppb.New(time.Now().Add(time.Hour)),
+			Status:     pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE,
+			Endpoint:   "tcp://127.0.0.1:22",
+			UserId:     "u_1234567890",
+			TargetId:   "ttcp_1234567890",
+		}
+		if req.GetSessionId() == "s_recorded" {
+			resp.SessionRecording = rec
+		}
+		return resp, nil
+	}
+	mockSessionClient.AuthorizeConnectionFn = func(_ context.Context, req *pbs.AuthorizeConnectionRequest) (*pbs.AuthorizeConnectionResponse, error) {
+		return &pbs.AuthorizeConnectionResponse{
+			ConnectionId:    fmt.Sprintf("sc_%s", req.GetSessionId()),
+			Status:          pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+			ConnectionsLeft: -1,
+		}, nil
+	}
+	sm, err := session.NewManager(mockSessionClient)
+	require.NoError(err)
+	recorded, err := sm.LoadLocalSession(ctx, "s_recorded", "w_1234567890")
+	require.NoError(err)
+	notRecorded, err := sm.LoadLocalSession(ctx, "s_notrecorded", "w_1234567890")
+	require.NoError(err)
+	_, cancel := context.WithCancel(ctx)
+	defer cancel()
+	recConn, _, err := recorded.RequestAuthorizeConnection(ctx, "w_1234567890", cancel)
+	require.NoError(err)
+	notRecConn, _, err := notRecorded.RequestAuthorizeConnection(ctx, "w_1234567890", cancel)
+	require.NoError(err)
+
+	fs, err := local.NewFS(ctx, t.TempDir())
+	require.NoError(err)
+	var gotBucket *storagebuckets.StorageBucket
+	m, err := NewManager(ctx,
+		func() session.Manager { return sm },
+		func(_ context.Context, b *storagebuckets.StorageBucket) (storage.FS, error) {
+			gotBucket = b
+			return fs, nil
+		},
+		func() string { return "w_1234567890" },
+	)
+	require.NoError(err)
+
+	_, err = m.NewConnectionRecorder(ctx, "")
+	assert.Error(err)
+	_, err = m.NewConnectionRecorder(ctx, "sc_unknown")
+	assert.Error(err)
+
+	r, err := m.NewConnectionRecorder(ctx, notRecConn.GetConnectionId())
+	require.NoError(err)
+	assert.Nil(r)
+
+	r, err = m.NewConnectionRecorder(ctx, recConn.GetConnectionId())
+	require.NoError(err)
+	require.NotNil(r)
+	assert.True(proto.Equal(rec.GetStorageBucket(), gotBucket))
+
+	managed, err := m.SessionsManaged(ctx)
+	require.NoError(err)
+	assert.Equal([]string{"s_recorded"}, managed)
+
+	_, err = r.Inbound().Write([]byte("request"))
+	require.NoError(err)
+	_, err = r.Outbound().Write([]byte("response"))
+	require.NoError(err)
+
+	// Reauthorizing other sessions leaves the recording open and a closed
+	// session's recording stays open until its connections are closed.
+	require.NoError(m.ReauthorizeAllExcept(ctx, nil))
+	require.NoError(m.ReauthorizeAllExcept(ctx, []string{"s_recorded"}))
+	managed, err = m.SessionsManaged(ctx)
+	require.NoError(err)
+	assert.Equal([]string{"s_recorded"}, managed)
+
+	require.NoError(r.Close(ctx))
+	require.NoError(r.Close(ctx))
+	managed, err = m.SessionsManaged(ctx)
+	require.NoError(err)
+	assert.Empty(managed)
+
+	keyFn := func(kms.WrappedKeys) (kms.UnwrappedKeys, error) {
+		return kms.UnwrappedKeys{BsrKey: keys.BsrKey, PrivKey: keys.PrivKey}, nil
+	}
+	bs, err := bsr.OpenSession(ctx, rec.GetRecordingId(), fs, keyFn)
+	require.NoError(err)
+	assert.Equal(tcp.Protocol, bs.Meta.Protocol)
+	assert.Equal("s_recorded", bs.SessionMeta.PublicId)
+	assert.Equal("u_1234567890", bs.SessionMeta.User.PublicId)
+	assert.Equal("ttcp_1234567890", bs.SessionMeta.Target.PublicId)
+	assert.Equal("sb_1234567890", bs.SessionMeta.Target.StorageBucketId)
+	assert.Equal("w_1234567890", bs.SessionMeta.Worker.PublicId)
+	assert.Equal(uint64(1), bs.Summary.GetConnectionCount())
+
+	// Connections are recorded using their connection recording id.
+	bc, err := bs.OpenConnection(ctx, "cr_s_recorded")
+	require.NoError(err)
+	assert.Equal(uint64(len("request")), bc.Summary.GetBytesUp())
+	assert.Equal(uint64(len("response")), bc.Summary.GetBytesDown())
+	assert.Equal([]byte("request"), readData(t, bc, bsr.Inbound))
+	assert.Equal([]byte("response"), readData(t, bc, bsr.Outbound))
+}
+
+func TestManager_Shutdown(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	keys, err := kms.CreateKeys(ctx, kms.TestWrapper(t), "s_recorded")
+	require.NoError(err)
+	mockSessionClient := pbs.NewMockSessionServiceClient()
+	mockSessionClient.LookupSessionFn = func(_ context.Context, req *pbs.LookupSessionRequest) (*pbs.LookupSessionResponse, error) {
+		return &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId:   req.GetSessionId(),
+				Certificate: createTestCert(t),
+			},
+			Version:          1,
+			Expiration:       timestamppb.New(time.Now().Add(time.Hour)),
+			Status:           pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE,
+			SessionRecording: testSessionRecording(t, keys),
+		}, nil
+	}
+	mockSessionClient.AuthorizeConnectionFn = func(_ context.Context, req *pbs.AuthorizeConnectionRequest) (*pbs.AuthorizeConnectionResponse, error) {
+		return &pbs.AuthorizeConnectionResponse{
+			ConnectionId:    fmt.Sprintf("sc_%d", time.Now().UnixNano()),
+			Status:          pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+			ConnectionsLeft: -1,
+		}, nil
+	}
+	sm, err := session.NewManager(mockSessionClient)
+	require.NoError(err)
+	sess, err := sm.LoadLocalSession(ctx, "s_recorded", "w_1234567890")
+	require.NoError(err)
+	_, cancel := context.WithCancel(ctx)
+	defer cancel()
+	c1, _, err := sess.RequestAuthorizeConnection(ctx, "w_1234567890", cancel)
+	require.NoError(err)
+
+	fs, err := local.NewFS(ctx, t.TempDir())
+	require.NoError(err)
+	m, err := NewManager(ctx,
+		func() session.Manager { return sm },
+		func(context.Context, *storagebuckets.StorageBucket) (storage.FS, error) { return fs, nil },
+		func() string { return "w_1234567890" },
+	)
+	require.NoError(err)
+
+	r, err := m.NewConnectionRecorder(ctx, c1.GetConnectionId())
+	require.NoError(err)
+	require.NotNil(r)
+
+	m.Shutdown(ctx)
+	managed, err := m.SessionsManaged(ctx)
+	require.NoError(err)
+	assert.Empty(managed)
+	// The recorder was closed by the shutdown.
+	_, err = r.Inbound().Write([]byte("data"))
+	assert.Error(err)
+	assert.NoError(r.Close(ctx))
+
+	c2, _, err := sess.RequestAuthorizeConnection(ctx, "w_1234567890", cancel)
+	require.NoError(err)
+	_, err = m.NewConnectionRecorder(ctx, c2.GetConnectionId())
+	assert.Error(err)
+}
+
+func testSessionRecording(t *testing.T, keys *kms.Keys) *pbs.SessionRecording {
+	t.Helper()
+	marshal := func(m proto.Message) []byte {
+		b, err := proto.Marshal(m)
+		require.NoError(t, err)
+		return b
+	}
+	return &pbs.SessionRecording{
+		RecordingId: "sr_1234567890",
+		StorageBucket: &storagebuckets.StorageBucket{
+			Id:         "sb_1234567890",
+			BucketName: "bucket",
+		},
+		PubKey:              marshal(keys.PubKey),
+		PubKeySelfSignature: marshal(keys.PubKeySelfSignature),
+		PubKeyBsrSignature:  marshal(keys.PubKeyBsrSignature),
+		WrappedBsrKey:       marshal(keys.WrappedBsrKey),
+		WrappedPrivKey:      marshal(keys.WrappedPrivKey),
+		BsrKey:              marshal(keys.BsrKey),
+		PrivKey:             marshal(keys.PrivKey),
+		Compression:         "gzip",
+		Encryption:          "aes-gcm",
+	}
+}
+
+func readData(t *testing.T, c *bsr.Connection, dir bsr.Direction) []byte {
+	t.Helper()
+	ctx := context.Background()
+	s, err := c.OpenMessageScanner(ctx, dir)
+	require.NoError(t, err)
+	var data []byte
+	for {
+		chunk, err := s.Scan(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		switch c := chunk.(type) {
+		case *bsr.HeaderChunk:
+			assert.Equal(t, bsr.GzipCompression, c.Compression)
+			assert.Equal(t, bsr.AesGcmEncryption, c.Encryption)
+		case *tcp.DataChunk:
+			data = append(data, c.Data...)
+		}
+	}
+	return data
+}
+
+func createTestCert(t *testing.T) []byte {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageKeyAgreement | x509.KeyUsageCertSign,
+		SerialNumber:          big.NewInt(0),
+		NotBefore:             time.Now().Add(-30 * time.Second),
+		NotAfter:              time.Now().Add(5 * time.Minute),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	require.NoError(t, err)
+
+	return certBytes
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/bsr/tcp"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	intsession "github.com/hashicorp/boundary/internal/session"
+)
+
+// sessionRecorder owns the bsr session for a recorded session.
+type sessionRecorder struct {
+	id        string
+	startTime time.Time
+	onClose   func()
+	// keys are the session's bsr keys which the recorded data is encrypted
+	// with.
+	keys *kms.Keys
+
+	mu              sync.Mutex
+	bs              *bsr.Session
+	open            map[*connectionRecorder]struct{}
+	connectionCount uint64
+	closing         bool
+	closed          bool
+}
+
+func newSessionRecorder(id string, bs *bsr.Session, keys *kms.Keys, onClose func()) *sessionRecorder {
+	return &sessionRecorder{
+		id:        id,
+		startTime: time.Now(),
+		onClose:   onClose,
+		keys:      keys,
+		bs:        bs,
+		open:      make(map[*connectionRecorder]struct{}),
+	}
+}
+
+// newConnectionRecorder creates a bsr connection and the writers for both
+// directions of data for the connection.
+func (s *sessionRecorder) newConnectionRecorder(ctx context.Context, connectionId string) (*connectionRecorder, error) {
+	const op = "recording.(sessionRecorder).newConnectionRecorder"
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closing || s.closed {
+		return nil, errors.New(ctx, errors.Internal, op, "session recording is closed")
+	}
+
+	// The connection is recorded using its connection recording id.
+	recordingId, err := intsession.ConnectionRecordingId(ctx, connectionId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	bc, err := s.bs.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: recordingId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create bsr connection"))
+	}
+	cr := &connectionRecorder{
+		id:        connectionId,
+		startTime: time.Now(),
+		conn:      bc,
+		session:   s,
+	}
+	if cr.inbound, err = newCountingWriter(ctx, bc, bsr.Inbound, s.id, s.keys); err != nil {
+		_ = bc.Close(ctx)
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if cr.outbound, err = newCountingWriter(ctx, bc, bsr.Outbound, s.id, s.keys); err != nil {
+		_ = cr.inbound.Close()
+		_ = bc.Close(ctx)
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	s.open[cr] = struct{}{}
+	s.connectionCount++
+	return cr, nil
+}
+
+// connectionClosed removes the connection from the set of open connections
+// and closes the session recording if a close was requested and this was the
+// last open connection.
+func (s *sessionRecorder) connectionClosed(ctx context.Context, cr *connectionRecorder) error {
+	s.mu.Lock()
+	delete(s.open, cr)
+	closeNow := s.closing && len(s.open) == 0
+	s.mu.Unlock()
+	if closeNow {
+		return s.close(ctx)
+	}
+	return nil
+}
+
+// requestClose closes the session recording once all of its connections are
+// closed.
+func (s *sessionRecorder) requestClose(ctx context.Context) error {
+	s.mu.Lock()
+	s.closing = true
+	closeNow := len(s.open) == 0
+	s.mu.Unlock()
+	if closeNow {
+		return s.close(ctx)
+	}
+	return nil
+}
+
+// closeAll closes all open connection recordings and the session recording.
+func (s *sessionRecorder) closeAll(ctx context.Context) error {
+	s.mu.Lock()
+	s.closing = true
+	open := make([]*connectionRecorder, 0, len(s.open))
+	for cr := range s.open {
+		open = append(open, cr)
+	}
+	s.mu.Unlock()
+
+	var retErr error
+	for _, cr := range open {
+		if err := cr.Close(ctx); err != nil {
+			retErr = stderrors.Join(retErr, err)
+		}
+	}
+	// Closing the last connection closes the session, but close it here in
+	// case there were no open connections.
+	if err := s.close(ctx); err != nil {
+		retErr = stderrors.Join(retErr, err)
+	}
+	return retErr
+}
+
+// close writes the session summary and closes the bsr session.  Calling close
+// more than once is not an error.
+func (s *sessionRecorder) close(ctx context.Context) error {
+	const op = "recording.(sessionRecorder).close"
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	defer s.onClose()
+
+	var retErr error
+	if err := s.bs.EncodeSummary(ctx, &bsr.BaseSessionSummary{
+		Id:              s.id,
+		ConnectionCount: s.connectionCount,
+		StartTime:       s.startTime,
+		EndTime:         time.Now(),
+	}); err != nil {
+		retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg("unable to write session summary")))
+	}
+	if err := s.bs.Close(ctx); err != nil {
+		retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg("unable to close bsr session")))
+	}
+	return retErr
+}
+
+// connectionRecorder records both directions of data for a tcp connection.
+type connectionRecorder struct {
+	id        string
+	startTime time.Time
+	conn      *bsr.Connection
+	session   *sessionRecorder
+	inbound   *countingWriter
+	outbound  *countingWriter
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+var _ proxy.ConnectionRecorder = (*connectionRecorder)(nil)
+
+// Inbound returns the writer for data sent from the client to the endpoint.
+func (c *connectionRecorder) Inbound() io.Writer {
+	return c.inbound
+}
+
+// Outbound returns the writer for data sent from the endpoint to the client.
+func (c *connectionRecorder) Outbound() io.Writer {
+	return c.outbound
+}
+
+// Close closes the data writers, writes the connection summary and closes the
+// bsr connection.  Calling Close more than once returns the result of the
+// first call.
+func (c *connectionRecorder) Close(ctx context.Context) error {
+	const op = "recording.(connectionRecorder).Close"
+	c.closeOnce.Do(func() {
+		if err := c.inbound.Close(); err != nil {
+			c.closeErr = stderrors.Join(c.closeErr, errors.Wrap(ctx, err, op, errors.WithMsg("unable to close inbound data")))
+		}
+		if err := c.outbound.Close(); err != nil {
+			c.closeErr = stderrors.Join(c.closeErr, errors.Wrap(ctx, err, op, errors.WithMsg("unable to close outbound data")))
+		}
+		if err := c.conn.EncodeSummary(ctx, &bsr.BaseConnectionSummary{
+			Id:        c.id,
+			StartTime: c.startTime,
+			EndTime:   time.Now(),
+			BytesUp:   c.inbound.count(),
+			BytesDown: c.outbound.count(),
+		}); err != nil {
+			c.closeErr = stderrors.Join(c.closeErr, errors.Wrap(ctx, err, op, errors.WithMsg("unable to write connection summary")))
+		}
+		if err := c.conn.Close(ctx); err != nil {
+			c.closeErr = stderrors.Join(c.closeErr, errors.Wrap(ctx, err, op, errors.WithMsg("unable to close bsr connection")))
+		}
+		if err := c.session.connectionClosed(ctx, c); err != nil {
+			c.closeErr = stderrors.Join(c.closeErr, err)
+		}
+	})
+	return c.closeErr
+}
+
+// countingWriter is a tcp.DataWriter which counts the bytes written to it.
+type countingWriter struct {
+	*tcp.DataWriter
+	n atomic.Uint64
+}
+
+// newCountingWriter returns a countingWriter which writes tcp data chunks to
+// the connection's messages file for the direction. The data is compressed and
+// encrypted as selected for the session, using the session's bsr key.
+func newCountingWriter(ctx context.Context, bc *bsr.Connection, dir bsr.Direction, sessionId string, keys *kms.Keys) (*countingWriter, error) {
+	w, err := bc.NewMessagesWriter(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	dw, err := tcp.NewDataWriter(ctx, w, dir, bc.Compression(), bc.Encryption(), sessionId, bsr.WithKeys(keys), bsr.WithDictionary(bc.Dictionary()))
+	if err != nil {
+		if c, ok := w.(io.Closer); ok {
+			_ = c.Close()
+		}
+		return nil, err
+	}
+	return &countingWriter{DataWriter: dw}, nil
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.DataWriter.Write(p)
+	w.n.Add(uint64(n))
+	return n, err
+}
+
+func (w *countingWriter) count() uint64 {
+	return w.n.Load()
+}
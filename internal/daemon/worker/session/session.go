@@ -48,6 +48,9 @@ type ConnectionCloseData struct {
 	SessionId string
 	BytesUp   int64
 	BytesDown int64
+	// Reason is the reason the connection was closed.  If unset
+	// session.UnknownReason is reported.
+	Reason session.ClosedReason
 }
 
 // Session is the local representation of a session.  After initial loading
@@ -77,6 +80,11 @@ type Session interface {
 	GetCertificate() *x509.Certificate
 	GetPrivateKey() []byte
 	GetId() string
+	GetUserId() string
+	GetTargetId() string
+	// GetSessionRecording returns the information needed to record the
+	// session, or nil if the session is not recorded.
+	GetSessionRecording() *pbs.SessionRecording
 
 	// CancelOpenLocalConnections closes the local connections in this session
 	//based on the connection's state by calling the connections context cancel
@@ -295,6 +303,24 @@ func (s *sess) GetId() string {
 	return s.sessionId
 }
 
+func (s *sess) GetUserId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetUserId()
+}
+
+func (s *sess) GetTargetId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetTargetId()
+}
+
+func (s *sess) GetSessionRecording() *pbs.SessionRecording {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetSessionRecording()
+}
+
 func (s *sess) RequestCancel(ctx context.Context) error {
 	st, err := cancel(ctx, s.client, s.GetId())
 	if err != nil {
@@ -548,9 +574,13 @@ func closeConnections(ctx context.Context, sessClient pbs.SessionServiceClient,
 func makeCloseConnectionRequest(closeInfo map[string]*ConnectionCloseData) *pbs.CloseConnectionRequest {
 	closeData := make([]*pbs.CloseConnectionRequestData, 0, len(closeInfo))
 	for connId, data := range closeInfo {
+		reason := data.Reason
+		if reason == "" {
+			reason = session.UnknownReason
+		}
 		closeData = append(closeData, &pbs.CloseConnectionRequestData{
 			ConnectionId: connId,
-			Reason:       session.UnknownReason.String(),
+			Reason:       reason.String(),
 			BytesUp:      data.BytesUp,
 			BytesDown:    data.BytesDown,
 		})
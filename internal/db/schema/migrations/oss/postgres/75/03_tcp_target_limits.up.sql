@@ -0,0 +1,136 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- bandwidth_limit is the maximum rate, in bytes per second, of the data
+  -- proxied by a connection. session_byte_quota is the maximum number of bytes
+  -- proxied by all the connections of a session. Null means no limit.
+  alter table target_tcp
+    add column bandwidth_limit bigint
+      constraint bandwidth_limit_must_be_greater_than_0
+      check(bandwidth_limit > 0),
+    add column session_byte_quota bigint
+      constraint session_byte_quota_must_be_greater_than_0
+      check(session_byte_quota > 0);
+
+  -- The new columns are appended so the view can be replaced without
+  -- dropping the whx_* views which depend on it.
+  -- replaces target_all_subtypes defined in oss/75/02_postgres_targets.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'tcp' as type,
+    bandwidth_limit,
+    session_byte_quota
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    false as enable_tls,
+    'ssh' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota
+  from target_ssh
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    enable_tls,
+    'http' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota
+  from target_http
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'postgres' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota
+  from target_postgres;
+
+  -- drop constraint so we can add the quota exceeded reason
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  -- replaces the constraint defined in 0/51_connection.up.sql
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'quota exceeded'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('quota exceeded');
+
+commit;
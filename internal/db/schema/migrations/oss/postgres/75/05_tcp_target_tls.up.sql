@@ -0,0 +1,165 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- terminate_tls indicates the worker terminates the TLS connections of
+  -- clients with a certificate issued for the session and opens a new TLS
+  -- connection to the endpoint. tls_server_name is the name used to verify the
+  -- endpoint's certificate, defaulting to the endpoint's host.
+  -- tls_ca_certificates is a PEM bundle of the certificate authorities trusted
+  -- to verify the endpoint's certificate, defaulting to the worker's system
+  -- pool. tls_min_version is the minimum TLS version accepted from the
+  -- endpoint.
+  alter table target_tcp
+    add column terminate_tls boolean not null default false,
+    add column tls_server_name text
+      constraint tls_server_name_must_not_be_empty
+      check(length(trim(tls_server_name)) > 0),
+    add column tls_ca_certificates text
+      constraint tls_ca_certificates_must_not_be_empty
+      check(length(trim(tls_ca_certificates)) > 0),
+    add column tls_min_version text
+      constraint tls_min_version_must_be_valid
+      check(tls_min_version in ('1.2', '1.3'));
+
+  -- The new columns are appended so the view can be replaced without
+  -- dropping the whx_* views which depend on it.
+  -- replaces target_all_subtypes defined in oss/75/04_udp_targets.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'tcp' as type,
+    bandwidth_limit,
+    session_byte_quota,
+    terminate_tls,
+    tls_server_name,
+    tls_ca_certificates,
+    tls_min_version
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    false as enable_tls,
+    'ssh' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version
+  from target_ssh
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    enable_tls,
+    'http' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version
+  from target_http
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'postgres' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version
+  from target_postgres
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'udp' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version
+  from target_udp;
+
+commit;
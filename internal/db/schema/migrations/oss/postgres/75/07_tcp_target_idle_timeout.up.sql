@@ -0,0 +1,210 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- idle_timeout_seconds is the number of seconds a connection can go without
+  -- data proxied in either direction before the worker closes it. Null means
+  -- idle connections are only closed when the session ends.
+  alter table target_tcp
+    add column idle_timeout_seconds int
+      constraint idle_timeout_seconds_must_be_greater_than_0
+      check(idle_timeout_seconds > 0);
+
+  -- The new column is appended so the view can be replaced without dropping
+  -- the whx_* views which depend on it.
+  -- replaces target_all_subtypes defined in oss/75/06_kubernetes_targets.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'tcp' as type,
+    bandwidth_limit,
+    session_byte_quota,
+    terminate_tls,
+    tls_server_name,
+    tls_ca_certificates,
+    tls_min_version,
+    idle_timeout_seconds
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    false as enable_tls,
+    'ssh' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds
+  from target_ssh
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    enable_tls,
+    'http' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds
+  from target_http
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'postgres' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds
+  from target_postgres
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'udp' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds
+  from target_udp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'kubernetes' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    tls_server_name,
+    tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds
+  from target_kubernetes;
+
+  -- drop constraint so we can add the idle timeout reason
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  -- replaces the constraint defined in oss/75/03_tcp_target_limits.up.sql
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'quota exceeded',
+          'idle timeout'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('idle timeout');
+
+commit;
@@ -0,0 +1,38 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table scim_token (
+    public_id wt_public_id primary key,
+    create_time wt_timestamp,
+    scope_id wt_scope_id not null,
+    auth_method_id wt_public_id not null
+      constraint scim_token_auth_method_id_uq
+        unique,
+    token bytea not null
+      constraint token_must_not_be_empty
+        check(length(token) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    constraint auth_method_fkey
+      foreign key (scope_id, auth_method_id)
+        references auth_method (scope_id, public_id)
+        on delete cascade
+        on update cascade
+  );
+  comment on table scim_token is
+    'scim_token is a table where each row is the token an identity provider uses to provision '
+    'the users, groups and accounts of an auth method through the SCIM API. '
+    'An auth method has at most one SCIM token.';
+
+  create trigger default_create_time_column before insert on scim_token
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on scim_token
+    for each row execute procedure immutable_columns('public_id', 'create_time', 'scope_id', 'auth_method_id');
+
+commit;
@@ -0,0 +1,98 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_password_method
+    add column totp_required boolean not null default false;
+  comment on column auth_password_method.totp_required is
+    'totp_required indicates that accounts of the auth method must authenticate with a TOTP code.';
+
+  -- Replaces view from 2/20_pass.up.sql to add totp_required.
+  create or replace view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.totp_required
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+
+  create table auth_password_totp (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      constraint auth_password_totp_password_account_id_uq
+        unique
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    secret bytea not null
+      constraint secret_must_not_be_empty
+        check(length(secret) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    confirmed boolean not null default false,
+    last_used_step bigint not null default 0
+      constraint last_used_step_must_not_be_negative
+        check(last_used_step >= 0)
+  );
+  comment on table auth_password_totp is
+    'auth_password_totp is a table where each row is the TOTP enrollment of a password account. '
+    'An enrollment is only used to authenticate once it has been confirmed with a TOTP code.';
+
+  create trigger update_time_column before update on auth_password_totp
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_password_totp
+    for each row execute procedure immutable_columns('private_id', 'password_account_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_password_totp
+    for each row execute procedure default_create_time();
+
+  create table auth_password_totp_recovery_code (
+    totp_id wt_private_id not null
+      constraint auth_password_totp_fkey
+        references auth_password_totp (private_id)
+        on delete cascade
+        on update cascade,
+    code_hash bytea not null
+      constraint code_hash_must_not_be_empty
+        check(length(code_hash) > 0),
+    create_time wt_timestamp,
+    primary key (totp_id, code_hash)
+  );
+  comment on table auth_password_totp_recovery_code is
+    'auth_password_totp_recovery_code is a table where each row is the hash of a single use '
+    'recovery code which can be used instead of a TOTP code.';
+
+  create trigger immutable_columns before update on auth_password_totp_recovery_code
+    for each row execute procedure immutable_columns('totp_id', 'code_hash', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_password_totp_recovery_code
+    for each row execute procedure default_create_time();
+
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('auth_password_totp', 1),
+    ('auth_password_totp_recovery_code', 1);
+
+commit;
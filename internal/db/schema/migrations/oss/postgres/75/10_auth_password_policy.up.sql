@@ -0,0 +1,136 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_password_method
+    add column min_password_character_classes int not null default 0
+      constraint min_password_character_classes_must_be_between_0_and_4
+        check(min_password_character_classes between 0 and 4),
+    add column deny_common_passwords boolean not null default false,
+    add column password_history_count int not null default 0
+      constraint password_history_count_must_not_be_negative
+        check(password_history_count >= 0),
+    add column max_password_age_days int not null default 0
+      constraint max_password_age_days_must_not_be_negative
+        check(max_password_age_days >= 0),
+    add column lockout_threshold int not null default 0
+      constraint lockout_threshold_must_not_be_negative
+        check(lockout_threshold >= 0),
+    add column lockout_duration_seconds int not null default 0
+      constraint lockout_duration_seconds_must_not_be_negative
+        check(lockout_duration_seconds >= 0);
+  comment on column auth_password_method.min_password_character_classes is
+    'min_password_character_classes is the number of character classes (lowercase, uppercase, digits and symbols) a password must contain.';
+  comment on column auth_password_method.deny_common_passwords is
+    'deny_common_passwords indicates that commonly used and breached passwords are rejected.';
+  comment on column auth_password_method.password_history_count is
+    'password_history_count is the number of previous passwords of an account which can not be reused. Zero disables the check.';
+  comment on column auth_password_method.max_password_age_days is
+    'max_password_age_days is the number of days after which a password must be changed. Zero disables expiration.';
+  comment on column auth_password_method.lockout_threshold is
+    'lockout_threshold is the number of consecutive failed authentication attempts after which an account is locked. Zero disables lockout.';
+  comment on column auth_password_method.lockout_duration_seconds is
+    'lockout_duration_seconds is the number of seconds an account stays locked. Zero locks the account until it is unlocked by an administrator.';
+
+  -- Replaces view from 75/09_auth_password_totp.up.sql to add the password
+  -- policy columns.
+  create or replace view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.totp_required,
+    am.min_password_character_classes,
+    am.deny_common_passwords,
+    am.password_history_count,
+    am.max_password_age_days,
+    am.lockout_threshold,
+    am.lockout_duration_seconds
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+
+  -- Replaces view from 0/14_auth_password_views.up.sql to add the password
+  -- policy columns.
+  drop view auth_password_current_conf;
+  create view auth_password_current_conf as
+      select pm.min_login_name_length, pm.min_password_length,
+             pm.min_password_character_classes, pm.deny_common_passwords,
+             pm.password_history_count,
+             c.*
+        from auth_password_method pm
+  inner join auth_password_conf_union c
+          on pm.password_conf_id = c.password_conf_id;
+
+  create table auth_password_argon2_cred_history (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    password_conf_id wt_private_id not null
+      constraint auth_password_argon2_conf_fkey
+        references auth_password_argon2_conf (private_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    salt bytea not null
+      constraint salt_must_not_be_empty
+        check(length(salt) > 0),
+    derived_key bytea not null
+      constraint derived_key_must_not_be_empty
+        check(length(derived_key) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade
+  );
+  comment on table auth_password_argon2_cred_history is
+    'auth_password_argon2_cred_history is a table where each row is a previous argon2 credential of a password account. '
+    'It is used to prevent the reuse of previous passwords.';
+
+  create index auth_password_argon2_cred_history_account_create_time_ix
+    on auth_password_argon2_cred_history (password_account_id, create_time desc);
+
+  create trigger immutable_columns before update on auth_password_argon2_cred_history
+    for each row execute procedure immutable_columns('private_id', 'password_account_id', 'password_conf_id', 'create_time', 'derived_key');
+
+  create trigger default_create_time_column before insert on auth_password_argon2_cred_history
+    for each row execute procedure default_create_time();
+
+  create table auth_password_account_lockout (
+    password_account_id wt_public_id primary key
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    failed_attempt_count int not null default 0
+      constraint failed_attempt_count_must_not_be_negative
+        check(failed_attempt_count >= 0),
+    last_failed_time timestamp with time zone,
+    locked_time timestamp with time zone
+  );
+  comment on table auth_password_account_lockout is
+    'auth_password_account_lockout is a table where each row contains the failed authentication attempts of a password account '
+    'and the time the account was locked, if it is locked.';
+
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('auth_password_argon2_cred_history', 1);
+
+commit;
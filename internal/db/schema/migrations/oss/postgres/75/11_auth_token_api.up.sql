@@ -0,0 +1,67 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_token
+    add column name wt_name,
+    add column description wt_description,
+    add column api_token boolean not null default false,
+    add constraint auth_token_auth_account_id_name_uq
+      unique(auth_account_id, name),
+    add constraint api_token_must_have_name
+      check(api_token = false or name is not null);
+  comment on column auth_token.api_token is
+    'api_token indicates that the auth token is a long-lived, named API token created by its user '
+    'instead of by authenticating. API tokens do not become stale and can be restricted by grants.';
+
+  drop trigger immutable_columns on auth_token;
+  create trigger immutable_columns before update on auth_token
+    for each row execute procedure immutable_columns('public_id', 'auth_account_id', 'create_time', 'api_token');
+
+  -- Replaces view from 2/05_authtoken.up.sql to add the api token columns.
+  create or replace view auth_token_account as
+        select at.public_id,
+                at.token,
+                at.auth_account_id,
+                at.create_time,
+                at.update_time,
+                at.approximate_last_access_time,
+                at.expiration_time,
+                aa.scope_id,
+                aa.iam_user_id,
+                aa.auth_method_id,
+                at.status,
+                at.name,
+                at.description,
+                at.api_token
+          from auth_token as at
+    inner join auth_account as aa
+            on at.auth_account_id = aa.public_id;
+
+  create table auth_token_grant (
+    create_time wt_timestamp,
+    auth_token_id wt_public_id not null
+      constraint auth_token_fkey
+        references auth_token (public_id)
+        on delete cascade
+        on update cascade,
+    canonical_grant text not null
+      constraint canonical_grant_must_not_be_empty
+        check(length(trim(canonical_grant)) > 0),
+    raw_grant text not null
+      constraint raw_grant_must_not_be_empty
+        check(length(trim(raw_grant)) > 0),
+    primary key(auth_token_id, canonical_grant)
+  );
+  comment on table auth_token_grant is
+    'auth_token_grant is a table where each row is a grant of an API token. '
+    'The permissions of an API token with grants are limited to the permissions of its user which are also allowed by its grants.';
+
+  create trigger default_create_time_column before insert on auth_token_grant
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_token_grant
+    for each row execute procedure immutable_columns('create_time', 'auth_token_id', 'canonical_grant', 'raw_grant');
+
+commit;
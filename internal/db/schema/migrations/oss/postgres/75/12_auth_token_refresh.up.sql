@@ -0,0 +1,26 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+-- Remove immutable property
+drop trigger immutable_role_grant on iam_role_grant;
+
+-- Add the refresh:self action to the default auth token grant, so users can
+-- refresh the auth token they authenticated with. Roles which already have the
+-- updated grant are skipped to avoid violating the primary key.
+update iam_role_grant
+set
+  canonical_grant = 'id=*;type=auth-token;actions=delete:self,list,read:self,refresh:self',
+  raw_grant       = 'id=*;type=auth-token;actions=list,read:self,delete:self,refresh:self'
+where canonical_grant = 'id=*;type=auth-token;actions=delete:self,list,read:self'
+  and role_id not in (
+    select role_id
+      from iam_role_grant
+     where canonical_grant = 'id=*;type=auth-token;actions=delete:self,list,read:self,refresh:self'
+  );
+
+create trigger immutable_role_grant before update on iam_role_grant
+  for each row execute procedure iam_immutable_role_grant();
+
+commit;
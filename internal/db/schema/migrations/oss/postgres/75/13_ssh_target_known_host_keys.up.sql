@@ -0,0 +1,193 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- known_host_keys are the ssh public keys, one per line in authorized_keys
+  -- format, trusted as the host key of the target's endpoints. The worker does
+  -- not connect to an endpoint whose host key is not one of them, so sessions
+  -- to ssh targets without known host keys cannot be established.
+  alter table target_ssh
+    add column known_host_keys text
+      constraint known_host_keys_must_not_be_empty
+      check(length(trim(known_host_keys)) > 0);
+
+  -- The new column is appended so the view can be replaced without dropping
+  -- the whx_* views which depend on it.
+  -- replaces target_all_subtypes defined in oss/75/07_tcp_target_idle_timeout.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'tcp' as type,
+    bandwidth_limit,
+    session_byte_quota,
+    terminate_tls,
+    tls_server_name,
+    tls_ca_certificates,
+    tls_min_version,
+    idle_timeout_seconds,
+    null as known_host_keys
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    false as enable_tls,
+    'ssh' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    known_host_keys
+  from target_ssh
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    enable_tls,
+    'http' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys
+  from target_http
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'postgres' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys
+  from target_postgres
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'udp' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys
+  from target_udp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'kubernetes' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    tls_server_name,
+    tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys
+  from target_kubernetes;
+
+commit;
@@ -0,0 +1,33 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- A session is recorded with the same bsr keys for all of its connections,
+  -- so the keys are created along with the session's recording and stored on
+  -- it.  The bsr key and the private key are only stored wrapped by the bsr
+  -- kms.
+  alter table recording_session
+    add column pub_key bytea not null
+      constraint pub_key_must_not_be_empty
+        check(length(pub_key) > 0),
+    add column pub_key_self_signature bytea not null
+      constraint pub_key_self_signature_must_not_be_empty
+        check(length(pub_key_self_signature) > 0),
+    add column pub_key_bsr_signature bytea not null
+      constraint pub_key_bsr_signature_must_not_be_empty
+        check(length(pub_key_bsr_signature) > 0),
+    add column wrapped_bsr_key bytea not null
+      constraint wrapped_bsr_key_must_not_be_empty
+        check(length(wrapped_bsr_key) > 0),
+    add column wrapped_priv_key bytea not null
+      constraint wrapped_priv_key_must_not_be_empty
+        check(length(wrapped_priv_key) > 0);
+
+  drop trigger immutable_columns on recording_session;
+  create trigger immutable_columns before update on recording_session
+    for each row execute procedure immutable_columns('public_id', 'storage_bucket_id', 'create_time',
+        'user_scope_hst_id', 'user_hst_id', 'pub_key', 'pub_key_self_signature', 'pub_key_bsr_signature',
+        'wrapped_bsr_key', 'wrapped_priv_key');
+
+commit;
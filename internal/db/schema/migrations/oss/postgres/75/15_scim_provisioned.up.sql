@@ -0,0 +1,53 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- The SCIM API only deletes the accounts and users it created, so the ones
+  -- it provisions are recorded.  A row is deleted along with its account or
+  -- user, or with the auth method which provisioned it.
+  create table scim_provisioned_account (
+    account_id wt_public_id primary key
+      constraint auth_account_fkey
+        references auth_account (public_id)
+        on delete cascade
+        on update cascade,
+    auth_method_id wt_public_id not null
+      constraint auth_method_fkey
+        references auth_method (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp
+  );
+  comment on table scim_provisioned_account is
+    'scim_provisioned_account is a table where each row is an account created through the SCIM API of its auth method.';
+
+  create trigger default_create_time_column before insert on scim_provisioned_account
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on scim_provisioned_account
+    for each row execute procedure immutable_columns('account_id', 'auth_method_id', 'create_time');
+
+  create table scim_provisioned_user (
+    user_id wt_public_id primary key
+      constraint iam_user_fkey
+        references iam_user (public_id)
+        on delete cascade
+        on update cascade,
+    auth_method_id wt_public_id not null
+      constraint auth_method_fkey
+        references auth_method (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp
+  );
+  comment on table scim_provisioned_user is
+    'scim_provisioned_user is a table where each row is a user created through the SCIM API of an auth method.';
+
+  create trigger default_create_time_column before insert on scim_provisioned_user
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on scim_provisioned_user
+    for each row execute procedure immutable_columns('user_id', 'auth_method_id', 'create_time');
+
+commit;
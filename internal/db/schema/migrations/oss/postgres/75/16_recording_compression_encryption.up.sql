@@ -0,0 +1,225 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- recording_compression and recording_encryption are the compression and
+  -- encryption of the chunks of the target's session recordings.  If they are
+  -- null the chunks are compressed with zstd and encrypted with aes-gcm.
+  alter table target_ssh
+    add column recording_compression text
+      constraint recording_compression_must_be_valid
+      check(recording_compression in ('none', 'gzip', 'zstd')),
+    add column recording_encryption text
+      constraint recording_encryption_must_be_valid
+      check(recording_encryption in ('none', 'aes-gcm'));
+
+  -- The compression and encryption of a session's recording are taken from
+  -- its target when the recording is created, so every connection of the
+  -- session is recorded the same way even if the target is updated.
+  -- Recordings made before they were configurable used zstd and aes-gcm.
+  alter table recording_session
+    add column compression text not null default 'zstd'
+      constraint compression_must_be_valid
+      check(compression in ('none', 'gzip', 'zstd')),
+    add column encryption text not null default 'aes-gcm'
+      constraint encryption_must_be_valid
+      check(encryption in ('none', 'aes-gcm'));
+
+  drop trigger immutable_columns on recording_session;
+  create trigger immutable_columns before update on recording_session
+    for each row execute procedure immutable_columns('public_id', 'storage_bucket_id', 'create_time',
+        'user_scope_hst_id', 'user_hst_id', 'pub_key', 'pub_key_self_signature', 'pub_key_bsr_signature',
+        'wrapped_bsr_key', 'wrapped_priv_key', 'compression', 'encryption');
+
+  -- The new columns are appended so the view can be replaced without dropping
+  -- the whx_* views which depend on it.
+  -- replaces target_all_subtypes defined in oss/75/13_ssh_target_known_host_keys.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'tcp' as type,
+    bandwidth_limit,
+    session_byte_quota,
+    terminate_tls,
+    tls_server_name,
+    tls_ca_certificates,
+    tls_min_version,
+    idle_timeout_seconds,
+    null as known_host_keys,
+    null as recording_compression,
+    null as recording_encryption
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    false as enable_tls,
+    'ssh' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    known_host_keys,
+    recording_compression,
+    recording_encryption
+  from target_ssh
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    enable_tls,
+    'http' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys,
+    null as recording_compression,
+    null as recording_encryption
+  from target_http
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'postgres' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys,
+    null as recording_compression,
+    null as recording_encryption
+  from target_postgres
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'udp' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys,
+    null as recording_compression,
+    null as recording_encryption
+  from target_udp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'kubernetes' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    tls_server_name,
+    tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys,
+    null as recording_compression,
+    null as recording_encryption
+  from target_kubernetes;
+
+commit;
@@ -0,0 +1,206 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- tls_server_name and tls_ca_certificates are used by the worker to verify
+  -- the certificate of the endpoint of an http target with enable_tls set.
+  -- If they are null the endpoint's host and the worker's system certificate
+  -- authorities are used.
+  alter table target_http
+    add column tls_server_name text
+      constraint tls_server_name_must_not_be_empty
+      check(length(trim(tls_server_name)) > 0),
+    add column tls_ca_certificates text
+      constraint tls_ca_certificates_must_not_be_empty
+      check(length(trim(tls_ca_certificates)) > 0);
+
+  -- replaces target_all_subtypes defined in oss/75/16_recording_compression_encryption.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'tcp' as type,
+    bandwidth_limit,
+    session_byte_quota,
+    terminate_tls,
+    tls_server_name,
+    tls_ca_certificates,
+    tls_min_version,
+    idle_timeout_seconds,
+    null as known_host_keys,
+    null as recording_compression,
+    null as recording_encryption
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    false as enable_tls,
+    'ssh' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    known_host_keys,
+    recording_compression,
+    recording_encryption
+  from target_ssh
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    enable_tls,
+    'http' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    tls_server_name,
+    tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys,
+    null as recording_compression,
+    null as recording_encryption
+  from target_http
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'postgres' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys,
+    null as recording_compression,
+    null as recording_encryption
+  from target_postgres
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'udp' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    null as tls_server_name,
+    null as tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys,
+    null as recording_compression,
+    null as recording_encryption
+  from target_udp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    false as enable_tls,
+    'kubernetes' as type,
+    null as bandwidth_limit,
+    null as session_byte_quota,
+    false as terminate_tls,
+    tls_server_name,
+    tls_ca_certificates,
+    null as tls_min_version,
+    null as idle_timeout_seconds,
+    null as known_host_keys,
+    null as recording_compression,
+    null as recording_encryption
+  from target_kubernetes;
+
+commit;
@@ -85,6 +85,31 @@ const (
 	// new passwords are equal.
 	PasswordsEqual Code = 203
 
+	// TotpCodeRequired results from authenticating to an account with a
+	// confirmed TOTP enrollment without providing a TOTP code.
+	TotpCodeRequired Code = 204
+
+	// TotpEnrollmentRequired results from authenticating to an account without
+	// a confirmed TOTP enrollment when its auth method requires TOTP.
+	TotpEnrollmentRequired Code = 205
+
+	// PasswordTooSimple results from attempting to set a password which does
+	// not contain enough character classes or contains the login name.
+	PasswordTooSimple Code = 206
+
+	// PasswordCommon results from attempting to set a password which is a
+	// commonly used or breached password.
+	PasswordCommon Code = 207
+
+	// PasswordReused results from attempting to set a password which equals
+	// one of the previous passwords of an account.
+	PasswordReused Code = 208
+
+	// PasswordExpired results from authenticating to an account whose
+	// password is older than the maximum password age of its auth method
+	// without providing a new password.
+	PasswordExpired Code = 209
+
 	Encrypt Code = 300 // Encrypt represents an error occurred during the underlying encryption process
 	Decrypt Code = 301 // Decrypt represents an error occurred during the underlying decryption process
 	Encode  Code = 302 // Encode represents an error occurred during the underlying encoding/marshaling process
@@ -165,6 +165,36 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    PasswordsEqual,
 			want: PasswordsEqual,
 		},
+		{
+			name: "TotpCodeRequired",
+			c:    TotpCodeRequired,
+			want: TotpCodeRequired,
+		},
+		{
+			name: "TotpEnrollmentRequired",
+			c:    TotpEnrollmentRequired,
+			want: TotpEnrollmentRequired,
+		},
+		{
+			name: "PasswordTooSimple",
+			c:    PasswordTooSimple,
+			want: PasswordTooSimple,
+		},
+		{
+			name: "PasswordCommon",
+			c:    PasswordCommon,
+			want: PasswordCommon,
+		},
+		{
+			name: "PasswordReused",
+			c:    PasswordReused,
+			want: PasswordReused,
+		},
+		{
+			name: "PasswordExpired",
+			c:    PasswordExpired,
+			want: PasswordExpired,
+		},
 		{
 			name: "Encrypt",
 			c:    Encrypt,
@@ -119,6 +119,30 @@ var errorCodeInfo = map[Code]Info{
 		Message: "old and new password are equal",
 		Kind:    Password,
 	},
+	TotpCodeRequired: {
+		Message: "totp code required",
+		Kind:    Password,
+	},
+	TotpEnrollmentRequired: {
+		Message: "totp enrollment required",
+		Kind:    Password,
+	},
+	PasswordTooSimple: {
+		Message: "too simple",
+		Kind:    Password,
+	},
+	PasswordCommon: {
+		Message: "too common",
+		Kind:    Password,
+	},
+	PasswordReused: {
+		Message: "previously used",
+		Kind:    Password,
+	},
+	PasswordExpired: {
+		Message: "password expired",
+		Kind:    Password,
+	},
 	Encrypt: {
 		Message: "error occurred during encrypt",
 		Kind:    Encryption,
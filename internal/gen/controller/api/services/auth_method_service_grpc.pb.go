@@ -48,6 +48,13 @@ type AuthMethodServiceClient interface {
 	ChangeState(ctx context.Context, in *ChangeStateRequest, opts ...grpc.CallOption) (*ChangeStateResponse, error)
 	// Authenticate validates credentials provided and returns an Auth Token.
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	// CreateScimToken creates the token which the Auth Method's identity
+	// provider uses to provision users, groups and accounts through the
+	// controller's SCIM API, revoking the Auth Method's previous SCIM token if
+	// it has one. The token is only returned in this response.
+	CreateScimToken(ctx context.Context, in *CreateScimTokenRequest, opts ...grpc.CallOption) (*CreateScimTokenResponse, error)
+	// DeleteScimToken revokes the SCIM token of the Auth Method.
+	DeleteScimToken(ctx context.Context, in *DeleteScimTokenRequest, opts ...grpc.CallOption) (*DeleteScimTokenResponse, error)
 }
 
 type authMethodServiceClient struct {
@@ -121,6 +128,24 @@ func (c *authMethodServiceClient) Authenticate(ctx context.Context, in *Authenti
 	return out, nil
 }
 
+func (c *authMethodServiceClient) CreateScimToken(ctx context.Context, in *CreateScimTokenRequest, opts ...grpc.CallOption) (*CreateScimTokenResponse, error) {
+	out := new(CreateScimTokenResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AuthMethodService/CreateScimToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authMethodServiceClient) DeleteScimToken(ctx context.Context, in *DeleteScimTokenRequest, opts ...grpc.CallOption) (*DeleteScimTokenResponse, error) {
+	out := new(DeleteScimTokenResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AuthMethodService/DeleteScimToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthMethodServiceServer is the server API for AuthMethodService service.
 // All implementations must embed UnimplementedAuthMethodServiceServer
 // for forward compatibility
@@ -155,6 +180,13 @@ type AuthMethodServiceServer interface {
 	ChangeState(context.Context, *ChangeStateRequest) (*ChangeStateResponse, error)
 	// Authenticate validates credentials provided and returns an Auth Token.
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	// CreateScimToken creates the token which the Auth Method's identity
+	// provider uses to provision users, groups and accounts through the
+	// controller's SCIM API, revoking the Auth Method's previous SCIM token if
+	// it has one. The token is only returned in this response.
+	CreateScimToken(context.Context, *CreateScimTokenRequest) (*CreateScimTokenResponse, error)
+	// DeleteScimToken revokes the SCIM token of the Auth Method.
+	DeleteScimToken(context.Context, *DeleteScimTokenRequest) (*DeleteScimTokenResponse, error)
 	mustEmbedUnimplementedAuthMethodServiceServer()
 }
 
@@ -183,6 +215,12 @@ func (UnimplementedAuthMethodServiceServer) ChangeState(context.Context, *Change
 func (UnimplementedAuthMethodServiceServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
 }
+func (UnimplementedAuthMethodServiceServer) CreateScimToken(context.Context, *CreateScimTokenRequest) (*CreateScimTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScimToken not implemented")
+}
+func (UnimplementedAuthMethodServiceServer) DeleteScimToken(context.Context, *DeleteScimTokenRequest) (*DeleteScimTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScimToken not implemented")
+}
 func (UnimplementedAuthMethodServiceServer) mustEmbedUnimplementedAuthMethodServiceServer() {}
 
 // UnsafeAuthMethodServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -322,6 +360,42 @@ func _AuthMethodService_Authenticate_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthMethodService_CreateScimToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScimTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthMethodServiceServer).CreateScimToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AuthMethodService/CreateScimToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthMethodServiceServer).CreateScimToken(ctx, req.(*CreateScimTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthMethodService_DeleteScimToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScimTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthMethodServiceServer).DeleteScimToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AuthMethodService/DeleteScimToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthMethodServiceServer).DeleteScimToken(ctx, req.(*DeleteScimTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthMethodService_ServiceDesc is the grpc.ServiceDesc for AuthMethodService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -357,6 +431,14 @@ var AuthMethodService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authenticate",
 			Handler:    _AuthMethodService_Authenticate_Handler,
 		},
+		{
+			MethodName: "CreateScimToken",
+			Handler:    _AuthMethodService_CreateScimToken_Handler,
+		},
+		{
+			MethodName: "DeleteScimToken",
+			Handler:    _AuthMethodService_DeleteScimToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/auth_method_service.proto",
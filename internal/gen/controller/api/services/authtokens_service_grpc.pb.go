@@ -22,6 +22,13 @@ type AuthTokenServiceClient interface {
 	// must include the Auth Token id and if it is missing, malformed or
 	// referencing a non existing resource an error is returned.
 	GetAuthToken(ctx context.Context, in *GetAuthTokenRequest, opts ...grpc.CallOption) (*GetAuthTokenResponse, error)
+	// CreateAuthToken creates a long-lived API token for the account of the
+	// requesting user.  The provided request must include the scope id of the
+	// requesting user's account and a name which isn't used by another API
+	// token of the account.  The request may include grants which limit the
+	// permissions of the API token to a subset of those of the user.  API
+	// tokens cannot be used to create API tokens.
+	CreateAuthToken(ctx context.Context, in *CreateAuthTokenRequest, opts ...grpc.CallOption) (*CreateAuthTokenResponse, error)
 	// ListAuthTokens returns a list of stored Auth Tokens which exist inside
 	// the provided scope.  The request must include the scope ids for
 	// the Auth Tokens being listed.  If the scope id is missing, malformed, or
@@ -30,6 +37,11 @@ type AuthTokenServiceClient interface {
 	// DeleteAuthToken removes a Auth Token from Boundary. If the provided
 	// Auth Token id is malformed or not provided an error is returned.
 	DeleteAuthToken(ctx context.Context, in *DeleteAuthTokenRequest, opts ...grpc.CallOption) (*DeleteAuthTokenResponse, error)
+	// RefreshAuthToken rotates the value of the Auth Token used to make the
+	// request and resets the time it becomes stale. The previous token value is
+	// no longer valid once the Auth Token is refreshed. The expiration time of
+	// the Auth Token is not changed. The response contains the new token value.
+	RefreshAuthToken(ctx context.Context, in *RefreshAuthTokenRequest, opts ...grpc.CallOption) (*RefreshAuthTokenResponse, error)
 }
 
 type authTokenServiceClient struct {
@@ -49,6 +61,15 @@ func (c *authTokenServiceClient) GetAuthToken(ctx context.Context, in *GetAuthTo
 	return out, nil
 }
 
+func (c *authTokenServiceClient) CreateAuthToken(ctx context.Context, in *CreateAuthTokenRequest, opts ...grpc.CallOption) (*CreateAuthTokenResponse, error) {
+	out := new(CreateAuthTokenResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AuthTokenService/CreateAuthToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authTokenServiceClient) ListAuthTokens(ctx context.Context, in *ListAuthTokensRequest, opts ...grpc.CallOption) (*ListAuthTokensResponse, error) {
 	out := new(ListAuthTokensResponse)
 	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AuthTokenService/ListAuthTokens", in, out, opts...)
@@ -67,6 +88,15 @@ func (c *authTokenServiceClient) DeleteAuthToken(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *authTokenServiceClient) RefreshAuthToken(ctx context.Context, in *RefreshAuthTokenRequest, opts ...grpc.CallOption) (*RefreshAuthTokenResponse, error) {
+	out := new(RefreshAuthTokenResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AuthTokenService/RefreshAuthToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthTokenServiceServer is the server API for AuthTokenService service.
 // All implementations must embed UnimplementedAuthTokenServiceServer
 // for forward compatibility
@@ -75,6 +105,13 @@ type AuthTokenServiceServer interface {
 	// must include the Auth Token id and if it is missing, malformed or
 	// referencing a non existing resource an error is returned.
 	GetAuthToken(context.Context, *GetAuthTokenRequest) (*GetAuthTokenResponse, error)
+	// CreateAuthToken creates a long-lived API token for the account of the
+	// requesting user.  The provided request must include the scope id of the
+	// requesting user's account and a name which isn't used by another API
+	// token of the account.  The request may include grants which limit the
+	// permissions of the API token to a subset of those of the user.  API
+	// tokens cannot be used to create API tokens.
+	CreateAuthToken(context.Context, *CreateAuthTokenRequest) (*CreateAuthTokenResponse, error)
 	// ListAuthTokens returns a list of stored Auth Tokens which exist inside
 	// the provided scope.  The request must include the scope ids for
 	// the Auth Tokens being listed.  If the scope id is missing, malformed, or
@@ -83,6 +120,11 @@ type AuthTokenServiceServer interface {
 	// DeleteAuthToken removes a Auth Token from Boundary. If the provided
 	// Auth Token id is malformed or not provided an error is returned.
 	DeleteAuthToken(context.Context, *DeleteAuthTokenRequest) (*DeleteAuthTokenResponse, error)
+	// RefreshAuthToken rotates the value of the Auth Token used to make the
+	// request and resets the time it becomes stale. The previous token value is
+	// no longer valid once the Auth Token is refreshed. The expiration time of
+	// the Auth Token is not changed. The response contains the new token value.
+	RefreshAuthToken(context.Context, *RefreshAuthTokenRequest) (*RefreshAuthTokenResponse, error)
 	mustEmbedUnimplementedAuthTokenServiceServer()
 }
 
@@ -93,12 +135,18 @@ type UnimplementedAuthTokenServiceServer struct {
 func (UnimplementedAuthTokenServiceServer) GetAuthToken(context.Context, *GetAuthTokenRequest) (*GetAuthTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthToken not implemented")
 }
+func (UnimplementedAuthTokenServiceServer) CreateAuthToken(context.Context, *CreateAuthTokenRequest) (*CreateAuthTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuthToken not implemented")
+}
 func (UnimplementedAuthTokenServiceServer) ListAuthTokens(context.Context, *ListAuthTokensRequest) (*ListAuthTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAuthTokens not implemented")
 }
 func (UnimplementedAuthTokenServiceServer) DeleteAuthToken(context.Context, *DeleteAuthTokenRequest) (*DeleteAuthTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuthToken not implemented")
 }
+func (UnimplementedAuthTokenServiceServer) RefreshAuthToken(context.Context, *RefreshAuthTokenRequest) (*RefreshAuthTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshAuthToken not implemented")
+}
 func (UnimplementedAuthTokenServiceServer) mustEmbedUnimplementedAuthTokenServiceServer() {}
 
 // UnsafeAuthTokenServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -130,6 +178,24 @@ func _AuthTokenService_GetAuthToken_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthTokenService_CreateAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuthTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthTokenServiceServer).CreateAuthToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AuthTokenService/CreateAuthToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthTokenServiceServer).CreateAuthToken(ctx, req.(*CreateAuthTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthTokenService_ListAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAuthTokensRequest)
 	if err := dec(in); err != nil {
@@ -166,6 +232,24 @@ func _AuthTokenService_DeleteAuthToken_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthTokenService_RefreshAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshAuthTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthTokenServiceServer).RefreshAuthToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AuthTokenService/RefreshAuthToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthTokenServiceServer).RefreshAuthToken(ctx, req.(*RefreshAuthTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthTokenService_ServiceDesc is the grpc.ServiceDesc for AuthTokenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +261,10 @@ var AuthTokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthToken",
 			Handler:    _AuthTokenService_GetAuthToken_Handler,
 		},
+		{
+			MethodName: "CreateAuthToken",
+			Handler:    _AuthTokenService_CreateAuthToken_Handler,
+		},
 		{
 			MethodName: "ListAuthTokens",
 			Handler:    _AuthTokenService_ListAuthTokens_Handler,
@@ -185,6 +273,10 @@ var AuthTokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAuthToken",
 			Handler:    _AuthTokenService_DeleteAuthToken_Handler,
 		},
+		{
+			MethodName: "RefreshAuthToken",
+			Handler:    _AuthTokenService_RefreshAuthToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/authtokens_service.proto",
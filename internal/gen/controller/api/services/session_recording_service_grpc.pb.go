@@ -31,7 +31,8 @@ type SessionRecordingServiceClient interface {
 	// Supports both Session ID and Session recording ID for looking up a Session recording.
 	// Supports both Connection ID and Connection recording ID to look up a Connection recording.
 	// A Channel recording ID is required to look up a Channel recording.
-	// The only supported mime type is "application/x-asciicast".
+	// Channel recordings can be downloaded as "application/x-asciicast" or "text/plain",
+	// and Connection recordings of TCP sessions as "application/x-pcapng".
 	Download(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (SessionRecordingService_DownloadClient, error)
 }
 
@@ -109,7 +110,8 @@ type SessionRecordingServiceServer interface {
 	// Supports both Session ID and Session recording ID for looking up a Session recording.
 	// Supports both Connection ID and Connection recording ID to look up a Connection recording.
 	// A Channel recording ID is required to look up a Channel recording.
-	// The only supported mime type is "application/x-asciicast".
+	// Channel recordings can be downloaded as "application/x-asciicast" or "text/plain",
+	// and Connection recordings of TCP sessions as "application/x-pcapng".
 	Download(*DownloadRequest, SessionRecordingService_DownloadServer) error
 	mustEmbedUnimplementedSessionRecordingServiceServer()
 }
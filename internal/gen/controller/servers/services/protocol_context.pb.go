@@ -0,0 +1,858 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: controller/servers/services/v1/protocol_context.proto
+
+package services
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SshProtocolContext is the protocol context sent to a worker proxying a
+// connection for a session to an ssh target.
+type SshProtocolContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// injected_credentials are the credentials the worker uses to authenticate
+	// to the endpoint. The client never sees these credentials.
+	InjectedCredentials []*Credential `protobuf:"bytes,10,rep,name=injected_credentials,json=injectedCredentials,proto3" json:"injected_credentials,omitempty" class:"secret"` // @gotags: `class:"secret"`
+	// pkcs8_host_key is the private key the worker uses as its host key when
+	// terminating the client's ssh connection.
+	Pkcs8HostKey []byte `protobuf:"bytes,20,opt,name=pkcs8_host_key,json=pkcs8HostKey,proto3" json:"pkcs8_host_key,omitempty" class:"secret"` // @gotags: `class:"secret"`
+	// known_host_keys are the ssh public keys, one per line in authorized_keys
+	// format, trusted as the endpoint's host key. The worker does not connect to
+	// the endpoint if it is empty.
+	KnownHostKeys string `protobuf:"bytes,30,opt,name=known_host_keys,json=knownHostKeys,proto3" json:"known_host_keys,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *SshProtocolContext) Reset() {
+	*x = SshProtocolContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SshProtocolContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshProtocolContext) ProtoMessage() {}
+
+func (x *SshProtocolContext) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshProtocolContext.ProtoReflect.Descriptor instead.
+func (*SshProtocolContext) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SshProtocolContext) GetInjectedCredentials() []*Credential {
+	if x != nil {
+		return x.InjectedCredentials
+	}
+	return nil
+}
+
+func (x *SshProtocolContext) GetPkcs8HostKey() []byte {
+	if x != nil {
+		return x.Pkcs8HostKey
+	}
+	return nil
+}
+
+func (x *SshProtocolContext) GetKnownHostKeys() string {
+	if x != nil {
+		return x.KnownHostKeys
+	}
+	return ""
+}
+
+type HttpProtocolContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// injected_credentials are the credentials the worker adds to the requests
+	// it forwards to the endpoint. The client never sees these credentials.
+	InjectedCredentials []*Credential `protobuf:"bytes,10,rep,name=injected_credentials,json=injectedCredentials,proto3" json:"injected_credentials,omitempty" class:"secret"` // @gotags: `class:"secret"`
+	// enable_tls indicates the worker connects to the endpoint using TLS.
+	EnableTls bool `protobuf:"varint,20,opt,name=enable_tls,json=enableTls,proto3" json:"enable_tls,omitempty" class:"public"` // @gotags: `class:"public"`
+	// host is the host and port of the endpoint. The worker uses it as the Host
+	// header of the forwarded requests and to verify the endpoint's certificate.
+	Host string `protobuf:"bytes,30,opt,name=host,proto3" json:"host,omitempty" class:"public"` // @gotags: `class:"public"`
+	// tls_server_name is the name used to verify the endpoint's certificate. If
+	// empty, the host is used.
+	TlsServerName string `protobuf:"bytes,40,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty" class:"public"` // @gotags: `class:"public"`
+	// tls_ca_certificates is a PEM bundle of the certificate authorities used to
+	// verify the endpoint's certificate. If empty, the worker's system
+	// certificate authorities are used.
+	TlsCaCertificates string `protobuf:"bytes,50,opt,name=tls_ca_certificates,json=tlsCaCertificates,proto3" json:"tls_ca_certificates,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *HttpProtocolContext) Reset() {
+	*x = HttpProtocolContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpProtocolContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpProtocolContext) ProtoMessage() {}
+
+func (x *HttpProtocolContext) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpProtocolContext.ProtoReflect.Descriptor instead.
+func (*HttpProtocolContext) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HttpProtocolContext) GetInjectedCredentials() []*Credential {
+	if x != nil {
+		return x.InjectedCredentials
+	}
+	return nil
+}
+
+func (x *HttpProtocolContext) GetEnableTls() bool {
+	if x != nil {
+		return x.EnableTls
+	}
+	return false
+}
+
+func (x *HttpProtocolContext) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *HttpProtocolContext) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *HttpProtocolContext) GetTlsCaCertificates() string {
+	if x != nil {
+		return x.TlsCaCertificates
+	}
+	return ""
+}
+
+type PostgresProtocolContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// injected_credentials are the credentials the worker uses to authenticate
+	// to the endpoint. The client never sees these credentials.
+	InjectedCredentials []*Credential `protobuf:"bytes,10,rep,name=injected_credentials,json=injectedCredentials,proto3" json:"injected_credentials,omitempty" class:"secret"` // @gotags: `class:"secret"`
+	// session_id is the id of the session, recorded in the audit events of the
+	// queries sent by the client.
+	SessionId string `protobuf:"bytes,20,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// user_id is the id of the user who authorized the session, recorded in the
+	// audit events of the queries sent by the client.
+	UserId string `protobuf:"bytes,30,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *PostgresProtocolContext) Reset() {
+	*x = PostgresProtocolContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostgresProtocolContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostgresProtocolContext) ProtoMessage() {}
+
+func (x *PostgresProtocolContext) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostgresProtocolContext.ProtoReflect.Descriptor instead.
+func (*PostgresProtocolContext) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PostgresProtocolContext) GetInjectedCredentials() []*Credential {
+	if x != nil {
+		return x.InjectedCredentials
+	}
+	return nil
+}
+
+func (x *PostgresProtocolContext) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PostgresProtocolContext) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// PostgresQuery is the details of the audit event written by the worker for a
+// query sent by the client of a postgres session.
+type PostgresQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// connection_id is the id of the session connection the query was sent on.
+	ConnectionId string `protobuf:"bytes,10,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// database is the database the client connected to.
+	Database string `protobuf:"bytes,20,opt,name=database,proto3" json:"database,omitempty" class:"public"` // @gotags: `class:"public"`
+	// user is the user the worker authenticated to the endpoint as.
+	User string `protobuf:"bytes,30,opt,name=user,proto3" json:"user,omitempty" class:"public"` // @gotags: `class:"public"`
+	// query is the text of the query. Queries may contain literal values, so
+	// they are redacted by default.
+	Query string `protobuf:"bytes,40,opt,name=query,proto3" json:"query,omitempty" class:"sensitive"` // @gotags: `class:"sensitive"`
+}
+
+func (x *PostgresQuery) Reset() {
+	*x = PostgresQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostgresQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostgresQuery) ProtoMessage() {}
+
+func (x *PostgresQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostgresQuery.ProtoReflect.Descriptor instead.
+func (*PostgresQuery) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PostgresQuery) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *PostgresQuery) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *PostgresQuery) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *PostgresQuery) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+// TcpProtocolContext contains the limits the worker enforces on the connections
+// proxied for tcp targets and the settings it uses to terminate TLS. It is only
+// sent when the target has limits or terminates TLS.
+type TcpProtocolContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// session_id is the id of the session. The session byte quota is shared by
+	// the connections of the session proxied by the worker.
+	SessionId string `protobuf:"bytes,10,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// bandwidth_limit is the maximum rate, in bytes per second, of the data
+	// proxied by the connection. 0 means no limit.
+	BandwidthLimit uint64 `protobuf:"varint,20,opt,name=bandwidth_limit,json=bandwidthLimit,proto3" json:"bandwidth_limit,omitempty" class:"public"` // @gotags: `class:"public"`
+	// session_byte_quota is the maximum number of bytes proxied by all the
+	// connections of the session. 0 means no limit.
+	SessionByteQuota uint64 `protobuf:"varint,30,opt,name=session_byte_quota,json=sessionByteQuota,proto3" json:"session_byte_quota,omitempty" class:"public"` // @gotags: `class:"public"`
+	// session_bytes_used is the number of bytes proxied by the connections of
+	// the session known to the controller when the connection was authorized.
+	SessionBytesUsed uint64 `protobuf:"varint,40,opt,name=session_bytes_used,json=sessionBytesUsed,proto3" json:"session_bytes_used,omitempty" class:"public"` // @gotags: `class:"public"`
+	// terminate_tls indicates the worker terminates the client's TLS connection
+	// with tls_certificate and opens a new TLS connection to the endpoint.
+	TerminateTls bool `protobuf:"varint,50,opt,name=terminate_tls,json=terminateTls,proto3" json:"terminate_tls,omitempty" class:"public"` // @gotags: `class:"public"`
+	// host is the host and port of the endpoint. The worker uses the host to
+	// verify the endpoint's certificate when tls_server_name is not set.
+	Host string `protobuf:"bytes,60,opt,name=host,proto3" json:"host,omitempty" class:"public"` // @gotags: `class:"public"`
+	// tls_server_name is the name used to verify the endpoint's certificate.
+	TlsServerName string `protobuf:"bytes,70,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty" class:"public"` // @gotags: `class:"public"`
+	// tls_ca_certificates is a PEM bundle of the certificate authorities used to
+	// verify the endpoint's certificate. If empty, the worker's system
+	// certificate authorities are used.
+	TlsCaCertificates string `protobuf:"bytes,80,opt,name=tls_ca_certificates,json=tlsCaCertificates,proto3" json:"tls_ca_certificates,omitempty" class:"public"` // @gotags: `class:"public"`
+	// tls_min_version is the minimum TLS version accepted from the endpoint,
+	// "1.2" or "1.3". If empty, TLS 1.2 is required.
+	TlsMinVersion string `protobuf:"bytes,90,opt,name=tls_min_version,json=tlsMinVersion,proto3" json:"tls_min_version,omitempty" class:"public"` // @gotags: `class:"public"`
+	// injected_credentials are the credentials the worker uses to authenticate
+	// to the endpoint. A json credential with "certificate" and "private_key"
+	// PEM fields is presented as the worker's client certificate.
+	InjectedCredentials []*Credential `protobuf:"bytes,100,rep,name=injected_credentials,json=injectedCredentials,proto3" json:"injected_credentials,omitempty" class:"secret"` // @gotags: `class:"secret"`
+	// tls_ca_certificate is the DER encoded TLS certificate authority of the
+	// session, which issued tls_certificate. The client trusts it as the
+	// certificate authority of the certificates the worker presents when
+	// terminating TLS. Its key is never sent to the worker.
+	TlsCaCertificate []byte `protobuf:"bytes,110,opt,name=tls_ca_certificate,json=tlsCaCertificate,proto3" json:"tls_ca_certificate,omitempty" class:"public"` // @gotags: `class:"public"`
+	// tls_certificate is the DER encoded certificate the worker presents to the
+	// client when terminating TLS. It is issued by tls_ca_certificate for the
+	// connection and is only valid for the endpoint's host and the loopback
+	// names.
+	TlsCertificate []byte `protobuf:"bytes,120,opt,name=tls_certificate,json=tlsCertificate,proto3" json:"tls_certificate,omitempty" class:"public"` // @gotags: `class:"public"`
+	// idle_timeout_seconds is the number of seconds a connection can go without
+	// data proxied in either direction before the worker closes it. If 0, idle
+	// connections are not closed.
+	IdleTimeoutSeconds uint32 `protobuf:"varint,130,opt,name=idle_timeout_seconds,json=idleTimeoutSeconds,proto3" json:"idle_timeout_seconds,omitempty" class:"public"` // @gotags: `class:"public"`
+	// pkcs8_tls_key is the private key of tls_certificate.
+	Pkcs8TlsKey []byte `protobuf:"bytes,140,opt,name=pkcs8_tls_key,json=pkcs8TlsKey,proto3" json:"pkcs8_tls_key,omitempty" class:"secret"` // @gotags: `class:"secret"`
+}
+
+func (x *TcpProtocolContext) Reset() {
+	*x = TcpProtocolContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TcpProtocolContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TcpProtocolContext) ProtoMessage() {}
+
+func (x *TcpProtocolContext) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TcpProtocolContext.ProtoReflect.Descriptor instead.
+func (*TcpProtocolContext) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TcpProtocolContext) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *TcpProtocolContext) GetBandwidthLimit() uint64 {
+	if x != nil {
+		return x.BandwidthLimit
+	}
+	return 0
+}
+
+func (x *TcpProtocolContext) GetSessionByteQuota() uint64 {
+	if x != nil {
+		return x.SessionByteQuota
+	}
+	return 0
+}
+
+func (x *TcpProtocolContext) GetSessionBytesUsed() uint64 {
+	if x != nil {
+		return x.SessionBytesUsed
+	}
+	return 0
+}
+
+func (x *TcpProtocolContext) GetTerminateTls() bool {
+	if x != nil {
+		return x.TerminateTls
+	}
+	return false
+}
+
+func (x *TcpProtocolContext) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *TcpProtocolContext) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *TcpProtocolContext) GetTlsCaCertificates() string {
+	if x != nil {
+		return x.TlsCaCertificates
+	}
+	return ""
+}
+
+func (x *TcpProtocolContext) GetTlsMinVersion() string {
+	if x != nil {
+		return x.TlsMinVersion
+	}
+	return ""
+}
+
+func (x *TcpProtocolContext) GetInjectedCredentials() []*Credential {
+	if x != nil {
+		return x.InjectedCredentials
+	}
+	return nil
+}
+
+func (x *TcpProtocolContext) GetTlsCaCertificate() []byte {
+	if x != nil {
+		return x.TlsCaCertificate
+	}
+	return nil
+}
+
+func (x *TcpProtocolContext) GetTlsCertificate() []byte {
+	if x != nil {
+		return x.TlsCertificate
+	}
+	return nil
+}
+
+func (x *TcpProtocolContext) GetIdleTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.IdleTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *TcpProtocolContext) GetPkcs8TlsKey() []byte {
+	if x != nil {
+		return x.Pkcs8TlsKey
+	}
+	return nil
+}
+
+// KubernetesProtocolContext is the protocol context sent to a worker proxying a
+// connection for a session to a kubernetes target.
+type KubernetesProtocolContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// injected_credentials are the credentials holding the service account token
+	// the worker adds to the requests it forwards to the Kubernetes API server.
+	// The client never sees these credentials.
+	InjectedCredentials []*Credential `protobuf:"bytes,10,rep,name=injected_credentials,json=injectedCredentials,proto3" json:"injected_credentials,omitempty" class:"secret"` // @gotags: `class:"secret"`
+	// host is the host and port of the Kubernetes API server.
+	Host string `protobuf:"bytes,20,opt,name=host,proto3" json:"host,omitempty" class:"public"` // @gotags: `class:"public"`
+	// tls_server_name is the name used to verify the Kubernetes API server's
+	// certificate. If empty, the host is used.
+	TlsServerName string `protobuf:"bytes,30,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty" class:"public"` // @gotags: `class:"public"`
+	// tls_ca_certificates is a PEM bundle of the certificate authorities used to
+	// verify the Kubernetes API server's certificate. If empty, the worker's
+	// system certificate authorities are used.
+	TlsCaCertificates string `protobuf:"bytes,40,opt,name=tls_ca_certificates,json=tlsCaCertificates,proto3" json:"tls_ca_certificates,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *KubernetesProtocolContext) Reset() {
+	*x = KubernetesProtocolContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubernetesProtocolContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesProtocolContext) ProtoMessage() {}
+
+func (x *KubernetesProtocolContext) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesProtocolContext.ProtoReflect.Descriptor instead.
+func (*KubernetesProtocolContext) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KubernetesProtocolContext) GetInjectedCredentials() []*Credential {
+	if x != nil {
+		return x.InjectedCredentials
+	}
+	return nil
+}
+
+func (x *KubernetesProtocolContext) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *KubernetesProtocolContext) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *KubernetesProtocolContext) GetTlsCaCertificates() string {
+	if x != nil {
+		return x.TlsCaCertificates
+	}
+	return ""
+}
+
+var File_controller_servers_services_v1_protocol_context_proto protoreflect.FileDescriptor
+
+var file_controller_servers_services_v1_protocol_context_proto_rawDesc = []byte{
+	0x0a, 0x35, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x01, 0x0a, 0x12, 0x53, 0x73, 0x68,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x5d, 0x0a, 0x14, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x13, 0x69, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x70, 0x6b, 0x63, 0x73, 0x38, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x6b, 0x63, 0x73, 0x38, 0x48, 0x6f, 0x73,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xff, 0x01, 0x0a,
+	0x13, 0x48, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x5d, 0x0a, 0x14, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x13,
+	0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6c,
+	0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x6c, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e,
+	0x0a, 0x13, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x6c, 0x73,
+	0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0xb0,
+	0x01, 0x0a, 0x17, 0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x5d, 0x0a, 0x14, 0x69, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x52, 0x13, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x7a, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0xff, 0x04,
+	0x0a, 0x12, 0x54, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x79, 0x74, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6c, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6c, 0x73,
+	0x5f, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73,
+	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x5a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x74, 0x6c, 0x73, 0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x5d, 0x0a, 0x14, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x13, 0x69, 0x6e, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x6c,
+	0x73, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x78, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x69, 0x64, 0x6c, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x82, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6b,
+	0x63, 0x73, 0x38, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x8c, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x70, 0x6b, 0x63, 0x73, 0x38, 0x54, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x22,
+	0xe6, 0x01, 0x0a, 0x19, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x5d, 0x0a,
+	0x14, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x13, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6c, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6c, 0x73, 0x5f,
+	0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x42, 0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_controller_servers_services_v1_protocol_context_proto_rawDescOnce sync.Once
+	file_controller_servers_services_v1_protocol_context_proto_rawDescData = file_controller_servers_services_v1_protocol_context_proto_rawDesc
+)
+
+func file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP() []byte {
+	file_controller_servers_services_v1_protocol_context_proto_rawDescOnce.Do(func() {
+		file_controller_servers_services_v1_protocol_context_proto_rawDescData = protoimpl.X.CompressGZIP(file_controller_servers_services_v1_protocol_context_proto_rawDescData)
+	})
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescData
+}
+
+var file_controller_servers_services_v1_protocol_context_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_controller_servers_services_v1_protocol_context_proto_goTypes = []interface{}{
+	(*SshProtocolContext)(nil),        // 0: controller.servers.services.v1.SshProtocolContext
+	(*HttpProtocolContext)(nil),       // 1: controller.servers.services.v1.HttpProtocolContext
+	(*PostgresProtocolContext)(nil),   // 2: controller.servers.services.v1.PostgresProtocolContext
+	(*PostgresQuery)(nil),             // 3: controller.servers.services.v1.PostgresQuery
+	(*TcpProtocolContext)(nil),        // 4: controller.servers.services.v1.TcpProtocolContext
+	(*KubernetesProtocolContext)(nil), // 5: controller.servers.services.v1.KubernetesProtocolContext
+	(*Credential)(nil),                // 6: controller.servers.services.v1.Credential
+}
+var file_controller_servers_services_v1_protocol_context_proto_depIdxs = []int32{
+	6, // 0: controller.servers.services.v1.SshProtocolContext.injected_credentials:type_name -> controller.servers.services.v1.Credential
+	6, // 1: controller.servers.services.v1.HttpProtocolContext.injected_credentials:type_name -> controller.servers.services.v1.Credential
+	6, // 2: controller.servers.services.v1.PostgresProtocolContext.injected_credentials:type_name -> controller.servers.services.v1.Credential
+	6, // 3: controller.servers.services.v1.TcpProtocolContext.injected_credentials:type_name -> controller.servers.services.v1.Credential
+	6, // 4: controller.servers.services.v1.KubernetesProtocolContext.injected_credentials:type_name -> controller.servers.services.v1.Credential
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_controller_servers_services_v1_protocol_context_proto_init() }
+func file_controller_servers_services_v1_protocol_context_proto_init() {
+	if File_controller_servers_services_v1_protocol_context_proto != nil {
+		return
+	}
+	file_controller_servers_services_v1_credential_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SshProtocolContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpProtocolContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostgresProtocolContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostgresQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpProtocolContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubernetesProtocolContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_controller_servers_services_v1_protocol_context_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_controller_servers_services_v1_protocol_context_proto_goTypes,
+		DependencyIndexes: file_controller_servers_services_v1_protocol_context_proto_depIdxs,
+		MessageInfos:      file_controller_servers_services_v1_protocol_context_proto_msgTypes,
+	}.Build()
+	File_controller_servers_services_v1_protocol_context_proto = out.File
+	file_controller_servers_services_v1_protocol_context_proto_rawDesc = nil
+	file_controller_servers_services_v1_protocol_context_proto_goTypes = nil
+	file_controller_servers_services_v1_protocol_context_proto_depIdxs = nil
+}
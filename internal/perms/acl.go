@@ -41,6 +41,11 @@ func (a AclGrant) Actions() ([]action.Type, []string) {
 // action is allowed on a resource based on a principal's (user or group) grants.
 type ACL struct {
 	scopeMap map[string][]AclGrant
+
+	// limit, if set, is an ACL which must also allow an action for it to be
+	// allowed by this ACL. It is used to restrict the permissions of API
+	// tokens with grants to a subset of the permissions of their user.
+	limit *ACL
 }
 
 // ACLResults provides a type for the permission's engine results so that we can
@@ -112,6 +117,16 @@ func NewACL(grants ...Grant) ACL {
 	return ret
 }
 
+// Intersect returns an ACL which only allows the actions, output fields and
+// permissions allowed by both a and limit.
+func (a ACL) Intersect(limit ACL) ACL {
+	if a.limit != nil {
+		limit = a.limit.Intersect(limit)
+	}
+	a.limit = &limit
+	return a
+}
+
 func aclGrantFromGrant(grant Grant, id string) AclGrant {
 	return AclGrant{
 		scope:        grant.scope,
@@ -123,7 +138,38 @@ func aclGrantFromGrant(grant Grant, id string) AclGrant {
 }
 
 // Allowed determines if the grants for an ACL allow an action for a resource.
-func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option) (results ACLResults) {
+func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option) ACLResults {
+	results := a.allowed(r, aType, userId, opt...)
+	if a.limit == nil {
+		return results
+	}
+	limitResults := a.limit.Allowed(r, aType, userId, opt...)
+	results.Authorized = results.Authorized && limitResults.Authorized
+	results.OutputFields = intersectOutputFields(results.OutputFields, limitResults.OutputFields)
+	return results
+}
+
+// intersectOutputFields returns the output fields allowed by both a and b. If
+// only one of them has set fields, those are returned.
+func intersectOutputFields(a, b *OutputFields) *OutputFields {
+	aFields, aSet := a.Fields()
+	_, bSet := b.Fields()
+	switch {
+	case !bSet || b.Has("*"):
+		return a
+	case !aSet || a.Has("*"):
+		return b
+	}
+	fields := make([]string, 0, len(aFields))
+	for _, f := range aFields {
+		if b.Has(f) {
+			fields = append(fields, f)
+		}
+	}
+	return (*OutputFields)(nil).AddFields(fields)
+}
+
+func (a ACL) allowed(r Resource, aType action.Type, userId string, opt ...Option) (results ACLResults) {
 	opts := getOpts(opt...)
 
 	// First, get the grants within the specified scope
@@ -286,6 +332,52 @@ func (a ACL) ListPermissions(requestedScopes map[string]*scopes.ScopeInfo,
 	requestedType resource.Type,
 	idActions action.ActionSet,
 	userId string,
+) []Permission {
+	perms := a.listPermissions(requestedScopes, requestedType, idActions, userId)
+	if a.limit == nil {
+		return perms
+	}
+	limitPerms := make(map[string]Permission, len(perms))
+	for _, p := range a.limit.ListPermissions(requestedScopes, requestedType, idActions, userId) {
+		limitPerms[p.ScopeId] = p
+	}
+	ret := make([]Permission, 0, len(perms))
+	for _, p := range perms {
+		lp, ok := limitPerms[p.ScopeId]
+		if !ok {
+			continue
+		}
+		p.OnlySelf = p.OnlySelf || lp.OnlySelf
+		switch {
+		case lp.All:
+			// The limit allows every resource the ACL allows
+		case p.All:
+			p.All = false
+			p.ResourceIds = lp.ResourceIds
+		default:
+			limitIds := make(map[string]bool, len(lp.ResourceIds))
+			for _, id := range lp.ResourceIds {
+				limitIds[id] = true
+			}
+			var ids []string
+			for _, id := range p.ResourceIds {
+				if limitIds[id] {
+					ids = append(ids, id)
+				}
+			}
+			p.ResourceIds = ids
+		}
+		if p.All || len(p.ResourceIds) > 0 {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+func (a ACL) listPermissions(requestedScopes map[string]*scopes.ScopeInfo,
+	requestedType resource.Type,
+	idActions action.ActionSet,
+	userId string,
 ) []Permission {
 	perms := make([]Permission, 0, len(requestedScopes))
 	for scopeId := range requestedScopes {
@@ -861,6 +861,118 @@ func TestACL_ListPermissions(t *testing.T) {
 	}
 }
 
+func TestACL_Intersect(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userId := "u_1234567890"
+
+	parse := func(t *testing.T, sgs ...scopeGrant) ACL {
+		t.Helper()
+		var grants []Grant
+		for _, sg := range sgs {
+			for _, g := range sg.grants {
+				grant, err := Parse(ctx, sg.scope, g)
+				require.NoError(t, err)
+				grants = append(grants, grant)
+			}
+		}
+		return NewACL(grants...)
+	}
+
+	acl := parse(t, scopeGrant{
+		scope: "p_1",
+		grants: []string{
+			"id=*;type=target;actions=read,update,delete,list;output_fields=id,name,description",
+			"id=*;type=session;actions=read,cancel,list",
+		},
+	})
+	limit := parse(t, scopeGrant{
+		scope: "p_1",
+		grants: []string{
+			"id=*;type=target;actions=read,list,authorize-session;output_fields=id,name,scope_id",
+			"id=s_1234567890;type=session;actions=read",
+		},
+	})
+	intersected := acl.Intersect(limit)
+
+	t.Run("allowed", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			resource     Resource
+			action       action.Type
+			authorized   bool
+			outputFields []string
+		}{
+			{
+				name:         "allowed by both",
+				resource:     Resource{ScopeId: "p_1", Id: "ttcp_1234567890", Type: resource.Target},
+				action:       action.Read,
+				authorized:   true,
+				outputFields: []string{"id", "name"},
+			},
+			{
+				name:       "only allowed by acl",
+				resource:   Resource{ScopeId: "p_1", Id: "ttcp_1234567890", Type: resource.Target},
+				action:     action.Delete,
+				authorized: false,
+			},
+			{
+				name:       "only allowed by limit",
+				resource:   Resource{ScopeId: "p_1", Id: "ttcp_1234567890", Type: resource.Target},
+				action:     action.AuthorizeSession,
+				authorized: false,
+			},
+			{
+				name:       "only allowed by acl for another id",
+				resource:   Resource{ScopeId: "p_1", Id: "s_0987654321", Type: resource.Session},
+				action:     action.Read,
+				authorized: false,
+			},
+			{
+				name:       "other scope",
+				resource:   Resource{ScopeId: "p_2", Id: "ttcp_1234567890", Type: resource.Target},
+				action:     action.Read,
+				authorized: false,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert := assert.New(t)
+				results := intersected.Allowed(tt.resource, tt.action, userId)
+				assert.Equal(tt.authorized, results.Authorized)
+				if tt.authorized {
+					fields, _ := results.OutputFields.Fields()
+					assert.ElementsMatch(tt.outputFields, fields)
+				}
+			})
+		}
+	})
+
+	t.Run("list permissions", func(t *testing.T) {
+		assert := assert.New(t)
+		requestedScopes := map[string]*scopes.ScopeInfo{"p_1": nil}
+		targetPerms := intersected.ListPermissions(requestedScopes, resource.Target, action.ActionSet{action.Read}, userId)
+		assert.ElementsMatch([]Permission{
+			{
+				ScopeId:  "p_1",
+				Resource: resource.Target,
+				Action:   action.List,
+				All:      true,
+			},
+		}, targetPerms)
+		sessionPerms := intersected.ListPermissions(requestedScopes, resource.Session, action.ActionSet{action.Read}, userId)
+		assert.ElementsMatch([]Permission{
+			{
+				ScopeId:     "p_1",
+				Resource:    resource.Session,
+				Action:      action.List,
+				ResourceIds: []string{"s_1234567890"},
+			},
+		}, sessionPerms)
+	})
+}
+
 func TestJsonMarshal(t *testing.T) {
 	res := &Resource{
 		ScopeId: "scope",
@@ -932,7 +1044,7 @@ func Test_AnonRestrictions(t *testing.T) {
 				if i == resource.Controller || i == resource.Worker {
 					continue
 				}
-				for j := action.Type(1); j <= action.Download; j++ {
+				for j := action.Type(1); j <= action.RefreshSelf; j++ {
 					id := "foobar"
 					prefixes := globals.ResourcePrefixesFromType(resource.Type(i))
 					if len(prefixes) > 0 {
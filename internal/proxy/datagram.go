@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxDatagramSize is the largest udp payload which can be framed by
+// WriteDatagram.
+const MaxDatagramSize = 65535
+
+// WriteDatagram writes the datagram b to w prefixed with its length as a 2 byte
+// big endian integer, so udp datagrams can be relayed over the byte stream of a
+// proxied connection while keeping their boundaries.
+func WriteDatagram(w io.Writer, b []byte) error {
+	if len(b) > MaxDatagramSize {
+		return fmt.Errorf("datagram of %d bytes exceeds the maximum of %d bytes", len(b), MaxDatagramSize)
+	}
+	frame := make([]byte, 2+len(b))
+	binary.BigEndian.PutUint16(frame, uint16(len(b)))
+	copy(frame[2:], b)
+	_, err := w.Write(frame)
+	return err
+}
+
+// ReadDatagram reads a datagram written by WriteDatagram from r into buf, which
+// should be at least MaxDatagramSize bytes long, and returns the datagram.  The
+// returned slice shares buf's underlying array.  io.EOF is only returned when r
+// ends between datagrams.
+func ReadDatagram(r io.Reader, buf []byte) ([]byte, error) {
+	var l [2]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return nil, err
+	}
+	n := int(binary.BigEndian.Uint16(l[:]))
+	if n > len(buf) {
+		return nil, fmt.Errorf("datagram of %d bytes exceeds the buffer of %d bytes", n, len(buf))
+	}
+	if _, err := io.ReadFull(r, buf[:n]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// WriteMessage writes m to w framed with WriteDatagram.  It is used to send
+// handshake messages over the streams of a multiplexed connection, which
+// unlike a websocket don't keep message boundaries.
+func WriteMessage(w io.Writer, m proto.Message) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return WriteDatagram(w, b)
+}
+
+// ReadMessage reads a message written by WriteMessage from r into m.  Nothing
+// past the message is read from r.
+func ReadMessage(r io.Reader, m proto.Message) error {
+	b, err := ReadDatagram(r, make([]byte, MaxDatagramSize))
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(b, m)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDatagram(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		var stream bytes.Buffer
+		datagrams := [][]byte{[]byte("first"), {}, bytes.Repeat([]byte("x"), MaxDatagramSize)}
+		for _, d := range datagrams {
+			require.NoError(t, WriteDatagram(&stream, d))
+		}
+		buf := make([]byte, MaxDatagramSize)
+		for _, d := range datagrams {
+			got, err := ReadDatagram(&stream, buf)
+			require.NoError(t, err)
+			assert.Equal(t, d, got)
+		}
+		_, err := ReadDatagram(&stream, buf)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+	t.Run("too large", func(t *testing.T) {
+		var stream bytes.Buffer
+		assert.Error(t, WriteDatagram(&stream, make([]byte, MaxDatagramSize+1)))
+		assert.Zero(t, stream.Len())
+	})
+	t.Run("small buffer", func(t *testing.T) {
+		var stream bytes.Buffer
+		require.NoError(t, WriteDatagram(&stream, []byte("datagram")))
+		_, err := ReadDatagram(&stream, make([]byte, 4))
+		assert.Error(t, err)
+	})
+	t.Run("truncated", func(t *testing.T) {
+		var stream bytes.Buffer
+		require.NoError(t, WriteDatagram(&stream, []byte("datagram")))
+		stream.Truncate(5)
+		_, err := ReadDatagram(&stream, make([]byte, MaxDatagramSize))
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
+
+func TestMessage(t *testing.T) {
+	var stream bytes.Buffer
+	want := &HandshakeResult{ConnectionLimit: 10, ConnectionsLeft: 4}
+	require.NoError(t, WriteMessage(&stream, want))
+	stream.WriteString("trailing")
+
+	got := &HandshakeResult{}
+	require.NoError(t, ReadMessage(&stream, got))
+	assert.True(t, proto.Equal(want, got))
+	assert.Equal(t, "trailing", stream.String())
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"io"
+
+	"github.com/hashicorp/yamux"
+)
+
+// NewMuxConfig returns the yamux configuration used by the client and the
+// worker for the sessions of a websocket negotiated with the
+// globals.TcpProxyV2 subprotocol.
+func NewMuxConfig() *yamux.Config {
+	cfg := yamux.DefaultConfig()
+	cfg.LogOutput = io.Discard
+	return cfg
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package scim provides the tokens an identity provider uses to provision the
+// users, groups and accounts of an auth method through the controller's SCIM
+// 2.0 API.
+//
+// A token's value is a base62 value with a version prefix which is encrypted
+// at rest with the database key of the auth method's scope.  An auth method
+// has at most one token: creating a token revokes the previous one, and
+// deleting the auth method deletes its token.  Requests present the token as
+// "<public id>_<value>" in a bearer Authorization header.
+//
+// # Repository
+//
+// A repository provides methods for creating, validating and deleting SCIM
+// tokens, for finding the users of the accounts in an auth method, and for
+// recording which users and accounts were created through the SCIM API so
+// only those are deleted through it.
+package scim
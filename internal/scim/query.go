@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+const (
+	deleteAuthMethodTokenQuery = `
+delete from scim_token
+ where auth_method_id = ?;
+`
+
+	accountUsersQuery = `
+select public_id,
+       iam_user_id
+  from auth_account
+ where auth_method_id = ?
+   and iam_user_id is not null;
+`
+
+	insertProvisionedAccountQuery = `
+insert into scim_provisioned_account
+  (account_id, auth_method_id)
+values
+  (?, ?);
+`
+
+	insertProvisionedUserQuery = `
+insert into scim_provisioned_user
+  (user_id, auth_method_id)
+values
+  (?, ?);
+`
+
+	provisionedQuery = `
+select exists(select 1
+                from scim_provisioned_user
+               where user_id = @user_id
+                 and auth_method_id = @auth_method_id),
+       exists(select 1
+                from scim_provisioned_account
+               where account_id = @account_id
+                 and auth_method_id = @auth_method_id);
+`
+)
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// A Repository stores and retrieves the persistent types in the scim
+// package. It is not safe to use a repository concurrently.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+}
+
+// NewRepository creates a new Repository. The returned repository is not safe
+// for concurrent go routines to access it.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms) (*Repository, error) {
+	const op = "scim.NewRepository"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil db reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil db writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil kms")
+	}
+	return &Repository{
+		reader: r,
+		writer: w,
+		kms:    kms,
+	}, nil
+}
+
+// CreateToken creates a SCIM token for the auth method in the scope and
+// returns it, including its value.  Any existing token of the auth method is
+// deleted.
+func (r *Repository) CreateToken(ctx context.Context, scopeId, authMethodId string) (*Token, error) {
+	const op = "scim.(Repository).CreateToken"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	tok, err := newToken(ctx, scopeId, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	var newToken *Token
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			// tokens are not replicated, so they don't need oplog entries.
+			if _, err := w.Exec(ctx, deleteAuthMethodTokenQuery, []any{authMethodId}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("deleting previous token"))
+			}
+			newToken = tok.clone()
+			if err := newToken.encrypt(ctx, databaseWrapper); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if err := w.Create(ctx, newToken); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(authMethodId))
+	}
+	newToken.CtValue = nil
+	newToken.KeyId = ""
+	return newToken, nil
+}
+
+// DeleteToken deletes the SCIM token of the auth method, returning the number
+// of tokens deleted.
+func (r *Repository) DeleteToken(ctx context.Context, authMethodId string) (int, error) {
+	const op = "scim.(Repository).DeleteToken"
+	if authMethodId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	var rowsDeleted int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			// tokens are not replicated, so they don't need oplog entries.
+			rowsDeleted, err = w.Exec(ctx, deleteAuthMethodTokenQuery, []any{authMethodId})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(authMethodId))
+	}
+	return rowsDeleted, nil
+}
+
+// ValidateToken returns the SCIM token whose value is the provided one.  For
+// security reasons the token's value is not included in the returned Token.
+// If no token has the value nil, nil is returned.
+//
+// NOTE: Do not log or add the token string to any errors to avoid leaking it
+// as it is a secret.
+func (r *Repository) ValidateToken(ctx context.Context, token string) (*Token, error) {
+	const op = "scim.(Repository).ValidateToken"
+	if token == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	}
+	// The value is "<prefix>_<id>_<secret>".
+	split := strings.SplitN(token, "_", 3)
+	if len(split) != 3 || split[0] != globals.ScimTokenPrefix {
+		return nil, nil
+	}
+	id, value := split[0]+"_"+split[1], split[2]
+
+	tok := allocToken()
+	tok.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, tok); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, tok.GetScopeId(), kms.KeyPurposeDatabase, kms.WithKeyId(tok.GetKeyId()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := tok.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if subtle.ConstantTimeCompare([]byte(tok.GetValue()), []byte(value)) != 1 {
+		return nil, nil
+	}
+	tok.Value = ""
+	tok.CtValue = nil
+	tok.KeyId = ""
+	return tok, nil
+}
+
+// AccountUsers returns the ids of the users of the accounts in the auth
+// method, keyed by account id.  Accounts without a user are not included.
+func (r *Repository) AccountUsers(ctx context.Context, authMethodId string) (map[string]string, error) {
+	const op = "scim.(Repository).AccountUsers"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	rows, err := r.reader.Query(ctx, accountUsersQuery, []any{authMethodId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	ret := make(map[string]string)
+	for rows.Next() {
+		var accountId, userId string
+		if err := rows.Scan(&accountId, &userId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ret[accountId] = userId
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+// AddProvisioned records that the user and its account were created through
+// the SCIM API of the auth method, so they can be deleted through it.
+func (r *Repository) AddProvisioned(ctx context.Context, authMethodId, userId, accountId string) error {
+	const op = "scim.(Repository).AddProvisioned"
+	switch {
+	case authMethodId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case userId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	case accountId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, insertProvisionedUserQuery, []any{userId, authMethodId}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("recording user"))
+			}
+			if _, err := w.Exec(ctx, insertProvisionedAccountQuery, []any{accountId, authMethodId}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("recording account"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(userId))
+	}
+	return nil
+}
+
+// Provisioned reports whether the user and the account were created through
+// the SCIM API of the auth method.
+func (r *Repository) Provisioned(ctx context.Context, authMethodId, userId, accountId string) (bool, bool, error) {
+	const op = "scim.(Repository).Provisioned"
+	switch {
+	case authMethodId == "":
+		return false, false, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case userId == "":
+		return false, false, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	case accountId == "":
+		return false, false, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	rows, err := r.reader.Query(ctx, provisionedQuery, []any{
+		sql.Named("auth_method_id", authMethodId),
+		sql.Named("user_id", userId),
+		sql.Named("account_id", accountId),
+	})
+	if err != nil {
+		return false, false, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var user, account bool
+	for rows.Next() {
+		if err := rows.Scan(&user, &account); err != nil {
+			return false, false, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, false, errors.Wrap(ctx, err, op)
+	}
+	return user, account, nil
+}
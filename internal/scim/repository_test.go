@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_New(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	tests := []struct {
+		name      string
+		r         db.Reader
+		w         db.Writer
+		kms       *kms.Kms
+		wantIsErr errors.Code
+	}{
+		{name: "valid", r: rw, w: rw, kms: kmsCache},
+		{name: "nil-reader", w: rw, kms: kmsCache, wantIsErr: errors.InvalidParameter},
+		{name: "nil-writer", r: rw, kms: kmsCache, wantIsErr: errors.InvalidParameter},
+		{name: "nil-kms", r: rw, w: rw, wantIsErr: errors.InvalidParameter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewRepository(ctx, tt.r, tt.w, tt.kms)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "Unexpected error %s", err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.NotNil(got)
+		})
+	}
+}
+
+func TestRepository_Tokens(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	require.NoError(t, kmsCache.CreateKeys(ctx, org.GetPublicId()))
+	am := password.TestAuthMethod(t, conn, org.GetPublicId())
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	t.Run("create-validate-delete", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tok, err := repo.CreateToken(ctx, org.GetPublicId(), am.GetPublicId())
+		require.NoError(err)
+		assert.Empty(tok.GetCtValue())
+		bearer := tok.BearerToken()
+		require.NotEmpty(bearer)
+
+		got, err := repo.ValidateToken(ctx, bearer)
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(tok.GetPublicId(), got.GetPublicId())
+		assert.Equal(org.GetPublicId(), got.GetScopeId())
+		assert.Equal(am.GetPublicId(), got.GetAuthMethodId())
+		assert.Empty(got.GetValue())
+
+		got, err = repo.ValidateToken(ctx, bearer+"x")
+		require.NoError(err)
+		assert.Nil(got)
+
+		// Creating a new token replaces the previous one.
+		tok2, err := repo.CreateToken(ctx, org.GetPublicId(), am.GetPublicId())
+		require.NoError(err)
+		got, err = repo.ValidateToken(ctx, bearer)
+		require.NoError(err)
+		assert.Nil(got)
+		got, err = repo.ValidateToken(ctx, tok2.BearerToken())
+		require.NoError(err)
+		assert.NotNil(got)
+
+		n, err := repo.DeleteToken(ctx, am.GetPublicId())
+		require.NoError(err)
+		assert.Equal(1, n)
+		got, err = repo.ValidateToken(ctx, tok2.BearerToken())
+		require.NoError(err)
+		assert.Nil(got)
+
+		n, err = repo.DeleteToken(ctx, am.GetPublicId())
+		require.NoError(err)
+		assert.Equal(0, n)
+	})
+
+	t.Run("invalid-params", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.CreateToken(ctx, "", am.GetPublicId())
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.CreateToken(ctx, org.GetPublicId(), "")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.DeleteToken(ctx, "")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.ValidateToken(ctx, "")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		got, err := repo.ValidateToken(ctx, "at_1234567890_abc")
+		assert.NoError(err)
+		assert.Nil(got)
+	})
+}
+
+func TestRepository_AccountUsers(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	am := password.TestAuthMethod(t, conn, org.GetPublicId())
+	accts := password.TestMultipleAccounts(t, conn, am.GetPublicId(), 3)
+	u1 := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithAccountIds(accts[0].GetPublicId()))
+	u2 := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithAccountIds(accts[1].GetPublicId()))
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	got, err := repo.AccountUsers(ctx, am.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		accts[0].GetPublicId(): u1.GetPublicId(),
+		accts[1].GetPublicId(): u2.GetPublicId(),
+	}, got)
+}
+
+func TestRepository_Provisioned(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	ams := password.TestAuthMethods(t, conn, org.GetPublicId(), 2)
+	accts := password.TestMultipleAccounts(t, conn, ams[0].GetPublicId(), 2)
+	u1 := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithAccountIds(accts[0].GetPublicId()))
+	u2 := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithAccountIds(accts[1].GetPublicId()))
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	require.NoError(t, repo.AddProvisioned(ctx, ams[0].GetPublicId(), u1.GetPublicId(), accts[0].GetPublicId()))
+	assert.Error(t, repo.AddProvisioned(ctx, ams[0].GetPublicId(), u1.GetPublicId(), accts[0].GetPublicId()))
+
+	tests := []struct {
+		name               string
+		authMethodId       string
+		userId, accountId  string
+		wantUser, wantAcct bool
+		wantIsErr          errors.Code
+	}{
+		{name: "provisioned", authMethodId: ams[0].GetPublicId(), userId: u1.GetPublicId(), accountId: accts[0].GetPublicId(), wantUser: true, wantAcct: true},
+		{name: "not-provisioned", authMethodId: ams[0].GetPublicId(), userId: u2.GetPublicId(), accountId: accts[1].GetPublicId()},
+		{name: "other-auth-method", authMethodId: ams[1].GetPublicId(), userId: u1.GetPublicId(), accountId: accts[0].GetPublicId()},
+		{name: "missing-auth-method", userId: u1.GetPublicId(), accountId: accts[0].GetPublicId(), wantIsErr: errors.InvalidParameter},
+		{name: "missing-user", authMethodId: ams[0].GetPublicId(), accountId: accts[0].GetPublicId(), wantIsErr: errors.InvalidParameter},
+		{name: "missing-account", authMethodId: ams[0].GetPublicId(), userId: u1.GetPublicId(), wantIsErr: errors.InvalidParameter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			user, acct, err := repo.Provisioned(ctx, tt.authMethodId, tt.userId, tt.accountId)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "Unexpected error %s", err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantUser, user)
+			assert.Equal(tt.wantAcct, acct)
+		})
+	}
+}
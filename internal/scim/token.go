@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/scim/store"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultTokenTableName = "scim_token"
+
+	// The version prefix is used to differentiate token versions just for future proofing.
+	tokenValueVersionPrefix = "0"
+	tokenLength             = 24
+)
+
+// A Token authenticates the SCIM requests of an auth method's identity
+// provider.  It is owned by the auth method.
+type Token struct {
+	*store.Token
+	tableName string `gorm:"-"`
+}
+
+func allocToken() *Token {
+	return &Token{
+		Token: &store.Token{},
+	}
+}
+
+func (t *Token) clone() *Token {
+	cp := proto.Clone(t.Token)
+	return &Token{
+		Token: cp.(*store.Token),
+	}
+}
+
+// TableName returns the table name.
+func (t *Token) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return defaultTokenTableName
+}
+
+// SetTableName sets the table name.
+func (t *Token) SetTableName(n string) {
+	t.tableName = n
+}
+
+// BearerToken returns the bearer token presented by SCIM requests
+// authenticated with the token.  It is empty unless the token was just
+// created.
+func (t *Token) BearerToken() string {
+	if t.GetValue() == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s_%s", t.GetPublicId(), t.GetValue())
+}
+
+// encrypt the token's value using the provided cipher (wrapping.Wrapper)
+func (t *Token) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "scim.(Token).encrypt"
+	// structwrapping doesn't support embedding, so we'll pass in the store.Token directly
+	if err := structwrapping.WrapStruct(ctx, cipher, t.Token, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get cipher key id"))
+	}
+	t.KeyId = keyId
+	return nil
+}
+
+// decrypt the token's value using the provided cipher (wrapping.Wrapper)
+func (t *Token) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "scim.(Token).decrypt"
+	// structwrapping doesn't support embedding, so we'll pass in the store.Token directly
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t.Token, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func newTokenId(ctx context.Context) (string, error) {
+	const op = "scim.newTokenId"
+	id, err := db.NewPublicId(ctx, globals.ScimTokenPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+// newToken generates a new in-memory token for the auth method.
+func newToken(ctx context.Context, scopeId, authMethodId string) (*Token, error) {
+	const op = "scim.newToken"
+	id, err := newTokenId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	value, err := base62.Random(tokenLength)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	return &Token{
+		Token: &store.Token{
+			PublicId:     id,
+			ScopeId:      scopeId,
+			AuthMethodId: authMethodId,
+			Value:        tokenValueVersionPrefix + value,
+		},
+	}, nil
+}
@@ -14,12 +14,14 @@ import (
 type ClosedReason string
 
 const (
-	UnknownReason          ClosedReason = "unknown"
-	ConnectionTimedOut     ClosedReason = "timed out"
-	ConnectionClosedByUser ClosedReason = "closed by end-user"
-	ConnectionCanceled     ClosedReason = "canceled"
-	ConnectionNetworkError ClosedReason = "network error"
-	ConnectionSystemError  ClosedReason = "system error"
+	UnknownReason           ClosedReason = "unknown"
+	ConnectionTimedOut      ClosedReason = "timed out"
+	ConnectionClosedByUser  ClosedReason = "closed by end-user"
+	ConnectionCanceled      ClosedReason = "canceled"
+	ConnectionNetworkError  ClosedReason = "network error"
+	ConnectionSystemError   ClosedReason = "system error"
+	ConnectionQuotaExceeded ClosedReason = "quota exceeded"
+	ConnectionIdleTimeout   ClosedReason = "idle timeout"
 )
 
 // String representation of the termination reason
@@ -42,6 +44,10 @@ func convertToClosedReason(ctx context.Context, s string) (ClosedReason, error)
 		return ConnectionNetworkError, nil
 	case ConnectionSystemError.String():
 		return ConnectionSystemError, nil
+	case ConnectionQuotaExceeded.String():
+		return ConnectionQuotaExceeded, nil
+	case ConnectionIdleTimeout.String():
+		return ConnectionIdleTimeout, nil
 	default:
 		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is not a valid reason", s))
 	}
@@ -5,6 +5,8 @@ package session
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/db"
@@ -57,3 +59,57 @@ func newConnectionStateId(ctx context.Context) (string, error) {
 	}
 	return id, nil
 }
+
+// RecordingId returns the id of the recording of the session with the provided
+// id.  A session recording shares the random part of its session's id, so
+// either id can be derived from the other.
+func RecordingId(ctx context.Context, sessionId string) (string, error) {
+	const op = "session.RecordingId"
+	id, err := replaceIdPrefix(sessionId, globals.SessionPrefix, globals.SessionRecordingPrefix)
+	if err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, err.Error())
+	}
+	return id, nil
+}
+
+// IdFromRecordingId returns the id of the session recorded by the session
+// recording with the provided id.
+func IdFromRecordingId(ctx context.Context, recordingId string) (string, error) {
+	const op = "session.IdFromRecordingId"
+	id, err := replaceIdPrefix(recordingId, globals.SessionRecordingPrefix, globals.SessionPrefix)
+	if err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, err.Error())
+	}
+	return id, nil
+}
+
+// ConnectionRecordingId returns the id of the recording of the connection with
+// the provided id.  A connection recording shares the random part of its
+// connection's id, so either id can be derived from the other.
+func ConnectionRecordingId(ctx context.Context, connectionId string) (string, error) {
+	const op = "session.ConnectionRecordingId"
+	id, err := replaceIdPrefix(connectionId, ConnectionPrefix, globals.ConnectionRecordingPrefix)
+	if err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, err.Error())
+	}
+	return id, nil
+}
+
+// ConnectionIdFromRecordingId returns the id of the connection recorded by the
+// connection recording with the provided id.
+func ConnectionIdFromRecordingId(ctx context.Context, recordingId string) (string, error) {
+	const op = "session.ConnectionIdFromRecordingId"
+	id, err := replaceIdPrefix(recordingId, globals.ConnectionRecordingPrefix, ConnectionPrefix)
+	if err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, err.Error())
+	}
+	return id, nil
+}
+
+func replaceIdPrefix(id, from, to string) (string, error) {
+	suffix, ok := strings.CutPrefix(id, from+"_")
+	if !ok || suffix == "" {
+		return "", fmt.Errorf("%q is not a %q id", id, from)
+	}
+	return fmt.Sprintf("%s_%s", to, suffix), nil
+}
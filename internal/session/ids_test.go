@@ -37,3 +37,42 @@ func Test_Ids(t *testing.T) {
 		assert.True(t, strings.HasPrefix(id, ConnectionStatePrefix+"_"))
 	})
 }
+
+func Test_RecordingIds(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	t.Run("session", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		id, err := newId(ctx)
+		require.NoError(err)
+		recId, err := RecordingId(ctx, id)
+		require.NoError(err)
+		assert.Equal(globals.SessionRecordingPrefix+strings.TrimPrefix(id, globals.SessionPrefix), recId)
+		got, err := IdFromRecordingId(ctx, recId)
+		require.NoError(err)
+		assert.Equal(id, got)
+
+		_, err = RecordingId(ctx, recId)
+		assert.Error(err)
+		_, err = IdFromRecordingId(ctx, id)
+		assert.Error(err)
+		_, err = RecordingId(ctx, globals.SessionPrefix+"_")
+		assert.Error(err)
+	})
+	t.Run("connection", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		id, err := newConnectionId(ctx)
+		require.NoError(err)
+		recId, err := ConnectionRecordingId(ctx, id)
+		require.NoError(err)
+		assert.Equal(globals.ConnectionRecordingPrefix+strings.TrimPrefix(id, ConnectionPrefix), recId)
+		got, err := ConnectionIdFromRecordingId(ctx, recId)
+		require.NoError(err)
+		assert.Equal(id, got)
+
+		_, err = ConnectionRecordingId(ctx, recId)
+		assert.Error(err)
+		_, err = ConnectionIdFromRecordingId(ctx, id)
+		assert.Error(err)
+	})
+}
@@ -437,6 +437,38 @@ values
 	sessionCredentialDynamicBatchInsertReturning = `
   returning session_id, library_id, credential_id, credential_purpose;
 `
+
+	// addRecordingConnection adds the connection recording of a connection
+	// if the connection's session is recorded.
+	addRecordingConnection = `
+insert into recording_connection
+	(public_id, session_id, session_connection_id, recording_session_id)
+select
+	@public_id, @session_id, @session_connection_id, public_id
+from
+	recording_session
+where
+	session_id = @session_id;
+`
+
+	connectionRecordingSessionId = `
+select
+	recording_session_id
+from
+	recording_connection
+where
+	public_id = @public_id;
+`
+
+	channelRecordingSessionId = `
+select
+	rc.recording_session_id
+from
+	recording_channel ch
+	join recording_connection rc on rc.public_id = ch.recording_connection_id
+where
+	ch.public_id = @public_id;
+`
 )
 
 func batchInsertSessionCredentialDynamic(creds []*DynamicCredential) (string, []any, error) {
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+)
+
+const (
+	// DefaultRecordingCompression is the compression of the chunks of a
+	// session recording whose target does not configure one.
+	DefaultRecordingCompression = "zstd"
+	// DefaultRecordingEncryption is the encryption of the chunks of a
+	// session recording whose target does not configure one.
+	DefaultRecordingEncryption = "aes-gcm"
+)
+
+// Recording is the recording of a session whose target has session recording
+// enabled.  It holds the storage bucket the session is recorded to and the
+// bsr keys its connections are recorded with, which are created once for the
+// session, along with the compression and encryption of its chunks.  The bsr key and the private key are only stored wrapped by the
+// bsr kms.
+type Recording struct {
+	// PublicId of the session recording, which is derived from the id of
+	// the recorded session.
+	PublicId string `json:"public_id,omitempty" gorm:"primary_key"`
+	// SessionId of the recorded session, which is empty once the session has
+	// been deleted.
+	SessionId string `json:"session_id,omitempty" gorm:"default:null"`
+	// StorageBucketId of the storage bucket the session is recorded to.
+	StorageBucketId string `json:"storage_bucket_id,omitempty" gorm:"default:null"`
+	// PubKey and the signatures of it, along with WrappedBsrKey and
+	// WrappedPrivKey, are the marshaled bsr keys of the recording.
+	PubKey              []byte `json:"pub_key,omitempty" gorm:"default:null"`
+	PubKeySelfSignature []byte `json:"pub_key_self_signature,omitempty" gorm:"default:null"`
+	PubKeyBsrSignature  []byte `json:"pub_key_bsr_signature,omitempty" gorm:"default:null"`
+	WrappedBsrKey       []byte `json:"wrapped_bsr_key,omitempty" gorm:"default:null"`
+	WrappedPrivKey      []byte `json:"wrapped_priv_key,omitempty" gorm:"default:null"`
+	// Compression and Encryption of the recording's chunks, which
+	// CreateRecording sets to DefaultRecordingCompression and
+	// DefaultRecordingEncryption if they are empty.
+	Compression string `json:"compression,omitempty" gorm:"default:null"`
+	Encryption  string `json:"encryption,omitempty" gorm:"default:null"`
+	// CreateTime from the RDBMS
+	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name.
+func (r *Recording) TableName() string {
+	return "recording_session"
+}
+
+// GetPublicId returns the public id of the session recording.
+func (r *Recording) GetPublicId() string {
+	return r.PublicId
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// CreateRecording creates the recording of the session in rec, whose public
+// id is derived from the session's id.  If the session already has a
+// recording, for instance because it was created concurrently, the existing
+// recording is returned instead so a session is only ever recorded with one
+// set of bsr keys.  The recording's compression and encryption default to
+// DefaultRecordingCompression and DefaultRecordingEncryption.  All options are
+// ignored.
+func (r *Repository) CreateRecording(ctx context.Context, rec *Recording, _ ...Option) (*Recording, error) {
+	const op = "session.(Repository).CreateRecording"
+	switch {
+	case rec == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing recording")
+	case rec.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id is not empty")
+	case rec.SessionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case rec.StorageBucketId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing storage bucket id")
+	case len(rec.PubKey) == 0, len(rec.PubKeySelfSignature) == 0, len(rec.PubKeyBsrSignature) == 0,
+		len(rec.WrappedBsrKey) == 0, len(rec.WrappedPrivKey) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing bsr keys")
+	}
+	id, err := RecordingId(ctx, rec.SessionId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	newRec := *rec
+	newRec.PublicId = id
+	if newRec.Compression == "" {
+		newRec.Compression = DefaultRecordingCompression
+	}
+	if newRec.Encryption == "" {
+		newRec.Encryption = DefaultRecordingEncryption
+	}
+	if err := r.writer.Create(ctx, &newRec); err != nil {
+		if errors.IsUniqueError(err) {
+			return r.LookupRecording(ctx, id)
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", rec.SessionId)))
+	}
+	return &newRec, nil
+}
+
+// LookupRecording returns the session recording which contains the recorded
+// resource with the provided id, which can be the id of a session recording,
+// a connection recording or a channel recording.  Returns nil, nil if no
+// session recording contains the resource.  All options are ignored.
+func (r *Repository) LookupRecording(ctx context.Context, id string, _ ...Option) (*Recording, error) {
+	const op = "session.(Repository).LookupRecording"
+	var query string
+	switch {
+	case id == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing id")
+	case strings.HasPrefix(id, globals.SessionRecordingPrefix+"_"):
+	case strings.HasPrefix(id, globals.ConnectionRecordingPrefix+"_"):
+		query = connectionRecordingSessionId
+	case strings.HasPrefix(id, globals.ChannelRecordingPrefix+"_"):
+		query = channelRecordingSessionId
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%q is not a recording id", id))
+	}
+	if query != "" {
+		rows, err := r.reader.Query(ctx, query, []any{sql.Named("public_id", id)})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		defer rows.Close()
+		found := false
+		for rows.Next() {
+			if err := rows.Scan(&id); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+			}
+			found = true
+		}
+		if err := rows.Err(); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if !found {
+			return nil, nil
+		}
+	}
+
+	rec := &Recording{PublicId: id}
+	if err := r.reader.LookupByPublicId(ctx, rec); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", id)))
+	}
+	return rec, nil
+}
+
+// ListRecordings returns the session recordings made to the storage buckets
+// with the provided ids.  All options are ignored.
+func (r *Repository) ListRecordings(ctx context.Context, storageBucketIds []string, _ ...Option) ([]*Recording, error) {
+	const op = "session.(Repository).ListRecordings"
+	if len(storageBucketIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing storage bucket ids")
+	}
+	var recs []*Recording
+	if err := r.reader.SearchWhere(ctx, &recs, "storage_bucket_id in (?)", []any{storageBucketIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return recs, nil
+}
+
+// AddRecordingConnection adds the connection recording of the connection with
+// the provided id if its session is recorded, so the connection recording can
+// be looked up by its id.  Nothing is added if the session is not recorded.
+// All options are ignored.
+func (r *Repository) AddRecordingConnection(ctx context.Context, sessionId, connectionId string, _ ...Option) error {
+	const op = "session.(Repository).AddRecordingConnection"
+	switch {
+	case sessionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case connectionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+	}
+	recordingId, err := ConnectionRecordingId(ctx, connectionId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := r.writer.Exec(ctx, addRecordingConnection, []any{
+		sql.Named("public_id", recordingId),
+		sql.Named("session_id", sessionId),
+		sql.Named("session_connection_id", connectionId),
+	}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", connectionId)))
+	}
+	return nil
+}
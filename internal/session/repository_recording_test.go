@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Recording(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	rw := db.New(conn)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	// Only sessions of ssh targets can be recorded.
+	params := TestSessionParams(t, conn, wrapper, iamRepo)
+	org, err := iamRepo.LookupScope(ctx, params.ProjectId)
+	require.NoError(t, err)
+	plg := plugin.TestPlugin(t, conn, "test", plugin.WithStorageFlag(true))
+	sb := pluginstorage.TestStorageBucket(t, conn, org.GetParentId(), plg.GetPublicId(), "bucket")
+	tar := ssh.TestTarget(ctx, t, conn, params.ProjectId, "recorded", target.WithHostSources([]string{params.HostSetId}),
+		target.WithEnableSessionRecording(true), target.WithStorageBucketId(sb.GetPublicId()))
+	params.TargetId = tar.GetPublicId()
+	recorded := TestSession(t, conn, wrapper, params)
+	notRecorded := TestSession(t, conn, wrapper, params)
+	configured := TestSession(t, conn, wrapper, params)
+
+	stored := TestRecording(t, conn, recorded.PublicId, sb.GetPublicId())
+
+	t.Run("create", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		// The recording of a session which is already recorded is
+		// returned with its keys unchanged.
+		rec := &Recording{
+			SessionId:           recorded.PublicId,
+			StorageBucketId:     sb.GetPublicId(),
+			PubKey:              []byte("pub key"),
+			PubKeySelfSignature: []byte("self signature"),
+			PubKeyBsrSignature:  []byte("bsr signature"),
+			WrappedBsrKey:       []byte("bsr key"),
+			WrappedPrivKey:      []byte("priv key"),
+		}
+		got, err := repo.CreateRecording(ctx, rec)
+		require.NoError(err)
+		assert.Equal(stored.PublicId, got.PublicId)
+		assert.Equal(stored.PubKey, got.PubKey)
+		assert.Equal(stored.WrappedBsrKey, got.WrappedBsrKey)
+		assert.Equal(stored.WrappedPrivKey, got.WrappedPrivKey)
+
+		// The compression and encryption default to those recordings were
+		// made with before they were configurable.
+		rec.SessionId = configured.PublicId
+		rec.Compression = "gzip"
+		got, err = repo.CreateRecording(ctx, rec)
+		require.NoError(err)
+		assert.Equal("gzip", got.Compression)
+		assert.Equal(DefaultRecordingEncryption, got.Encryption)
+		got, err = repo.LookupRecording(ctx, got.PublicId)
+		require.NoError(err)
+		assert.Equal("gzip", got.Compression)
+		assert.Equal(DefaultRecordingEncryption, got.Encryption)
+
+		_, err = repo.CreateRecording(ctx, &Recording{SessionId: recorded.PublicId, StorageBucketId: sb.GetPublicId()})
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.CreateRecording(ctx, nil)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("lookup", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c := TestConnection(t, conn, recorded.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+		require.NoError(repo.AddRecordingConnection(ctx, recorded.PublicId, c.PublicId))
+		connRecId, err := ConnectionRecordingId(ctx, c.PublicId)
+		require.NoError(err)
+		_, err = rw.Exec(ctx, "insert into recording_channel (public_id, recording_connection_id) values (?, ?)",
+			[]any{"chr_1234567890", connRecId})
+		require.NoError(err)
+
+		for _, id := range []string{stored.PublicId, connRecId, "chr_1234567890"} {
+			got, err := repo.LookupRecording(ctx, id)
+			require.NoError(err, id)
+			require.NotNil(got, id)
+			assert.Equal(stored.PublicId, got.PublicId, id)
+			assert.Equal(recorded.PublicId, got.SessionId, id)
+			assert.Equal(sb.GetPublicId(), got.StorageBucketId, id)
+			assert.Equal(stored.PubKey, got.PubKey, id)
+			assert.Equal(DefaultRecordingCompression, got.Compression, id)
+			assert.Equal(DefaultRecordingEncryption, got.Encryption, id)
+		}
+		for _, id := range []string{"sr_1234567890", "cr_1234567890", "chr_0987654321"} {
+			got, err := repo.LookupRecording(ctx, id)
+			require.NoError(err, id)
+			assert.Nil(got, id)
+		}
+		_, err = repo.LookupRecording(ctx, recorded.PublicId)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("connection of a session which is not recorded", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c := TestConnection(t, conn, notRecorded.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+		require.NoError(repo.AddRecordingConnection(ctx, notRecorded.PublicId, c.PublicId))
+		connRecId, err := ConnectionRecordingId(ctx, c.PublicId)
+		require.NoError(err)
+		got, err := repo.LookupRecording(ctx, connRecId)
+		require.NoError(err)
+		assert.Nil(got)
+	})
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ListRecordings(ctx, []string{sb.GetPublicId()})
+		require.NoError(err)
+		require.Len(got, 2)
+		configuredId, err := RecordingId(ctx, configured.PublicId)
+		require.NoError(err)
+		assert.ElementsMatch([]string{stored.PublicId, configuredId}, []string{got[0].PublicId, got[1].PublicId})
+
+		got, err = repo.ListRecordings(ctx, []string{"sb_1234567890"})
+		require.NoError(err)
+		assert.Empty(got)
+		_, err = repo.ListRecordings(ctx, nil)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
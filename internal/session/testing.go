@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -23,6 +24,7 @@ import (
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -60,6 +62,39 @@ func TestConnectionState(t testing.TB, conn *db.DB, connectionId string, state C
 	return s
 }
 
+// TestRecording creates the recording of the session with the provided id to
+// the storage bucket in the repository.  Its bsr keys are wrapped by a test
+// bsr kms.
+func TestRecording(t testing.TB, conn *db.DB, sessionId, storageBucketId string) *Recording {
+	t.Helper()
+	ctx := context.Background()
+	require := require.New(t)
+	keys, err := bsrkms.CreateKeys(ctx, bsrkms.TestWrapper(t), sessionId)
+	require.NoError(err)
+	id, err := RecordingId(ctx, sessionId)
+	require.NoError(err)
+	rec := &Recording{
+		PublicId:        id,
+		SessionId:       sessionId,
+		StorageBucketId: storageBucketId,
+	}
+	for _, f := range []struct {
+		to *[]byte
+		m  proto.Message
+	}{
+		{&rec.PubKey, keys.PubKey},
+		{&rec.PubKeySelfSignature, keys.PubKeySelfSignature},
+		{&rec.PubKeyBsrSignature, keys.PubKeyBsrSignature},
+		{&rec.WrappedBsrKey, keys.WrappedBsrKey},
+		{&rec.WrappedPrivKey, keys.WrappedPrivKey},
+	} {
+		*f.to, err = proto.Marshal(f.m)
+		require.NoError(err)
+	}
+	require.NoError(db.New(conn).Create(ctx, rec))
+	return rec
+}
+
 // TestState creates a test state for the sessionId in the repository.
 func TestState(t testing.TB, conn *db.DB, sessionId string, state Status) *State {
 	t.Helper()
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"golang.org/x/crypto/hkdf"
+)
+
+// tlsCertificateLifetime is the longest a certificate issued by the TLS
+// certificate authority of a session is valid.  A certificate is issued for
+// each connection and only has to be valid for its TLS handshake.
+const tlsCertificateLifetime = 5 * time.Minute
+
+// tlsCaKeyInfo is the HKDF info the key of the TLS certificate authority of a
+// session is derived with from the session's private key.
+const tlsCaKeyInfo = "boundary session tls ca"
+
+// TlsCa returns the certificate authority of the certificates a worker
+// presents to the client when it terminates the TLS connections of the
+// session, and its private key.  The key is derived from the session's private
+// key, so the same certificate authority is returned for the session each
+// time.  It can only issue certificates for the host of the session's endpoint
+// and for the loopback names the client's local proxy is reached with, and it
+// expires with the session.
+func TlsCa(ctx context.Context, s *Session) (*x509.Certificate, ed25519.PrivateKey, error) {
+	const op = "session.TlsCa"
+	switch {
+	case s == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing session")
+	case s.PublicId == "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case len(s.CertificatePrivateKey) != ed25519.PrivateKeySize:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing session private key")
+	case s.CreateTime.GetTimestamp() == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing create time")
+	case s.ExpirationTime.GetTimestamp() == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing expiration time")
+	}
+	dnsNames, ips, err := tlsNames(ctx, s.Endpoint)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ed25519.PrivateKey(s.CertificatePrivateKey).Seed(), nil, []byte(tlsCaKeyInfo)), seed); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	key := ed25519.NewKeyFromSeed(seed)
+
+	// Everything in the certificate is derived from the session, so it is
+	// the same each time it is created.
+	serial := sha256.Sum256([]byte(s.PublicId))
+	template := &x509.Certificate{
+		SerialNumber:                new(big.Int).SetBytes(serial[:16]),
+		Subject:                     pkix.Name{CommonName: fmt.Sprintf("%s tls ca", s.PublicId)},
+		NotBefore:                   s.CreateTime.GetTimestamp().AsTime().Add(-1 * time.Minute),
+		NotAfter:                    s.ExpirationTime.GetTimestamp().AsTime(),
+		KeyUsage:                    x509.KeyUsageCertSign,
+		BasicConstraintsValid:       true,
+		IsCA:                        true,
+		MaxPathLenZero:              true,
+		PermittedDNSDomainsCritical: true,
+		PermittedDNSDomains:         dnsNames,
+	}
+	for _, ip := range ips {
+		mask := net.CIDRMask(128, 128)
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, mask = ip4, net.CIDRMask(32, 32)
+		}
+		template.PermittedIPRanges = append(template.PermittedIPRanges, &net.IPNet{IP: ip, Mask: mask})
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.GenCert))
+	}
+	ca, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.GenCert))
+	}
+	return ca, key, nil
+}
+
+// IssueTlsCertificate returns a certificate issued by the TLS certificate
+// authority of the session for the host of the session's endpoint and the
+// loopback names, along with its PKCS #8 encoded private key.  The certificate
+// is valid for at most tlsCertificateLifetime.
+func IssueTlsCertificate(ctx context.Context, s *Session) ([]byte, []byte, error) {
+	const op = "session.IssueTlsCertificate"
+	ca, caKey, err := TlsCa(ctx, s)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	dnsNames, ips, err := tlsNames(ctx, s.Endpoint)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	notAfter := time.Now().Add(tlsCertificateLifetime)
+	if ca.NotAfter.Before(notAfter) {
+		notAfter = ca.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: dnsNames[len(dnsNames)-1]},
+		DNSNames:     dnsNames,
+		IPAddresses:  ips,
+		NotBefore:    time.Now().Add(-1 * time.Minute),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.GenCert))
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return der, pkcs8, nil
+}
+
+// tlsNames returns the DNS names and IP addresses the TLS certificate
+// authority of a session with the provided endpoint can issue certificates
+// for: the loopback names followed by the endpoint's host.
+func tlsNames(ctx context.Context, endpoint string) ([]string, []net.IP, error) {
+	const op = "session.tlsNames"
+	dnsNames := []string{"localhost"}
+	ips := []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse session endpoint"))
+	}
+	host := u.Hostname()
+	switch ip := net.ParseIP(host); {
+	case host == "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "session endpoint is missing its host")
+	case ip != nil:
+		ips = append(ips, ip)
+	case host != "localhost":
+		dnsNames = append(dnsNames, host)
+	}
+	return dnsNames, ips, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTlsCa(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	now := time.Now()
+	s := &Session{
+		PublicId:              "s_1234567890",
+		Endpoint:              "tcp://db.example.com:5432",
+		CertificatePrivateKey: key,
+		CreateTime:            timestamp.New(now),
+		ExpirationTime:        timestamp.New(now.Add(time.Hour)),
+	}
+
+	t.Run("derived from the session", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ca, caKey, err := TlsCa(ctx, s)
+		require.NoError(err)
+		again, againKey, err := TlsCa(ctx, s)
+		require.NoError(err)
+		assert.Equal(ca.Raw, again.Raw)
+		assert.Equal(caKey, againKey)
+		assert.NotEqual(key, caKey)
+		assert.True(ca.IsCA)
+		assert.True(ca.MaxPathLenZero)
+		assert.True(ca.PermittedDNSDomainsCritical)
+		assert.Equal([]string{"localhost", "db.example.com"}, ca.PermittedDNSDomains)
+		assert.Len(ca.PermittedIPRanges, 2)
+		assert.True(ca.NotAfter.Equal(now.Add(time.Hour).Truncate(time.Second)))
+
+		other := *s
+		other.PublicId = "s_0987654321"
+		otherCa, _, err := TlsCa(ctx, &other)
+		require.NoError(err)
+		assert.NotEqual(ca.Raw, otherCa.Raw)
+	})
+
+	t.Run("issued certificate", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ca, _, err := TlsCa(ctx, s)
+		require.NoError(err)
+		roots := x509.NewCertPool()
+		roots.AddCert(ca)
+		der, pkcs8, err := IssueTlsCertificate(ctx, s)
+		require.NoError(err)
+		_, err = x509.ParsePKCS8PrivateKey(pkcs8)
+		require.NoError(err)
+		leaf, err := x509.ParseCertificate(der)
+		require.NoError(err)
+		assert.False(leaf.NotAfter.After(time.Now().Add(tlsCertificateLifetime)))
+		for _, name := range []string{"localhost", "127.0.0.1", "::1", "db.example.com"} {
+			_, err := leaf.Verify(x509.VerifyOptions{DNSName: name, Roots: roots})
+			assert.NoError(err, name)
+		}
+		_, err = leaf.Verify(x509.VerifyOptions{DNSName: "other.example.com", Roots: roots})
+		assert.Error(err)
+	})
+
+	t.Run("constrained to the session's hosts", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ca, caKey, err := TlsCa(ctx, s)
+		require.NoError(err)
+		roots := x509.NewCertPool()
+		roots.AddCert(ca)
+		for name, template := range map[string]*x509.Certificate{
+			"dns name":   {DNSNames: []string{"www.bank.example"}},
+			"ip address": {IPAddresses: []net.IP{net.IPv4(10, 0, 0, 1)}},
+		} {
+			template.SerialNumber = big.NewInt(1)
+			template.Subject = pkix.Name{CommonName: name}
+			template.NotBefore = time.Now().Add(-1 * time.Minute)
+			template.NotAfter = time.Now().Add(time.Minute)
+			template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+			pub, _, err := ed25519.GenerateKey(rand.Reader)
+			require.NoError(err)
+			der, err := x509.CreateCertificate(rand.Reader, template, ca, pub, caKey)
+			require.NoError(err)
+			leaf, err := x509.ParseCertificate(der)
+			require.NoError(err)
+			_, err = leaf.Verify(x509.VerifyOptions{Roots: roots})
+			assert.Error(err, name)
+		}
+	})
+
+	t.Run("ip endpoint", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ipSession := *s
+		ipSession.Endpoint = "tcp://10.0.0.1:5432"
+		ca, _, err := TlsCa(ctx, &ipSession)
+		require.NoError(err)
+		assert.Equal([]string{"localhost"}, ca.PermittedDNSDomains)
+		assert.Len(ca.PermittedIPRanges, 3)
+	})
+
+	t.Run("invalid session", func(t *testing.T) {
+		for name, invalid := range map[string]func(*Session){
+			"missing id":              func(s *Session) { s.PublicId = "" },
+			"missing private key":     func(s *Session) { s.CertificatePrivateKey = nil },
+			"missing create time":     func(s *Session) { s.CreateTime = nil },
+			"missing expiration time": func(s *Session) { s.ExpirationTime = nil },
+			"missing endpoint host":   func(s *Session) { s.Endpoint = "tcp://:5432" },
+		} {
+			t.Run(name, func(t *testing.T) {
+				invalidSession := *s
+				invalid(&invalidSession)
+				_, _, err := TlsCa(ctx, &invalidSession)
+				assert.Error(t, err)
+				_, _, err = IssueTlsCertificate(ctx, &invalidSession)
+				assert.Error(t, err)
+			})
+		}
+		_, _, err := TlsCa(ctx, nil)
+		assert.Error(t, err)
+	})
+}
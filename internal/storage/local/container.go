@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/storage"
+)
+
+// container is a storage.Container backed by a directory.
+type container struct {
+	path     string
+	readOnly bool
+
+	mu     sync.Mutex
+	closed bool
+}
+
+var _ storage.Container = (*container)(nil)
+
+// Close closes the container.  Files and sub containers that were already
+// opened are not affected.
+func (c *container) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+// Create creates a new file in the container that can be read and written.
+// If the file already exists it is truncated.
+func (c *container) Create(ctx context.Context, name string) (storage.File, error) {
+	const op = "local.(container).Create"
+	f, err := c.OpenFile(ctx, name, storage.WithCreateFile(), storage.WithFileAccessMode(storage.ReadWrite))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return f, nil
+}
+
+// OpenFile opens a file in the container.
+// Supports the following options:
+//   - WithFileAccessMode: Sets the access mode for the file. Files opened for
+//     writing without WithCreateFile are appended to.
+//   - WithCreateFile: Creates the file if it does not exist. If the file
+//     exists, it is truncated.
+//
+// WithCloseSyncMode is ignored since files are written directly to their final
+// location.  Files in a read-only container can only be opened for reading.
+func (c *container) OpenFile(_ context.Context, name string, options ...storage.Option) (storage.File, error) {
+	const op = "local.(container).OpenFile"
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, fmt.Errorf("%s: %w", op, ErrClosed)
+	}
+	path, err := joinPath(c.path, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	opts := storage.GetOpts(options...)
+	switch {
+	case c.readOnly && (opts.WithCreateFile || opts.WithFileAccessMode != storage.ReadOnly):
+		return nil, fmt.Errorf("%s: container is read-only: %w", op, ErrReadOnly)
+	case opts.WithCreateFile && opts.WithFileAccessMode == storage.ReadOnly:
+		return nil, fmt.Errorf("%s: cannot create file in read-only mode: %w", op, ErrReadOnly)
+	}
+
+	var flag int
+	switch opts.WithFileAccessMode {
+	case storage.WriteOnly:
+		flag = os.O_WRONLY
+	case storage.ReadWrite:
+		flag = os.O_RDWR
+	default:
+		flag = os.O_RDONLY
+	}
+	switch {
+	case opts.WithCreateFile:
+		flag |= os.O_CREATE | os.O_TRUNC
+	case opts.WithFileAccessMode != storage.ReadOnly:
+		flag |= os.O_APPEND
+	}
+
+	f, err := os.OpenFile(path, flag, defaultFilePerm)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%s: %w: %w", op, err, ErrDoesNotExist)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if fi.IsDir() {
+		_ = f.Close()
+		return nil, fmt.Errorf("%s: %q is a directory", op, name)
+	}
+	return &file{
+		f:          f,
+		accessMode: opts.WithFileAccessMode,
+	}, nil
+}
+
+// SubContainer creates or opens a container in this container.  The container
+// is created when the WithCreateFile option is provided, in which case it is an
+// error if it already exists.
+func (c *container) SubContainer(_ context.Context, name string, options ...storage.Option) (storage.Container, error) {
+	const op = "local.(container).SubContainer"
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, fmt.Errorf("%s: %w", op, ErrClosed)
+	}
+	path, err := joinPath(c.path, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	opts := storage.GetOpts(options...)
+	switch {
+	case c.readOnly && opts.WithCreateFile:
+		return nil, fmt.Errorf("%s: container is read-only: %w", op, ErrReadOnly)
+	case opts.WithCreateFile && opts.WithFileAccessMode == storage.ReadOnly:
+		return nil, fmt.Errorf("%s: cannot create container in read-only mode: %w", op, ErrReadOnly)
+	case opts.WithCreateFile:
+		if err := os.Mkdir(path, defaultContainerPerm); err != nil {
+			if errors.Is(err, os.ErrExist) {
+				return nil, fmt.Errorf("%s: %w: %w", op, err, ErrAlreadyExists)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	default:
+		if err := isDir(path); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return &container{
+		path:     path,
+		readOnly: c.readOnly,
+	}, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/hashicorp/boundary/internal/storage"
+)
+
+// file is a storage.File backed by an os.File.
+type file struct {
+	f          *os.File
+	accessMode storage.AccessMode
+}
+
+var _ storage.File = (*file)(nil)
+
+// Stat returns the FileInfo describing the file.
+func (f *file) Stat() (fs.FileInfo, error) {
+	return f.f.Stat()
+}
+
+// Read reads from the file.  It is an error to read from a write-only file.
+func (f *file) Read(b []byte) (int, error) {
+	const op = "local.(file).Read"
+	if f.accessMode == storage.WriteOnly {
+		return 0, fmt.Errorf("%s: file is write-only", op)
+	}
+	n, err := f.f.Read(b)
+	if err != nil && err != io.EOF {
+		return n, fmt.Errorf("%s: %w", op, err)
+	}
+	return n, err
+}
+
+// Write writes to the file.  It is an error to write to a read-only file.
+func (f *file) Write(b []byte) (int, error) {
+	const op = "local.(file).Write"
+	if f.accessMode == storage.ReadOnly {
+		return 0, fmt.Errorf("%s: %w", op, ErrReadOnly)
+	}
+	n, err := f.f.Write(b)
+	if err != nil {
+		return n, fmt.Errorf("%s: %w", op, err)
+	}
+	return n, nil
+}
+
+// WriteString writes a string to the file.
+func (f *file) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
+
+// Close closes the file.  Closing a file more than once is not an error.
+func (f *file) Close() error {
+	const op = "local.(file).Close"
+	if err := f.f.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
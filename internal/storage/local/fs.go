@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package local provides a storage.FS that stores containers and files in a
+// directory on the local filesystem, and a storage.RecordingStorage which maps
+// storage buckets onto directories on the local filesystem.
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/storage"
+)
+
+// Common errors
+var (
+	ErrClosed        = errors.New("closed")
+	ErrAlreadyExists = errors.New("already exists")
+	ErrDoesNotExist  = errors.New("does not exist")
+	ErrReadOnly      = errors.New("read-only")
+)
+
+const (
+	defaultContainerPerm = 0o750
+	defaultFilePerm      = 0o640
+)
+
+// FS is a storage.FS that creates containers as directories within a root
+// directory on the local filesystem.
+type FS struct {
+	path     string
+	readOnly bool
+}
+
+var _ storage.FS = (*FS)(nil)
+
+// NewFS creates an FS rooted at the provided path, which must be an existing
+// directory.  Supported options: WithReadOnly
+func NewFS(_ context.Context, path string, opt ...Option) (*FS, error) {
+	const op = "local.NewFS"
+	if path == "" {
+		return nil, fmt.Errorf("%s: missing path", op)
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %w", op, err, ErrDoesNotExist)
+	}
+	if !stat.IsDir() {
+		return nil, fmt.Errorf("%s: %q is not a directory: %w", op, path, ErrDoesNotExist)
+	}
+	opts := getOpts(opt...)
+	return &FS{
+		path:     path,
+		readOnly: opts.withReadOnly,
+	}, nil
+}
+
+// Path returns the directory the FS is rooted at.
+func (fs *FS) Path() string {
+	return fs.path
+}
+
+// New creates the named root container within the FS.  It is an error if the
+// container already exists or the FS is read-only.
+func (fs *FS) New(_ context.Context, name string) (storage.Container, error) {
+	const op = "local.(FS).New"
+	if fs.readOnly {
+		return nil, fmt.Errorf("%s: %w", op, ErrReadOnly)
+	}
+	path, err := joinPath(fs.path, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := os.Mkdir(path, defaultContainerPerm); err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("%s: %w: %w", op, err, ErrAlreadyExists)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &container{
+		path: path,
+	}, nil
+}
+
+// Open opens an existing root container.
+func (fs *FS) Open(_ context.Context, name string) (storage.Container, error) {
+	const op = "local.(FS).Open"
+	path, err := joinPath(fs.path, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := isDir(path); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &container{
+		path:     path,
+		readOnly: fs.readOnly,
+	}, nil
+}
+
+// List returns the names of the root containers within the FS, sorted by
+// name.
+func (fs *FS) List(_ context.Context) ([]string, error) {
+	const op = "local.(FS).List"
+	entries, err := os.ReadDir(fs.path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			names = append(names, e.Name())
+		}
+	}
+	return names, nil
+}
+
+// joinPath joins a parent path with the name of a single child container or
+// file.  The name cannot contain any path separators or refer to the parent.
+func joinPath(parentPath, name string) (string, error) {
+	switch {
+	case name == "":
+		return "", fmt.Errorf("missing name")
+	case strings.ContainsAny(name, `/\`):
+		return "", fmt.Errorf("name %q contains a path separator", name)
+	case name == "." || name == "..":
+		return "", fmt.Errorf("name %q must be within the parent container", name)
+	}
+	return filepath.Join(parentPath, name), nil
+}
+
+func isDir(path string) error {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("%w: %w", err, ErrDoesNotExist)
+	}
+	if !stat.IsDir() {
+		return fmt.Errorf("%q is not a directory: %w", path, ErrDoesNotExist)
+	}
+	return nil
+}
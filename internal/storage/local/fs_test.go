@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFS(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	notDir := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(notDir, nil, 0o600))
+
+	cases := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{"valid", dir, false},
+		{"empty", "", true},
+		{"missing", filepath.Join(dir, "missing"), true},
+		{"not a directory", notDir, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs, err := NewFS(ctx, tc.path)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, fs)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.path, fs.Path())
+		})
+	}
+}
+
+func TestFS_NewOpen(t *testing.T) {
+	ctx := context.Background()
+	fs, err := NewFS(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	_, err = fs.Open(ctx, "c")
+	assert.ErrorIs(t, err, ErrDoesNotExist)
+	names, err := fs.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	c, err := fs.New(ctx, "c")
+	require.NoError(t, err)
+	require.NoError(t, c.Close())
+	assert.DirExists(t, filepath.Join(fs.Path(), "c"))
+
+	_, err = fs.New(ctx, "c")
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+
+	c, err = fs.Open(ctx, "c")
+	require.NoError(t, err)
+	require.NoError(t, c.Close())
+
+	// Files in the root directory are not containers.
+	require.NoError(t, os.WriteFile(filepath.Join(fs.Path(), "file"), nil, 0o600))
+	_, err = fs.New(ctx, "b")
+	require.NoError(t, err)
+	names, err = fs.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "c"}, names)
+
+	for _, name := range []string{"", ".", "..", "a/b", `a\b`} {
+		_, err = fs.New(ctx, name)
+		assert.Error(t, err, "name %q", name)
+		_, err = fs.Open(ctx, name)
+		assert.Error(t, err, "name %q", name)
+	}
+}
+
+func TestContainer(t *testing.T) {
+	ctx := context.Background()
+	fs, err := NewFS(ctx, t.TempDir())
+	require.NoError(t, err)
+	c, err := fs.New(ctx, "c")
+	require.NoError(t, err)
+
+	t.Run("files", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		f, err := c.Create(ctx, "f")
+		require.NoError(err)
+		_, err = f.WriteString("hello")
+		require.NoError(err)
+		require.NoError(f.Close())
+		require.NoError(f.Close())
+
+		f, err = c.OpenFile(ctx, "f", storage.WithFileAccessMode(storage.WriteOnly))
+		require.NoError(err)
+		_, err = f.Write([]byte(" world"))
+		require.NoError(err)
+		_, err = f.Read(make([]byte, 1))
+		assert.Error(err)
+		require.NoError(f.Close())
+
+		f, err = c.OpenFile(ctx, "f")
+		require.NoError(err)
+		got, err := io.ReadAll(f)
+		require.NoError(err)
+		assert.Equal("hello world", string(got))
+		_, err = f.Write([]byte("x"))
+		assert.ErrorIs(err, ErrReadOnly)
+		fi, err := f.Stat()
+		require.NoError(err)
+		assert.Equal(int64(len("hello world")), fi.Size())
+		require.NoError(f.Close())
+
+		_, err = c.OpenFile(ctx, "f", storage.WithCreateFile())
+		assert.ErrorIs(err, ErrReadOnly)
+		_, err = c.OpenFile(ctx, "missing")
+		assert.ErrorIs(err, ErrDoesNotExist)
+	})
+
+	t.Run("sub containers", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := c.SubContainer(ctx, "sub")
+		assert.ErrorIs(err, ErrDoesNotExist)
+		_, err = c.SubContainer(ctx, "sub", storage.WithCreateFile())
+		assert.ErrorIs(err, ErrReadOnly)
+
+		sub, err := c.SubContainer(ctx, "sub", storage.WithCreateFile(), storage.WithFileAccessMode(storage.WriteOnly))
+		require.NoError(err)
+		f, err := sub.Create(ctx, "f")
+		require.NoError(err)
+		require.NoError(f.Close())
+		assert.FileExists(filepath.Join(fs.Path(), "c", "sub", "f"))
+
+		_, err = c.SubContainer(ctx, "sub", storage.WithCreateFile(), storage.WithFileAccessMode(storage.WriteOnly))
+		assert.ErrorIs(err, ErrAlreadyExists)
+		_, err = c.SubContainer(ctx, "sub")
+		assert.NoError(err)
+
+		_, err = c.OpenFile(ctx, "sub")
+		assert.Error(err)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		require.NoError(t, c.Close())
+		_, err := c.Create(ctx, "g")
+		assert.ErrorIs(t, err, ErrClosed)
+		_, err = c.SubContainer(ctx, "sub")
+		assert.ErrorIs(t, err, ErrClosed)
+	})
+}
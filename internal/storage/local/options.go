@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withReadOnly bool
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithReadOnly provides an option to make an FS read-only.  Containers and
+// files can be opened for reading but not created or written.
+func WithReadOnly(readOnly bool) Option {
+	return func(o *options) {
+		o.withReadOnly = readOnly
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+)
+
+const (
+	// bucketsDir is the directory within the recording storage path that
+	// contains the storage bucket directories.
+	bucketsDir = "buckets"
+
+	// tempDir is the directory within the recording storage path that
+	// contains temporary files.
+	tempDir = "tmp"
+)
+
+// RecordingStorage is a storage.RecordingStorage that stores the contents of
+// storage buckets in a directory on the local filesystem, which can be a
+// mounted network filesystem.  A storage bucket is mapped to the directory
+// <path>/buckets/<bucket name>/<bucket prefix>.  Since files are written
+// directly to the bucket's directory, no syncing to an external object store
+// is done.
+type RecordingStorage struct {
+	bucketsPath string
+	tempPath    string
+	plgClients  map[string]plgpb.StoragePluginServiceClient
+}
+
+var _ storage.RecordingStorage = (*RecordingStorage)(nil)
+
+// NewRecordingStorage creates a RecordingStorage rooted at the provided path.
+// The path is created if it does not exist.  Any temporary files left from a
+// previous run are removed.  The plugin clients are returned by
+// PluginClients.
+func NewRecordingStorage(ctx context.Context, p string, plgClients map[string]plgpb.StoragePluginServiceClient) (*RecordingStorage, error) {
+	const op = "local.NewRecordingStorage"
+	if p == "" {
+		return nil, fmt.Errorf("%s: missing path", op)
+	}
+	p, err := filepath.Abs(p)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	rs := &RecordingStorage{
+		bucketsPath: filepath.Join(p, bucketsDir),
+		tempPath:    filepath.Join(p, tempDir),
+		plgClients:  plgClients,
+	}
+	if err := os.RemoveAll(rs.tempPath); err != nil {
+		return nil, fmt.Errorf("%s: unable to remove temporary files: %w", op, err)
+	}
+	for _, dir := range []string{rs.bucketsPath, rs.tempPath} {
+		if err := os.MkdirAll(dir, defaultContainerPerm); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return rs, nil
+}
+
+// NewSyncingFS returns an FS for the storage bucket's directory, creating the
+// directory if needed.  Files are written directly to the directory, so the
+// storage options are ignored.
+func (rs *RecordingStorage) NewSyncingFS(ctx context.Context, bucket *storagebuckets.StorageBucket, _ ...storage.Option) (storage.FS, error) {
+	const op = "local.(RecordingStorage).NewSyncingFS"
+	p, err := rs.bucketPath(bucket)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := os.MkdirAll(p, defaultContainerPerm); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	fs, err := NewFS(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return fs, nil
+}
+
+// NewRemoteFS returns a read-only FS for the storage bucket's directory,
+// which must exist.
+func (rs *RecordingStorage) NewRemoteFS(ctx context.Context, bucket *storagebuckets.StorageBucket, _ ...storage.Option) (storage.FS, error) {
+	const op = "local.(RecordingStorage).NewRemoteFS"
+	p, err := rs.bucketPath(bucket)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	fs, err := NewFS(ctx, p, WithReadOnly(true))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return fs, nil
+}
+
+// PluginClients returns the plugin clients the RecordingStorage was created
+// with.
+func (rs *RecordingStorage) PluginClients() map[string]plgpb.StoragePluginServiceClient {
+	return rs.plgClients
+}
+
+// CreateTemp creates a temporary file using the pattern p, as described by
+// os.CreateTemp.  The file is removed when closed.
+func (rs *RecordingStorage) CreateTemp(_ context.Context, p string) (storage.TempFile, error) {
+	const op = "local.(RecordingStorage).CreateTemp"
+	if strings.ContainsAny(p, `/\`) {
+		return nil, fmt.Errorf("%s: pattern %q contains a path separator", op, p)
+	}
+	f, err := os.CreateTemp(rs.tempPath, p)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &tempFile{File: f}, nil
+}
+
+// bucketPath returns the directory for the storage bucket.
+func (rs *RecordingStorage) bucketPath(bucket *storagebuckets.StorageBucket) (string, error) {
+	switch {
+	case bucket == nil:
+		return "", fmt.Errorf("missing storage bucket")
+	case bucket.GetBucketName() == "":
+		return "", fmt.Errorf("missing storage bucket name")
+	}
+	p, err := joinPath(rs.bucketsPath, bucket.GetBucketName())
+	if err != nil {
+		return "", fmt.Errorf("invalid storage bucket name: %w", err)
+	}
+	if prefix := bucket.GetBucketPrefix(); prefix != "" {
+		// Bucket prefixes use forward slashes to separate their elements,
+		// like the keys of an object store.
+		if path.IsAbs(prefix) || strings.Contains(prefix, `\`) {
+			return "", fmt.Errorf("invalid storage bucket prefix %q", prefix)
+		}
+		for _, elem := range strings.Split(path.Clean(prefix), "/") {
+			if p, err = joinPath(p, elem); err != nil {
+				return "", fmt.Errorf("invalid storage bucket prefix: %w", err)
+			}
+		}
+	}
+	return p, nil
+}
+
+// tempFile is a storage.TempFile which is removed when it is closed.
+type tempFile struct {
+	*os.File
+}
+
+var _ storage.TempFile = (*tempFile)(nil)
+
+// Close closes and removes the file.
+func (f *tempFile) Close() error {
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+	return os.Remove(f.File.Name())
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecordingStorage(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewRecordingStorage(ctx, "", nil)
+	assert.Error(t, err)
+
+	dir := filepath.Join(t.TempDir(), "recordings")
+	plgClients := map[string]plgpb.StoragePluginServiceClient{"test": nil}
+	rs, err := NewRecordingStorage(ctx, dir, plgClients)
+	require.NoError(t, err)
+	assert.DirExists(t, filepath.Join(dir, bucketsDir))
+	assert.DirExists(t, filepath.Join(dir, tempDir))
+	assert.Equal(t, plgClients, rs.PluginClients())
+
+	// Temporary files are removed when the storage is created.
+	leftover := filepath.Join(dir, tempDir, "leftover")
+	require.NoError(t, os.WriteFile(leftover, []byte("data"), 0o600))
+	_, err = NewRecordingStorage(ctx, dir, nil)
+	require.NoError(t, err)
+	assert.NoFileExists(t, leftover)
+	assert.DirExists(t, filepath.Join(dir, tempDir))
+}
+
+func TestRecordingStorage_FS(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	rs, err := NewRecordingStorage(ctx, dir, nil)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		bucket   *storagebuckets.StorageBucket
+		wantPath string
+		wantErr  bool
+	}{
+		{
+			name:    "nil bucket",
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			bucket:  &storagebuckets.StorageBucket{BucketPrefix: "prefix"},
+			wantErr: true,
+		},
+		{
+			name:    "name with separator",
+			bucket:  &storagebuckets.StorageBucket{BucketName: "bucket/name"},
+			wantErr: true,
+		},
+		{
+			name:    "parent name",
+			bucket:  &storagebuckets.StorageBucket{BucketName: ".."},
+			wantErr: true,
+		},
+		{
+			name:    "absolute prefix",
+			bucket:  &storagebuckets.StorageBucket{BucketName: "bucket", BucketPrefix: "/prefix"},
+			wantErr: true,
+		},
+		{
+			name:    "prefix outside bucket",
+			bucket:  &storagebuckets.StorageBucket{BucketName: "bucket", BucketPrefix: "a/../../other"},
+			wantErr: true,
+		},
+		{
+			name:    "prefix with backslash",
+			bucket:  &storagebuckets.StorageBucket{BucketName: "bucket", BucketPrefix: `a\b`},
+			wantErr: true,
+		},
+		{
+			name:     "name",
+			bucket:   &storagebuckets.StorageBucket{BucketName: "bucket"},
+			wantPath: filepath.Join(dir, bucketsDir, "bucket"),
+		},
+		{
+			name:     "prefix",
+			bucket:   &storagebuckets.StorageBucket{BucketName: "bucket", BucketPrefix: "a/b/"},
+			wantPath: filepath.Join(dir, bucketsDir, "bucket", "a", "b"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			fs, err := rs.NewSyncingFS(ctx, tc.bucket)
+			if tc.wantErr {
+				assert.Error(err)
+				assert.Nil(fs)
+				_, err = rs.NewRemoteFS(ctx, tc.bucket)
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.wantPath, fs.(*FS).Path())
+			assert.DirExists(tc.wantPath)
+
+			c, err := fs.New(ctx, "recording")
+			require.NoError(err)
+			f, err := c.Create(ctx, "file")
+			require.NoError(err)
+			_, err = f.WriteString("recorded")
+			require.NoError(err)
+			require.NoError(f.Close())
+			require.NoError(c.Close())
+
+			remote, err := rs.NewRemoteFS(ctx, tc.bucket)
+			require.NoError(err)
+			_, err = remote.New(ctx, "other")
+			assert.ErrorIs(err, ErrReadOnly)
+			rc, err := remote.Open(ctx, "recording")
+			require.NoError(err)
+			_, err = rc.Create(ctx, "new")
+			assert.ErrorIs(err, ErrReadOnly)
+			_, err = rc.OpenFile(ctx, "file", storage.WithFileAccessMode(storage.WriteOnly))
+			assert.ErrorIs(err, ErrReadOnly)
+			_, err = rc.SubContainer(ctx, "sub", storage.WithCreateFile(), storage.WithFileAccessMode(storage.ReadWrite))
+			assert.ErrorIs(err, ErrReadOnly)
+			rf, err := rc.OpenFile(ctx, "file")
+			require.NoError(err)
+			got, err := io.ReadAll(rf)
+			require.NoError(err)
+			assert.Equal("recorded", string(got))
+			require.NoError(rf.Close())
+		})
+	}
+
+	_, err = rs.NewRemoteFS(ctx, &storagebuckets.StorageBucket{BucketName: "missing"})
+	assert.ErrorIs(t, err, ErrDoesNotExist)
+}
+
+func TestRecordingStorage_CreateTemp(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	rs, err := NewRecordingStorage(ctx, t.TempDir(), nil)
+	require.NoError(err)
+
+	_, err = rs.CreateTemp(ctx, "bad/pattern")
+	assert.Error(err)
+
+	f, err := rs.CreateTemp(ctx, "temp-*")
+	require.NoError(err)
+	_, err = f.WriteString("temporary")
+	require.NoError(err)
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(err)
+	got, err := io.ReadAll(f)
+	require.NoError(err)
+	assert.Equal("temporary", string(got))
+
+	fi, err := f.Stat()
+	require.NoError(err)
+	name := filepath.Join(rs.tempPath, fi.Name())
+	assert.FileExists(name)
+	require.NoError(f.Close())
+	assert.NoFileExists(name)
+}
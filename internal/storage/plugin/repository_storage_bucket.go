@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// LookupStorageBucket returns the StorageBucket for id. Returns nil, nil if no
+// StorageBucket is found for id. The storage bucket's secrets are not
+// returned. All options are ignored.
+func (r *Repository) LookupStorageBucket(ctx context.Context, id string, _ ...Option) (*StorageBucket, error) {
+	const op = "plugin.(Repository).LookupStorageBucket"
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	sb := allocStorageBucket()
+	sb.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, sb); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+	return sb, nil
+}
+
+// ListStorageBuckets returns a slice of StorageBuckets for the scope IDs. The
+// storage buckets' secrets are not returned. WithLimit is the only option
+// supported.
+func (r *Repository) ListStorageBuckets(ctx context.Context, scopeIds []string, opt ...Option) ([]*StorageBucket, error) {
+	const op = "plugin.(Repository).ListStorageBuckets"
+	if len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	}
+	opts := getOpts(opt...)
+	var buckets []*StorageBucket
+	if err := r.reader.SearchWhere(ctx, &buckets, "scope_id in (?)", []any{scopeIds}, db.WithLimit(opts.withLimit)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return buckets, nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_LookupStorageBucket(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	plg := plugin.TestPlugin(t, conn, "test", plugin.WithStorageFlag(true))
+	sb := TestStorageBucket(t, conn, org.GetPublicId(), plg.GetPublicId(), "bucket", WithName("name"), WithBucketPrefix("prefix"))
+
+	t.Run("found", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupStorageBucket(ctx, sb.GetPublicId())
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(sb.GetPublicId(), got.GetPublicId())
+		assert.Equal(org.GetPublicId(), got.GetScopeId())
+		assert.Equal("name", got.GetName())
+		assert.Equal("bucket", got.GetBucketName())
+		assert.Equal("prefix", got.GetBucketPrefix())
+		assert.Nil(got.Secrets)
+	})
+	t.Run("not-found", func(t *testing.T) {
+		got, err := repo.LookupStorageBucket(ctx, "sb_1234567890")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("missing-id", func(t *testing.T) {
+		_, err := repo.LookupStorageBucket(ctx, "")
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
+
+func TestRepository_ListStorageBuckets(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org1, _ := iam.TestScopes(t, iamRepo)
+	org2, _ := iam.TestScopes(t, iamRepo)
+	plg := plugin.TestPlugin(t, conn, "test", plugin.WithStorageFlag(true))
+	for _, name := range []string{"one", "two"} {
+		TestStorageBucket(t, conn, org1.GetPublicId(), plg.GetPublicId(), name)
+	}
+	TestStorageBucket(t, conn, org2.GetPublicId(), plg.GetPublicId(), "three")
+
+	cases := []struct {
+		name     string
+		scopeIds []string
+		opt      []Option
+		want     int
+		wantErr  bool
+	}{
+		{
+			name:    "no-scopes",
+			wantErr: true,
+		},
+		{
+			name:     "one-scope",
+			scopeIds: []string{org1.GetPublicId()},
+			want:     2,
+		},
+		{
+			name:     "all-scopes",
+			scopeIds: []string{org1.GetPublicId(), org2.GetPublicId()},
+			want:     3,
+		},
+		{
+			name:     "with-limit",
+			scopeIds: []string{org1.GetPublicId(), org2.GetPublicId()},
+			opt:      []Option{WithLimit(1)},
+			want:     1,
+		},
+		{
+			name:     "empty-scope",
+			scopeIds: []string{"o_1234567890"},
+			want:     0,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := repo.ListStorageBuckets(ctx, tc.scopeIds, tc.opt...)
+			if tc.wantErr {
+				assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, got, tc.want)
+		})
+	}
+}
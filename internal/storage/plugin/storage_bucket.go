@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // StorageBucket represents a bucket within an external object store. It contains secret
@@ -65,6 +66,38 @@ func (s *StorageBucket) SetTableName(n string) {
 	s.tableName = n
 }
 
+// ToProto returns the storage bucket as a storagebuckets.StorageBucket, which
+// is how storage buckets are provided to a storage.RecordingStorage and to
+// workers. The storage bucket's secrets are not included.
+func (s *StorageBucket) ToProto(ctx context.Context) (*storagebuckets.StorageBucket, error) {
+	const op = "plugin.(StorageBucket).ToProto"
+	out := &storagebuckets.StorageBucket{
+		Id:           s.GetPublicId(),
+		ScopeId:      s.GetScopeId(),
+		PluginId:     s.GetPluginId(),
+		BucketName:   s.GetBucketName(),
+		BucketPrefix: s.GetBucketPrefix(),
+		WorkerFilter: s.GetWorkerFilter(),
+		CreatedTime:  s.GetCreateTime().GetTimestamp(),
+		UpdatedTime:  s.GetUpdateTime().GetTimestamp(),
+		Version:      s.GetVersion(),
+		Type:         "plugin",
+	}
+	if s.GetName() != "" {
+		out.Name = wrapperspb.String(s.GetName())
+	}
+	if s.GetDescription() != "" {
+		out.Description = wrapperspb.String(s.GetDescription())
+	}
+	if len(s.GetAttributes()) > 0 {
+		out.Attributes = &structpb.Struct{}
+		if err := proto.Unmarshal(s.GetAttributes(), out.Attributes); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to unmarshal attributes"))
+		}
+	}
+	return out, nil
+}
+
 func (s *StorageBucket) oplog(op oplog.OpType) oplog.Metadata {
 	metadata := oplog.Metadata{
 		"resource-public-id": []string{s.PublicId},
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/storage/plugin/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestStorageBucket_ToProto(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	attrs, err := structpb.NewStruct(map[string]any{"region": "us-east-1"})
+	require.NoError(err)
+	attrBytes, err := proto.Marshal(attrs)
+	require.NoError(err)
+
+	sb := &StorageBucket{
+		StorageBucket: &store.StorageBucket{
+			PublicId:     "sb_1234567890",
+			ScopeId:      "o_1234567890",
+			PluginId:     "pl_1234567890",
+			Name:         "name",
+			BucketName:   "bucket",
+			BucketPrefix: "prefix",
+			WorkerFilter: `"test" in "/tags/type"`,
+			Attributes:   attrBytes,
+			Version:      2,
+		},
+		Secrets: &structpb.Struct{Fields: map[string]*structpb.Value{"secret": structpb.NewStringValue("value")}},
+	}
+	got, err := sb.ToProto(ctx)
+	require.NoError(err)
+	assert.Equal("sb_1234567890", got.GetId())
+	assert.Equal("o_1234567890", got.GetScopeId())
+	assert.Equal("pl_1234567890", got.GetPluginId())
+	assert.Equal("name", got.GetName().GetValue())
+	assert.Nil(got.GetDescription())
+	assert.Equal("bucket", got.GetBucketName())
+	assert.Equal("prefix", got.GetBucketPrefix())
+	assert.Equal(`"test" in "/tags/type"`, got.GetWorkerFilter())
+	assert.Equal(uint32(2), got.GetVersion())
+	assert.True(proto.Equal(attrs, got.GetAttributes()))
+	assert.Nil(got.GetSecrets())
+
+	sb.Attributes = []byte("not a struct")
+	_, err = sb.ToProto(ctx)
+	assert.Error(err)
+}
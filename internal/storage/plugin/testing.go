@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/storage/plugin/store"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStorageBucket creates a storage bucket without secrets in the scope
+// that can be used by tests in other packages. Supported options: WithName,
+// WithDescription, WithBucketPrefix and WithWorkerFilter.
+func TestStorageBucket(t testing.TB, conn *db.DB, scopeId, pluginId, bucketName string, opt ...Option) *StorageBucket {
+	t.Helper()
+	ctx := context.Background()
+	require := require.New(t)
+	opts := getOpts(opt...)
+	workerFilter := opts.withWorkerFilter
+	if workerFilter == "" {
+		workerFilter = `"test" in "/tags/type"`
+	}
+
+	sb := &StorageBucket{
+		StorageBucket: &store.StorageBucket{
+			ScopeId:      scopeId,
+			PluginId:     pluginId,
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+			BucketName:   bucketName,
+			BucketPrefix: opts.withBucketPrefix,
+			WorkerFilter: workerFilter,
+			Attributes:   []byte{},
+			SecretsHmac:  []byte("test"),
+		},
+	}
+	var err error
+	sb.PublicId, err = newStorageBucketId(ctx)
+	require.NoError(err)
+	require.NoError(db.New(conn).Create(ctx, sb))
+	return sb
+}
@@ -42,6 +42,8 @@ type Bucket interface {
 type FS interface {
 	New(ctx context.Context, name string) (Container, error)
 	Open(ctx context.Context, name string) (Container, error)
+	// List returns the names of the root containers in the FS.
+	List(ctx context.Context) ([]string, error)
 }
 
 // A Container is a filesystem abstraction that can create files or other containers.
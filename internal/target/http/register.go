@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package http
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+type targetHooks struct{}
+
+func init() {
+	target.Register(Subtype, targetHooks{}, TargetPrefix)
+}
+
+const (
+	// TargetPrefix is the prefix for public ids of an http.Target.
+	TargetPrefix = "thttp"
+
+	// DefaultPort is the port used by an http.Target when no default port is
+	// provided.
+	DefaultPort = 80
+)
+
+// Vet validates that the given target.Target is an http.Target and that it
+// has a Target store.
+func (h targetHooks) Vet(ctx context.Context, t target.Target) error {
+	const op = "http.vet"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not an http.Target")
+	}
+
+	if tt == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	}
+
+	if tt.Target == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+	if tt.GetDefaultPort() == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target default port")
+	}
+	if tt.GetDefaultPort() > math.MaxUint16 {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid default port number")
+	}
+	if tt.GetDefaultClientPort() > math.MaxUint16 {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid default client port number")
+	}
+	if err := vetTlsCaCertificates(ctx, tt); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// VetForUpdate validates that the given target.Target is an http.Target,
+// and that it has a Target store and that it isn't attempting to clear or
+// set to zero the default port.
+func (h targetHooks) VetForUpdate(ctx context.Context, t target.Target, paths []string) error {
+	const op = "http.vetForUpdate"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not an http.Target")
+	}
+
+	switch {
+	case tt == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	case tt.Target == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+
+	for _, f := range paths {
+		if strings.EqualFold("defaultport", f) {
+			if tt.GetDefaultPort() == 0 {
+				return errors.New(ctx, errors.InvalidParameter, op, "clearing or setting default port to zero")
+			}
+			if tt.GetDefaultPort() > math.MaxUint16 {
+				return errors.New(ctx, errors.InvalidParameter, op, "invalid default port number")
+			}
+		}
+		if strings.EqualFold("defaultclientport", f) {
+			if tt.GetDefaultClientPort() > math.MaxUint16 {
+				return errors.New(ctx, errors.InvalidParameter, op, "invalid default client port number")
+			}
+		}
+		if strings.EqualFold("tlscacertificates", f) {
+			if err := vetTlsCaCertificates(ctx, tt); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+	}
+
+	return nil
+}
+
+// vetTlsCaCertificates checks that the certificate authorities used to verify
+// the endpoint, if any are set, contain a PEM certificate.
+func vetTlsCaCertificates(ctx context.Context, tt *Target) error {
+	const op = "http.vetTlsCaCertificates"
+	if tt.GetTlsCaCertificates() != "" {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(tt.GetTlsCaCertificates())) {
+			return errors.New(ctx, errors.InvalidParameter, op, "tls ca certificates contain no valid PEM certificates")
+		}
+	}
+	return nil
+}
+
+// VetCredentialSources checks that all the provided credential sources have a
+// CredentialPurpose of InjectedApplicationPurpose. The credentials of an
+// http.Target are only ever added to requests by the worker,
+// so any other CredentialPurpose will result in an error.
+func (h targetHooks) VetCredentialSources(ctx context.Context, libs []*target.CredentialLibrary, creds []*target.StaticCredential) error {
+	const op = "http.VetCredentialSources"
+
+	for _, c := range libs {
+		if c.GetCredentialPurpose() != string(credential.InjectedApplicationPurpose) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("http.Target only supports credential purpose: %q", credential.InjectedApplicationPurpose))
+		}
+	}
+	for _, c := range creds {
+		if c.GetCredentialPurpose() != string(credential.InjectedApplicationPurpose) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("http.Target only supports credential purpose: %q", credential.InjectedApplicationPurpose))
+		}
+	}
+	return nil
+}
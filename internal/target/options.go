@@ -51,6 +51,17 @@ type options struct {
 	WithAddress                string
 	WithStorageBucketId        string
 	WithEnableSessionRecording bool
+	WithEnableTls              bool
+	WithBandwidthLimit         uint64
+	WithSessionByteQuota       uint64
+	WithTerminateTls           bool
+	WithTlsServerName          string
+	WithTlsCaCertificates      string
+	WithTlsMinVersion          string
+	WithIdleTimeoutSeconds     uint32
+	WithKnownHostKeys          string
+	WithRecordingCompression   string
+	WithRecordingEncryption    string
 	WithNetResolver            intglobals.NetIpResolver
 }
 
@@ -252,6 +263,96 @@ func WithEnableSessionRecording(enable bool) Option {
 	}
 }
 
+// WithEnableTls provides an option to enable TLS for the connection from the
+// worker to the target's endpoint
+func WithEnableTls(enable bool) Option {
+	return func(o *options) {
+		o.WithEnableTls = enable
+	}
+}
+
+// WithBandwidthLimit provides an option to limit the rate, in bytes per
+// second, of the data proxied by each connection to the target
+func WithBandwidthLimit(limit uint64) Option {
+	return func(o *options) {
+		o.WithBandwidthLimit = limit
+	}
+}
+
+// WithSessionByteQuota provides an option to limit the number of bytes proxied
+// by all the connections of a session to the target
+func WithSessionByteQuota(quota uint64) Option {
+	return func(o *options) {
+		o.WithSessionByteQuota = quota
+	}
+}
+
+// WithTerminateTls provides an option to have the worker terminate the TLS
+// connections of clients to the target and open new TLS connections to its
+// endpoints
+func WithTerminateTls(terminate bool) Option {
+	return func(o *options) {
+		o.WithTerminateTls = terminate
+	}
+}
+
+// WithTlsServerName provides an option to set the name used to verify the
+// certificate of the target's endpoints
+func WithTlsServerName(name string) Option {
+	return func(o *options) {
+		o.WithTlsServerName = name
+	}
+}
+
+// WithTlsCaCertificates provides an option to set the PEM bundle of the
+// certificate authorities used to verify the certificate of the target's
+// endpoints
+func WithTlsCaCertificates(certs string) Option {
+	return func(o *options) {
+		o.WithTlsCaCertificates = certs
+	}
+}
+
+// WithTlsMinVersion provides an option to set the minimum TLS version accepted
+// from the target's endpoints
+func WithTlsMinVersion(version string) Option {
+	return func(o *options) {
+		o.WithTlsMinVersion = version
+	}
+}
+
+// WithIdleTimeoutSeconds provides an option to set the number of seconds a
+// connection to the target can go without data proxied before it is closed
+func WithIdleTimeoutSeconds(seconds uint32) Option {
+	return func(o *options) {
+		o.WithIdleTimeoutSeconds = seconds
+	}
+}
+
+// WithKnownHostKeys provides an option to set the ssh public keys trusted as
+// the host key of the target's endpoints
+func WithKnownHostKeys(keys string) Option {
+	return func(o *options) {
+		o.WithKnownHostKeys = keys
+	}
+}
+
+// WithRecordingCompression provides an option to set the compression of the
+// target's session recordings
+func WithRecordingCompression(c string) Option {
+	return func(o *options) {
+		o.WithRecordingCompression = c
+	}
+}
+
+// WithRecordingEncryption provides an option to set the encryption of the
+// target's session recordings
+func WithRecordingEncryption(e string) Option {
+	return func(o *options) {
+		o.WithRecordingEncryption = e
+	}
+}
+
 // WithStorageBucketId provides an option to set a storage bucket on a target
 func WithStorageBucketId(id string) Option {
 	return func(o *options) {
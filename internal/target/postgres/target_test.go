@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTarget_Create(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+	type args struct {
+		projectId string
+		opt       []target.Option
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          target.Target
+		wantErr       bool
+		wantIsErr     errors.Code
+		create        bool
+		wantCreateErr bool
+	}{
+		{
+			name:      "empty-projectId",
+			args:      args{},
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid-proj-id",
+			args: args{
+				projectId: prj.PublicId,
+				opt:       []target.Option{target.WithName("valid-proj-id")},
+			},
+			want: func() target.Target {
+				t, _ := target.New(
+					ctx,
+					postgres.Subtype,
+					prj.PublicId,
+					target.WithName("valid-proj-id"),
+					target.WithDefaultPort(postgres.DefaultPort),
+					target.WithSessionMaxSeconds(uint32((8 * time.Hour).Seconds())),
+					target.WithSessionConnectionLimit(-1),
+				)
+				return t
+			}(),
+			create: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := target.New(ctx, postgres.Subtype, tt.args.projectId, tt.args.opt...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+			assert.Equal(uint32(postgres.DefaultPort), got.GetDefaultPort())
+			if tt.create {
+				id, err := db.NewPublicId(ctx, globals.PostgresTargetPrefix)
+				require.NoError(err)
+				got.SetPublicId(ctx, id)
+				err = db.New(conn).Create(ctx, got)
+				if tt.wantCreateErr {
+					assert.Error(err)
+					return
+				}
+
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestTarget_Delete(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	tar := postgres.TestTarget(ctx, t, conn, proj.PublicId, postgres.TestTargetName(t, proj.PublicId))
+	deleteTarget := postgres.NewTestTarget(ctx, "")
+	deleteTarget.SetPublicId(ctx, tar.GetPublicId())
+	deletedRows, err := rw.Delete(ctx, deleteTarget)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deletedRows)
+
+	foundTarget := postgres.NewTestTarget(ctx, "")
+	foundTarget.SetPublicId(ctx, tar.GetPublicId())
+	err = rw.LookupById(ctx, foundTarget)
+	require.Error(t, err)
+	assert.True(t, errors.IsNotFoundError(err))
+}
+
+func TestTarget_Vet(t *testing.T) {
+	t.Parallel()
+	target.TestVet(t, postgres.Vet, postgres.NewTestTarget, target.TestVetPortCases(postgres.DefaultPort))
+}
+
+func TestTarget_VetCredentialSources(t *testing.T) {
+	t.Parallel()
+	target.TestVetCredentialSources(t, postgres.VetCredentialSources, "tpostgres_1234567890", credential.InjectedApplicationPurpose)
+}
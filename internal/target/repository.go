@@ -503,6 +503,51 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		return nil, db.NoRowsAffected, err
 	}
 
+	// Fields of other target subtypes are invalid in the field mask.
+	updateFields := map[string]any{
+		"Name":                   target.GetName(),
+		"Description":            target.GetDescription(),
+		"DefaultPort":            target.GetDefaultPort(),
+		"DefaultClientPort":      target.GetDefaultClientPort(),
+		"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
+		"SessionConnectionLimit": target.GetSessionConnectionLimit(),
+		"WorkerFilter":           target.GetWorkerFilter(),
+		"EgressWorkerFilter":     target.GetEgressWorkerFilter(),
+		"IngressWorkerFilter":    target.GetIngressWorkerFilter(),
+		"Address":                target.GetAddress(),
+		"StorageBucketId":        target.GetStorageBucketId(),
+		"EnableSessionRecording": target.GetEnableSessionRecording(),
+	}
+	et, tlsEnabled := target.(TlsEnabledTarget)
+	if tlsEnabled {
+		updateFields["EnableTls"] = et.GetEnableTls()
+	}
+	lt, limited := target.(LimitedTarget)
+	if limited {
+		updateFields["BandwidthLimit"] = lt.GetBandwidthLimit()
+		updateFields["SessionByteQuota"] = lt.GetSessionByteQuota()
+		updateFields["IdleTimeoutSeconds"] = lt.GetIdleTimeoutSeconds()
+	}
+	tlst, tlsTerminating := target.(TlsTerminatingTarget)
+	if tlsTerminating {
+		updateFields["TerminateTls"] = tlst.GetTerminateTls()
+		updateFields["TlsMinVersion"] = tlst.GetTlsMinVersion()
+	}
+	ept, endpointTls := target.(EndpointTlsTarget)
+	if endpointTls {
+		updateFields["TlsServerName"] = ept.GetTlsServerName()
+		updateFields["TlsCaCertificates"] = ept.GetTlsCaCertificates()
+	}
+	kt, knownHostKeys := target.(KnownHostKeysTarget)
+	if knownHostKeys {
+		updateFields["KnownHostKeys"] = kt.GetKnownHostKeys()
+	}
+	rt, recording := target.(RecordingTarget)
+	if recording {
+		updateFields["RecordingCompression"] = rt.GetRecordingCompression()
+		updateFields["RecordingEncryption"] = rt.GetRecordingEncryption()
+	}
+
 	var addressEndpoint string
 	for _, f := range fieldMaskPaths {
 		switch {
@@ -520,6 +565,17 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 			addressEndpoint = target.GetAddress()
 		case strings.EqualFold("storagebucketid", f):
 		case strings.EqualFold("enablesessionrecording", f):
+		case tlsEnabled && strings.EqualFold("enabletls", f):
+		case limited && strings.EqualFold("bandwidthlimit", f):
+		case limited && strings.EqualFold("sessionbytequota", f):
+		case limited && strings.EqualFold("idletimeoutseconds", f):
+		case tlsTerminating && strings.EqualFold("terminatetls", f):
+		case tlsTerminating && strings.EqualFold("tlsminversion", f):
+		case endpointTls && strings.EqualFold("tlsservername", f):
+		case endpointTls && strings.EqualFold("tlscacertificates", f):
+		case knownHostKeys && strings.EqualFold("knownhostkeys", f):
+		case recording && strings.EqualFold("recordingcompression", f):
+		case recording && strings.EqualFold("recordingencryption", f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -527,22 +583,9 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
-		map[string]any{
-			"Name":                   target.GetName(),
-			"Description":            target.GetDescription(),
-			"DefaultPort":            target.GetDefaultPort(),
-			"DefaultClientPort":      target.GetDefaultClientPort(),
-			"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
-			"SessionConnectionLimit": target.GetSessionConnectionLimit(),
-			"WorkerFilter":           target.GetWorkerFilter(),
-			"EgressWorkerFilter":     target.GetEgressWorkerFilter(),
-			"IngressWorkerFilter":    target.GetIngressWorkerFilter(),
-			"Address":                target.GetAddress(),
-			"StorageBucketId":        target.GetStorageBucketId(),
-			"EnableSessionRecording": target.GetEnableSessionRecording(),
-		},
+		updateFields,
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording"},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording", "EnableTls", "TerminateTls"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
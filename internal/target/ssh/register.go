@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+	gssh "golang.org/x/crypto/ssh"
+)
+
+type targetHooks struct{}
+
+func init() {
+	target.Register(Subtype, targetHooks{}, TargetPrefix)
+}
+
+const (
+	// TargetPrefix is the prefix for public ids of a ssh.Target.
+	TargetPrefix = "tssh"
+
+	// DefaultPort is the port used by a ssh.Target when no default port is
+	// provided.
+	DefaultPort = 22
+)
+
+// Vet validates that the given target.Target is a ssh.Target and that it
+// has a Target store.
+func (h targetHooks) Vet(ctx context.Context, t target.Target) error {
+	const op = "ssh.vet"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not a ssh.Target")
+	}
+
+	if tt == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	}
+
+	if tt.Target == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+	if tt.GetDefaultPort() == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target default port")
+	}
+	if tt.GetDefaultPort() > math.MaxUint16 {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid default port number")
+	}
+	if tt.GetDefaultClientPort() > math.MaxUint16 {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid default client port number")
+	}
+	if tt.GetEnableSessionRecording() && tt.GetStorageBucketId() == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "session recording requires a storage bucket")
+	}
+	if err := vetKnownHostKeys(ctx, tt); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := vetRecording(ctx, tt); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// VetForUpdate validates that the given target.Target is a ssh.Target,
+// and that it has a Target store and that it isn't attempting to clear or
+// set to zero the default port.
+func (h targetHooks) VetForUpdate(ctx context.Context, t target.Target, paths []string) error {
+	const op = "ssh.vetForUpdate"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not a ssh.Target")
+	}
+
+	switch {
+	case tt == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	case tt.Target == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+
+	for _, f := range paths {
+		if strings.EqualFold("defaultport", f) {
+			if tt.GetDefaultPort() == 0 {
+				return errors.New(ctx, errors.InvalidParameter, op, "clearing or setting default port to zero")
+			}
+			if tt.GetDefaultPort() > math.MaxUint16 {
+				return errors.New(ctx, errors.InvalidParameter, op, "invalid default port number")
+			}
+		}
+		if strings.EqualFold("defaultclientport", f) {
+			if tt.GetDefaultClientPort() > math.MaxUint16 {
+				return errors.New(ctx, errors.InvalidParameter, op, "invalid default client port number")
+			}
+		}
+		if strings.EqualFold("knownhostkeys", f) {
+			if err := vetKnownHostKeys(ctx, tt); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+		if strings.EqualFold("recordingcompression", f) || strings.EqualFold("recordingencryption", f) {
+			if err := vetRecording(ctx, tt); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+	}
+
+	return nil
+}
+
+// vetKnownHostKeys checks that the known host keys of the target, if any are
+// set, are ssh public keys.
+func vetKnownHostKeys(ctx context.Context, tt *Target) error {
+	const op = "ssh.vetKnownHostKeys"
+	if tt.GetKnownHostKeys() != "" {
+		if _, err := ParseKnownHostKeys(tt.GetKnownHostKeys()); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, op, err.Error())
+		}
+	}
+	return nil
+}
+
+// vetRecording checks that the compression and encryption of the target's
+// session recordings, if they are set, are supported by bsr.
+func vetRecording(ctx context.Context, tt *Target) error {
+	const op = "ssh.vetRecording"
+	if c := tt.GetRecordingCompression(); c != "" {
+		if _, err := bsr.ParseCompression(c); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported recording compression %q", c))
+		}
+	}
+	if e := tt.GetRecordingEncryption(); e != "" {
+		if _, err := bsr.ParseEncryption(e); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported recording encryption %q", e))
+		}
+	}
+	return nil
+}
+
+// ParseKnownHostKeys parses the ssh public keys in keys, which contains one
+// key per line in authorized_keys format.  Empty lines and lines starting with
+// '#' are ignored.  An error is returned if a line is not a public key or if
+// keys contains no public keys.
+func ParseKnownHostKeys(keys string) ([]gssh.PublicKey, error) {
+	var ret []gssh.PublicKey
+	for i, line := range strings.Split(keys, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, _, _, _, err := gssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			return nil, fmt.Errorf("line %d of the known host keys is not an ssh public key: %w", i+1, err)
+		}
+		ret = append(ret, key)
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("known host keys contain no ssh public keys")
+	}
+	return ret, nil
+}
+
+// VetCredentialSources checks that all the provided credential sources have a
+// CredentialPurpose of InjectedApplicationPurpose. The credentials of a
+// ssh.Target are only ever used by the worker to authenticate to the endpoint,
+// so any other CredentialPurpose will result in an error.
+func (h targetHooks) VetCredentialSources(ctx context.Context, libs []*target.CredentialLibrary, creds []*target.StaticCredential) error {
+	const op = "ssh.VetCredentialSources"
+
+	for _, c := range libs {
+		if c.GetCredentialPurpose() != string(credential.InjectedApplicationPurpose) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh.Target only supports credential purpose: %q", credential.InjectedApplicationPurpose))
+		}
+	}
+	for _, c := range creds {
+		if c.GetCredentialPurpose() != string(credential.InjectedApplicationPurpose) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh.Target only supports credential purpose: %q", credential.InjectedApplicationPurpose))
+		}
+	}
+	return nil
+}
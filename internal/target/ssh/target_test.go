@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTarget_Create(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+	type args struct {
+		projectId string
+		opt       []target.Option
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          target.Target
+		wantErr       bool
+		wantIsErr     errors.Code
+		create        bool
+		wantCreateErr bool
+	}{
+		{
+			name:      "empty-projectId",
+			args:      args{},
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid-proj-id",
+			args: args{
+				projectId: prj.PublicId,
+				opt:       []target.Option{target.WithName("valid-proj-id")},
+			},
+			want: func() target.Target {
+				t, _ := target.New(
+					ctx,
+					ssh.Subtype,
+					prj.PublicId,
+					target.WithName("valid-proj-id"),
+					target.WithDefaultPort(ssh.DefaultPort),
+					target.WithSessionMaxSeconds(uint32((8 * time.Hour).Seconds())),
+					target.WithSessionConnectionLimit(-1),
+				)
+				return t
+			}(),
+			create: true,
+		},
+		{
+			name: "recording-without-storage-bucket",
+			args: args{
+				projectId: prj.PublicId,
+				opt: []target.Option{
+					target.WithName("recording-without-storage-bucket"),
+					target.WithEnableSessionRecording(true),
+				},
+			},
+			want: func() target.Target {
+				t, _ := target.New(
+					ctx,
+					ssh.Subtype,
+					prj.PublicId,
+					target.WithName("recording-without-storage-bucket"),
+					target.WithEnableSessionRecording(true),
+				)
+				return t
+			}(),
+			create:        true,
+			wantCreateErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := target.New(ctx, ssh.Subtype, tt.args.projectId, tt.args.opt...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+			assert.Equal(uint32(ssh.DefaultPort), got.GetDefaultPort())
+			if tt.create {
+				id, err := db.NewPublicId(ctx, globals.SshTargetPrefix)
+				require.NoError(err)
+				got.SetPublicId(ctx, id)
+				err = db.New(conn).Create(ctx, got)
+				if tt.wantCreateErr {
+					assert.Error(err)
+					return
+				}
+
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestTarget_Delete(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	tar := ssh.TestTarget(ctx, t, conn, proj.PublicId, ssh.TestTargetName(t, proj.PublicId))
+	deleteTarget := ssh.NewTestTarget(ctx, "")
+	deleteTarget.SetPublicId(ctx, tar.GetPublicId())
+	deletedRows, err := rw.Delete(ctx, deleteTarget)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deletedRows)
+
+	foundTarget := ssh.NewTestTarget(ctx, "")
+	foundTarget.SetPublicId(ctx, tar.GetPublicId())
+	err = rw.LookupById(ctx, foundTarget)
+	require.Error(t, err)
+	assert.True(t, errors.IsNotFoundError(err))
+}
+
+const testKnownHostKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGb9ECWmEzf6FQbrBZ9w7lshQhqowtrbLDFw4rXAxZuE endpoint"
+
+func TestTarget_Vet(t *testing.T) {
+	t.Parallel()
+	cases := append(target.TestVetPortCases(ssh.DefaultPort),
+		target.TestVetCase{
+			Name: "recording with storage bucket",
+			Opt:  []target.Option{target.WithEnableSessionRecording(true), target.WithStorageBucketId("sb_1234567890")},
+		},
+		target.TestVetCase{
+			Name:    "recording without storage bucket",
+			Opt:     []target.Option{target.WithEnableSessionRecording(true)},
+			WantErr: true,
+		},
+		target.TestVetCase{
+			Name: "known host keys",
+			Opt:  []target.Option{target.WithKnownHostKeys("# endpoint\n" + testKnownHostKey + "\n\n")},
+		},
+		target.TestVetCase{
+			Name:    "malformed known host keys",
+			Opt:     []target.Option{target.WithKnownHostKeys(testKnownHostKey + "\nnot a key")},
+			WantErr: true,
+		},
+		target.TestVetCase{
+			Name:    "known host keys without keys",
+			Opt:     []target.Option{target.WithKnownHostKeys("# endpoint\n")},
+			WantErr: true,
+		},
+		target.TestVetCase{
+			Name: "recording compression and encryption",
+			Opt:  []target.Option{target.WithRecordingCompression("gzip"), target.WithRecordingEncryption("none")},
+		},
+		target.TestVetCase{
+			Name:    "unsupported recording compression",
+			Opt:     []target.Option{target.WithRecordingCompression("lz4")},
+			WantErr: true,
+		},
+		target.TestVetCase{
+			Name:    "unsupported recording encryption",
+			Opt:     []target.Option{target.WithRecordingEncryption("aes-cbc")},
+			WantErr: true,
+		},
+	)
+	target.TestVet(t, ssh.Vet, ssh.NewTestTarget, cases)
+}
+
+func TestTarget_VetCredentialSources(t *testing.T) {
+	t.Parallel()
+	target.TestVetCredentialSources(t, ssh.VetCredentialSources, "tssh_1234567890", credential.InjectedApplicationPurpose)
+}
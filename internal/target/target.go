@@ -61,6 +61,59 @@ type Target interface {
 	Oplog(op oplog.OpType) oplog.Metadata
 }
 
+// TlsEnabledTarget is implemented by the target subtypes whose connections the
+// worker can make to the endpoint using TLS.
+type TlsEnabledTarget interface {
+	GetEnableTls() bool
+	SetEnableTls(bool)
+}
+
+// LimitedTarget is implemented by the target subtypes which can limit the data
+// proxied by their connections and close idle connections.
+type LimitedTarget interface {
+	GetBandwidthLimit() uint64
+	GetSessionByteQuota() uint64
+	GetIdleTimeoutSeconds() uint32
+	SetBandwidthLimit(uint64)
+	SetSessionByteQuota(uint64)
+	SetIdleTimeoutSeconds(uint32)
+}
+
+// TlsTerminatingTarget is implemented by the target subtypes on which the
+// worker can terminate the client's TLS connection and open a new one to the
+// endpoint.
+type TlsTerminatingTarget interface {
+	GetTerminateTls() bool
+	GetTlsMinVersion() string
+	SetTerminateTls(bool)
+	SetTlsMinVersion(string)
+}
+
+// EndpointTlsTarget is implemented by the target subtypes with settings the
+// worker verifies the certificate of the endpoint with.
+type EndpointTlsTarget interface {
+	GetTlsServerName() string
+	GetTlsCaCertificates() string
+	SetTlsServerName(string)
+	SetTlsCaCertificates(string)
+}
+
+// KnownHostKeysTarget is implemented by the target subtypes with ssh host keys
+// the worker verifies the endpoint's host key against.
+type KnownHostKeysTarget interface {
+	GetKnownHostKeys() string
+	SetKnownHostKeys(string)
+}
+
+// RecordingTarget is implemented by the target subtypes whose session
+// recordings can be made with a configurable compression and encryption.
+type RecordingTarget interface {
+	GetRecordingCompression() string
+	GetRecordingEncryption() string
+	SetRecordingCompression(string)
+	SetRecordingEncryption(string)
+}
+
 const (
 	targetsViewDefaultTable = "target_all_subtypes"
 )
@@ -180,5 +233,28 @@ func (t *targetView) targetSubtype(ctx context.Context, address string) (Target,
 	tt.SetCredentialSources(t.CredentialSources)
 	tt.SetEnableSessionRecording(t.EnableSessionRecording)
 	tt.SetStorageBucketId(t.StorageBucketId)
+	if et, ok := tt.(TlsEnabledTarget); ok {
+		et.SetEnableTls(t.EnableTls)
+	}
+	if lt, ok := tt.(LimitedTarget); ok {
+		lt.SetBandwidthLimit(t.BandwidthLimit)
+		lt.SetSessionByteQuota(t.SessionByteQuota)
+		lt.SetIdleTimeoutSeconds(t.IdleTimeoutSeconds)
+	}
+	if tlst, ok := tt.(TlsTerminatingTarget); ok {
+		tlst.SetTerminateTls(t.TerminateTls)
+		tlst.SetTlsMinVersion(t.TlsMinVersion)
+	}
+	if et, ok := tt.(EndpointTlsTarget); ok {
+		et.SetTlsServerName(t.TlsServerName)
+		et.SetTlsCaCertificates(t.TlsCaCertificates)
+	}
+	if kt, ok := tt.(KnownHostKeysTarget); ok {
+		kt.SetKnownHostKeys(t.KnownHostKeys)
+	}
+	if rt, ok := tt.(RecordingTarget); ok {
+		rt.SetRecordingCompression(t.RecordingCompression)
+		rt.SetRecordingEncryption(t.RecordingEncryption)
+	}
 	return tt, nil
 }
@@ -5,6 +5,8 @@ package tcp
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"math"
 	"strings"
@@ -25,6 +27,13 @@ const (
 	TargetPrefix = "ttcp"
 )
 
+// tlsVersions maps the values supported for the minimum TLS version of a
+// tcp.Target to their crypto/tls version.
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
 // Vet validates that the given target.Target is a tcp.Target and that it
 // has a Target store.
 func (h targetHooks) Vet(ctx context.Context, t target.Target) error {
@@ -51,6 +60,15 @@ func (h targetHooks) Vet(ctx context.Context, t target.Target) error {
 	if tt.GetDefaultClientPort() > math.MaxUint16 {
 		return errors.New(ctx, errors.InvalidParameter, op, "invalid default client port number")
 	}
+	if tt.GetBandwidthLimit() > math.MaxInt64 {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid bandwidth limit")
+	}
+	if tt.GetSessionByteQuota() > math.MaxInt64 {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid session byte quota")
+	}
+	if err := vetTls(ctx, tt); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
 	return nil
 }
 
@@ -86,25 +104,69 @@ func (h targetHooks) VetForUpdate(ctx context.Context, t target.Target, paths []
 				return errors.New(ctx, errors.InvalidParameter, op, "invalid default client port number")
 			}
 		}
+		if strings.EqualFold("bandwidthlimit", f) {
+			if tt.GetBandwidthLimit() > math.MaxInt64 {
+				return errors.New(ctx, errors.InvalidParameter, op, "invalid bandwidth limit")
+			}
+		}
+		if strings.EqualFold("sessionbytequota", f) {
+			if tt.GetSessionByteQuota() > math.MaxInt64 {
+				return errors.New(ctx, errors.InvalidParameter, op, "invalid session byte quota")
+			}
+		}
+		if strings.EqualFold("tlscacertificates", f) || strings.EqualFold("tlsminversion", f) {
+			if err := vetTls(ctx, tt); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
 	}
 
 	return nil
 }
 
-// VetCredentialSources checks that all the provided credential sources have a CredentialPurpose
-// of BrokeredPurpose. Any other CredentialPurpose will result in an error.
+// vetTls validates the TLS certificate authorities and minimum version of the
+// tcp.Target.  They are used by the worker when it terminates TLS.
+func vetTls(ctx context.Context, tt *Target) error {
+	const op = "tcp.vetTls"
+	if tt.GetTlsCaCertificates() != "" {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(tt.GetTlsCaCertificates())) {
+			return errors.New(ctx, errors.InvalidParameter, op, "tls ca certificates contain no valid PEM certificates")
+		}
+	}
+	if tt.GetTlsMinVersion() != "" {
+		if _, ok := tlsVersions[tt.GetTlsMinVersion()]; !ok {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported tls min version %q", tt.GetTlsMinVersion()))
+		}
+	}
+	return nil
+}
+
+// VetCredentialSources checks that all the provided credential sources have a
+// CredentialPurpose of BrokeredPurpose or InjectedApplicationPurpose.  Injected
+// application credentials are only used by the worker, as the client
+// certificate presented to the endpoint, when the tcp.Target terminates TLS.
+// Any other CredentialPurpose will result in an error.
 func (h targetHooks) VetCredentialSources(ctx context.Context, libs []*target.CredentialLibrary, creds []*target.StaticCredential) error {
 	const op = "tcp.VetCredentialSources"
 
 	for _, c := range libs {
-		if c.GetCredentialPurpose() != string(credential.BrokeredPurpose) {
-			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("tcp.Target only supports credential purpose: %q", credential.BrokeredPurpose))
+		if !supportedCredentialPurpose(c.GetCredentialPurpose()) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("tcp.Target only supports credential purposes: %q, %q", credential.BrokeredPurpose, credential.InjectedApplicationPurpose))
 		}
 	}
 	for _, c := range creds {
-		if c.GetCredentialPurpose() != string(credential.BrokeredPurpose) {
-			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("tcp.Target only supports credential purpose: %q", credential.BrokeredPurpose))
+		if !supportedCredentialPurpose(c.GetCredentialPurpose()) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("tcp.Target only supports credential purposes: %q, %q", credential.BrokeredPurpose, credential.InjectedApplicationPurpose))
 		}
 	}
 	return nil
 }
+
+func supportedCredentialPurpose(purpose string) bool {
+	switch credential.Purpose(purpose) {
+	case credential.BrokeredPurpose, credential.InjectedApplicationPurpose:
+		return true
+	default:
+		return false
+	}
+}
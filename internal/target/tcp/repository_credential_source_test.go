@@ -217,8 +217,15 @@ func TestRepository_AddTargetCredentialSources(t *testing.T) {
 				targetVersion: 1,
 				ids:           target.CredentialSources{InjectedApplicationCredentialIds: []string{lib1.PublicId}},
 			},
-			wantErr:     true,
-			wantErrCode: errors.InvalidParameter,
+			wantCredSources: map[string]target.CredentialSource{
+				lib1.PublicId + "_" + string(credential.InjectedApplicationPurpose): &target.TargetCredentialSource{
+					CredentialSource: &store.CredentialSource{
+						CredentialSourceId: lib1.PublicId,
+						CredentialPurpose:  string(credential.InjectedApplicationPurpose),
+					},
+				},
+			},
+			wantErr: false,
 		},
 		{
 			name: "injected-app-credential-purpose-static",
@@ -226,8 +233,15 @@ func TestRepository_AddTargetCredentialSources(t *testing.T) {
 				targetVersion: 1,
 				ids:           target.CredentialSources{InjectedApplicationCredentialIds: []string{cred1.PublicId}},
 			},
-			wantErr:     true,
-			wantErrCode: errors.InvalidParameter,
+			wantCredSources: map[string]target.CredentialSource{
+				cred1.PublicId + "_" + string(credential.InjectedApplicationPurpose): &target.TargetCredentialSource{
+					CredentialSource: &store.CredentialSource{
+						CredentialSourceId: cred1.PublicId,
+						CredentialPurpose:  string(credential.InjectedApplicationPurpose),
+					},
+				},
+			},
+			wantErr: false,
 		},
 		{
 			name: "bad-version",
@@ -207,6 +207,54 @@ func TestRepository_CreateTarget(t *testing.T) {
 			wantErr:     true,
 			wantIsError: errors.Exception,
 		},
+		{
+			name: "terminate-tls",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("terminate-tls"),
+						target.WithDefaultPort(uint32(443)),
+						target.WithTerminateTls(true),
+						target.WithTlsServerName("db.example.com"),
+						target.WithTlsMinVersion("1.3"))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid-tls-ca-certificates",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("invalid-tls-ca-certificates"),
+						target.WithDefaultPort(uint32(443)),
+						target.WithTerminateTls(true),
+						target.WithTlsCaCertificates("not a certificate"))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr:     true,
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-tls-min-version",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("invalid-tls-min-version"),
+						target.WithDefaultPort(uint32(443)),
+						target.WithTerminateTls(true),
+						target.WithTlsMinVersion("1.0"))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr:     true,
+			wantIsError: errors.InvalidParameter,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -482,6 +530,20 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 			wantIsError:     errors.InvalidFieldMask,
 			wantHostSources: true,
 		},
+		{
+			name: "fields-of-other-subtypes",
+			args: args{
+				name:           "valid" + id,
+				fieldMaskPaths: []string{"KnownHostKeys"},
+				ProjectId:      proj.PublicId,
+			},
+			newProjectId:    proj.PublicId,
+			wantErr:         true,
+			wantRowsUpdate:  0,
+			wantErrMsg:      "target.(Repository).UpdateTarget: invalid field mask: KnownHostKeys: parameter violation: error #103",
+			wantIsError:     errors.InvalidFieldMask,
+			wantHostSources: true,
+		},
 		{
 			name: "no-public-id",
 			args: args{
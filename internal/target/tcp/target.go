@@ -39,13 +39,18 @@ type Target struct {
 
 // Ensure Target implements interfaces
 var (
-	_ target.Target           = (*Target)(nil)
-	_ db.VetForWriter         = (*Target)(nil)
-	_ oplog.ReplayableMessage = (*Target)(nil)
+	_ target.Target               = (*Target)(nil)
+	_ target.LimitedTarget        = (*Target)(nil)
+	_ target.TlsTerminatingTarget = (*Target)(nil)
+	_ target.EndpointTlsTarget    = (*Target)(nil)
+	_ db.VetForWriter             = (*Target)(nil)
+	_ oplog.ReplayableMessage     = (*Target)(nil)
 )
 
-// NewTarget creates a new in memory tcp target.  WithName, WithDescription and
-// WithDefaultPort options are supported
+// NewTarget creates a new in memory tcp target.  WithName, WithDescription,
+// WithDefaultPort, WithBandwidthLimit, WithSessionByteQuota, WithTerminateTls,
+// WithTlsServerName, WithTlsCaCertificates, WithTlsMinVersion and
+// WithIdleTimeoutSeconds options are supported
 func (h targetHooks) NewTarget(ctx context.Context, projectId string, opt ...target.Option) (target.Target, error) {
 	const op = "tcp.NewTarget"
 	opts := target.GetOpts(opt...)
@@ -64,6 +69,13 @@ func (h targetHooks) NewTarget(ctx context.Context, projectId string, opt ...tar
 			WorkerFilter:           opts.WithWorkerFilter,
 			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
 			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			BandwidthLimit:         opts.WithBandwidthLimit,
+			SessionByteQuota:       opts.WithSessionByteQuota,
+			TerminateTls:           opts.WithTerminateTls,
+			TlsServerName:          opts.WithTlsServerName,
+			TlsCaCertificates:      opts.WithTlsCaCertificates,
+			TlsMinVersion:          opts.WithTlsMinVersion,
+			IdleTimeoutSeconds:     opts.WithIdleTimeoutSeconds,
 		},
 		Address: opts.WithAddress,
 	}
@@ -230,5 +242,33 @@ func (t *Target) SetCredentialSources(sources []target.CredentialSource) {
 	t.CredentialSources = sources
 }
 
+func (t *Target) SetBandwidthLimit(limit uint64) {
+	t.BandwidthLimit = limit
+}
+
+func (t *Target) SetSessionByteQuota(quota uint64) {
+	t.SessionByteQuota = quota
+}
+
+func (t *Target) SetTerminateTls(terminate bool) {
+	t.TerminateTls = terminate
+}
+
+func (t *Target) SetTlsServerName(name string) {
+	t.TlsServerName = name
+}
+
+func (t *Target) SetTlsCaCertificates(certs string) {
+	t.TlsCaCertificates = certs
+}
+
+func (t *Target) SetTlsMinVersion(version string) {
+	t.TlsMinVersion = version
+}
+
+func (t *Target) SetIdleTimeoutSeconds(seconds uint32) {
+	t.IdleTimeoutSeconds = seconds
+}
+
 func (t *Target) SetEnableSessionRecording(_ bool) {}
 func (t *Target) SetStorageBucketId(_ string)      {}
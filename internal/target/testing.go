@@ -5,11 +5,21 @@ package target
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/target/store"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -73,3 +83,96 @@ func TestTargetAddress(t testing.TB, conn *db.DB, targetId, address string) *Add
 	require.NoError(err)
 	return staticAddress
 }
+
+// TestCaCertificate returns a PEM encoded self-signed certificate authority for
+// the tls_ca_certificates of targets.
+func TestCaCertificate(t testing.TB) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// TestVetCase is a case for the Vet hook of a target subtype. WantErr is
+// whether Vet rejects the target created with Opt with an InvalidParameter
+// error.
+type TestVetCase struct {
+	Name    string
+	Opt     []Option
+	WantErr bool
+}
+
+// TestVetPortCases returns the default port and default client port cases
+// shared by the target subtypes. defaultPort is the port the subtype uses
+// when none is provided, or zero if the subtype requires a default port.
+func TestVetPortCases(defaultPort uint32) []TestVetCase {
+	noPort := TestVetCase{Name: "default port"}
+	if defaultPort == 0 {
+		noPort = TestVetCase{Name: "missing port", WantErr: true}
+	}
+	return []TestVetCase{
+		noPort,
+		{
+			Name: "valid port",
+			Opt:  []Option{WithDefaultPort(8080), WithDefaultClientPort(8080)},
+		},
+		{
+			Name:    "invalid port",
+			Opt:     []Option{WithDefaultPort(70000)},
+			WantErr: true,
+		},
+		{
+			Name:    "invalid client port",
+			Opt:     []Option{WithDefaultPort(8080), WithDefaultClientPort(70000)},
+			WantErr: true,
+		},
+	}
+}
+
+// TestVet runs the cases against vet, the Vet hook of a target subtype, using
+// newTarget to create the subtype's targets.
+func TestVet(t *testing.T, vet func(context.Context, Target) error, newTarget func(context.Context, string, ...Option) Target, cases []TestVetCase) {
+	t.Helper()
+	ctx := context.Background()
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := vet(ctx, newTarget(ctx, "p_1234567890", tc.Opt...))
+			if tc.WantErr {
+				assert.Error(t, err)
+				assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// TestVetCredentialSources checks that vet, the VetCredentialSources hook of a
+// target subtype, accepts credential libraries and static credentials with
+// the supported purpose and rejects those with any other purpose.
+func TestVetCredentialSources(t *testing.T, vet func(context.Context, []*CredentialLibrary, []*StaticCredential) error, targetId string, supported credential.Purpose) {
+	t.Helper()
+	ctx := context.Background()
+	for _, p := range credential.ValidPurposes {
+		t.Run(string(p), func(t *testing.T) {
+			libs := []*CredentialLibrary{TestNewCredentialLibrary(targetId, "clvlt_1234567890", p)}
+			creds := []*StaticCredential{TestNewStaticCredential(targetId, "credup_1234567890", p)}
+			if p == supported {
+				assert.NoError(t, vet(ctx, libs, creds))
+				return
+			}
+			assert.Error(t, vet(ctx, libs, nil))
+			assert.Error(t, vet(ctx, nil, creds))
+		})
+	}
+}
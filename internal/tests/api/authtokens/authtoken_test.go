@@ -146,6 +146,42 @@ func TestCrud(t *testing.T) {
 	require.NoError(err)
 }
 
+func TestRefresh(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	tc := controller.NewTestController(t, nil)
+	defer tc.Shutdown()
+
+	client := tc.Client()
+	methods := authmethods.NewClient(client)
+
+	result, err := methods.Authenticate(tc.Context(), tc.Server().DevPasswordAuthMethodId, "login", map[string]any{"login_name": "user", "password": "passpass"})
+	require.NoError(err)
+	origToken := new(authtokens.AuthToken)
+	require.NoError(json.Unmarshal(result.GetRawAttributes(), origToken))
+
+	client.SetToken(origToken.Token)
+	tokens := authtokens.NewClient(client)
+
+	refreshed, err := tokens.Refresh(tc.Context(), origToken.Id)
+	require.NoError(err)
+	assert.Equal(origToken.Id, refreshed.Item.Id)
+	assert.NotEmpty(refreshed.Item.Token)
+	assert.NotEqual(origToken.Token, refreshed.Item.Token)
+	assert.True(origToken.ExpirationTime.Equal(refreshed.Item.ExpirationTime))
+
+	// The previous token value can no longer be used.
+	_, err = tokens.Read(tc.Context(), origToken.Id)
+	require.Error(err)
+	apiErr := api.AsServerError(err)
+	require.NotNil(apiErr)
+	assert.EqualValues(http.StatusUnauthorized, apiErr.Response().StatusCode())
+
+	client.SetToken(refreshed.Item.Token)
+	at, err := tokens.Read(tc.Context(), origToken.Id)
+	require.NoError(err)
+	assert.Equal(origToken.Id, at.Item.Id)
+}
+
 func TestErrors(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 	tc := controller.NewTestController(t, nil)
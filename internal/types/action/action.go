@@ -68,6 +68,13 @@ const (
 	ListScopeKeyVersionDestructionJobs Type = 54
 	DestroyScopeKeyVersion             Type = 55
 	Download                           Type = 56
+	CreateScimToken                    Type = 57
+	DeleteScimToken                    Type = 58
+	EnrollTotp                         Type = 59
+	ConfirmTotp                        Type = 60
+	RemoveTotp                         Type = 61
+	Unlock                             Type = 62
+	RefreshSelf                        Type = 63
 
 	// When adding new actions, be sure to update:
 	//
@@ -132,6 +139,13 @@ var Map = map[string]Type{
 	ListScopeKeyVersionDestructionJobs.String(): ListScopeKeyVersionDestructionJobs,
 	DestroyScopeKeyVersion.String():             DestroyScopeKeyVersion,
 	Download.String():                           Download,
+	CreateScimToken.String():                    CreateScimToken,
+	DeleteScimToken.String():                    DeleteScimToken,
+	EnrollTotp.String():                         EnrollTotp,
+	ConfirmTotp.String():                        ConfirmTotp,
+	RemoveTotp.String():                         RemoveTotp,
+	Unlock.String():                             Unlock,
+	RefreshSelf.String():                        RefreshSelf,
 }
 
 var DeprecatedMap = map[string]Type{
@@ -202,6 +216,13 @@ func (a Type) String() string {
 		"list-key-version-destruction-jobs",
 		"destroy-key-version",
 		"download",
+		"create-scim-token",
+		"delete-scim-token",
+		"enroll-totp",
+		"confirm-totp",
+		"remove-totp",
+		"unlock",
+		"refresh:self",
 	}[a]
 }
 
@@ -138,6 +138,34 @@ func TestAction(t *testing.T) {
 			action: Download,
 			want:   "download",
 		},
+		{
+			action: CreateScimToken,
+			want:   "create-scim-token",
+		},
+		{
+			action: DeleteScimToken,
+			want:   "delete-scim-token",
+		},
+		{
+			action: EnrollTotp,
+			want:   "enroll-totp",
+		},
+		{
+			action: ConfirmTotp,
+			want:   "confirm-totp",
+		},
+		{
+			action: RemoveTotp,
+			want:   "remove-totp",
+		},
+		{
+			action: Unlock,
+			want:   "unlock",
+		},
+		{
+			action: RefreshSelf,
+			want:   "refresh:self",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
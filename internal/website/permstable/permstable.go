@@ -353,6 +353,38 @@ var account = &Resource{
 						"id=<pin>;type=<type>;actions=change-password",
 					},
 				},
+				&Action{
+					Name:        "enroll-totp",
+					Description: "Create a TOTP enrollment for a password account",
+					Examples: []string{
+						"id=<id>;actions=enroll-totp",
+						"id=<pin>;type=<type>;actions=enroll-totp",
+					},
+				},
+				&Action{
+					Name:        "confirm-totp",
+					Description: "Confirm the TOTP enrollment of a password account with a TOTP code",
+					Examples: []string{
+						"id=<id>;actions=confirm-totp",
+						"id=<pin>;type=<type>;actions=confirm-totp",
+					},
+				},
+				&Action{
+					Name:        "remove-totp",
+					Description: "Remove the TOTP enrollment of a password account",
+					Examples: []string{
+						"id=<id>;actions=remove-totp",
+						"id=<pin>;type=<type>;actions=remove-totp",
+					},
+				},
+				&Action{
+					Name:        "unlock",
+					Description: "Unlock a password account locked after failed authentication attempts",
+					Examples: []string{
+						"id=<id>;actions=unlock",
+						"id=<pin>;type=<type>;actions=unlock",
+					},
+				},
 			),
 		},
 	},
@@ -399,6 +431,13 @@ var authToken = &Resource{
 				"Type": "auth-token",
 			},
 			Actions: []*Action{
+				{
+					Name:        "create",
+					Description: "Create an API token for the requesting user",
+					Examples: []string{
+						"type=<type>;actions=create",
+					},
+				},
 				{
 					Name:        "list",
 					Description: "List auth tokens",
@@ -429,6 +468,13 @@ var authToken = &Resource{
 						"id=<id>;actions=delete",
 					},
 				},
+				{
+					Name:        "refresh:self",
+					Description: "Rotate the value of the auth token used to make the request",
+					Examples: []string{
+						"id=*;type=auth-token;actions=refresh:self",
+					},
+				},
 			},
 		},
 	},
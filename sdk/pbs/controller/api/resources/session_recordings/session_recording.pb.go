@@ -52,7 +52,7 @@ type ChannelRecording struct {
 	Duration *durationpb.Duration `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty" class:"public"` // @gotags: class:"public"
 	// MimeTypes define the mime types that can
 	// be used to consume the recording of this Channel.
-	// The only supported mime type is "application/x-asciicast".
+	// The supported mime types are "application/x-asciicast" and "text/plain".
 	MimeTypes []string `protobuf:"bytes,9,rep,name=mime_types,proto3" json:"mime_types,omitempty" class:"public"` // @gotags: class:"public"
 }
 
@@ -177,7 +177,7 @@ type ConnectionRecording struct {
 	Duration *durationpb.Duration `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty" class:"public"` // @gotags: class:"public"
 	// MimeTypes define the mime types that can
 	// be used to consume the recording of this Connection.
-	// No mime types are currently supported.
+	// The only supported mime type is "application/x-pcapng", for the Connections of TCP sessions.
 	MimeTypes []string `protobuf:"bytes,9,rep,name=mime_types,proto3" json:"mime_types,omitempty" class:"public"` // @gotags: class:"public"
 	// Optionally, the channels used in this Connection,
 	// if it is using a multiplexed protocol, such as SSH.
@@ -12,7 +12,12 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/http"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/kubernetes"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/postgres"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/ssh"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/tcp"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/udp"
 )
 
 type option struct {